@@ -25,6 +25,18 @@ const (
 	BearerAuthScopes = "BearerAuth.Scopes"
 )
 
+// Defines values for AccountCapabilities.
+const (
+	AccountCapabilitiesApiKey AccountCapabilities = "apiKey"
+	AccountCapabilitiesLogin  AccountCapabilities = "login"
+)
+
+// Defines values for AccountUpdateRequestCapabilities.
+const (
+	AccountUpdateRequestCapabilitiesApiKey AccountUpdateRequestCapabilities = "apiKey"
+	AccountUpdateRequestCapabilitiesLogin  AccountUpdateRequestCapabilities = "login"
+)
+
 // Defines values for BackupStorageType.
 const (
 	BackupStorageTypeAzure BackupStorageType = "azure"
@@ -104,6 +116,30 @@ const (
 	PodSchedulingPolicySpecEngineTypePxc        PodSchedulingPolicySpecEngineType = "pxc"
 )
 
+// Defines values for TemporaryGrantEventAction.
+const (
+	Expired TemporaryGrantEventAction = "expired"
+	Granted TemporaryGrantEventAction = "granted"
+)
+
+// Defines values for UpgradeImpactRestartReasons.
+const (
+	CrVersionUpdate UpgradeImpactRestartReasons = "crVersionUpdate"
+	EngineUpgrade   UpgradeImpactRestartReasons = "engineUpgrade"
+)
+
+// Defines values for UpgradePlanApprovalSchedule.
+const (
+	UpgradePlanApprovalScheduleImmediate         UpgradePlanApprovalSchedule = "immediate"
+	UpgradePlanApprovalScheduleMaintenanceWindow UpgradePlanApprovalSchedule = "maintenanceWindow"
+)
+
+// Defines values for UpgradePlanApprovalResultStatus.
+const (
+	Queued  UpgradePlanApprovalResultStatus = "queued"
+	Started UpgradePlanApprovalResultStatus = "started"
+)
+
 // Defines values for UpgradeTaskPendingTask.
 const (
 	NotReady      UpgradeTaskPendingTask = "notReady"
@@ -119,6 +155,81 @@ const (
 	Pxc        ListPodSchedulingPolicyParamsEngineType = "pxc"
 )
 
+// APIKey Long-lived token issued to a built-in user for programmatic access
+type APIKey struct {
+	// CreatedAt The time the API key was created at
+	CreatedAt time.Time `json:"createdAt"`
+
+	// ExpiresAt The time the API key expires at. Omitted if the key never expires.
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+
+	// LastUsedAt The approximate time the API key was last used at. Omitted if the key was never used.
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty"`
+
+	// Name Unique name of the API key within the account
+	Name string `json:"name"`
+}
+
+// APIKeyList defines model for APIKeyList.
+type APIKeyList struct {
+	Items []APIKey `json:"items"`
+}
+
+// APIKeyRequest Parameters of a new API key
+type APIKeyRequest struct {
+	// ExpiresAt The time the API key expires at. The key never expires if omitted.
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+
+	// Name Unique name of the API key within the account
+	Name string `json:"name"`
+}
+
+// Account Built-in user account
+type Account struct {
+	Capabilities []AccountCapabilities `json:"capabilities"`
+
+	// Enabled Disabled accounts cannot log in, and their tokens are rejected
+	Enabled bool `json:"enabled"`
+
+	// Locked True if the account is locked after too many failed logins
+	Locked bool `json:"locked"`
+
+	// MfaEnabled True if the account is enrolled in multi-factor authentication
+	MfaEnabled bool `json:"mfaEnabled"`
+
+	// PasswordUpdatedAt The time the password was last changed at
+	PasswordUpdatedAt *time.Time `json:"passwordUpdatedAt,omitempty"`
+	Username          string     `json:"username"`
+}
+
+// AccountCapabilities defines model for Account.Capabilities.
+type AccountCapabilities string
+
+// AccountCreateRequest defines model for AccountCreateRequest.
+type AccountCreateRequest struct {
+	Password string `json:"password"`
+	Username string `json:"username"`
+}
+
+// AccountList defines model for AccountList.
+type AccountList struct {
+	Items []Account `json:"items"`
+}
+
+// AccountPasswordRequest defines model for AccountPasswordRequest.
+type AccountPasswordRequest struct {
+	Password string `json:"password"`
+}
+
+// AccountUpdateRequest The changes of an account. The omitted properties are left as they are.
+type AccountUpdateRequest struct {
+	Capabilities *[]AccountUpdateRequestCapabilities `json:"capabilities,omitempty"`
+	Enabled      *bool                               `json:"enabled,omitempty"`
+}
+
+// AccountUpdateRequestCapabilities defines model for AccountUpdateRequest.Capabilities.
+type AccountUpdateRequestCapabilities string
+
 // BackupStorage Backup storage information
 type BackupStorage struct {
 	// AllowedNamespaces List of namespaces allowed to use this backup storage
@@ -166,6 +277,15 @@ type CreateBackupStorageParams struct {
 // CreateBackupStorageParamsType defines model for CreateBackupStorageParams.Type.
 type CreateBackupStorageParamsType string
 
+// CreatedAPIKey A newly created API key along with its token
+type CreatedAPIKey struct {
+	// ApiKey Long-lived token issued to a built-in user for programmatic access
+	ApiKey APIKey `json:"apiKey"`
+
+	// Token The token of the API key. It cannot be retrieved later.
+	Token string `json:"token"`
+}
+
 // DataImportJob DataImportJob is the schema for the dataimportjobs API.
 type DataImportJob struct {
 	// ApiVersion APIVersion defines the versioned schema of this representation of an object.
@@ -270,6 +390,29 @@ type DataImportJobList struct {
 	Metadata *map[string]interface{} `json:"metadata,omitempty"`
 }
 
+// DataImportJobProgress progress of a data import job
+type DataImportJobProgress struct {
+	BytesProcessed *int64     `json:"bytesProcessed,omitempty"`
+	BytesTotal     *int64     `json:"bytesTotal,omitempty"`
+	CompletedAt    *time.Time `json:"completedAt,omitempty"`
+
+	// Logs most recent log lines of the import pod
+	Logs    *[]string `json:"logs,omitempty"`
+	Message *string   `json:"message,omitempty"`
+
+	// PercentComplete estimated completion percentage, only set when the importer reports the totals
+	PercentComplete *float32 `json:"percentComplete,omitempty"`
+
+	// Phase current phase of the data import job
+	Phase *string `json:"phase,omitempty"`
+
+	// PodName name of the pod running the import
+	PodName         *string    `json:"podName,omitempty"`
+	StartedAt       *time.Time `json:"startedAt,omitempty"`
+	TablesProcessed *int64     `json:"tablesProcessed,omitempty"`
+	TablesTotal     *int64     `json:"tablesTotal,omitempty"`
+}
+
 // DataImporter DataImporter defines a reusable strategy for importing data into a DatabaseCluster.
 type DataImporter struct {
 	// ApiVersion APIVersion defines the versioned schema of this representation of an object.
@@ -1143,6 +1286,27 @@ type DatabaseEngineList struct {
 	Metadata *map[string]interface{} `json:"metadata,omitempty"`
 }
 
+// EngineVersionPattern Matches engine versions
+type EngineVersionPattern struct {
+	// EngineType The engine type (pxc, psmdb or postgresql) the pattern applies to. The pattern applies to all engines if it is not set
+	EngineType *string `json:"engineType,omitempty"`
+
+	// Version A shell pattern matching the versions, e.g. 8.0.*
+	Version string `json:"version"`
+}
+
+// EngineVersionPolicy Restricts the engine versions that may be used in a namespace
+type EngineVersionPolicy struct {
+	// Allowed The allowed versions. If there are no patterns for an engine, all its versions are allowed
+	Allowed *[]EngineVersionPattern `json:"allowed,omitempty"`
+
+	// Denied The denied versions. Takes precedence over the allowed versions
+	Denied *[]EngineVersionPattern `json:"denied,omitempty"`
+
+	// RecommendedOnly Allow only the versions that are recommended
+	RecommendedOnly *bool `json:"recommendedOnly,omitempty"`
+}
+
 // Error Error response
 type Error struct {
 	Message *string `json:"message,omitempty"`
@@ -1178,6 +1342,40 @@ type ResourcesCapacity struct {
 	MemoryBytes *uint64 `json:"memoryBytes,omitempty"`
 }
 
+// MaintenanceWindow A recurring period of time during which disruptive operations are allowed
+type MaintenanceWindow struct {
+	// Duration For how long the maintenance window stays open, e.g. `4h`
+	Duration string `json:"duration"`
+
+	// Name Unique name of the maintenance window
+	Name string `json:"name"`
+
+	// Schedule Standard cron expression that defines when the maintenance window opens, e.g. `0 2 * * 0`.
+	// The schedule is evaluated in UTC unless a `CRON_TZ=` prefix is specified.
+	Schedule string `json:"schedule"`
+}
+
+// MaintenanceWindows The maintenance windows of a namespace and their current state
+type MaintenanceWindows struct {
+	// NextWindowEnd The end of the currently open or the next maintenance window
+	NextWindowEnd *time.Time `json:"nextWindowEnd,omitempty"`
+
+	// NextWindowStart The start of the currently open or the next maintenance window
+	NextWindowStart *time.Time `json:"nextWindowStart,omitempty"`
+
+	// Open Whether disruptive operations are currently allowed in the namespace
+	Open bool `json:"open"`
+
+	// QueuedUpgrade An approved operator upgrade that waits for the next maintenance window
+	QueuedUpgrade *QueuedUpgrade      `json:"queuedUpgrade,omitempty"`
+	Windows       []MaintenanceWindow `json:"windows"`
+}
+
+// MaintenanceWindowsSpec The maintenance windows of a namespace
+type MaintenanceWindowsSpec struct {
+	Windows []MaintenanceWindow `json:"windows"`
+}
+
 // MonitoringInstance Monitoring instance information
 type MonitoringInstance = MonitoringInstanceBaseWithName
 
@@ -1269,16 +1467,93 @@ type MonitoringInstancesList = []MonitoringInstance
 // NamespaceList defines model for NamespaceList.
 type NamespaceList = []string
 
+// OIDCClaimMapping Claims of the OIDC tokens that describe the user
+type OIDCClaimMapping struct {
+	// GroupsClaims Claims that hold the groups, `groups` if empty
+	GroupsClaims *[]string `json:"groupsClaims,omitempty"`
+
+	// GroupsPrefix Prefix prepended to the names of the groups
+	GroupsPrefix *string `json:"groupsPrefix,omitempty"`
+
+	// UsernameClaim Claim that holds the username, `sub` if empty
+	UsernameClaim *string `json:"usernameClaim,omitempty"`
+
+	// UsernamePrefix Prefix prepended to the usernames, required if more than one OIDC provider is configured
+	UsernamePrefix *string `json:"usernamePrefix,omitempty"`
+}
+
 // OIDCConfig Everest OIDC provider configuration
 type OIDCConfig struct {
+	// Audiences Accepted audiences of the OIDC tokens. If empty, the tokens must be issued for the client ID, as their audience or their authorized party
+	Audiences *[]string `json:"audiences,omitempty"`
+
 	// ClientId OIDC application clientID
 	ClientId string `json:"clientId"`
 
+	// GroupsClaims Claims of the OIDC tokens that hold the groups
+	GroupsClaims *[]string `json:"groupsClaims,omitempty"`
+
+	// GroupsPrefix Prefix prepended to the names of the groups
+	GroupsPrefix *string `json:"groupsPrefix,omitempty"`
+
 	// IssuerURL OIDC provider url
 	IssuerURL string `json:"issuerURL"`
 
+	// Name Name of the OIDC provider
+	Name *string `json:"name,omitempty"`
+
 	// Scopes OIDC scopes
 	Scopes []string `json:"scopes"`
+
+	// UsernameClaim Claim of the OIDC tokens that holds the username
+	UsernameClaim *string `json:"usernameClaim,omitempty"`
+
+	// UsernamePrefix Prefix prepended to the usernames, required if more than one OIDC provider is configured
+	UsernamePrefix *string `json:"usernamePrefix,omitempty"`
+}
+
+// ObjectPermissions The actions allowed on the objects matching a pattern
+type ObjectPermissions struct {
+	Actions []string `json:"actions"`
+
+	// Object The object pattern of the RBAC policy
+	Object string `json:"object"`
+}
+
+// PasswordChangeRequest defines model for PasswordChangeRequest.
+type PasswordChangeRequest struct {
+	CurrentPassword string `json:"currentPassword"`
+	NewPassword     string `json:"newPassword"`
+}
+
+// PermissionExplanation defines model for PermissionExplanation.
+type PermissionExplanation struct {
+	Allowed bool `json:"allowed"`
+
+	// DenyOverride Whether a matching deny rule takes precedence over the matching allow rules
+	DenyOverride bool `json:"denyOverride"`
+
+	// Enabled Whether RBAC is enabled. All requests are allowed if it is disabled
+	Enabled bool `json:"enabled"`
+
+	// Policies The policy rules (p) matching the request
+	Policies [][]string `json:"policies"`
+
+	// Roles The grouping rules (g) through which the subject inherits the roles of the matching policy rules
+	Roles [][]string `json:"roles"`
+}
+
+// PermissionExplanationRequest defines model for PermissionExplanationRequest.
+type PermissionExplanationRequest struct {
+	Action string `json:"action"`
+
+	// Attributes The attributes of the object matched against the conditions of the policy rules, e.g. engineType, storageType or labels.<key>
+	Attributes *map[string]string `json:"attributes,omitempty"`
+	Object     string             `json:"object"`
+	Resource   string             `json:"resource"`
+
+	// Subject The user, group or role to explain the permission for. Defaults to the current user and its groups
+	Subject *string `json:"subject,omitempty"`
 }
 
 // PodSchedulingPolicy PodSchedulingPolicy is the Schema for the Pod Scheduling Policy API.
@@ -4874,6 +5149,58 @@ type PodSchedulingPolicyList struct {
 	Metadata *map[string]interface{} `json:"metadata,omitempty"`
 }
 
+// QueuedUpgrade An approved operator upgrade that waits for the next maintenance window
+type QueuedUpgrade struct {
+	// RequestedAt The time the upgrade was approved
+	RequestedAt *time.Time `json:"requestedAt,omitempty"`
+
+	// RequestedBy The user who approved the upgrade
+	RequestedBy *string `json:"requestedBy,omitempty"`
+}
+
+// RBACPolicyDiff Line-based difference between two versions of the RBAC policy.
+// Removed lines are prefixed with '-', added lines with '+' and unchanged lines with a space.
+type RBACPolicyDiff struct {
+	// From Number of the version compared from
+	From  int      `json:"from"`
+	Lines []string `json:"lines"`
+
+	// To Number of the version compared to
+	To int `json:"to"`
+}
+
+// RBACPolicyVersion A recorded version of the RBAC policy
+type RBACPolicyVersion struct {
+	// Author The user who made the change
+	Author string `json:"author"`
+
+	// Enabled Whether RBAC was enabled
+	Enabled bool `json:"enabled"`
+
+	// Policy Content of the policy
+	Policy *string `json:"policy,omitempty"`
+
+	// Timestamp The time the change was recorded at
+	Timestamp time.Time `json:"timestamp"`
+
+	// Valid Whether the policy passed the validation
+	Valid bool `json:"valid"`
+
+	// Version Number of the version
+	Version int `json:"version"`
+}
+
+// RBACPolicyVersionList The most recent versions of the RBAC policy
+type RBACPolicyVersionList struct {
+	Items []RBACPolicyVersion `json:"items"`
+}
+
+// ResourcePermissions The actions allowed on the objects of a resource
+type ResourcePermissions struct {
+	Objects  []ObjectPermissions `json:"objects"`
+	Resource string              `json:"resource"`
+}
+
 // Secret Secret holds secret data of a certain type. The total bytes of the values in the Data field must be less than MaxSecretSize bytes.
 type Secret struct {
 	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
@@ -4902,6 +5229,9 @@ type Secret struct {
 type Settings struct {
 	// OidcConfig Everest OIDC provider configuration
 	OidcConfig OIDCConfig `json:"oidcConfig"`
+
+	// OidcProviders All the configured OIDC providers, the first one is the same as oidcConfig
+	OidcProviders *[]OIDCConfig `json:"oidcProviders,omitempty"`
 }
 
 // StorageClass StorageClass describes the parameters for a class of storage for which PersistentVolumes can be dynamically provisioned.
@@ -4915,6 +5245,88 @@ type StorageClass struct {
 	Metadata *map[string]interface{} `json:"metadata,omitempty"`
 }
 
+// Telemetry Telemetry payload
+type Telemetry struct {
+	Reports []TelemetryReport `json:"reports"`
+}
+
+// TelemetryMetric Key-value metric
+type TelemetryMetric struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// TelemetryReport A single telemetry report
+type TelemetryReport struct {
+	CreateTime time.Time `json:"createTime"`
+	Id         string    `json:"id"`
+
+	// InstanceId The ID of the Everest installation
+	InstanceId    string            `json:"instanceId"`
+	Metrics       []TelemetryMetric `json:"metrics"`
+	ProductFamily string            `json:"productFamily"`
+}
+
+// TemporaryGrant Assignment of an RBAC role to a user or a group until the expiry time
+type TemporaryGrant struct {
+	// ExpiresAt The time the assignment is removed at
+	ExpiresAt time.Time `json:"expiresAt"`
+
+	// GrantedAt The time the grant was created at
+	GrantedAt time.Time `json:"grantedAt"`
+
+	// GrantedBy The user who created the grant
+	GrantedBy string `json:"grantedBy"`
+
+	// Reason Justification of the grant
+	Reason *string `json:"reason,omitempty"`
+
+	// Role The assigned role
+	Role string `json:"role"`
+
+	// Subject The user or group the role is assigned to
+	Subject string `json:"subject"`
+}
+
+// TemporaryGrantEvent A recorded change of the temporary RBAC grants
+type TemporaryGrantEvent struct {
+	// Action The kind of the change
+	Action TemporaryGrantEventAction `json:"action"`
+
+	// Actor The user who made the change, empty for automatic changes
+	Actor *string `json:"actor,omitempty"`
+
+	// Grant Assignment of an RBAC role to a user or a group until the expiry time
+	Grant TemporaryGrant `json:"grant"`
+
+	// Time The time of the change
+	Time time.Time `json:"time"`
+}
+
+// TemporaryGrantEventAction The kind of the change
+type TemporaryGrantEventAction string
+
+// TemporaryGrantRequest Assignment of an RBAC role to a user or a group until the expiry time
+type TemporaryGrantRequest struct {
+	// ExpiresAt The time the assignment is removed at
+	ExpiresAt time.Time `json:"expiresAt"`
+
+	// Reason Justification of the grant
+	Reason *string `json:"reason,omitempty"`
+
+	// Role The assigned role
+	Role string `json:"role"`
+
+	// Subject The user or group the role is assigned to
+	Subject string `json:"subject"`
+}
+
+// TemporaryGrants The temporary RBAC grants and the history of their changes
+type TemporaryGrants struct {
+	Grants  []TemporaryGrant      `json:"grants"`
+	History []TemporaryGrantEvent `json:"history"`
+}
+
 // UpdateBackupStorageParams Backup storage parameters
 type UpdateBackupStorageParams struct {
 	AccessKey *string `json:"accessKey,omitempty"`
@@ -4945,14 +5357,80 @@ type Upgrade struct {
 	TargetVersion *string `json:"targetVersion,omitempty"`
 }
 
+// UpgradeImpact Impact of the operator upgrade on a database cluster
+type UpgradeImpact struct {
+	// EngineType Type of the database engine
+	EngineType *string `json:"engineType,omitempty"`
+
+	// EngineVersion The current version of the database engine
+	EngineVersion *string `json:"engineVersion,omitempty"`
+
+	// EngineVersionSupported Whether the current engine version is supported by the target operator version
+	EngineVersionSupported *bool `json:"engineVersionSupported,omitempty"`
+
+	// EstimatedRestartPods The estimated number of pods that will be restarted
+	EstimatedRestartPods *int `json:"estimatedRestartPods,omitempty"`
+
+	// MinimumEngineVersion The minimum engine version required by the target operator version
+	MinimumEngineVersion *string `json:"minimumEngineVersion,omitempty"`
+
+	// Name Name of the database cluster
+	Name *string `json:"name,omitempty"`
+
+	// OperatorTargetVersion The version of the operator the database cluster will be managed by after the upgrade
+	OperatorTargetVersion *string `json:"operatorTargetVersion,omitempty"`
+
+	// Ready Whether the database cluster is currently ready
+	Ready *bool `json:"ready,omitempty"`
+
+	// RequiresEngineUpgrade Whether the engine version needs to be upgraded before the operator upgrade
+	RequiresEngineUpgrade *bool `json:"requiresEngineUpgrade,omitempty"`
+
+	// RequiresRestart Whether the database cluster will be restarted as a result of the upgrade
+	RequiresRestart *bool `json:"requiresRestart,omitempty"`
+
+	// RestartReasons The reasons for which the database cluster will be restarted
+	RestartReasons *[]UpgradeImpactRestartReasons `json:"restartReasons,omitempty"`
+
+	// Status The current status of the database cluster
+	Status *string `json:"status,omitempty"`
+}
+
+// UpgradeImpactRestartReasons defines model for UpgradeImpact.RestartReasons.
+type UpgradeImpactRestartReasons string
+
 // UpgradePlan Operators upgrade plan
 type UpgradePlan struct {
-	PendingActions *[]UpgradeTask `json:"pendingActions,omitempty"`
-	Upgrades       *[]Upgrade     `json:"upgrades,omitempty"`
+	// Impact Impact of the operator upgrades on each database cluster in the namespace
+	Impact         *[]UpgradeImpact `json:"impact,omitempty"`
+	PendingActions *[]UpgradeTask   `json:"pendingActions,omitempty"`
+	Upgrades       *[]Upgrade       `json:"upgrades,omitempty"`
+}
+
+// UpgradePlanApproval This object is used to trigger the operator upgrade in a namespace.
+type UpgradePlanApproval struct {
+	// Schedule When the upgrade should be performed.
+	// `immediate` upgrades the operators right away and fails if the namespace is outside of its maintenance windows.
+	// `maintenanceWindow` upgrades the operators right away if a maintenance window is open,
+	// otherwise the upgrade is queued until the next maintenance window.
+	Schedule *UpgradePlanApprovalSchedule `json:"schedule,omitempty"`
 }
 
-// UpgradePlanApproval This no-op object is used to trigger the operator upgrade in a namespace.
-type UpgradePlanApproval = map[string]interface{}
+// UpgradePlanApprovalSchedule When the upgrade should be performed.
+// `immediate` upgrades the operators right away and fails if the namespace is outside of its maintenance windows.
+// `maintenanceWindow` upgrades the operators right away if a maintenance window is open,
+// otherwise the upgrade is queued until the next maintenance window.
+type UpgradePlanApprovalSchedule string
+
+// UpgradePlanApprovalResult The result of an operator upgrade approval
+type UpgradePlanApprovalResult struct {
+	// ScheduledAt The start of the maintenance window in which a queued upgrade will be performed
+	ScheduledAt *time.Time                       `json:"scheduledAt,omitempty"`
+	Status      *UpgradePlanApprovalResultStatus `json:"status,omitempty"`
+}
+
+// UpgradePlanApprovalResultStatus defines model for UpgradePlanApprovalResult.Status.
+type UpgradePlanApprovalResultStatus string
 
 // UpgradeTask defines model for UpgradeTask.
 type UpgradeTask struct {
@@ -4970,8 +5448,20 @@ type UpgradeTaskPendingTask string
 
 // UserCredentials defines model for UserCredentials.
 type UserCredentials struct {
-	Password *string `json:"password,omitempty"`
-	Username *string `json:"username,omitempty"`
+	// MfaCode A TOTP code or a recovery code. Required if the account is enrolled in multi-factor authentication.
+	MfaCode *string `json:"mfaCode,omitempty"`
+
+	// NewPassword A new password that replaces the current one if it has expired according to the password policy.
+	// It is ignored if the current password has not expired.
+	NewPassword *string `json:"newPassword,omitempty"`
+	Password    *string `json:"password,omitempty"`
+	Username    *string `json:"username,omitempty"`
+}
+
+// UserPermissionMatrix defines model for UserPermissionMatrix.
+type UserPermissionMatrix struct {
+	Enabled   bool                  `json:"enabled"`
+	Resources []ResourcePermissions `json:"resources"`
 }
 
 // UserPermissions defines model for UserPermissions.
@@ -4980,6 +5470,32 @@ type UserPermissions struct {
 	Permissions *[][]string `json:"permissions,omitempty"`
 }
 
+// UserSession Login session of a built-in user
+type UserSession struct {
+	// ExpiresAt The time the current token of the session expires at
+	ExpiresAt time.Time `json:"expiresAt"`
+
+	// Id ID of the session, i.e. the ID of its current token
+	Id string `json:"id"`
+
+	// Ip IP address the session was started from
+	Ip *string `json:"ip,omitempty"`
+
+	// IssuedAt The time the current token of the session was issued at
+	IssuedAt time.Time `json:"issuedAt"`
+
+	// LoginAt The time the user logged in at
+	LoginAt time.Time `json:"loginAt"`
+
+	// UserAgent User agent the session was started with
+	UserAgent *string `json:"userAgent,omitempty"`
+}
+
+// UserSessionList defines model for UserSessionList.
+type UserSessionList struct {
+	Items []UserSession `json:"items"`
+}
+
 // Version Everest version info
 type Version struct {
 	FullCommit  string `json:"fullCommit"`
@@ -5074,6 +5590,12 @@ type ListDataImportersParams struct {
 	SupportedEngines *[]string `form:"supportedEngines,omitempty" json:"supportedEngines,omitempty"`
 }
 
+// GetDataImportJobProgressParams defines parameters for GetDataImportJobProgress.
+type GetDataImportJobProgressParams struct {
+	// TailLines Number of the most recent log lines of the import pod to return. Defaults to 100.
+	TailLines *int `form:"tailLines,omitempty" json:"tailLines,omitempty"`
+}
+
 // DeleteDatabaseClusterBackupParams defines parameters for DeleteDatabaseClusterBackup.
 type DeleteDatabaseClusterBackupParams struct {
 	// CleanupBackupStorage If set, remove the backed up data from storage
@@ -5104,12 +5626,42 @@ type ListPodSchedulingPolicyParams struct {
 // ListPodSchedulingPolicyParamsEngineType defines parameters for ListPodSchedulingPolicy.
 type ListPodSchedulingPolicyParamsEngineType string
 
+// DiffRBACPolicyVersionsParams defines parameters for DiffRBACPolicyVersions.
+type DiffRBACPolicyVersionsParams struct {
+	// From Number of the version to compare from
+	From int `form:"from" json:"from"`
+
+	// To Number of the version to compare to
+	To int `form:"to" json:"to"`
+}
+
+// CreateAccountJSONRequestBody defines body for CreateAccount for application/json ContentType.
+type CreateAccountJSONRequestBody = AccountCreateRequest
+
+// UpdateAccountJSONRequestBody defines body for UpdateAccount for application/json ContentType.
+type UpdateAccountJSONRequestBody = AccountUpdateRequest
+
+// SetAccountPasswordJSONRequestBody defines body for SetAccountPassword for application/json ContentType.
+type SetAccountPasswordJSONRequestBody = AccountPasswordRequest
+
+// CreateAPIKeyJSONRequestBody defines body for CreateAPIKey for application/json ContentType.
+type CreateAPIKeyJSONRequestBody = APIKeyRequest
+
+// CreateDataImporterJSONRequestBody defines body for CreateDataImporter for application/json ContentType.
+type CreateDataImporterJSONRequestBody = DataImporter
+
+// UpdateDataImporterJSONRequestBody defines body for UpdateDataImporter for application/json ContentType.
+type UpdateDataImporterJSONRequestBody = DataImporter
+
 // CreateBackupStorageJSONRequestBody defines body for CreateBackupStorage for application/json ContentType.
 type CreateBackupStorageJSONRequestBody = CreateBackupStorageParams
 
 // UpdateBackupStorageJSONRequestBody defines body for UpdateBackupStorage for application/json ContentType.
 type UpdateBackupStorageJSONRequestBody = UpdateBackupStorageParams
 
+// CreateDataImportJobJSONRequestBody defines body for CreateDataImportJob for application/json ContentType.
+type CreateDataImportJobJSONRequestBody = DataImportJob
+
 // CreateDatabaseClusterBackupJSONRequestBody defines body for CreateDatabaseClusterBackup for application/json ContentType.
 type CreateDatabaseClusterBackupJSONRequestBody = DatabaseClusterBackup
 
@@ -5134,12 +5686,21 @@ type ApproveUpgradePlanJSONRequestBody = UpgradePlanApproval
 // UpdateDatabaseEngineJSONRequestBody defines body for UpdateDatabaseEngine for application/json ContentType.
 type UpdateDatabaseEngineJSONRequestBody = DatabaseEngine
 
+// UpdateEngineVersionPolicyJSONRequestBody defines body for UpdateEngineVersionPolicy for application/json ContentType.
+type UpdateEngineVersionPolicyJSONRequestBody = EngineVersionPolicy
+
+// UpdateMaintenanceWindowsJSONRequestBody defines body for UpdateMaintenanceWindows for application/json ContentType.
+type UpdateMaintenanceWindowsJSONRequestBody = MaintenanceWindowsSpec
+
 // CreateMonitoringInstanceJSONRequestBody defines body for CreateMonitoringInstance for application/json ContentType.
 type CreateMonitoringInstanceJSONRequestBody = MonitoringInstanceCreateParams
 
 // UpdateMonitoringInstanceJSONRequestBody defines body for UpdateMonitoringInstance for application/json ContentType.
 type UpdateMonitoringInstanceJSONRequestBody = MonitoringInstanceUpdateParams
 
+// ExplainPermissionJSONRequestBody defines body for ExplainPermission for application/json ContentType.
+type ExplainPermissionJSONRequestBody = PermissionExplanationRequest
+
 // CreatePodSchedulingPolicyJSONRequestBody defines body for CreatePodSchedulingPolicy for application/json ContentType.
 type CreatePodSchedulingPolicyJSONRequestBody = PodSchedulingPolicy
 
@@ -5149,6 +5710,15 @@ type UpdatePodSchedulingPolicyJSONRequestBody = PodSchedulingPolicy
 // CreateSessionJSONRequestBody defines body for CreateSession for application/json ContentType.
 type CreateSessionJSONRequestBody = UserCredentials
 
+// ChangePasswordJSONRequestBody defines body for ChangePassword for application/json ContentType.
+type ChangePasswordJSONRequestBody = PasswordChangeRequest
+
+// UpdateOIDCClaimMappingJSONRequestBody defines body for UpdateOIDCClaimMapping for application/json ContentType.
+type UpdateOIDCClaimMappingJSONRequestBody = OIDCClaimMapping
+
+// CreateTemporaryGrantJSONRequestBody defines body for CreateTemporaryGrant for application/json ContentType.
+type CreateTemporaryGrantJSONRequestBody = TemporaryGrantRequest
+
 // AsDatabaseClusterSpecEngineResourcesCpu0 returns the union data inside the DatabaseCluster_Spec_Engine_Resources_Cpu as a DatabaseClusterSpecEngineResourcesCpu0
 func (t DatabaseCluster_Spec_Engine_Resources_Cpu) AsDatabaseClusterSpecEngineResourcesCpu0() (DatabaseClusterSpecEngineResourcesCpu0, error) {
 	var body DatabaseClusterSpecEngineResourcesCpu0
@@ -5656,12 +6226,75 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// ListAccounts request
+	ListAccounts(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateAccountWithBody request with any body
+	CreateAccountWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateAccount(ctx context.Context, body CreateAccountJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteAccount request
+	DeleteAccount(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAccount request
+	GetAccount(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateAccountWithBody request with any body
+	UpdateAccountWithBody(ctx context.Context, username string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateAccount(ctx context.Context, username string, body UpdateAccountJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ResetAccountMFA request
+	ResetAccountMFA(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetAccountPasswordWithBody request with any body
+	SetAccountPasswordWithBody(ctx context.Context, username string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetAccountPassword(ctx context.Context, username string, body SetAccountPasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteUserSessions request
+	DeleteUserSessions(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListUserSessions request
+	ListUserSessions(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteUserSession request
+	DeleteUserSession(ctx context.Context, username string, sessionId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UnlockAccount request
+	UnlockAccount(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListAPIKeys request
+	ListAPIKeys(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateAPIKeyWithBody request with any body
+	CreateAPIKeyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateAPIKey(ctx context.Context, body CreateAPIKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteAPIKey request
+	DeleteAPIKey(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetKubernetesClusterInfo request
 	GetKubernetesClusterInfo(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// ListDataImporters request
 	ListDataImporters(ctx context.Context, params *ListDataImportersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// CreateDataImporterWithBody request with any body
+	CreateDataImporterWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateDataImporter(ctx context.Context, body CreateDataImporterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteDataImporter request
+	DeleteDataImporter(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateDataImporterWithBody request with any body
+	UpdateDataImporterWithBody(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateDataImporter(ctx context.Context, name string, body UpdateDataImporterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ListNamespaces request
 	ListNamespaces(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -5684,6 +6317,23 @@ type ClientInterface interface {
 
 	UpdateBackupStorage(ctx context.Context, namespace string, name string, body UpdateBackupStorageJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// CreateDataImportJobWithBody request with any body
+	CreateDataImportJobWithBody(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateDataImportJob(ctx context.Context, namespace string, body CreateDataImportJobJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteDataImportJob request
+	DeleteDataImportJob(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetDataImportJob request
+	GetDataImportJob(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CancelDataImportJob request
+	CancelDataImportJob(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetDataImportJobProgress request
+	GetDataImportJobProgress(ctx context.Context, namespace string, name string, params *GetDataImportJobProgressParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// CreateDatabaseClusterBackupWithBody request with any body
 	CreateDatabaseClusterBackupWithBody(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -5759,6 +6409,9 @@ type ClientInterface interface {
 	// GetUpgradePlan request
 	GetUpgradePlan(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// CancelUpgradePlanApproval request
+	CancelUpgradePlanApproval(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ApproveUpgradePlanWithBody request with any body
 	ApproveUpgradePlanWithBody(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -5772,6 +6425,22 @@ type ClientInterface interface {
 
 	UpdateDatabaseEngine(ctx context.Context, namespace string, name string, body UpdateDatabaseEngineJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetEngineVersionPolicy request
+	GetEngineVersionPolicy(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateEngineVersionPolicyWithBody request with any body
+	UpdateEngineVersionPolicyWithBody(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateEngineVersionPolicy(ctx context.Context, namespace string, body UpdateEngineVersionPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetMaintenanceWindows request
+	GetMaintenanceWindows(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateMaintenanceWindowsWithBody request with any body
+	UpdateMaintenanceWindowsWithBody(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateMaintenanceWindows(ctx context.Context, namespace string, body UpdateMaintenanceWindowsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ListMonitoringInstances request
 	ListMonitoringInstances(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -5794,6 +6463,14 @@ type ClientInterface interface {
 	// GetUserPermissions request
 	GetUserPermissions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ExplainPermissionWithBody request with any body
+	ExplainPermissionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ExplainPermission(ctx context.Context, body ExplainPermissionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUserPermissionMatrix request
+	GetUserPermissionMatrix(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ListPodSchedulingPolicy request
 	ListPodSchedulingPolicy(ctx context.Context, params *ListPodSchedulingPolicyParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -5824,15 +6501,54 @@ type ClientInterface interface {
 
 	CreateSession(ctx context.Context, body CreateSessionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ChangePasswordWithBody request with any body
+	ChangePasswordWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ChangePassword(ctx context.Context, body ChangePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RefreshSession request
+	RefreshSession(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetSettings request
 	GetSettings(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetOIDCClaimMapping request
+	GetOIDCClaimMapping(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateOIDCClaimMappingWithBody request with any body
+	UpdateOIDCClaimMappingWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateOIDCClaimMapping(ctx context.Context, body UpdateOIDCClaimMappingJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DiffRBACPolicyVersions request
+	DiffRBACPolicyVersions(ctx context.Context, params *DiffRBACPolicyVersionsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListRBACPolicyVersions request
+	ListRBACPolicyVersions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetRBACPolicyVersion request
+	GetRBACPolicyVersion(ctx context.Context, version int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RollbackRBACPolicy request
+	RollbackRBACPolicy(ctx context.Context, version int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListTemporaryGrants request
+	ListTemporaryGrants(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateTemporaryGrantWithBody request with any body
+	CreateTemporaryGrantWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateTemporaryGrant(ctx context.Context, body CreateTemporaryGrantJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTelemetry request
+	GetTelemetry(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// VersionInfo request
 	VersionInfo(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
-func (c *Client) GetKubernetesClusterInfo(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetKubernetesClusterInfoRequest(c.Server)
+func (c *Client) ListAccounts(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListAccountsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -5843,8 +6559,8 @@ func (c *Client) GetKubernetesClusterInfo(ctx context.Context, reqEditors ...Req
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListDataImporters(ctx context.Context, params *ListDataImportersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListDataImportersRequest(c.Server, params)
+func (c *Client) CreateAccountWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateAccountRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -5855,8 +6571,8 @@ func (c *Client) ListDataImporters(ctx context.Context, params *ListDataImporter
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListNamespaces(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListNamespacesRequest(c.Server)
+func (c *Client) CreateAccount(ctx context.Context, body CreateAccountJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateAccountRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -5867,8 +6583,8 @@ func (c *Client) ListNamespaces(ctx context.Context, reqEditors ...RequestEditor
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListBackupStorages(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListBackupStoragesRequest(c.Server, namespace)
+func (c *Client) DeleteAccount(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAccountRequest(c.Server, username)
 	if err != nil {
 		return nil, err
 	}
@@ -5879,8 +6595,8 @@ func (c *Client) ListBackupStorages(ctx context.Context, namespace string, reqEd
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateBackupStorageWithBody(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateBackupStorageRequestWithBody(c.Server, namespace, contentType, body)
+func (c *Client) GetAccount(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAccountRequest(c.Server, username)
 	if err != nil {
 		return nil, err
 	}
@@ -5891,8 +6607,8 @@ func (c *Client) CreateBackupStorageWithBody(ctx context.Context, namespace stri
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateBackupStorage(ctx context.Context, namespace string, body CreateBackupStorageJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateBackupStorageRequest(c.Server, namespace, body)
+func (c *Client) UpdateAccountWithBody(ctx context.Context, username string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateAccountRequestWithBody(c.Server, username, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -5903,8 +6619,8 @@ func (c *Client) CreateBackupStorage(ctx context.Context, namespace string, body
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteBackupStorage(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteBackupStorageRequest(c.Server, namespace, name)
+func (c *Client) UpdateAccount(ctx context.Context, username string, body UpdateAccountJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateAccountRequest(c.Server, username, body)
 	if err != nil {
 		return nil, err
 	}
@@ -5915,8 +6631,8 @@ func (c *Client) DeleteBackupStorage(ctx context.Context, namespace string, name
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetBackupStorage(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetBackupStorageRequest(c.Server, namespace, name)
+func (c *Client) ResetAccountMFA(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewResetAccountMFARequest(c.Server, username)
 	if err != nil {
 		return nil, err
 	}
@@ -5927,8 +6643,8 @@ func (c *Client) GetBackupStorage(ctx context.Context, namespace string, name st
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateBackupStorageWithBody(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateBackupStorageRequestWithBody(c.Server, namespace, name, contentType, body)
+func (c *Client) SetAccountPasswordWithBody(ctx context.Context, username string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetAccountPasswordRequestWithBody(c.Server, username, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -5939,8 +6655,8 @@ func (c *Client) UpdateBackupStorageWithBody(ctx context.Context, namespace stri
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateBackupStorage(ctx context.Context, namespace string, name string, body UpdateBackupStorageJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateBackupStorageRequest(c.Server, namespace, name, body)
+func (c *Client) SetAccountPassword(ctx context.Context, username string, body SetAccountPasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetAccountPasswordRequest(c.Server, username, body)
 	if err != nil {
 		return nil, err
 	}
@@ -5951,8 +6667,8 @@ func (c *Client) UpdateBackupStorage(ctx context.Context, namespace string, name
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateDatabaseClusterBackupWithBody(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateDatabaseClusterBackupRequestWithBody(c.Server, namespace, contentType, body)
+func (c *Client) DeleteUserSessions(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteUserSessionsRequest(c.Server, username)
 	if err != nil {
 		return nil, err
 	}
@@ -5963,8 +6679,8 @@ func (c *Client) CreateDatabaseClusterBackupWithBody(ctx context.Context, namesp
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateDatabaseClusterBackup(ctx context.Context, namespace string, body CreateDatabaseClusterBackupJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateDatabaseClusterBackupRequest(c.Server, namespace, body)
+func (c *Client) ListUserSessions(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListUserSessionsRequest(c.Server, username)
 	if err != nil {
 		return nil, err
 	}
@@ -5975,8 +6691,8 @@ func (c *Client) CreateDatabaseClusterBackup(ctx context.Context, namespace stri
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteDatabaseClusterBackup(ctx context.Context, namespace string, name string, params *DeleteDatabaseClusterBackupParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteDatabaseClusterBackupRequest(c.Server, namespace, name, params)
+func (c *Client) DeleteUserSession(ctx context.Context, username string, sessionId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteUserSessionRequest(c.Server, username, sessionId)
 	if err != nil {
 		return nil, err
 	}
@@ -5987,8 +6703,8 @@ func (c *Client) DeleteDatabaseClusterBackup(ctx context.Context, namespace stri
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetDatabaseClusterBackup(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetDatabaseClusterBackupRequest(c.Server, namespace, name)
+func (c *Client) UnlockAccount(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUnlockAccountRequest(c.Server, username)
 	if err != nil {
 		return nil, err
 	}
@@ -5999,8 +6715,8 @@ func (c *Client) GetDatabaseClusterBackup(ctx context.Context, namespace string,
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateDatabaseClusterRestoreWithBody(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateDatabaseClusterRestoreRequestWithBody(c.Server, namespace, contentType, body)
+func (c *Client) ListAPIKeys(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListAPIKeysRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -6011,8 +6727,8 @@ func (c *Client) CreateDatabaseClusterRestoreWithBody(ctx context.Context, names
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateDatabaseClusterRestore(ctx context.Context, namespace string, body CreateDatabaseClusterRestoreJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateDatabaseClusterRestoreRequest(c.Server, namespace, body)
+func (c *Client) CreateAPIKeyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateAPIKeyRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -6023,8 +6739,8 @@ func (c *Client) CreateDatabaseClusterRestore(ctx context.Context, namespace str
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteDatabaseClusterRestore(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteDatabaseClusterRestoreRequest(c.Server, namespace, name)
+func (c *Client) CreateAPIKey(ctx context.Context, body CreateAPIKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateAPIKeyRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -6035,8 +6751,8 @@ func (c *Client) DeleteDatabaseClusterRestore(ctx context.Context, namespace str
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetDatabaseClusterRestore(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetDatabaseClusterRestoreRequest(c.Server, namespace, name)
+func (c *Client) DeleteAPIKey(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAPIKeyRequest(c.Server, name)
 	if err != nil {
 		return nil, err
 	}
@@ -6047,8 +6763,8 @@ func (c *Client) GetDatabaseClusterRestore(ctx context.Context, namespace string
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateDatabaseClusterRestoreWithBody(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateDatabaseClusterRestoreRequestWithBody(c.Server, namespace, name, contentType, body)
+func (c *Client) GetKubernetesClusterInfo(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetKubernetesClusterInfoRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -6059,8 +6775,8 @@ func (c *Client) UpdateDatabaseClusterRestoreWithBody(ctx context.Context, names
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateDatabaseClusterRestore(ctx context.Context, namespace string, name string, body UpdateDatabaseClusterRestoreJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateDatabaseClusterRestoreRequest(c.Server, namespace, name, body)
+func (c *Client) ListDataImporters(ctx context.Context, params *ListDataImportersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListDataImportersRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -6071,8 +6787,8 @@ func (c *Client) UpdateDatabaseClusterRestore(ctx context.Context, namespace str
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListDatabaseClusters(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListDatabaseClustersRequest(c.Server, namespace)
+func (c *Client) CreateDataImporterWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateDataImporterRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -6083,8 +6799,8 @@ func (c *Client) ListDatabaseClusters(ctx context.Context, namespace string, req
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateDatabaseClusterWithBody(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateDatabaseClusterRequestWithBody(c.Server, namespace, contentType, body)
+func (c *Client) CreateDataImporter(ctx context.Context, body CreateDataImporterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateDataImporterRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -6095,8 +6811,8 @@ func (c *Client) CreateDatabaseClusterWithBody(ctx context.Context, namespace st
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateDatabaseCluster(ctx context.Context, namespace string, body CreateDatabaseClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateDatabaseClusterRequest(c.Server, namespace, body)
+func (c *Client) DeleteDataImporter(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteDataImporterRequest(c.Server, name)
 	if err != nil {
 		return nil, err
 	}
@@ -6107,8 +6823,8 @@ func (c *Client) CreateDatabaseCluster(ctx context.Context, namespace string, bo
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListDatabaseClusterBackups(ctx context.Context, namespace string, clusterName string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListDatabaseClusterBackupsRequest(c.Server, namespace, clusterName)
+func (c *Client) UpdateDataImporterWithBody(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateDataImporterRequestWithBody(c.Server, name, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -6119,8 +6835,8 @@ func (c *Client) ListDatabaseClusterBackups(ctx context.Context, namespace strin
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListDatabaseClusterRestores(ctx context.Context, namespace string, clusterName string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListDatabaseClusterRestoresRequest(c.Server, namespace, clusterName)
+func (c *Client) UpdateDataImporter(ctx context.Context, name string, body UpdateDataImporterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateDataImporterRequest(c.Server, name, body)
 	if err != nil {
 		return nil, err
 	}
@@ -6131,8 +6847,8 @@ func (c *Client) ListDatabaseClusterRestores(ctx context.Context, namespace stri
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListDataImportJobs(ctx context.Context, namespace string, dbName string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListDataImportJobsRequest(c.Server, namespace, dbName)
+func (c *Client) ListNamespaces(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListNamespacesRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -6143,8 +6859,8 @@ func (c *Client) ListDataImportJobs(ctx context.Context, namespace string, dbNam
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateDatabaseClusterSecretWithBody(ctx context.Context, namespace string, dbName string, params *CreateDatabaseClusterSecretParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateDatabaseClusterSecretRequestWithBody(c.Server, namespace, dbName, params, contentType, body)
+func (c *Client) ListBackupStorages(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListBackupStoragesRequest(c.Server, namespace)
 	if err != nil {
 		return nil, err
 	}
@@ -6155,8 +6871,8 @@ func (c *Client) CreateDatabaseClusterSecretWithBody(ctx context.Context, namesp
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateDatabaseClusterSecret(ctx context.Context, namespace string, dbName string, params *CreateDatabaseClusterSecretParams, body CreateDatabaseClusterSecretJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateDatabaseClusterSecretRequest(c.Server, namespace, dbName, params, body)
+func (c *Client) CreateBackupStorageWithBody(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateBackupStorageRequestWithBody(c.Server, namespace, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -6167,8 +6883,8 @@ func (c *Client) CreateDatabaseClusterSecret(ctx context.Context, namespace stri
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteDatabaseCluster(ctx context.Context, namespace string, name string, params *DeleteDatabaseClusterParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteDatabaseClusterRequest(c.Server, namespace, name, params)
+func (c *Client) CreateBackupStorage(ctx context.Context, namespace string, body CreateBackupStorageJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateBackupStorageRequest(c.Server, namespace, body)
 	if err != nil {
 		return nil, err
 	}
@@ -6179,8 +6895,8 @@ func (c *Client) DeleteDatabaseCluster(ctx context.Context, namespace string, na
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetDatabaseCluster(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetDatabaseClusterRequest(c.Server, namespace, name)
+func (c *Client) DeleteBackupStorage(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteBackupStorageRequest(c.Server, namespace, name)
 	if err != nil {
 		return nil, err
 	}
@@ -6191,8 +6907,8 @@ func (c *Client) GetDatabaseCluster(ctx context.Context, namespace string, name
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateDatabaseClusterWithBody(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateDatabaseClusterRequestWithBody(c.Server, namespace, name, contentType, body)
+func (c *Client) GetBackupStorage(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetBackupStorageRequest(c.Server, namespace, name)
 	if err != nil {
 		return nil, err
 	}
@@ -6203,8 +6919,8 @@ func (c *Client) UpdateDatabaseClusterWithBody(ctx context.Context, namespace st
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateDatabaseCluster(ctx context.Context, namespace string, name string, body UpdateDatabaseClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateDatabaseClusterRequest(c.Server, namespace, name, body)
+func (c *Client) UpdateBackupStorageWithBody(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateBackupStorageRequestWithBody(c.Server, namespace, name, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -6215,8 +6931,8 @@ func (c *Client) UpdateDatabaseCluster(ctx context.Context, namespace string, na
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetDatabaseClusterComponents(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetDatabaseClusterComponentsRequest(c.Server, namespace, name)
+func (c *Client) UpdateBackupStorage(ctx context.Context, namespace string, name string, body UpdateBackupStorageJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateBackupStorageRequest(c.Server, namespace, name, body)
 	if err != nil {
 		return nil, err
 	}
@@ -6227,8 +6943,8 @@ func (c *Client) GetDatabaseClusterComponents(ctx context.Context, namespace str
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetDatabaseClusterCredentials(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetDatabaseClusterCredentialsRequest(c.Server, namespace, name)
+func (c *Client) CreateDataImportJobWithBody(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateDataImportJobRequestWithBody(c.Server, namespace, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -6239,8 +6955,8 @@ func (c *Client) GetDatabaseClusterCredentials(ctx context.Context, namespace st
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetDatabaseClusterPitr(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetDatabaseClusterPitrRequest(c.Server, namespace, name)
+func (c *Client) CreateDataImportJob(ctx context.Context, namespace string, body CreateDataImportJobJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateDataImportJobRequest(c.Server, namespace, body)
 	if err != nil {
 		return nil, err
 	}
@@ -6251,8 +6967,8 @@ func (c *Client) GetDatabaseClusterPitr(ctx context.Context, namespace string, n
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListDatabaseEngines(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListDatabaseEnginesRequest(c.Server, namespace)
+func (c *Client) DeleteDataImportJob(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteDataImportJobRequest(c.Server, namespace, name)
 	if err != nil {
 		return nil, err
 	}
@@ -6263,8 +6979,8 @@ func (c *Client) ListDatabaseEngines(ctx context.Context, namespace string, reqE
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetUpgradePlan(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetUpgradePlanRequest(c.Server, namespace)
+func (c *Client) GetDataImportJob(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDataImportJobRequest(c.Server, namespace, name)
 	if err != nil {
 		return nil, err
 	}
@@ -6275,8 +6991,8 @@ func (c *Client) GetUpgradePlan(ctx context.Context, namespace string, reqEditor
 	return c.Client.Do(req)
 }
 
-func (c *Client) ApproveUpgradePlanWithBody(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewApproveUpgradePlanRequestWithBody(c.Server, namespace, contentType, body)
+func (c *Client) CancelDataImportJob(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCancelDataImportJobRequest(c.Server, namespace, name)
 	if err != nil {
 		return nil, err
 	}
@@ -6287,8 +7003,8 @@ func (c *Client) ApproveUpgradePlanWithBody(ctx context.Context, namespace strin
 	return c.Client.Do(req)
 }
 
-func (c *Client) ApproveUpgradePlan(ctx context.Context, namespace string, body ApproveUpgradePlanJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewApproveUpgradePlanRequest(c.Server, namespace, body)
+func (c *Client) GetDataImportJobProgress(ctx context.Context, namespace string, name string, params *GetDataImportJobProgressParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDataImportJobProgressRequest(c.Server, namespace, name, params)
 	if err != nil {
 		return nil, err
 	}
@@ -6299,8 +7015,8 @@ func (c *Client) ApproveUpgradePlan(ctx context.Context, namespace string, body
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetDatabaseEngine(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetDatabaseEngineRequest(c.Server, namespace, name)
+func (c *Client) CreateDatabaseClusterBackupWithBody(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateDatabaseClusterBackupRequestWithBody(c.Server, namespace, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -6311,8 +7027,8 @@ func (c *Client) GetDatabaseEngine(ctx context.Context, namespace string, name s
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateDatabaseEngineWithBody(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateDatabaseEngineRequestWithBody(c.Server, namespace, name, contentType, body)
+func (c *Client) CreateDatabaseClusterBackup(ctx context.Context, namespace string, body CreateDatabaseClusterBackupJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateDatabaseClusterBackupRequest(c.Server, namespace, body)
 	if err != nil {
 		return nil, err
 	}
@@ -6323,8 +7039,8 @@ func (c *Client) UpdateDatabaseEngineWithBody(ctx context.Context, namespace str
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateDatabaseEngine(ctx context.Context, namespace string, name string, body UpdateDatabaseEngineJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateDatabaseEngineRequest(c.Server, namespace, name, body)
+func (c *Client) DeleteDatabaseClusterBackup(ctx context.Context, namespace string, name string, params *DeleteDatabaseClusterBackupParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteDatabaseClusterBackupRequest(c.Server, namespace, name, params)
 	if err != nil {
 		return nil, err
 	}
@@ -6335,8 +7051,8 @@ func (c *Client) UpdateDatabaseEngine(ctx context.Context, namespace string, nam
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListMonitoringInstances(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListMonitoringInstancesRequest(c.Server, namespace)
+func (c *Client) GetDatabaseClusterBackup(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDatabaseClusterBackupRequest(c.Server, namespace, name)
 	if err != nil {
 		return nil, err
 	}
@@ -6347,8 +7063,8 @@ func (c *Client) ListMonitoringInstances(ctx context.Context, namespace string,
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateMonitoringInstanceWithBody(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateMonitoringInstanceRequestWithBody(c.Server, namespace, contentType, body)
+func (c *Client) CreateDatabaseClusterRestoreWithBody(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateDatabaseClusterRestoreRequestWithBody(c.Server, namespace, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -6359,8 +7075,8 @@ func (c *Client) CreateMonitoringInstanceWithBody(ctx context.Context, namespace
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateMonitoringInstance(ctx context.Context, namespace string, body CreateMonitoringInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateMonitoringInstanceRequest(c.Server, namespace, body)
+func (c *Client) CreateDatabaseClusterRestore(ctx context.Context, namespace string, body CreateDatabaseClusterRestoreJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateDatabaseClusterRestoreRequest(c.Server, namespace, body)
 	if err != nil {
 		return nil, err
 	}
@@ -6371,8 +7087,8 @@ func (c *Client) CreateMonitoringInstance(ctx context.Context, namespace string,
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteMonitoringInstance(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteMonitoringInstanceRequest(c.Server, namespace, name)
+func (c *Client) DeleteDatabaseClusterRestore(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteDatabaseClusterRestoreRequest(c.Server, namespace, name)
 	if err != nil {
 		return nil, err
 	}
@@ -6383,8 +7099,8 @@ func (c *Client) DeleteMonitoringInstance(ctx context.Context, namespace string,
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetMonitoringInstance(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetMonitoringInstanceRequest(c.Server, namespace, name)
+func (c *Client) GetDatabaseClusterRestore(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDatabaseClusterRestoreRequest(c.Server, namespace, name)
 	if err != nil {
 		return nil, err
 	}
@@ -6395,8 +7111,8 @@ func (c *Client) GetMonitoringInstance(ctx context.Context, namespace string, na
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateMonitoringInstanceWithBody(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateMonitoringInstanceRequestWithBody(c.Server, namespace, name, contentType, body)
+func (c *Client) UpdateDatabaseClusterRestoreWithBody(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateDatabaseClusterRestoreRequestWithBody(c.Server, namespace, name, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -6407,8 +7123,8 @@ func (c *Client) UpdateMonitoringInstanceWithBody(ctx context.Context, namespace
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateMonitoringInstance(ctx context.Context, namespace string, name string, body UpdateMonitoringInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateMonitoringInstanceRequest(c.Server, namespace, name, body)
+func (c *Client) UpdateDatabaseClusterRestore(ctx context.Context, namespace string, name string, body UpdateDatabaseClusterRestoreJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateDatabaseClusterRestoreRequest(c.Server, namespace, name, body)
 	if err != nil {
 		return nil, err
 	}
@@ -6419,8 +7135,8 @@ func (c *Client) UpdateMonitoringInstance(ctx context.Context, namespace string,
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetUserPermissions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetUserPermissionsRequest(c.Server)
+func (c *Client) ListDatabaseClusters(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListDatabaseClustersRequest(c.Server, namespace)
 	if err != nil {
 		return nil, err
 	}
@@ -6431,8 +7147,8 @@ func (c *Client) GetUserPermissions(ctx context.Context, reqEditors ...RequestEd
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListPodSchedulingPolicy(ctx context.Context, params *ListPodSchedulingPolicyParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListPodSchedulingPolicyRequest(c.Server, params)
+func (c *Client) CreateDatabaseClusterWithBody(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateDatabaseClusterRequestWithBody(c.Server, namespace, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -6443,8 +7159,8 @@ func (c *Client) ListPodSchedulingPolicy(ctx context.Context, params *ListPodSch
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreatePodSchedulingPolicyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreatePodSchedulingPolicyRequestWithBody(c.Server, contentType, body)
+func (c *Client) CreateDatabaseCluster(ctx context.Context, namespace string, body CreateDatabaseClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateDatabaseClusterRequest(c.Server, namespace, body)
 	if err != nil {
 		return nil, err
 	}
@@ -6455,8 +7171,8 @@ func (c *Client) CreatePodSchedulingPolicyWithBody(ctx context.Context, contentT
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreatePodSchedulingPolicy(ctx context.Context, body CreatePodSchedulingPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreatePodSchedulingPolicyRequest(c.Server, body)
+func (c *Client) ListDatabaseClusterBackups(ctx context.Context, namespace string, clusterName string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListDatabaseClusterBackupsRequest(c.Server, namespace, clusterName)
 	if err != nil {
 		return nil, err
 	}
@@ -6467,8 +7183,8 @@ func (c *Client) CreatePodSchedulingPolicy(ctx context.Context, body CreatePodSc
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeletePodSchedulingPolicy(ctx context.Context, policyName string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeletePodSchedulingPolicyRequest(c.Server, policyName)
+func (c *Client) ListDatabaseClusterRestores(ctx context.Context, namespace string, clusterName string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListDatabaseClusterRestoresRequest(c.Server, namespace, clusterName)
 	if err != nil {
 		return nil, err
 	}
@@ -6479,8 +7195,8 @@ func (c *Client) DeletePodSchedulingPolicy(ctx context.Context, policyName strin
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetPodSchedulingPolicy(ctx context.Context, policyName string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetPodSchedulingPolicyRequest(c.Server, policyName)
+func (c *Client) ListDataImportJobs(ctx context.Context, namespace string, dbName string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListDataImportJobsRequest(c.Server, namespace, dbName)
 	if err != nil {
 		return nil, err
 	}
@@ -6491,8 +7207,8 @@ func (c *Client) GetPodSchedulingPolicy(ctx context.Context, policyName string,
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdatePodSchedulingPolicyWithBody(ctx context.Context, policyName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdatePodSchedulingPolicyRequestWithBody(c.Server, policyName, contentType, body)
+func (c *Client) CreateDatabaseClusterSecretWithBody(ctx context.Context, namespace string, dbName string, params *CreateDatabaseClusterSecretParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateDatabaseClusterSecretRequestWithBody(c.Server, namespace, dbName, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -6503,8 +7219,8 @@ func (c *Client) UpdatePodSchedulingPolicyWithBody(ctx context.Context, policyNa
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdatePodSchedulingPolicy(ctx context.Context, policyName string, body UpdatePodSchedulingPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdatePodSchedulingPolicyRequest(c.Server, policyName, body)
+func (c *Client) CreateDatabaseClusterSecret(ctx context.Context, namespace string, dbName string, params *CreateDatabaseClusterSecretParams, body CreateDatabaseClusterSecretJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateDatabaseClusterSecretRequest(c.Server, namespace, dbName, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -6515,8 +7231,8 @@ func (c *Client) UpdatePodSchedulingPolicy(ctx context.Context, policyName strin
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetKubernetesClusterResources(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetKubernetesClusterResourcesRequest(c.Server)
+func (c *Client) DeleteDatabaseCluster(ctx context.Context, namespace string, name string, params *DeleteDatabaseClusterParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteDatabaseClusterRequest(c.Server, namespace, name, params)
 	if err != nil {
 		return nil, err
 	}
@@ -6527,8 +7243,8 @@ func (c *Client) GetKubernetesClusterResources(ctx context.Context, reqEditors .
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteSession(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteSessionRequest(c.Server)
+func (c *Client) GetDatabaseCluster(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDatabaseClusterRequest(c.Server, namespace, name)
 	if err != nil {
 		return nil, err
 	}
@@ -6539,8 +7255,8 @@ func (c *Client) DeleteSession(ctx context.Context, reqEditors ...RequestEditorF
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateSessionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateSessionRequestWithBody(c.Server, contentType, body)
+func (c *Client) UpdateDatabaseClusterWithBody(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateDatabaseClusterRequestWithBody(c.Server, namespace, name, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -6551,8 +7267,8 @@ func (c *Client) CreateSessionWithBody(ctx context.Context, contentType string,
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateSession(ctx context.Context, body CreateSessionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateSessionRequest(c.Server, body)
+func (c *Client) UpdateDatabaseCluster(ctx context.Context, namespace string, name string, body UpdateDatabaseClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateDatabaseClusterRequest(c.Server, namespace, name, body)
 	if err != nil {
 		return nil, err
 	}
@@ -6563,8 +7279,8 @@ func (c *Client) CreateSession(ctx context.Context, body CreateSessionJSONReques
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetSettings(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetSettingsRequest(c.Server)
+func (c *Client) GetDatabaseClusterComponents(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDatabaseClusterComponentsRequest(c.Server, namespace, name)
 	if err != nil {
 		return nil, err
 	}
@@ -6575,8 +7291,8 @@ func (c *Client) GetSettings(ctx context.Context, reqEditors ...RequestEditorFn)
 	return c.Client.Do(req)
 }
 
-func (c *Client) VersionInfo(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewVersionInfoRequest(c.Server)
+func (c *Client) GetDatabaseClusterCredentials(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDatabaseClusterCredentialsRequest(c.Server, namespace, name)
 	if err != nil {
 		return nil, err
 	}
@@ -6587,589 +7303,652 @@ func (c *Client) VersionInfo(ctx context.Context, reqEditors ...RequestEditorFn)
 	return c.Client.Do(req)
 }
 
-// NewGetKubernetesClusterInfoRequest generates requests for GetKubernetesClusterInfo
-func NewGetKubernetesClusterInfoRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) GetDatabaseClusterPitr(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDatabaseClusterPitrRequest(c.Server, namespace, name)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/cluster-info")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ListDatabaseEngines(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListDatabaseEnginesRequest(c.Server, namespace)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) GetUpgradePlan(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUpgradePlanRequest(c.Server, namespace)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewListDataImportersRequest generates requests for ListDataImporters
-func NewListDataImportersRequest(server string, params *ListDataImportersParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) CancelUpgradePlanApproval(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCancelUpgradePlanApprovalRequest(c.Server, namespace)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/data-importers")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+func (c *Client) ApproveUpgradePlanWithBody(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApproveUpgradePlanRequestWithBody(c.Server, namespace, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ApproveUpgradePlan(ctx context.Context, namespace string, body ApproveUpgradePlanJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApproveUpgradePlanRequest(c.Server, namespace, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.SupportedEngines != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "supportedEngines", runtime.ParamLocationQuery, *params.SupportedEngines); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) GetDatabaseEngine(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDatabaseEngineRequest(c.Server, namespace, name)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewListNamespacesRequest generates requests for ListNamespaces
-func NewListNamespacesRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) UpdateDatabaseEngineWithBody(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateDatabaseEngineRequestWithBody(c.Server, namespace, name, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/namespaces")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UpdateDatabaseEngine(ctx context.Context, namespace string, name string, body UpdateDatabaseEngineJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateDatabaseEngineRequest(c.Server, namespace, name, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewListBackupStoragesRequest generates requests for ListBackupStorages
-func NewListBackupStoragesRequest(server string, namespace string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+func (c *Client) GetEngineVersionPolicy(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetEngineVersionPolicyRequest(c.Server, namespace)
 	if err != nil {
 		return nil, err
 	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/namespaces/%s/backup-storages", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UpdateEngineVersionPolicyWithBody(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateEngineVersionPolicyRequestWithBody(c.Server, namespace, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewCreateBackupStorageRequest calls the generic CreateBackupStorage builder with application/json body
-func NewCreateBackupStorageRequest(server string, namespace string, body CreateBackupStorageJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) UpdateEngineVersionPolicy(ctx context.Context, namespace string, body UpdateEngineVersionPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateEngineVersionPolicyRequest(c.Server, namespace, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateBackupStorageRequestWithBody(server, namespace, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCreateBackupStorageRequestWithBody generates requests for CreateBackupStorage with any type of body
-func NewCreateBackupStorageRequestWithBody(server string, namespace string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+func (c *Client) GetMaintenanceWindows(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetMaintenanceWindowsRequest(c.Server, namespace)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) UpdateMaintenanceWindowsWithBody(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateMaintenanceWindowsRequestWithBody(c.Server, namespace, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/namespaces/%s/backup-storages", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UpdateMaintenanceWindows(ctx context.Context, namespace string, body UpdateMaintenanceWindowsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateMaintenanceWindowsRequest(c.Server, namespace, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewDeleteBackupStorageRequest generates requests for DeleteBackupStorage
-func NewDeleteBackupStorageRequest(server string, namespace string, name string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+func (c *Client) ListMonitoringInstances(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListMonitoringInstancesRequest(c.Server, namespace)
 	if err != nil {
 		return nil, err
 	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) CreateMonitoringInstanceWithBody(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateMonitoringInstanceRequestWithBody(c.Server, namespace, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/namespaces/%s/backup-storages/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CreateMonitoringInstance(ctx context.Context, namespace string, body CreateMonitoringInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateMonitoringInstanceRequest(c.Server, namespace, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetBackupStorageRequest generates requests for GetBackupStorage
-func NewGetBackupStorageRequest(server string, namespace string, name string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+func (c *Client) DeleteMonitoringInstance(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteMonitoringInstanceRequest(c.Server, namespace, name)
 	if err != nil {
 		return nil, err
 	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetMonitoringInstance(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetMonitoringInstanceRequest(c.Server, namespace, name)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/namespaces/%s/backup-storages/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UpdateMonitoringInstanceWithBody(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateMonitoringInstanceRequestWithBody(c.Server, namespace, name, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewUpdateBackupStorageRequest calls the generic UpdateBackupStorage builder with application/json body
-func NewUpdateBackupStorageRequest(server string, namespace string, name string, body UpdateBackupStorageJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) UpdateMonitoringInstance(ctx context.Context, namespace string, name string, body UpdateMonitoringInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateMonitoringInstanceRequest(c.Server, namespace, name, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateBackupStorageRequestWithBody(server, namespace, name, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewUpdateBackupStorageRequestWithBody generates requests for UpdateBackupStorage with any type of body
-func NewUpdateBackupStorageRequestWithBody(server string, namespace string, name string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+func (c *Client) GetUserPermissions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUserPermissionsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) ExplainPermissionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExplainPermissionRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/namespaces/%s/backup-storages/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ExplainPermission(ctx context.Context, body ExplainPermissionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExplainPermissionRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("PATCH", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewCreateDatabaseClusterBackupRequest calls the generic CreateDatabaseClusterBackup builder with application/json body
-func NewCreateDatabaseClusterBackupRequest(server string, namespace string, body CreateDatabaseClusterBackupJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) GetUserPermissionMatrix(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUserPermissionMatrixRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateDatabaseClusterBackupRequestWithBody(server, namespace, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCreateDatabaseClusterBackupRequestWithBody generates requests for CreateDatabaseClusterBackup with any type of body
-func NewCreateDatabaseClusterBackupRequestWithBody(server string, namespace string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+func (c *Client) ListPodSchedulingPolicy(ctx context.Context, params *ListPodSchedulingPolicyParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListPodSchedulingPolicyRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) CreatePodSchedulingPolicyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePodSchedulingPolicyRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/namespaces/%s/database-cluster-backups", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CreatePodSchedulingPolicy(ctx context.Context, body CreatePodSchedulingPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePodSchedulingPolicyRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewDeleteDatabaseClusterBackupRequest generates requests for DeleteDatabaseClusterBackup
-func NewDeleteDatabaseClusterBackupRequest(server string, namespace string, name string, params *DeleteDatabaseClusterBackupParams) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+func (c *Client) DeletePodSchedulingPolicy(ctx context.Context, policyName string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeletePodSchedulingPolicyRequest(c.Server, policyName)
 	if err != nil {
 		return nil, err
 	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetPodSchedulingPolicy(ctx context.Context, policyName string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPodSchedulingPolicyRequest(c.Server, policyName)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/namespaces/%s/database-cluster-backups/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UpdatePodSchedulingPolicyWithBody(ctx context.Context, policyName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdatePodSchedulingPolicyRequestWithBody(c.Server, policyName, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.CleanupBackupStorage != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cleanupBackupStorage", runtime.ParamLocationQuery, *params.CleanupBackupStorage); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+func (c *Client) UpdatePodSchedulingPolicy(ctx context.Context, policyName string, body UpdatePodSchedulingPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdatePodSchedulingPolicyRequest(c.Server, policyName, body)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGetDatabaseClusterBackupRequest generates requests for GetDatabaseClusterBackup
-func NewGetDatabaseClusterBackupRequest(server string, namespace string, name string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+func (c *Client) GetKubernetesClusterResources(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetKubernetesClusterResourcesRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) DeleteSession(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteSessionRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/namespaces/%s/database-cluster-backups/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CreateSessionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateSessionRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewCreateDatabaseClusterRestoreRequest calls the generic CreateDatabaseClusterRestore builder with application/json body
-func NewCreateDatabaseClusterRestoreRequest(server string, namespace string, body CreateDatabaseClusterRestoreJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) CreateSession(ctx context.Context, body CreateSessionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateSessionRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateDatabaseClusterRestoreRequestWithBody(server, namespace, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCreateDatabaseClusterRestoreRequestWithBody generates requests for CreateDatabaseClusterRestore with any type of body
-func NewCreateDatabaseClusterRestoreRequestWithBody(server string, namespace string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+func (c *Client) ChangePasswordWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewChangePasswordRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) ChangePassword(ctx context.Context, body ChangePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewChangePasswordRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/namespaces/%s/database-cluster-restores", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) RefreshSession(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRefreshSessionRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewDeleteDatabaseClusterRestoreRequest generates requests for DeleteDatabaseClusterRestore
-func NewDeleteDatabaseClusterRestoreRequest(server string, namespace string, name string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+func (c *Client) GetSettings(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSettingsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetOIDCClaimMapping(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetOIDCClaimMappingRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/namespaces/%s/database-cluster-restores/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UpdateOIDCClaimMappingWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateOIDCClaimMappingRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetDatabaseClusterRestoreRequest generates requests for GetDatabaseClusterRestore
-func NewGetDatabaseClusterRestoreRequest(server string, namespace string, name string) (*http.Request, error) {
-	var err error
+func (c *Client) UpdateOIDCClaimMapping(ctx context.Context, body UpdateOIDCClaimMappingJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateOIDCClaimMappingRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	var pathParam0 string
+func (c *Client) DiffRBACPolicyVersions(ctx context.Context, params *DiffRBACPolicyVersionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDiffRBACPolicyVersionsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+func (c *Client) ListRBACPolicyVersions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListRBACPolicyVersionsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	var pathParam1 string
+func (c *Client) GetRBACPolicyVersion(ctx context.Context, version int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetRBACPolicyVersionRequest(c.Server, version)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+func (c *Client) RollbackRBACPolicy(ctx context.Context, version int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRollbackRBACPolicyRequest(c.Server, version)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListTemporaryGrants(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListTemporaryGrantsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateTemporaryGrantWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTemporaryGrantRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateTemporaryGrant(ctx context.Context, body CreateTemporaryGrantJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTemporaryGrantRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetTelemetry(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTelemetryRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) VersionInfo(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewVersionInfoRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewListAccountsRequest generates requests for ListAccounts
+func NewListAccountsRequest(server string) (*http.Request, error) {
+	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/namespaces/%s/database-cluster-restores/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/accounts")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7187,31 +7966,53 @@ func NewGetDatabaseClusterRestoreRequest(server string, namespace string, name s
 	return req, nil
 }
 
-// NewUpdateDatabaseClusterRestoreRequest calls the generic UpdateDatabaseClusterRestore builder with application/json body
-func NewUpdateDatabaseClusterRestoreRequest(server string, namespace string, name string, body UpdateDatabaseClusterRestoreJSONRequestBody) (*http.Request, error) {
+// NewCreateAccountRequest calls the generic CreateAccount builder with application/json body
+func NewCreateAccountRequest(server string, body CreateAccountJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdateDatabaseClusterRestoreRequestWithBody(server, namespace, name, "application/json", bodyReader)
+	return NewCreateAccountRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewUpdateDatabaseClusterRestoreRequestWithBody generates requests for UpdateDatabaseClusterRestore with any type of body
-func NewUpdateDatabaseClusterRestoreRequestWithBody(server string, namespace string, name string, contentType string, body io.Reader) (*http.Request, error) {
+// NewCreateAccountRequestWithBody generates requests for CreateAccount with any type of body
+func NewCreateAccountRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	operationPath := fmt.Sprintf("/accounts")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
 
-	var pathParam1 string
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteAccountRequest generates requests for DeleteAccount
+func NewDeleteAccountRequest(server string, username string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "username", runtime.ParamLocationPath, username)
 	if err != nil {
 		return nil, err
 	}
@@ -7221,7 +8022,7 @@ func NewUpdateDatabaseClusterRestoreRequestWithBody(server string, namespace str
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/namespaces/%s/database-cluster-restores/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/accounts/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7231,23 +8032,21 @@ func NewUpdateDatabaseClusterRestoreRequestWithBody(server string, namespace str
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewListDatabaseClustersRequest generates requests for ListDatabaseClusters
-func NewListDatabaseClustersRequest(server string, namespace string) (*http.Request, error) {
+// NewGetAccountRequest generates requests for GetAccount
+func NewGetAccountRequest(server string, username string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "username", runtime.ParamLocationPath, username)
 	if err != nil {
 		return nil, err
 	}
@@ -7257,7 +8056,7 @@ func NewListDatabaseClustersRequest(server string, namespace string) (*http.Requ
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters", pathParam0)
+	operationPath := fmt.Sprintf("/accounts/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7275,24 +8074,24 @@ func NewListDatabaseClustersRequest(server string, namespace string) (*http.Requ
 	return req, nil
 }
 
-// NewCreateDatabaseClusterRequest calls the generic CreateDatabaseCluster builder with application/json body
-func NewCreateDatabaseClusterRequest(server string, namespace string, body CreateDatabaseClusterJSONRequestBody) (*http.Request, error) {
+// NewUpdateAccountRequest calls the generic UpdateAccount builder with application/json body
+func NewUpdateAccountRequest(server string, username string, body UpdateAccountJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewCreateDatabaseClusterRequestWithBody(server, namespace, "application/json", bodyReader)
+	return NewUpdateAccountRequestWithBody(server, username, "application/json", bodyReader)
 }
 
-// NewCreateDatabaseClusterRequestWithBody generates requests for CreateDatabaseCluster with any type of body
-func NewCreateDatabaseClusterRequestWithBody(server string, namespace string, contentType string, body io.Reader) (*http.Request, error) {
+// NewUpdateAccountRequestWithBody generates requests for UpdateAccount with any type of body
+func NewUpdateAccountRequestWithBody(server string, username string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "username", runtime.ParamLocationPath, username)
 	if err != nil {
 		return nil, err
 	}
@@ -7302,7 +8101,7 @@ func NewCreateDatabaseClusterRequestWithBody(server string, namespace string, co
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters", pathParam0)
+	operationPath := fmt.Sprintf("/accounts/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7312,7 +8111,7 @@ func NewCreateDatabaseClusterRequestWithBody(server string, namespace string, co
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -7322,20 +8121,13 @@ func NewCreateDatabaseClusterRequestWithBody(server string, namespace string, co
 	return req, nil
 }
 
-// NewListDatabaseClusterBackupsRequest generates requests for ListDatabaseClusterBackups
-func NewListDatabaseClusterBackupsRequest(server string, namespace string, clusterName string) (*http.Request, error) {
+// NewResetAccountMFARequest generates requests for ResetAccountMFA
+func NewResetAccountMFARequest(server string, username string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "cluster-name", runtime.ParamLocationPath, clusterName)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "username", runtime.ParamLocationPath, username)
 	if err != nil {
 		return nil, err
 	}
@@ -7345,7 +8137,7 @@ func NewListDatabaseClusterBackupsRequest(server string, namespace string, clust
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters/%s/backups", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/accounts/%s/mfa", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7355,7 +8147,7 @@ func NewListDatabaseClusterBackupsRequest(server string, namespace string, clust
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -7363,20 +8155,24 @@ func NewListDatabaseClusterBackupsRequest(server string, namespace string, clust
 	return req, nil
 }
 
-// NewListDatabaseClusterRestoresRequest generates requests for ListDatabaseClusterRestores
-func NewListDatabaseClusterRestoresRequest(server string, namespace string, clusterName string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+// NewSetAccountPasswordRequest calls the generic SetAccountPassword builder with application/json body
+func NewSetAccountPasswordRequest(server string, username string, body SetAccountPasswordJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetAccountPasswordRequestWithBody(server, username, "application/json", bodyReader)
+}
 
-	var pathParam1 string
+// NewSetAccountPasswordRequestWithBody generates requests for SetAccountPassword with any type of body
+func NewSetAccountPasswordRequestWithBody(server string, username string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "cluster-name", runtime.ParamLocationPath, clusterName)
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "username", runtime.ParamLocationPath, username)
 	if err != nil {
 		return nil, err
 	}
@@ -7386,7 +8182,7 @@ func NewListDatabaseClusterRestoresRequest(server string, namespace string, clus
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters/%s/restores", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/accounts/%s/password", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7396,28 +8192,23 @@ func NewListDatabaseClusterRestoresRequest(server string, namespace string, clus
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewListDataImportJobsRequest generates requests for ListDataImportJobs
-func NewListDataImportJobsRequest(server string, namespace string, dbName string) (*http.Request, error) {
+// NewDeleteUserSessionsRequest generates requests for DeleteUserSessions
+func NewDeleteUserSessionsRequest(server string, username string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "dbName", runtime.ParamLocationPath, dbName)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "username", runtime.ParamLocationPath, username)
 	if err != nil {
 		return nil, err
 	}
@@ -7427,7 +8218,7 @@ func NewListDataImportJobsRequest(server string, namespace string, dbName string
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters/%s/data-import-jobs", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/accounts/%s/sessions", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7437,7 +8228,7 @@ func NewListDataImportJobsRequest(server string, namespace string, dbName string
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -7445,31 +8236,13 @@ func NewListDataImportJobsRequest(server string, namespace string, dbName string
 	return req, nil
 }
 
-// NewCreateDatabaseClusterSecretRequest calls the generic CreateDatabaseClusterSecret builder with application/json body
-func NewCreateDatabaseClusterSecretRequest(server string, namespace string, dbName string, params *CreateDatabaseClusterSecretParams, body CreateDatabaseClusterSecretJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateDatabaseClusterSecretRequestWithBody(server, namespace, dbName, params, "application/json", bodyReader)
-}
-
-// NewCreateDatabaseClusterSecretRequestWithBody generates requests for CreateDatabaseClusterSecret with any type of body
-func NewCreateDatabaseClusterSecretRequestWithBody(server string, namespace string, dbName string, params *CreateDatabaseClusterSecretParams, contentType string, body io.Reader) (*http.Request, error) {
+// NewListUserSessionsRequest generates requests for ListUserSessions
+func NewListUserSessionsRequest(server string, username string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "dbName", runtime.ParamLocationPath, dbName)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "username", runtime.ParamLocationPath, username)
 	if err != nil {
 		return nil, err
 	}
@@ -7479,7 +8252,7 @@ func NewCreateDatabaseClusterSecretRequestWithBody(server string, namespace stri
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters/%s/secret", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/accounts/%s/sessions", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7489,52 +8262,28 @@ func NewCreateDatabaseClusterSecretRequestWithBody(server string, namespace stri
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.SecretName != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "secretName", runtime.ParamLocationQuery, *params.SecretName); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewDeleteDatabaseClusterRequest generates requests for DeleteDatabaseCluster
-func NewDeleteDatabaseClusterRequest(server string, namespace string, name string, params *DeleteDatabaseClusterParams) (*http.Request, error) {
+// NewDeleteUserSessionRequest generates requests for DeleteUserSession
+func NewDeleteUserSessionRequest(server string, username string, sessionId string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "username", runtime.ParamLocationPath, username)
 	if err != nil {
 		return nil, err
 	}
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "sessionId", runtime.ParamLocationPath, sessionId)
 	if err != nil {
 		return nil, err
 	}
@@ -7544,7 +8293,7 @@ func NewDeleteDatabaseClusterRequest(server string, namespace string, name strin
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/accounts/%s/sessions/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7554,60 +8303,58 @@ func NewDeleteDatabaseClusterRequest(server string, namespace string, name strin
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.CleanupBackupStorage != nil {
+	return req, nil
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cleanupBackupStorage", runtime.ParamLocationQuery, *params.CleanupBackupStorage); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// NewUnlockAccountRequest generates requests for UnlockAccount
+func NewUnlockAccountRequest(server string, username string) (*http.Request, error) {
+	var err error
 
-		}
+	var pathParam0 string
 
-		queryURL.RawQuery = queryValues.Encode()
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "username", runtime.ParamLocationPath, username)
+	if err != nil {
+		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	return req, nil
-}
-
-// NewGetDatabaseClusterRequest generates requests for GetDatabaseCluster
-func NewGetDatabaseClusterRequest(server string, namespace string, name string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
+	operationPath := fmt.Sprintf("/accounts/%s/unlock", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
+	return req, nil
+}
+
+// NewListAPIKeysRequest generates requests for ListAPIKeys
+func NewListAPIKeysRequest(server string) (*http.Request, error) {
+	var err error
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api-keys")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7625,41 +8372,27 @@ func NewGetDatabaseClusterRequest(server string, namespace string, name string)
 	return req, nil
 }
 
-// NewUpdateDatabaseClusterRequest calls the generic UpdateDatabaseCluster builder with application/json body
-func NewUpdateDatabaseClusterRequest(server string, namespace string, name string, body UpdateDatabaseClusterJSONRequestBody) (*http.Request, error) {
+// NewCreateAPIKeyRequest calls the generic CreateAPIKey builder with application/json body
+func NewCreateAPIKeyRequest(server string, body CreateAPIKeyJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdateDatabaseClusterRequestWithBody(server, namespace, name, "application/json", bodyReader)
+	return NewCreateAPIKeyRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewUpdateDatabaseClusterRequestWithBody generates requests for UpdateDatabaseCluster with any type of body
-func NewUpdateDatabaseClusterRequestWithBody(server string, namespace string, name string, contentType string, body io.Reader) (*http.Request, error) {
+// NewCreateAPIKeyRequestWithBody generates requests for CreateAPIKey with any type of body
+func NewCreateAPIKeyRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api-keys")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7669,7 +8402,7 @@ func NewUpdateDatabaseClusterRequestWithBody(server string, namespace string, na
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -7679,20 +8412,13 @@ func NewUpdateDatabaseClusterRequestWithBody(server string, namespace string, na
 	return req, nil
 }
 
-// NewGetDatabaseClusterComponentsRequest generates requests for GetDatabaseClusterComponents
-func NewGetDatabaseClusterComponentsRequest(server string, namespace string, name string) (*http.Request, error) {
+// NewDeleteAPIKeyRequest generates requests for DeleteAPIKey
+func NewDeleteAPIKeyRequest(server string, name string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
 	if err != nil {
 		return nil, err
 	}
@@ -7702,7 +8428,7 @@ func NewGetDatabaseClusterComponentsRequest(server string, namespace string, nam
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters/%s/components", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api-keys/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7712,7 +8438,7 @@ func NewGetDatabaseClusterComponentsRequest(server string, namespace string, nam
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -7720,30 +8446,16 @@ func NewGetDatabaseClusterComponentsRequest(server string, namespace string, nam
 	return req, nil
 }
 
-// NewGetDatabaseClusterCredentialsRequest generates requests for GetDatabaseClusterCredentials
-func NewGetDatabaseClusterCredentialsRequest(server string, namespace string, name string) (*http.Request, error) {
+// NewGetKubernetesClusterInfoRequest generates requests for GetKubernetesClusterInfo
+func NewGetKubernetesClusterInfoRequest(server string) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters/%s/credentials", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/cluster-info")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7761,30 +8473,16 @@ func NewGetDatabaseClusterCredentialsRequest(server string, namespace string, na
 	return req, nil
 }
 
-// NewGetDatabaseClusterPitrRequest generates requests for GetDatabaseClusterPitr
-func NewGetDatabaseClusterPitrRequest(server string, namespace string, name string) (*http.Request, error) {
+// NewListDataImportersRequest generates requests for ListDataImporters
+func NewListDataImportersRequest(server string, params *ListDataImportersParams) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters/%s/pitr", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/data-importers")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7794,6 +8492,28 @@ func NewGetDatabaseClusterPitrRequest(server string, namespace string, name stri
 		return nil, err
 	}
 
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.SupportedEngines != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "supportedEngines", runtime.ParamLocationQuery, *params.SupportedEngines); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -7802,23 +8522,27 @@ func NewGetDatabaseClusterPitrRequest(server string, namespace string, name stri
 	return req, nil
 }
 
-// NewListDatabaseEnginesRequest generates requests for ListDatabaseEngines
-func NewListDatabaseEnginesRequest(server string, namespace string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+// NewCreateDataImporterRequest calls the generic CreateDataImporter builder with application/json body
+func NewCreateDataImporterRequest(server string, body CreateDataImporterJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateDataImporterRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateDataImporterRequestWithBody generates requests for CreateDataImporter with any type of body
+func NewCreateDataImporterRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/namespaces/%s/database-engines", pathParam0)
+	operationPath := fmt.Sprintf("/data-importers")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7828,21 +8552,23 @@ func NewListDatabaseEnginesRequest(server string, namespace string) (*http.Reque
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewGetUpgradePlanRequest generates requests for GetUpgradePlan
-func NewGetUpgradePlanRequest(server string, namespace string) (*http.Request, error) {
+// NewDeleteDataImporterRequest generates requests for DeleteDataImporter
+func NewDeleteDataImporterRequest(server string, name string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
 	if err != nil {
 		return nil, err
 	}
@@ -7852,7 +8578,7 @@ func NewGetUpgradePlanRequest(server string, namespace string) (*http.Request, e
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/namespaces/%s/database-engines/upgrade-plan", pathParam0)
+	operationPath := fmt.Sprintf("/data-importers/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7862,7 +8588,7 @@ func NewGetUpgradePlanRequest(server string, namespace string) (*http.Request, e
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -7870,24 +8596,24 @@ func NewGetUpgradePlanRequest(server string, namespace string) (*http.Request, e
 	return req, nil
 }
 
-// NewApproveUpgradePlanRequest calls the generic ApproveUpgradePlan builder with application/json body
-func NewApproveUpgradePlanRequest(server string, namespace string, body ApproveUpgradePlanJSONRequestBody) (*http.Request, error) {
+// NewUpdateDataImporterRequest calls the generic UpdateDataImporter builder with application/json body
+func NewUpdateDataImporterRequest(server string, name string, body UpdateDataImporterJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewApproveUpgradePlanRequestWithBody(server, namespace, "application/json", bodyReader)
+	return NewUpdateDataImporterRequestWithBody(server, name, "application/json", bodyReader)
 }
 
-// NewApproveUpgradePlanRequestWithBody generates requests for ApproveUpgradePlan with any type of body
-func NewApproveUpgradePlanRequestWithBody(server string, namespace string, contentType string, body io.Reader) (*http.Request, error) {
+// NewUpdateDataImporterRequestWithBody generates requests for UpdateDataImporter with any type of body
+func NewUpdateDataImporterRequestWithBody(server string, name string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
 	if err != nil {
 		return nil, err
 	}
@@ -7897,7 +8623,7 @@ func NewApproveUpgradePlanRequestWithBody(server string, namespace string, conte
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/namespaces/%s/database-engines/upgrade-plan/approval", pathParam0)
+	operationPath := fmt.Sprintf("/data-importers/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7907,7 +8633,7 @@ func NewApproveUpgradePlanRequestWithBody(server string, namespace string, conte
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -7917,20 +8643,40 @@ func NewApproveUpgradePlanRequestWithBody(server string, namespace string, conte
 	return req, nil
 }
 
-// NewGetDatabaseEngineRequest generates requests for GetDatabaseEngine
-func NewGetDatabaseEngineRequest(server string, namespace string, name string) (*http.Request, error) {
+// NewListNamespacesRequest generates requests for ListNamespaces
+func NewListNamespacesRequest(server string) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	operationPath := fmt.Sprintf("/namespaces")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
 
-	var pathParam1 string
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	return req, nil
+}
+
+// NewListBackupStoragesRequest generates requests for ListBackupStorages
+func NewListBackupStoragesRequest(server string, namespace string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
 	if err != nil {
 		return nil, err
 	}
@@ -7940,7 +8686,7 @@ func NewGetDatabaseEngineRequest(server string, namespace string, name string) (
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/namespaces/%s/database-engines/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/namespaces/%s/backup-storages", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7958,19 +8704,19 @@ func NewGetDatabaseEngineRequest(server string, namespace string, name string) (
 	return req, nil
 }
 
-// NewUpdateDatabaseEngineRequest calls the generic UpdateDatabaseEngine builder with application/json body
-func NewUpdateDatabaseEngineRequest(server string, namespace string, name string, body UpdateDatabaseEngineJSONRequestBody) (*http.Request, error) {
+// NewCreateBackupStorageRequest calls the generic CreateBackupStorage builder with application/json body
+func NewCreateBackupStorageRequest(server string, namespace string, body CreateBackupStorageJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdateDatabaseEngineRequestWithBody(server, namespace, name, "application/json", bodyReader)
+	return NewCreateBackupStorageRequestWithBody(server, namespace, "application/json", bodyReader)
 }
 
-// NewUpdateDatabaseEngineRequestWithBody generates requests for UpdateDatabaseEngine with any type of body
-func NewUpdateDatabaseEngineRequestWithBody(server string, namespace string, name string, contentType string, body io.Reader) (*http.Request, error) {
+// NewCreateBackupStorageRequestWithBody generates requests for CreateBackupStorage with any type of body
+func NewCreateBackupStorageRequestWithBody(server string, namespace string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -7980,19 +8726,12 @@ func NewUpdateDatabaseEngineRequestWithBody(server string, namespace string, nam
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/namespaces/%s/database-engines/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/namespaces/%s/backup-storages", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -8002,7 +8741,7 @@ func NewUpdateDatabaseEngineRequestWithBody(server string, namespace string, nam
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -8012,8 +8751,8 @@ func NewUpdateDatabaseEngineRequestWithBody(server string, namespace string, nam
 	return req, nil
 }
 
-// NewListMonitoringInstancesRequest generates requests for ListMonitoringInstances
-func NewListMonitoringInstancesRequest(server string, namespace string) (*http.Request, error) {
+// NewDeleteBackupStorageRequest generates requests for DeleteBackupStorage
+func NewDeleteBackupStorageRequest(server string, namespace string, name string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -8023,12 +8762,19 @@ func NewListMonitoringInstancesRequest(server string, namespace string) (*http.R
 		return nil, err
 	}
 
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/namespaces/%s/monitoring-instances", pathParam0)
+	operationPath := fmt.Sprintf("/namespaces/%s/backup-storages/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -8038,7 +8784,7 @@ func NewListMonitoringInstancesRequest(server string, namespace string) (*http.R
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -8046,24 +8792,20 @@ func NewListMonitoringInstancesRequest(server string, namespace string) (*http.R
 	return req, nil
 }
 
-// NewCreateMonitoringInstanceRequest calls the generic CreateMonitoringInstance builder with application/json body
-func NewCreateMonitoringInstanceRequest(server string, namespace string, body CreateMonitoringInstanceJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+// NewGetBackupStorageRequest generates requests for GetBackupStorage
+func NewGetBackupStorageRequest(server string, namespace string, name string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateMonitoringInstanceRequestWithBody(server, namespace, "application/json", bodyReader)
-}
-
-// NewCreateMonitoringInstanceRequestWithBody generates requests for CreateMonitoringInstance with any type of body
-func NewCreateMonitoringInstanceRequestWithBody(server string, namespace string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
 
-	var pathParam0 string
+	var pathParam1 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
 	if err != nil {
 		return nil, err
 	}
@@ -8073,7 +8815,7 @@ func NewCreateMonitoringInstanceRequestWithBody(server string, namespace string,
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/namespaces/%s/monitoring-instances", pathParam0)
+	operationPath := fmt.Sprintf("/namespaces/%s/backup-storages/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -8083,18 +8825,27 @@ func NewCreateMonitoringInstanceRequestWithBody(server string, namespace string,
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewDeleteMonitoringInstanceRequest generates requests for DeleteMonitoringInstance
-func NewDeleteMonitoringInstanceRequest(server string, namespace string, name string) (*http.Request, error) {
+// NewUpdateBackupStorageRequest calls the generic UpdateBackupStorage builder with application/json body
+func NewUpdateBackupStorageRequest(server string, namespace string, name string, body UpdateBackupStorageJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateBackupStorageRequestWithBody(server, namespace, name, "application/json", bodyReader)
+}
+
+// NewUpdateBackupStorageRequestWithBody generates requests for UpdateBackupStorage with any type of body
+func NewUpdateBackupStorageRequestWithBody(server string, namespace string, name string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -8116,7 +8867,7 @@ func NewDeleteMonitoringInstanceRequest(server string, namespace string, name st
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/namespaces/%s/monitoring-instances/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/namespaces/%s/backup-storages/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -8126,16 +8877,65 @@ func NewDeleteMonitoringInstanceRequest(server string, namespace string, name st
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewGetMonitoringInstanceRequest generates requests for GetMonitoringInstance
-func NewGetMonitoringInstanceRequest(server string, namespace string, name string) (*http.Request, error) {
+// NewCreateDataImportJobRequest calls the generic CreateDataImportJob builder with application/json body
+func NewCreateDataImportJobRequest(server string, namespace string, body CreateDataImportJobJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateDataImportJobRequestWithBody(server, namespace, "application/json", bodyReader)
+}
+
+// NewCreateDataImportJobRequestWithBody generates requests for CreateDataImportJob with any type of body
+func NewCreateDataImportJobRequestWithBody(server string, namespace string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/namespaces/%s/data-import-jobs", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteDataImportJobRequest generates requests for DeleteDataImportJob
+func NewDeleteDataImportJobRequest(server string, namespace string, name string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -8157,7 +8957,7 @@ func NewGetMonitoringInstanceRequest(server string, namespace string, name strin
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/namespaces/%s/monitoring-instances/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/namespaces/%s/data-import-jobs/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -8167,7 +8967,7 @@ func NewGetMonitoringInstanceRequest(server string, namespace string, name strin
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -8175,19 +8975,8 @@ func NewGetMonitoringInstanceRequest(server string, namespace string, name strin
 	return req, nil
 }
 
-// NewUpdateMonitoringInstanceRequest calls the generic UpdateMonitoringInstance builder with application/json body
-func NewUpdateMonitoringInstanceRequest(server string, namespace string, name string, body UpdateMonitoringInstanceJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateMonitoringInstanceRequestWithBody(server, namespace, name, "application/json", bodyReader)
-}
-
-// NewUpdateMonitoringInstanceRequestWithBody generates requests for UpdateMonitoringInstance with any type of body
-func NewUpdateMonitoringInstanceRequestWithBody(server string, namespace string, name string, contentType string, body io.Reader) (*http.Request, error) {
+// NewGetDataImportJobRequest generates requests for GetDataImportJob
+func NewGetDataImportJobRequest(server string, namespace string, name string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -8209,7 +8998,7 @@ func NewUpdateMonitoringInstanceRequestWithBody(server string, namespace string,
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/namespaces/%s/monitoring-instances/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/namespaces/%s/data-import-jobs/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -8219,26 +9008,38 @@ func NewUpdateMonitoringInstanceRequestWithBody(server string, namespace string,
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewGetUserPermissionsRequest generates requests for GetUserPermissions
-func NewGetUserPermissionsRequest(server string) (*http.Request, error) {
+// NewCancelDataImportJobRequest generates requests for CancelDataImportJob
+func NewCancelDataImportJobRequest(server string, namespace string, name string) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/permissions")
+	operationPath := fmt.Sprintf("/namespaces/%s/data-import-jobs/%s/cancel", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -8248,7 +9049,7 @@ func NewGetUserPermissionsRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -8256,16 +9057,30 @@ func NewGetUserPermissionsRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewListPodSchedulingPolicyRequest generates requests for ListPodSchedulingPolicy
-func NewListPodSchedulingPolicyRequest(server string, params *ListPodSchedulingPolicyParams) (*http.Request, error) {
+// NewGetDataImportJobProgressRequest generates requests for GetDataImportJobProgress
+func NewGetDataImportJobProgressRequest(server string, namespace string, name string, params *GetDataImportJobProgressParams) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/pod-scheduling-policies")
+	operationPath := fmt.Sprintf("/namespaces/%s/data-import-jobs/%s/progress", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -8278,25 +9093,9 @@ func NewListPodSchedulingPolicyRequest(server string, params *ListPodSchedulingP
 	if params != nil {
 		queryValues := queryURL.Query()
 
-		if params.EngineType != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "engineType", runtime.ParamLocationQuery, *params.EngineType); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.HasRules != nil {
+		if params.TailLines != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "hasRules", runtime.ParamLocationQuery, *params.HasRules); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "tailLines", runtime.ParamLocationQuery, *params.TailLines); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -8321,27 +9120,34 @@ func NewListPodSchedulingPolicyRequest(server string, params *ListPodSchedulingP
 	return req, nil
 }
 
-// NewCreatePodSchedulingPolicyRequest calls the generic CreatePodSchedulingPolicy builder with application/json body
-func NewCreatePodSchedulingPolicyRequest(server string, body CreatePodSchedulingPolicyJSONRequestBody) (*http.Request, error) {
+// NewCreateDatabaseClusterBackupRequest calls the generic CreateDatabaseClusterBackup builder with application/json body
+func NewCreateDatabaseClusterBackupRequest(server string, namespace string, body CreateDatabaseClusterBackupJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewCreatePodSchedulingPolicyRequestWithBody(server, "application/json", bodyReader)
+	return NewCreateDatabaseClusterBackupRequestWithBody(server, namespace, "application/json", bodyReader)
 }
 
-// NewCreatePodSchedulingPolicyRequestWithBody generates requests for CreatePodSchedulingPolicy with any type of body
-func NewCreatePodSchedulingPolicyRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewCreateDatabaseClusterBackupRequestWithBody generates requests for CreateDatabaseClusterBackup with any type of body
+func NewCreateDatabaseClusterBackupRequestWithBody(server string, namespace string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/pod-scheduling-policies")
+	operationPath := fmt.Sprintf("/namespaces/%s/database-cluster-backups", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -8361,13 +9167,20 @@ func NewCreatePodSchedulingPolicyRequestWithBody(server string, contentType stri
 	return req, nil
 }
 
-// NewDeletePodSchedulingPolicyRequest generates requests for DeletePodSchedulingPolicy
-func NewDeletePodSchedulingPolicyRequest(server string, policyName string) (*http.Request, error) {
+// NewDeleteDatabaseClusterBackupRequest generates requests for DeleteDatabaseClusterBackup
+func NewDeleteDatabaseClusterBackupRequest(server string, namespace string, name string, params *DeleteDatabaseClusterBackupParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "policy-name", runtime.ParamLocationPath, policyName)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
 	if err != nil {
 		return nil, err
 	}
@@ -8377,7 +9190,7 @@ func NewDeletePodSchedulingPolicyRequest(server string, policyName string) (*htt
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/pod-scheduling-policies/%s", pathParam0)
+	operationPath := fmt.Sprintf("/namespaces/%s/database-cluster-backups/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -8387,6 +9200,28 @@ func NewDeletePodSchedulingPolicyRequest(server string, policyName string) (*htt
 		return nil, err
 	}
 
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.CleanupBackupStorage != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cleanupBackupStorage", runtime.ParamLocationQuery, *params.CleanupBackupStorage); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
 	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -8395,13 +9230,20 @@ func NewDeletePodSchedulingPolicyRequest(server string, policyName string) (*htt
 	return req, nil
 }
 
-// NewGetPodSchedulingPolicyRequest generates requests for GetPodSchedulingPolicy
-func NewGetPodSchedulingPolicyRequest(server string, policyName string) (*http.Request, error) {
+// NewGetDatabaseClusterBackupRequest generates requests for GetDatabaseClusterBackup
+func NewGetDatabaseClusterBackupRequest(server string, namespace string, name string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "policy-name", runtime.ParamLocationPath, policyName)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
 	if err != nil {
 		return nil, err
 	}
@@ -8411,7 +9253,7 @@ func NewGetPodSchedulingPolicyRequest(server string, policyName string) (*http.R
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/pod-scheduling-policies/%s", pathParam0)
+	operationPath := fmt.Sprintf("/namespaces/%s/database-cluster-backups/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -8429,24 +9271,24 @@ func NewGetPodSchedulingPolicyRequest(server string, policyName string) (*http.R
 	return req, nil
 }
 
-// NewUpdatePodSchedulingPolicyRequest calls the generic UpdatePodSchedulingPolicy builder with application/json body
-func NewUpdatePodSchedulingPolicyRequest(server string, policyName string, body UpdatePodSchedulingPolicyJSONRequestBody) (*http.Request, error) {
+// NewCreateDatabaseClusterRestoreRequest calls the generic CreateDatabaseClusterRestore builder with application/json body
+func NewCreateDatabaseClusterRestoreRequest(server string, namespace string, body CreateDatabaseClusterRestoreJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdatePodSchedulingPolicyRequestWithBody(server, policyName, "application/json", bodyReader)
+	return NewCreateDatabaseClusterRestoreRequestWithBody(server, namespace, "application/json", bodyReader)
 }
 
-// NewUpdatePodSchedulingPolicyRequestWithBody generates requests for UpdatePodSchedulingPolicy with any type of body
-func NewUpdatePodSchedulingPolicyRequestWithBody(server string, policyName string, contentType string, body io.Reader) (*http.Request, error) {
+// NewCreateDatabaseClusterRestoreRequestWithBody generates requests for CreateDatabaseClusterRestore with any type of body
+func NewCreateDatabaseClusterRestoreRequestWithBody(server string, namespace string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "policy-name", runtime.ParamLocationPath, policyName)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
 	if err != nil {
 		return nil, err
 	}
@@ -8456,7 +9298,7 @@ func NewUpdatePodSchedulingPolicyRequestWithBody(server string, policyName strin
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/pod-scheduling-policies/%s", pathParam0)
+	operationPath := fmt.Sprintf("/namespaces/%s/database-cluster-restores", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -8466,7 +9308,7 @@ func NewUpdatePodSchedulingPolicyRequestWithBody(server string, policyName strin
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -8476,26 +9318,40 @@ func NewUpdatePodSchedulingPolicyRequestWithBody(server string, policyName strin
 	return req, nil
 }
 
-// NewGetKubernetesClusterResourcesRequest generates requests for GetKubernetesClusterResources
-func NewGetKubernetesClusterResourcesRequest(server string) (*http.Request, error) {
+// NewDeleteDatabaseClusterRestoreRequest generates requests for DeleteDatabaseClusterRestore
+func NewDeleteDatabaseClusterRestoreRequest(server string, namespace string, name string) (*http.Request, error) {
 	var err error
 
-	serverURL, err := url.Parse(server)
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/resources")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	var pathParam1 string
 
-	queryURL, err := serverURL.Parse(operationPath)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/namespaces/%s/database-cluster-restores/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -8503,16 +9359,30 @@ func NewGetKubernetesClusterResourcesRequest(server string) (*http.Request, erro
 	return req, nil
 }
 
-// NewDeleteSessionRequest generates requests for DeleteSession
-func NewDeleteSessionRequest(server string) (*http.Request, error) {
+// NewGetDatabaseClusterRestoreRequest generates requests for GetDatabaseClusterRestore
+func NewGetDatabaseClusterRestoreRequest(server string, namespace string, name string) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/session")
+	operationPath := fmt.Sprintf("/namespaces/%s/database-cluster-restores/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -8522,7 +9392,7 @@ func NewDeleteSessionRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -8530,27 +9400,41 @@ func NewDeleteSessionRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewCreateSessionRequest calls the generic CreateSession builder with application/json body
-func NewCreateSessionRequest(server string, body CreateSessionJSONRequestBody) (*http.Request, error) {
+// NewUpdateDatabaseClusterRestoreRequest calls the generic UpdateDatabaseClusterRestore builder with application/json body
+func NewUpdateDatabaseClusterRestoreRequest(server string, namespace string, name string, body UpdateDatabaseClusterRestoreJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewCreateSessionRequestWithBody(server, "application/json", bodyReader)
+	return NewUpdateDatabaseClusterRestoreRequestWithBody(server, namespace, name, "application/json", bodyReader)
 }
 
-// NewCreateSessionRequestWithBody generates requests for CreateSession with any type of body
-func NewCreateSessionRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewUpdateDatabaseClusterRestoreRequestWithBody generates requests for UpdateDatabaseClusterRestore with any type of body
+func NewUpdateDatabaseClusterRestoreRequestWithBody(server string, namespace string, name string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/session")
+	operationPath := fmt.Sprintf("/namespaces/%s/database-cluster-restores/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -8560,7 +9444,7 @@ func NewCreateSessionRequestWithBody(server string, contentType string, body io.
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -8570,16 +9454,23 @@ func NewCreateSessionRequestWithBody(server string, contentType string, body io.
 	return req, nil
 }
 
-// NewGetSettingsRequest generates requests for GetSettings
-func NewGetSettingsRequest(server string) (*http.Request, error) {
+// NewListDatabaseClustersRequest generates requests for ListDatabaseClusters
+func NewListDatabaseClustersRequest(server string, namespace string) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/settings")
+	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -8597,16 +9488,34 @@ func NewGetSettingsRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewVersionInfoRequest generates requests for VersionInfo
-func NewVersionInfoRequest(server string) (*http.Request, error) {
+// NewCreateDatabaseClusterRequest calls the generic CreateDatabaseCluster builder with application/json body
+func NewCreateDatabaseClusterRequest(server string, namespace string, body CreateDatabaseClusterJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateDatabaseClusterRequestWithBody(server, namespace, "application/json", bodyReader)
+}
+
+// NewCreateDatabaseClusterRequestWithBody generates requests for CreateDatabaseCluster with any type of body
+func NewCreateDatabaseClusterRequestWithBody(server string, namespace string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/version")
+	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -8616,1954 +9525,7119 @@ func NewVersionInfoRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	return nil
-}
+// NewListDatabaseClusterBackupsRequest generates requests for ListDatabaseClusterBackups
+func NewListDatabaseClusterBackupsRequest(server string, namespace string, clusterName string) (*http.Request, error) {
+	var err error
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+	var pathParam0 string
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "cluster-name", runtime.ParamLocationPath, clusterName)
+	if err != nil {
+		return nil, err
 	}
-}
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// GetKubernetesClusterInfoWithResponse request
-	GetKubernetesClusterInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetKubernetesClusterInfoResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// ListDataImportersWithResponse request
-	ListDataImportersWithResponse(ctx context.Context, params *ListDataImportersParams, reqEditors ...RequestEditorFn) (*ListDataImportersResponse, error)
+	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters/%s/backups", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// ListNamespacesWithResponse request
-	ListNamespacesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListNamespacesResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// ListBackupStoragesWithResponse request
-	ListBackupStoragesWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*ListBackupStoragesResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// CreateBackupStorageWithBodyWithResponse request with any body
-	CreateBackupStorageWithBodyWithResponse(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateBackupStorageResponse, error)
+	return req, nil
+}
 
-	CreateBackupStorageWithResponse(ctx context.Context, namespace string, body CreateBackupStorageJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateBackupStorageResponse, error)
+// NewListDatabaseClusterRestoresRequest generates requests for ListDatabaseClusterRestores
+func NewListDatabaseClusterRestoresRequest(server string, namespace string, clusterName string) (*http.Request, error) {
+	var err error
 
-	// DeleteBackupStorageWithResponse request
-	DeleteBackupStorageWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*DeleteBackupStorageResponse, error)
+	var pathParam0 string
 
-	// GetBackupStorageWithResponse request
-	GetBackupStorageWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetBackupStorageResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
 
-	// UpdateBackupStorageWithBodyWithResponse request with any body
-	UpdateBackupStorageWithBodyWithResponse(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateBackupStorageResponse, error)
+	var pathParam1 string
 
-	UpdateBackupStorageWithResponse(ctx context.Context, namespace string, name string, body UpdateBackupStorageJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateBackupStorageResponse, error)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "cluster-name", runtime.ParamLocationPath, clusterName)
+	if err != nil {
+		return nil, err
+	}
 
-	// CreateDatabaseClusterBackupWithBodyWithResponse request with any body
-	CreateDatabaseClusterBackupWithBodyWithResponse(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterBackupResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	CreateDatabaseClusterBackupWithResponse(ctx context.Context, namespace string, body CreateDatabaseClusterBackupJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterBackupResponse, error)
+	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters/%s/restores", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// DeleteDatabaseClusterBackupWithResponse request
-	DeleteDatabaseClusterBackupWithResponse(ctx context.Context, namespace string, name string, params *DeleteDatabaseClusterBackupParams, reqEditors ...RequestEditorFn) (*DeleteDatabaseClusterBackupResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetDatabaseClusterBackupWithResponse request
-	GetDatabaseClusterBackupWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterBackupResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// CreateDatabaseClusterRestoreWithBodyWithResponse request with any body
-	CreateDatabaseClusterRestoreWithBodyWithResponse(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterRestoreResponse, error)
+	return req, nil
+}
 
-	CreateDatabaseClusterRestoreWithResponse(ctx context.Context, namespace string, body CreateDatabaseClusterRestoreJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterRestoreResponse, error)
+// NewListDataImportJobsRequest generates requests for ListDataImportJobs
+func NewListDataImportJobsRequest(server string, namespace string, dbName string) (*http.Request, error) {
+	var err error
 
-	// DeleteDatabaseClusterRestoreWithResponse request
-	DeleteDatabaseClusterRestoreWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*DeleteDatabaseClusterRestoreResponse, error)
+	var pathParam0 string
 
-	// GetDatabaseClusterRestoreWithResponse request
-	GetDatabaseClusterRestoreWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterRestoreResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
 
-	// UpdateDatabaseClusterRestoreWithBodyWithResponse request with any body
-	UpdateDatabaseClusterRestoreWithBodyWithResponse(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDatabaseClusterRestoreResponse, error)
+	var pathParam1 string
 
-	UpdateDatabaseClusterRestoreWithResponse(ctx context.Context, namespace string, name string, body UpdateDatabaseClusterRestoreJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDatabaseClusterRestoreResponse, error)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "dbName", runtime.ParamLocationPath, dbName)
+	if err != nil {
+		return nil, err
+	}
 
-	// ListDatabaseClustersWithResponse request
-	ListDatabaseClustersWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*ListDatabaseClustersResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// CreateDatabaseClusterWithBodyWithResponse request with any body
-	CreateDatabaseClusterWithBodyWithResponse(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterResponse, error)
+	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters/%s/data-import-jobs", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	CreateDatabaseClusterWithResponse(ctx context.Context, namespace string, body CreateDatabaseClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// ListDatabaseClusterBackupsWithResponse request
-	ListDatabaseClusterBackupsWithResponse(ctx context.Context, namespace string, clusterName string, reqEditors ...RequestEditorFn) (*ListDatabaseClusterBackupsResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// ListDatabaseClusterRestoresWithResponse request
-	ListDatabaseClusterRestoresWithResponse(ctx context.Context, namespace string, clusterName string, reqEditors ...RequestEditorFn) (*ListDatabaseClusterRestoresResponse, error)
+	return req, nil
+}
 
-	// ListDataImportJobsWithResponse request
-	ListDataImportJobsWithResponse(ctx context.Context, namespace string, dbName string, reqEditors ...RequestEditorFn) (*ListDataImportJobsResponse, error)
+// NewCreateDatabaseClusterSecretRequest calls the generic CreateDatabaseClusterSecret builder with application/json body
+func NewCreateDatabaseClusterSecretRequest(server string, namespace string, dbName string, params *CreateDatabaseClusterSecretParams, body CreateDatabaseClusterSecretJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateDatabaseClusterSecretRequestWithBody(server, namespace, dbName, params, "application/json", bodyReader)
+}
 
-	// CreateDatabaseClusterSecretWithBodyWithResponse request with any body
-	CreateDatabaseClusterSecretWithBodyWithResponse(ctx context.Context, namespace string, dbName string, params *CreateDatabaseClusterSecretParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterSecretResponse, error)
+// NewCreateDatabaseClusterSecretRequestWithBody generates requests for CreateDatabaseClusterSecret with any type of body
+func NewCreateDatabaseClusterSecretRequestWithBody(server string, namespace string, dbName string, params *CreateDatabaseClusterSecretParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	CreateDatabaseClusterSecretWithResponse(ctx context.Context, namespace string, dbName string, params *CreateDatabaseClusterSecretParams, body CreateDatabaseClusterSecretJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterSecretResponse, error)
+	var pathParam0 string
 
-	// DeleteDatabaseClusterWithResponse request
-	DeleteDatabaseClusterWithResponse(ctx context.Context, namespace string, name string, params *DeleteDatabaseClusterParams, reqEditors ...RequestEditorFn) (*DeleteDatabaseClusterResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetDatabaseClusterWithResponse request
-	GetDatabaseClusterWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterResponse, error)
+	var pathParam1 string
 
-	// UpdateDatabaseClusterWithBodyWithResponse request with any body
-	UpdateDatabaseClusterWithBodyWithResponse(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDatabaseClusterResponse, error)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "dbName", runtime.ParamLocationPath, dbName)
+	if err != nil {
+		return nil, err
+	}
 
-	UpdateDatabaseClusterWithResponse(ctx context.Context, namespace string, name string, body UpdateDatabaseClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDatabaseClusterResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetDatabaseClusterComponentsWithResponse request
-	GetDatabaseClusterComponentsWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterComponentsResponse, error)
+	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters/%s/secret", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// GetDatabaseClusterCredentialsWithResponse request
-	GetDatabaseClusterCredentialsWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterCredentialsResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetDatabaseClusterPitrWithResponse request
-	GetDatabaseClusterPitrWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterPitrResponse, error)
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	// ListDatabaseEnginesWithResponse request
-	ListDatabaseEnginesWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*ListDatabaseEnginesResponse, error)
+		if params.SecretName != nil {
 
-	// GetUpgradePlanWithResponse request
-	GetUpgradePlanWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*GetUpgradePlanResponse, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "secretName", runtime.ParamLocationQuery, *params.SecretName); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	// ApproveUpgradePlanWithBodyWithResponse request with any body
-	ApproveUpgradePlanWithBodyWithResponse(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApproveUpgradePlanResponse, error)
+		}
 
-	ApproveUpgradePlanWithResponse(ctx context.Context, namespace string, body ApproveUpgradePlanJSONRequestBody, reqEditors ...RequestEditorFn) (*ApproveUpgradePlanResponse, error)
+		queryURL.RawQuery = queryValues.Encode()
+	}
 
-	// GetDatabaseEngineWithResponse request
-	GetDatabaseEngineWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseEngineResponse, error)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	// UpdateDatabaseEngineWithBodyWithResponse request with any body
-	UpdateDatabaseEngineWithBodyWithResponse(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDatabaseEngineResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	UpdateDatabaseEngineWithResponse(ctx context.Context, namespace string, name string, body UpdateDatabaseEngineJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDatabaseEngineResponse, error)
+	return req, nil
+}
 
-	// ListMonitoringInstancesWithResponse request
-	ListMonitoringInstancesWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*ListMonitoringInstancesResponse, error)
+// NewDeleteDatabaseClusterRequest generates requests for DeleteDatabaseCluster
+func NewDeleteDatabaseClusterRequest(server string, namespace string, name string, params *DeleteDatabaseClusterParams) (*http.Request, error) {
+	var err error
 
-	// CreateMonitoringInstanceWithBodyWithResponse request with any body
-	CreateMonitoringInstanceWithBodyWithResponse(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateMonitoringInstanceResponse, error)
+	var pathParam0 string
 
-	CreateMonitoringInstanceWithResponse(ctx context.Context, namespace string, body CreateMonitoringInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateMonitoringInstanceResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
 
-	// DeleteMonitoringInstanceWithResponse request
-	DeleteMonitoringInstanceWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*DeleteMonitoringInstanceResponse, error)
+	var pathParam1 string
 
-	// GetMonitoringInstanceWithResponse request
-	GetMonitoringInstanceWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetMonitoringInstanceResponse, error)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
 
-	// UpdateMonitoringInstanceWithBodyWithResponse request with any body
-	UpdateMonitoringInstanceWithBodyWithResponse(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateMonitoringInstanceResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	UpdateMonitoringInstanceWithResponse(ctx context.Context, namespace string, name string, body UpdateMonitoringInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateMonitoringInstanceResponse, error)
+	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// GetUserPermissionsWithResponse request
-	GetUserPermissionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUserPermissionsResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// ListPodSchedulingPolicyWithResponse request
-	ListPodSchedulingPolicyWithResponse(ctx context.Context, params *ListPodSchedulingPolicyParams, reqEditors ...RequestEditorFn) (*ListPodSchedulingPolicyResponse, error)
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	// CreatePodSchedulingPolicyWithBodyWithResponse request with any body
-	CreatePodSchedulingPolicyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePodSchedulingPolicyResponse, error)
+		if params.CleanupBackupStorage != nil {
 
-	CreatePodSchedulingPolicyWithResponse(ctx context.Context, body CreatePodSchedulingPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePodSchedulingPolicyResponse, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cleanupBackupStorage", runtime.ParamLocationQuery, *params.CleanupBackupStorage); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	// DeletePodSchedulingPolicyWithResponse request
-	DeletePodSchedulingPolicyWithResponse(ctx context.Context, policyName string, reqEditors ...RequestEditorFn) (*DeletePodSchedulingPolicyResponse, error)
+		}
 
-	// GetPodSchedulingPolicyWithResponse request
-	GetPodSchedulingPolicyWithResponse(ctx context.Context, policyName string, reqEditors ...RequestEditorFn) (*GetPodSchedulingPolicyResponse, error)
+		queryURL.RawQuery = queryValues.Encode()
+	}
 
-	// UpdatePodSchedulingPolicyWithBodyWithResponse request with any body
-	UpdatePodSchedulingPolicyWithBodyWithResponse(ctx context.Context, policyName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePodSchedulingPolicyResponse, error)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	UpdatePodSchedulingPolicyWithResponse(ctx context.Context, policyName string, body UpdatePodSchedulingPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePodSchedulingPolicyResponse, error)
+	return req, nil
+}
 
-	// GetKubernetesClusterResourcesWithResponse request
-	GetKubernetesClusterResourcesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetKubernetesClusterResourcesResponse, error)
+// NewGetDatabaseClusterRequest generates requests for GetDatabaseCluster
+func NewGetDatabaseClusterRequest(server string, namespace string, name string) (*http.Request, error) {
+	var err error
 
-	// DeleteSessionWithResponse request
-	DeleteSessionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeleteSessionResponse, error)
+	var pathParam0 string
 
-	// CreateSessionWithBodyWithResponse request with any body
-	CreateSessionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSessionResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
+	}
 
-	CreateSessionWithResponse(ctx context.Context, body CreateSessionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSessionResponse, error)
+	var pathParam1 string
 
-	// GetSettingsWithResponse request
-	GetSettingsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSettingsResponse, error)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
 
-	// VersionInfoWithResponse request
-	VersionInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*VersionInfoResponse, error)
-}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-type GetKubernetesClusterInfoResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *KubernetesClusterInfo
-	JSON400      *Error
-	JSON500      *Error
-}
+	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetKubernetesClusterInfoResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetKubernetesClusterInfoResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type ListDataImportersResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *DataImporterList
-	JSON400      *Error
-	JSON500      *Error
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r ListDataImportersResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
-
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListDataImportersResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewUpdateDatabaseClusterRequest calls the generic UpdateDatabaseCluster builder with application/json body
+func NewUpdateDatabaseClusterRequest(server string, namespace string, name string, body UpdateDatabaseClusterJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateDatabaseClusterRequestWithBody(server, namespace, name, "application/json", bodyReader)
 }
 
-type ListNamespacesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *NamespaceList
-}
+// NewUpdateDatabaseClusterRequestWithBody generates requests for UpdateDatabaseCluster with any type of body
+func NewUpdateDatabaseClusterRequestWithBody(server string, namespace string, name string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r ListNamespacesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	var pathParam0 string
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListNamespacesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type ListBackupStoragesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *BackupStoragesList
-	JSON400      *Error
-	JSON500      *Error
-}
+	var pathParam1 string
 
-// Status returns HTTPResponse.Status
-func (r ListBackupStoragesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListBackupStoragesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type CreateBackupStorageResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *BackupStorage
-	JSON400      *Error
-	JSON500      *Error
-}
+	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r CreateBackupStorageResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateBackupStorageResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeleteBackupStorageResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *Error
-	JSON500      *Error
-}
+	req.Header.Add("Content-Type", contentType)
 
-// Status returns HTTPResponse.Status
-func (r DeleteBackupStorageResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteBackupStorageResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewGetDatabaseClusterComponentsRequest generates requests for GetDatabaseClusterComponents
+func NewGetDatabaseClusterComponentsRequest(server string, namespace string, name string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetBackupStorageResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *BackupStorage
-	JSON400      *Error
-	JSON500      *Error
-}
+	var pathParam1 string
 
-// Status returns HTTPResponse.Status
-func (r GetBackupStorageResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetBackupStorageResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type UpdateBackupStorageResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *BackupStorage
-	JSON400      *Error
-	JSON500      *Error
-}
+	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters/%s/components", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r UpdateBackupStorageResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateBackupStorageResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type CreateDatabaseClusterBackupResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *DatabaseClusterBackup
-	JSON201      *DatabaseClusterBackup
-	JSON202      *DatabaseClusterBackup
-	JSON400      *Error
-	JSON500      *Error
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r CreateDatabaseClusterBackupResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+// NewGetDatabaseClusterCredentialsRequest generates requests for GetDatabaseClusterCredentials
+func NewGetDatabaseClusterCredentialsRequest(server string, namespace string, name string) (*http.Request, error) {
+	var err error
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateDatabaseClusterBackupResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeleteDatabaseClusterBackupResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *IoK8sApimachineryPkgApisMetaV1StatusV2
-	JSON400      *Error
-	JSON500      *Error
-}
+	var pathParam1 string
 
-// Status returns HTTPResponse.Status
-func (r DeleteDatabaseClusterBackupResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteDatabaseClusterBackupResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetDatabaseClusterBackupResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *DatabaseClusterBackup
-	JSON400      *Error
-	JSON500      *Error
-}
+	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters/%s/credentials", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetDatabaseClusterBackupResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetDatabaseClusterBackupResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type CreateDatabaseClusterRestoreResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *DatabaseClusterRestore
-	JSON201      *DatabaseClusterRestore
-	JSON202      *DatabaseClusterRestore
-	JSON400      *Error
-	JSON500      *Error
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r CreateDatabaseClusterRestoreResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+// NewGetDatabaseClusterPitrRequest generates requests for GetDatabaseClusterPitr
+func NewGetDatabaseClusterPitrRequest(server string, namespace string, name string) (*http.Request, error) {
+	var err error
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateDatabaseClusterRestoreResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeleteDatabaseClusterRestoreResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *IoK8sApimachineryPkgApisMetaV1StatusV2
-	JSON400      *Error
-	JSON500      *Error
-}
+	var pathParam1 string
 
-// Status returns HTTPResponse.Status
-func (r DeleteDatabaseClusterRestoreResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteDatabaseClusterRestoreResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetDatabaseClusterRestoreResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *DatabaseClusterRestore
-	JSON400      *Error
-	JSON500      *Error
-}
+	operationPath := fmt.Sprintf("/namespaces/%s/database-clusters/%s/pitr", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetDatabaseClusterRestoreResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetDatabaseClusterRestoreResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type UpdateDatabaseClusterRestoreResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *DatabaseClusterRestore
-	JSON201      *DatabaseClusterRestore
-	JSON400      *Error
-	JSON500      *Error
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r UpdateDatabaseClusterRestoreResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewListDatabaseEnginesRequest generates requests for ListDatabaseEngines
+func NewListDatabaseEnginesRequest(server string, namespace string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateDatabaseClusterRestoreResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type ListDatabaseClustersResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *DatabaseClusterList
-	JSON400      *Error
-	JSON500      *Error
-}
+	operationPath := fmt.Sprintf("/namespaces/%s/database-engines", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r ListDatabaseClustersResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListDatabaseClustersResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type CreateDatabaseClusterResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *DatabaseCluster
-	JSON201      *DatabaseCluster
-	JSON202      *DatabaseCluster
-	JSON400      *Error
-	JSON500      *Error
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r CreateDatabaseClusterResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewGetUpgradePlanRequest generates requests for GetUpgradePlan
+func NewGetUpgradePlanRequest(server string, namespace string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateDatabaseClusterResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type ListDatabaseClusterBackupsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *DatabaseClusterBackupList
-	JSON400      *Error
-	JSON500      *Error
-}
+	operationPath := fmt.Sprintf("/namespaces/%s/database-engines/upgrade-plan", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r ListDatabaseClusterBackupsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListDatabaseClusterBackupsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type ListDatabaseClusterRestoresResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *DatabaseClusterRestoreList
-	JSON400      *Error
-	JSON500      *Error
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r ListDatabaseClusterRestoresResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewCancelUpgradePlanApprovalRequest generates requests for CancelUpgradePlanApproval
+func NewCancelUpgradePlanApprovalRequest(server string, namespace string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListDatabaseClusterRestoresResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type ListDataImportJobsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *DataImportJobList
-	JSON500      *Error
-}
+	operationPath := fmt.Sprintf("/namespaces/%s/database-engines/upgrade-plan/approval", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r ListDataImportJobsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListDataImportJobsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type CreateDatabaseClusterSecretResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Secret
-	JSON400      *Error
-	JSON500      *Error
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r CreateDatabaseClusterSecretResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewApproveUpgradePlanRequest calls the generic ApproveUpgradePlan builder with application/json body
+func NewApproveUpgradePlanRequest(server string, namespace string, body ApproveUpgradePlanJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewApproveUpgradePlanRequestWithBody(server, namespace, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateDatabaseClusterSecretResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+// NewApproveUpgradePlanRequestWithBody generates requests for ApproveUpgradePlan with any type of body
+func NewApproveUpgradePlanRequestWithBody(server string, namespace string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-type DeleteDatabaseClusterResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *IoK8sApimachineryPkgApisMetaV1StatusV2
-	JSON400      *Error
-	JSON500      *Error
-}
+	var pathParam0 string
 
-// Status returns HTTPResponse.Status
-func (r DeleteDatabaseClusterResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteDatabaseClusterResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetDatabaseClusterResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *DatabaseCluster
-	JSON400      *Error
-	JSON500      *Error
-}
+	operationPath := fmt.Sprintf("/namespaces/%s/database-engines/upgrade-plan/approval", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetDatabaseClusterResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetDatabaseClusterResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type UpdateDatabaseClusterResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *DatabaseCluster
-	JSON400      *Error
-	JSON500      *Error
-}
+	req.Header.Add("Content-Type", contentType)
 
-// Status returns HTTPResponse.Status
-func (r UpdateDatabaseClusterResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateDatabaseClusterResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewGetDatabaseEngineRequest generates requests for GetDatabaseEngine
+func NewGetDatabaseEngineRequest(server string, namespace string, name string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetDatabaseClusterComponentsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *DatabaseClusterComponents
-	JSON400      *Error
-	JSON500      *Error
-}
+	var pathParam1 string
 
-// Status returns HTTPResponse.Status
-func (r GetDatabaseClusterComponentsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetDatabaseClusterComponentsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetDatabaseClusterCredentialsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *DatabaseClusterCredential
-	JSON400      *Error
-	JSON500      *Error
-}
+	operationPath := fmt.Sprintf("/namespaces/%s/database-engines/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetDatabaseClusterCredentialsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetDatabaseClusterCredentialsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetDatabaseClusterPitrResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *DatabaseClusterPitr
-	JSON400      *Error
-	JSON500      *Error
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetDatabaseClusterPitrResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewUpdateDatabaseEngineRequest calls the generic UpdateDatabaseEngine builder with application/json body
+func NewUpdateDatabaseEngineRequest(server string, namespace string, name string, body UpdateDatabaseEngineJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateDatabaseEngineRequestWithBody(server, namespace, name, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetDatabaseClusterPitrResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+// NewUpdateDatabaseEngineRequestWithBody generates requests for UpdateDatabaseEngine with any type of body
+func NewUpdateDatabaseEngineRequestWithBody(server string, namespace string, name string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-type ListDatabaseEnginesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *DatabaseEngineList
-	JSON400      *Error
-	JSON500      *Error
-}
+	var pathParam0 string
 
-// Status returns HTTPResponse.Status
-func (r ListDatabaseEnginesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListDatabaseEnginesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetUpgradePlanResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *UpgradePlan
-	JSON400      *Error
-	JSON500      *Error
-}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetUpgradePlanResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	operationPath := fmt.Sprintf("/namespaces/%s/database-engines/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetUpgradePlanResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
-
-type ApproveUpgradePlanResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *Error
-	JSON500      *Error
-}
 
-// Status returns HTTPResponse.Status
-func (r ApproveUpgradePlanResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ApproveUpgradePlanResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+	req.Header.Add("Content-Type", contentType)
 
-type GetDatabaseEngineResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *DatabaseEngine
-	JSON400      *Error
-	JSON500      *Error
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetDatabaseEngineResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewGetEngineVersionPolicyRequest generates requests for GetEngineVersionPolicy
+func NewGetEngineVersionPolicyRequest(server string, namespace string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetDatabaseEngineResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type UpdateDatabaseEngineResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *DatabaseEngine
-	JSON400      *Error
-	JSON500      *Error
-}
+	operationPath := fmt.Sprintf("/namespaces/%s/engine-version-policy", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r UpdateDatabaseEngineResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateDatabaseEngineResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type ListMonitoringInstancesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *MonitoringInstancesList
-	JSON400      *Error
-	JSON500      *Error
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r ListMonitoringInstancesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewUpdateEngineVersionPolicyRequest calls the generic UpdateEngineVersionPolicy builder with application/json body
+func NewUpdateEngineVersionPolicyRequest(server string, namespace string, body UpdateEngineVersionPolicyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateEngineVersionPolicyRequestWithBody(server, namespace, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListMonitoringInstancesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+// NewUpdateEngineVersionPolicyRequestWithBody generates requests for UpdateEngineVersionPolicy with any type of body
+func NewUpdateEngineVersionPolicyRequestWithBody(server string, namespace string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-type CreateMonitoringInstanceResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *MonitoringInstance
-	JSON400      *Error
-	JSON500      *Error
-}
+	var pathParam0 string
 
-// Status returns HTTPResponse.Status
-func (r CreateMonitoringInstanceResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateMonitoringInstanceResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeleteMonitoringInstanceResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *Error
-	JSON404      *Error
-	JSON500      *Error
-}
+	operationPath := fmt.Sprintf("/namespaces/%s/engine-version-policy", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r DeleteMonitoringInstanceResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteMonitoringInstanceResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetMonitoringInstanceResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *MonitoringInstance
-	JSON400      *Error
-	JSON404      *Error
-	JSON500      *Error
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetMonitoringInstanceResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewGetMaintenanceWindowsRequest generates requests for GetMaintenanceWindows
+func NewGetMaintenanceWindowsRequest(server string, namespace string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetMonitoringInstanceResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type UpdateMonitoringInstanceResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *MonitoringInstance
-	JSON400      *Error
-	JSON404      *Error
-	JSON500      *Error
-}
+	operationPath := fmt.Sprintf("/namespaces/%s/maintenance-windows", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r UpdateMonitoringInstanceResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateMonitoringInstanceResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetUserPermissionsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *UserPermissions
-	JSON400      *Error
-	JSON500      *Error
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetUserPermissionsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewUpdateMaintenanceWindowsRequest calls the generic UpdateMaintenanceWindows builder with application/json body
+func NewUpdateMaintenanceWindowsRequest(server string, namespace string, body UpdateMaintenanceWindowsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateMaintenanceWindowsRequestWithBody(server, namespace, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetUserPermissionsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+// NewUpdateMaintenanceWindowsRequestWithBody generates requests for UpdateMaintenanceWindows with any type of body
+func NewUpdateMaintenanceWindowsRequestWithBody(server string, namespace string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-type ListPodSchedulingPolicyResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *PodSchedulingPolicyList
-	JSON400      *Error
-	JSON500      *Error
-}
+	var pathParam0 string
 
-// Status returns HTTPResponse.Status
-func (r ListPodSchedulingPolicyResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListPodSchedulingPolicyResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type CreatePodSchedulingPolicyResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *PodSchedulingPolicy
-	JSON400      *Error
-	JSON500      *Error
-}
+	operationPath := fmt.Sprintf("/namespaces/%s/maintenance-windows", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r CreatePodSchedulingPolicyResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreatePodSchedulingPolicyResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeletePodSchedulingPolicyResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *IoK8sApimachineryPkgApisMetaV1StatusV2
-	JSON400      *Error
-	JSON500      *Error
-}
+	req.Header.Add("Content-Type", contentType)
 
-// Status returns HTTPResponse.Status
-func (r DeletePodSchedulingPolicyResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeletePodSchedulingPolicyResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+// NewListMonitoringInstancesRequest generates requests for ListMonitoringInstances
+func NewListMonitoringInstancesRequest(server string, namespace string) (*http.Request, error) {
+	var err error
 
-type GetPodSchedulingPolicyResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *PodSchedulingPolicy
-	JSON400      *Error
-	JSON500      *Error
-}
+	var pathParam0 string
 
-// Status returns HTTPResponse.Status
-func (r GetPodSchedulingPolicyResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetPodSchedulingPolicyResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type UpdatePodSchedulingPolicyResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *PodSchedulingPolicy
-	JSON400      *Error
-	JSON500      *Error
-}
+	operationPath := fmt.Sprintf("/namespaces/%s/monitoring-instances", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r UpdatePodSchedulingPolicyResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdatePodSchedulingPolicyResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetKubernetesClusterResourcesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *KubernetesClusterResources
-	JSON400      *Error
-	JSON500      *Error
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetKubernetesClusterResourcesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewCreateMonitoringInstanceRequest calls the generic CreateMonitoringInstance builder with application/json body
+func NewCreateMonitoringInstanceRequest(server string, namespace string, body CreateMonitoringInstanceJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateMonitoringInstanceRequestWithBody(server, namespace, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetKubernetesClusterResourcesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+// NewCreateMonitoringInstanceRequestWithBody generates requests for CreateMonitoringInstance with any type of body
+func NewCreateMonitoringInstanceRequestWithBody(server string, namespace string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-type DeleteSessionResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON429      *Error
-	JSON500      *Error
-}
+	var pathParam0 string
 
-// Status returns HTTPResponse.Status
-func (r DeleteSessionResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteSessionResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type CreateSessionResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		Token *string `json:"token,omitempty"`
+	operationPath := fmt.Sprintf("/namespaces/%s/monitoring-instances", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	JSON400 *Error
-	JSON429 *Error
-	JSON500 *Error
-}
 
-// Status returns HTTPResponse.Status
-func (r CreateSessionResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateSessionResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetSettingsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Settings
-}
+	req.Header.Add("Content-Type", contentType)
 
-// Status returns HTTPResponse.Status
-func (r GetSettingsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetSettingsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+// NewDeleteMonitoringInstanceRequest generates requests for DeleteMonitoringInstance
+func NewDeleteMonitoringInstanceRequest(server string, namespace string, name string) (*http.Request, error) {
+	var err error
 
-type VersionInfoResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Version
-}
+	var pathParam0 string
 
-// Status returns HTTPResponse.Status
-func (r VersionInfoResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r VersionInfoResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+	var pathParam1 string
 
-// GetKubernetesClusterInfoWithResponse request returning *GetKubernetesClusterInfoResponse
-func (c *ClientWithResponses) GetKubernetesClusterInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetKubernetesClusterInfoResponse, error) {
-	rsp, err := c.GetKubernetesClusterInfo(ctx, reqEditors...)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetKubernetesClusterInfoResponse(rsp)
-}
 
-// ListDataImportersWithResponse request returning *ListDataImportersResponse
-func (c *ClientWithResponses) ListDataImportersWithResponse(ctx context.Context, params *ListDataImportersParams, reqEditors ...RequestEditorFn) (*ListDataImportersResponse, error) {
-	rsp, err := c.ListDataImporters(ctx, params, reqEditors...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseListDataImportersResponse(rsp)
-}
 
-// ListNamespacesWithResponse request returning *ListNamespacesResponse
-func (c *ClientWithResponses) ListNamespacesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListNamespacesResponse, error) {
-	rsp, err := c.ListNamespaces(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	operationPath := fmt.Sprintf("/namespaces/%s/monitoring-instances/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return ParseListNamespacesResponse(rsp)
-}
 
-// ListBackupStoragesWithResponse request returning *ListBackupStoragesResponse
-func (c *ClientWithResponses) ListBackupStoragesWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*ListBackupStoragesResponse, error) {
-	rsp, err := c.ListBackupStorages(ctx, namespace, reqEditors...)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseListBackupStoragesResponse(rsp)
-}
 
-// CreateBackupStorageWithBodyWithResponse request with arbitrary body returning *CreateBackupStorageResponse
-func (c *ClientWithResponses) CreateBackupStorageWithBodyWithResponse(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateBackupStorageResponse, error) {
-	rsp, err := c.CreateBackupStorageWithBody(ctx, namespace, contentType, body, reqEditors...)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateBackupStorageResponse(rsp)
+
+	return req, nil
 }
 
-func (c *ClientWithResponses) CreateBackupStorageWithResponse(ctx context.Context, namespace string, body CreateBackupStorageJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateBackupStorageResponse, error) {
-	rsp, err := c.CreateBackupStorage(ctx, namespace, body, reqEditors...)
+// NewGetMonitoringInstanceRequest generates requests for GetMonitoringInstance
+func NewGetMonitoringInstanceRequest(server string, namespace string, name string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateBackupStorageResponse(rsp)
-}
 
-// DeleteBackupStorageWithResponse request returning *DeleteBackupStorageResponse
-func (c *ClientWithResponses) DeleteBackupStorageWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*DeleteBackupStorageResponse, error) {
-	rsp, err := c.DeleteBackupStorage(ctx, namespace, name, reqEditors...)
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteBackupStorageResponse(rsp)
-}
 
-// GetBackupStorageWithResponse request returning *GetBackupStorageResponse
-func (c *ClientWithResponses) GetBackupStorageWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetBackupStorageResponse, error) {
-	rsp, err := c.GetBackupStorage(ctx, namespace, name, reqEditors...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetBackupStorageResponse(rsp)
-}
 
-// UpdateBackupStorageWithBodyWithResponse request with arbitrary body returning *UpdateBackupStorageResponse
-func (c *ClientWithResponses) UpdateBackupStorageWithBodyWithResponse(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateBackupStorageResponse, error) {
-	rsp, err := c.UpdateBackupStorageWithBody(ctx, namespace, name, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	operationPath := fmt.Sprintf("/namespaces/%s/monitoring-instances/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return ParseUpdateBackupStorageResponse(rsp)
-}
 
-func (c *ClientWithResponses) UpdateBackupStorageWithResponse(ctx context.Context, namespace string, name string, body UpdateBackupStorageJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateBackupStorageResponse, error) {
-	rsp, err := c.UpdateBackupStorage(ctx, namespace, name, body, reqEditors...)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateBackupStorageResponse(rsp)
-}
 
-// CreateDatabaseClusterBackupWithBodyWithResponse request with arbitrary body returning *CreateDatabaseClusterBackupResponse
-func (c *ClientWithResponses) CreateDatabaseClusterBackupWithBodyWithResponse(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterBackupResponse, error) {
-	rsp, err := c.CreateDatabaseClusterBackupWithBody(ctx, namespace, contentType, body, reqEditors...)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateDatabaseClusterBackupResponse(rsp)
+
+	return req, nil
 }
 
-func (c *ClientWithResponses) CreateDatabaseClusterBackupWithResponse(ctx context.Context, namespace string, body CreateDatabaseClusterBackupJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterBackupResponse, error) {
-	rsp, err := c.CreateDatabaseClusterBackup(ctx, namespace, body, reqEditors...)
+// NewUpdateMonitoringInstanceRequest calls the generic UpdateMonitoringInstance builder with application/json body
+func NewUpdateMonitoringInstanceRequest(server string, namespace string, name string, body UpdateMonitoringInstanceJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateDatabaseClusterBackupResponse(rsp)
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateMonitoringInstanceRequestWithBody(server, namespace, name, "application/json", bodyReader)
 }
 
-// DeleteDatabaseClusterBackupWithResponse request returning *DeleteDatabaseClusterBackupResponse
-func (c *ClientWithResponses) DeleteDatabaseClusterBackupWithResponse(ctx context.Context, namespace string, name string, params *DeleteDatabaseClusterBackupParams, reqEditors ...RequestEditorFn) (*DeleteDatabaseClusterBackupResponse, error) {
-	rsp, err := c.DeleteDatabaseClusterBackup(ctx, namespace, name, params, reqEditors...)
+// NewUpdateMonitoringInstanceRequestWithBody generates requests for UpdateMonitoringInstance with any type of body
+func NewUpdateMonitoringInstanceRequestWithBody(server string, namespace string, name string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "namespace", runtime.ParamLocationPath, namespace)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteDatabaseClusterBackupResponse(rsp)
-}
 
-// GetDatabaseClusterBackupWithResponse request returning *GetDatabaseClusterBackupResponse
-func (c *ClientWithResponses) GetDatabaseClusterBackupWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterBackupResponse, error) {
-	rsp, err := c.GetDatabaseClusterBackup(ctx, namespace, name, reqEditors...)
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetDatabaseClusterBackupResponse(rsp)
-}
 
-// CreateDatabaseClusterRestoreWithBodyWithResponse request with arbitrary body returning *CreateDatabaseClusterRestoreResponse
-func (c *ClientWithResponses) CreateDatabaseClusterRestoreWithBodyWithResponse(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterRestoreResponse, error) {
-	rsp, err := c.CreateDatabaseClusterRestoreWithBody(ctx, namespace, contentType, body, reqEditors...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateDatabaseClusterRestoreResponse(rsp)
-}
 
-func (c *ClientWithResponses) CreateDatabaseClusterRestoreWithResponse(ctx context.Context, namespace string, body CreateDatabaseClusterRestoreJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterRestoreResponse, error) {
-	rsp, err := c.CreateDatabaseClusterRestore(ctx, namespace, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	operationPath := fmt.Sprintf("/namespaces/%s/monitoring-instances/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return ParseCreateDatabaseClusterRestoreResponse(rsp)
-}
 
-// DeleteDatabaseClusterRestoreWithResponse request returning *DeleteDatabaseClusterRestoreResponse
-func (c *ClientWithResponses) DeleteDatabaseClusterRestoreWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*DeleteDatabaseClusterRestoreResponse, error) {
-	rsp, err := c.DeleteDatabaseClusterRestore(ctx, namespace, name, reqEditors...)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteDatabaseClusterRestoreResponse(rsp)
-}
 
-// GetDatabaseClusterRestoreWithResponse request returning *GetDatabaseClusterRestoreResponse
-func (c *ClientWithResponses) GetDatabaseClusterRestoreWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterRestoreResponse, error) {
-	rsp, err := c.GetDatabaseClusterRestore(ctx, namespace, name, reqEditors...)
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetDatabaseClusterRestoreResponse(rsp)
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// UpdateDatabaseClusterRestoreWithBodyWithResponse request with arbitrary body returning *UpdateDatabaseClusterRestoreResponse
-func (c *ClientWithResponses) UpdateDatabaseClusterRestoreWithBodyWithResponse(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDatabaseClusterRestoreResponse, error) {
-	rsp, err := c.UpdateDatabaseClusterRestoreWithBody(ctx, namespace, name, contentType, body, reqEditors...)
+// NewGetUserPermissionsRequest generates requests for GetUserPermissions
+func NewGetUserPermissionsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateDatabaseClusterRestoreResponse(rsp)
-}
 
-func (c *ClientWithResponses) UpdateDatabaseClusterRestoreWithResponse(ctx context.Context, namespace string, name string, body UpdateDatabaseClusterRestoreJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDatabaseClusterRestoreResponse, error) {
-	rsp, err := c.UpdateDatabaseClusterRestore(ctx, namespace, name, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	operationPath := fmt.Sprintf("/permissions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return ParseUpdateDatabaseClusterRestoreResponse(rsp)
-}
 
-// ListDatabaseClustersWithResponse request returning *ListDatabaseClustersResponse
-func (c *ClientWithResponses) ListDatabaseClustersWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*ListDatabaseClustersResponse, error) {
-	rsp, err := c.ListDatabaseClusters(ctx, namespace, reqEditors...)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseListDatabaseClustersResponse(rsp)
-}
 
-// CreateDatabaseClusterWithBodyWithResponse request with arbitrary body returning *CreateDatabaseClusterResponse
-func (c *ClientWithResponses) CreateDatabaseClusterWithBodyWithResponse(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterResponse, error) {
-	rsp, err := c.CreateDatabaseClusterWithBody(ctx, namespace, contentType, body, reqEditors...)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateDatabaseClusterResponse(rsp)
+
+	return req, nil
 }
 
-func (c *ClientWithResponses) CreateDatabaseClusterWithResponse(ctx context.Context, namespace string, body CreateDatabaseClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterResponse, error) {
-	rsp, err := c.CreateDatabaseCluster(ctx, namespace, body, reqEditors...)
+// NewExplainPermissionRequest calls the generic ExplainPermission builder with application/json body
+func NewExplainPermissionRequest(server string, body ExplainPermissionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateDatabaseClusterResponse(rsp)
+	bodyReader = bytes.NewReader(buf)
+	return NewExplainPermissionRequestWithBody(server, "application/json", bodyReader)
 }
 
-// ListDatabaseClusterBackupsWithResponse request returning *ListDatabaseClusterBackupsResponse
-func (c *ClientWithResponses) ListDatabaseClusterBackupsWithResponse(ctx context.Context, namespace string, clusterName string, reqEditors ...RequestEditorFn) (*ListDatabaseClusterBackupsResponse, error) {
-	rsp, err := c.ListDatabaseClusterBackups(ctx, namespace, clusterName, reqEditors...)
+// NewExplainPermissionRequestWithBody generates requests for ExplainPermission with any type of body
+func NewExplainPermissionRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseListDatabaseClusterBackupsResponse(rsp)
-}
 
-// ListDatabaseClusterRestoresWithResponse request returning *ListDatabaseClusterRestoresResponse
-func (c *ClientWithResponses) ListDatabaseClusterRestoresWithResponse(ctx context.Context, namespace string, clusterName string, reqEditors ...RequestEditorFn) (*ListDatabaseClusterRestoresResponse, error) {
-	rsp, err := c.ListDatabaseClusterRestores(ctx, namespace, clusterName, reqEditors...)
-	if err != nil {
-		return nil, err
+	operationPath := fmt.Sprintf("/permissions/explain")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return ParseListDatabaseClusterRestoresResponse(rsp)
-}
 
-// ListDataImportJobsWithResponse request returning *ListDataImportJobsResponse
-func (c *ClientWithResponses) ListDataImportJobsWithResponse(ctx context.Context, namespace string, dbName string, reqEditors ...RequestEditorFn) (*ListDataImportJobsResponse, error) {
-	rsp, err := c.ListDataImportJobs(ctx, namespace, dbName, reqEditors...)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseListDataImportJobsResponse(rsp)
-}
 
-// CreateDatabaseClusterSecretWithBodyWithResponse request with arbitrary body returning *CreateDatabaseClusterSecretResponse
-func (c *ClientWithResponses) CreateDatabaseClusterSecretWithBodyWithResponse(ctx context.Context, namespace string, dbName string, params *CreateDatabaseClusterSecretParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterSecretResponse, error) {
-	rsp, err := c.CreateDatabaseClusterSecretWithBody(ctx, namespace, dbName, params, contentType, body, reqEditors...)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateDatabaseClusterSecretResponse(rsp)
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-func (c *ClientWithResponses) CreateDatabaseClusterSecretWithResponse(ctx context.Context, namespace string, dbName string, params *CreateDatabaseClusterSecretParams, body CreateDatabaseClusterSecretJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterSecretResponse, error) {
-	rsp, err := c.CreateDatabaseClusterSecret(ctx, namespace, dbName, params, body, reqEditors...)
+// NewGetUserPermissionMatrixRequest generates requests for GetUserPermissionMatrix
+func NewGetUserPermissionMatrixRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateDatabaseClusterSecretResponse(rsp)
-}
 
-// DeleteDatabaseClusterWithResponse request returning *DeleteDatabaseClusterResponse
-func (c *ClientWithResponses) DeleteDatabaseClusterWithResponse(ctx context.Context, namespace string, name string, params *DeleteDatabaseClusterParams, reqEditors ...RequestEditorFn) (*DeleteDatabaseClusterResponse, error) {
-	rsp, err := c.DeleteDatabaseCluster(ctx, namespace, name, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	operationPath := fmt.Sprintf("/permissions/matrix")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return ParseDeleteDatabaseClusterResponse(rsp)
-}
 
-// GetDatabaseClusterWithResponse request returning *GetDatabaseClusterResponse
-func (c *ClientWithResponses) GetDatabaseClusterWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterResponse, error) {
-	rsp, err := c.GetDatabaseCluster(ctx, namespace, name, reqEditors...)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetDatabaseClusterResponse(rsp)
-}
 
-// UpdateDatabaseClusterWithBodyWithResponse request with arbitrary body returning *UpdateDatabaseClusterResponse
-func (c *ClientWithResponses) UpdateDatabaseClusterWithBodyWithResponse(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDatabaseClusterResponse, error) {
-	rsp, err := c.UpdateDatabaseClusterWithBody(ctx, namespace, name, contentType, body, reqEditors...)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateDatabaseClusterResponse(rsp)
+
+	return req, nil
 }
 
-func (c *ClientWithResponses) UpdateDatabaseClusterWithResponse(ctx context.Context, namespace string, name string, body UpdateDatabaseClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDatabaseClusterResponse, error) {
-	rsp, err := c.UpdateDatabaseCluster(ctx, namespace, name, body, reqEditors...)
+// NewListPodSchedulingPolicyRequest generates requests for ListPodSchedulingPolicy
+func NewListPodSchedulingPolicyRequest(server string, params *ListPodSchedulingPolicyParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateDatabaseClusterResponse(rsp)
-}
 
-// GetDatabaseClusterComponentsWithResponse request returning *GetDatabaseClusterComponentsResponse
-func (c *ClientWithResponses) GetDatabaseClusterComponentsWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterComponentsResponse, error) {
-	rsp, err := c.GetDatabaseClusterComponents(ctx, namespace, name, reqEditors...)
+	operationPath := fmt.Sprintf("/pod-scheduling-policies")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetDatabaseClusterComponentsResponse(rsp)
-}
 
-// GetDatabaseClusterCredentialsWithResponse request returning *GetDatabaseClusterCredentialsResponse
-func (c *ClientWithResponses) GetDatabaseClusterCredentialsWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterCredentialsResponse, error) {
-	rsp, err := c.GetDatabaseClusterCredentials(ctx, namespace, name, reqEditors...)
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.EngineType != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "engineType", runtime.ParamLocationQuery, *params.EngineType); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.HasRules != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "hasRules", runtime.ParamLocationQuery, *params.HasRules); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetDatabaseClusterCredentialsResponse(rsp)
+
+	return req, nil
 }
 
-// GetDatabaseClusterPitrWithResponse request returning *GetDatabaseClusterPitrResponse
-func (c *ClientWithResponses) GetDatabaseClusterPitrWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterPitrResponse, error) {
-	rsp, err := c.GetDatabaseClusterPitr(ctx, namespace, name, reqEditors...)
+// NewCreatePodSchedulingPolicyRequest calls the generic CreatePodSchedulingPolicy builder with application/json body
+func NewCreatePodSchedulingPolicyRequest(server string, body CreatePodSchedulingPolicyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetDatabaseClusterPitrResponse(rsp)
+	bodyReader = bytes.NewReader(buf)
+	return NewCreatePodSchedulingPolicyRequestWithBody(server, "application/json", bodyReader)
 }
 
-// ListDatabaseEnginesWithResponse request returning *ListDatabaseEnginesResponse
-func (c *ClientWithResponses) ListDatabaseEnginesWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*ListDatabaseEnginesResponse, error) {
-	rsp, err := c.ListDatabaseEngines(ctx, namespace, reqEditors...)
+// NewCreatePodSchedulingPolicyRequestWithBody generates requests for CreatePodSchedulingPolicy with any type of body
+func NewCreatePodSchedulingPolicyRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseListDatabaseEnginesResponse(rsp)
-}
 
-// GetUpgradePlanWithResponse request returning *GetUpgradePlanResponse
-func (c *ClientWithResponses) GetUpgradePlanWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*GetUpgradePlanResponse, error) {
-	rsp, err := c.GetUpgradePlan(ctx, namespace, reqEditors...)
+	operationPath := fmt.Sprintf("/pod-scheduling-policies")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetUpgradePlanResponse(rsp)
-}
 
-// ApproveUpgradePlanWithBodyWithResponse request with arbitrary body returning *ApproveUpgradePlanResponse
-func (c *ClientWithResponses) ApproveUpgradePlanWithBodyWithResponse(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApproveUpgradePlanResponse, error) {
-	rsp, err := c.ApproveUpgradePlanWithBody(ctx, namespace, contentType, body, reqEditors...)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseApproveUpgradePlanResponse(rsp)
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-func (c *ClientWithResponses) ApproveUpgradePlanWithResponse(ctx context.Context, namespace string, body ApproveUpgradePlanJSONRequestBody, reqEditors ...RequestEditorFn) (*ApproveUpgradePlanResponse, error) {
-	rsp, err := c.ApproveUpgradePlan(ctx, namespace, body, reqEditors...)
+// NewDeletePodSchedulingPolicyRequest generates requests for DeletePodSchedulingPolicy
+func NewDeletePodSchedulingPolicyRequest(server string, policyName string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "policy-name", runtime.ParamLocationPath, policyName)
 	if err != nil {
 		return nil, err
 	}
-	return ParseApproveUpgradePlanResponse(rsp)
-}
 
-// GetDatabaseEngineWithResponse request returning *GetDatabaseEngineResponse
-func (c *ClientWithResponses) GetDatabaseEngineWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseEngineResponse, error) {
-	rsp, err := c.GetDatabaseEngine(ctx, namespace, name, reqEditors...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetDatabaseEngineResponse(rsp)
-}
 
-// UpdateDatabaseEngineWithBodyWithResponse request with arbitrary body returning *UpdateDatabaseEngineResponse
-func (c *ClientWithResponses) UpdateDatabaseEngineWithBodyWithResponse(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDatabaseEngineResponse, error) {
-	rsp, err := c.UpdateDatabaseEngineWithBody(ctx, namespace, name, contentType, body, reqEditors...)
+	operationPath := fmt.Sprintf("/pod-scheduling-policies/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateDatabaseEngineResponse(rsp)
-}
 
-func (c *ClientWithResponses) UpdateDatabaseEngineWithResponse(ctx context.Context, namespace string, name string, body UpdateDatabaseEngineJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDatabaseEngineResponse, error) {
-	rsp, err := c.UpdateDatabaseEngine(ctx, namespace, name, body, reqEditors...)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateDatabaseEngineResponse(rsp)
+
+	return req, nil
 }
 
-// ListMonitoringInstancesWithResponse request returning *ListMonitoringInstancesResponse
-func (c *ClientWithResponses) ListMonitoringInstancesWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*ListMonitoringInstancesResponse, error) {
-	rsp, err := c.ListMonitoringInstances(ctx, namespace, reqEditors...)
+// NewGetPodSchedulingPolicyRequest generates requests for GetPodSchedulingPolicy
+func NewGetPodSchedulingPolicyRequest(server string, policyName string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "policy-name", runtime.ParamLocationPath, policyName)
 	if err != nil {
 		return nil, err
 	}
-	return ParseListMonitoringInstancesResponse(rsp)
-}
 
-// CreateMonitoringInstanceWithBodyWithResponse request with arbitrary body returning *CreateMonitoringInstanceResponse
-func (c *ClientWithResponses) CreateMonitoringInstanceWithBodyWithResponse(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateMonitoringInstanceResponse, error) {
-	rsp, err := c.CreateMonitoringInstanceWithBody(ctx, namespace, contentType, body, reqEditors...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateMonitoringInstanceResponse(rsp)
-}
 
-func (c *ClientWithResponses) CreateMonitoringInstanceWithResponse(ctx context.Context, namespace string, body CreateMonitoringInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateMonitoringInstanceResponse, error) {
-	rsp, err := c.CreateMonitoringInstance(ctx, namespace, body, reqEditors...)
+	operationPath := fmt.Sprintf("/pod-scheduling-policies/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateMonitoringInstanceResponse(rsp)
-}
 
-// DeleteMonitoringInstanceWithResponse request returning *DeleteMonitoringInstanceResponse
-func (c *ClientWithResponses) DeleteMonitoringInstanceWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*DeleteMonitoringInstanceResponse, error) {
-	rsp, err := c.DeleteMonitoringInstance(ctx, namespace, name, reqEditors...)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteMonitoringInstanceResponse(rsp)
+
+	return req, nil
 }
 
-// GetMonitoringInstanceWithResponse request returning *GetMonitoringInstanceResponse
-func (c *ClientWithResponses) GetMonitoringInstanceWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetMonitoringInstanceResponse, error) {
-	rsp, err := c.GetMonitoringInstance(ctx, namespace, name, reqEditors...)
+// NewUpdatePodSchedulingPolicyRequest calls the generic UpdatePodSchedulingPolicy builder with application/json body
+func NewUpdatePodSchedulingPolicyRequest(server string, policyName string, body UpdatePodSchedulingPolicyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetMonitoringInstanceResponse(rsp)
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdatePodSchedulingPolicyRequestWithBody(server, policyName, "application/json", bodyReader)
 }
 
-// UpdateMonitoringInstanceWithBodyWithResponse request with arbitrary body returning *UpdateMonitoringInstanceResponse
-func (c *ClientWithResponses) UpdateMonitoringInstanceWithBodyWithResponse(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateMonitoringInstanceResponse, error) {
-	rsp, err := c.UpdateMonitoringInstanceWithBody(ctx, namespace, name, contentType, body, reqEditors...)
+// NewUpdatePodSchedulingPolicyRequestWithBody generates requests for UpdatePodSchedulingPolicy with any type of body
+func NewUpdatePodSchedulingPolicyRequestWithBody(server string, policyName string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "policy-name", runtime.ParamLocationPath, policyName)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateMonitoringInstanceResponse(rsp)
-}
 
-func (c *ClientWithResponses) UpdateMonitoringInstanceWithResponse(ctx context.Context, namespace string, name string, body UpdateMonitoringInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateMonitoringInstanceResponse, error) {
-	rsp, err := c.UpdateMonitoringInstance(ctx, namespace, name, body, reqEditors...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateMonitoringInstanceResponse(rsp)
+
+	operationPath := fmt.Sprintf("/pod-scheduling-policies/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// GetUserPermissionsWithResponse request returning *GetUserPermissionsResponse
-func (c *ClientWithResponses) GetUserPermissionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUserPermissionsResponse, error) {
-	rsp, err := c.GetUserPermissions(ctx, reqEditors...)
+// NewGetKubernetesClusterResourcesRequest generates requests for GetKubernetesClusterResources
+func NewGetKubernetesClusterResourcesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetUserPermissionsResponse(rsp)
+
+	operationPath := fmt.Sprintf("/resources")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
 }
 
-// ListPodSchedulingPolicyWithResponse request returning *ListPodSchedulingPolicyResponse
-func (c *ClientWithResponses) ListPodSchedulingPolicyWithResponse(ctx context.Context, params *ListPodSchedulingPolicyParams, reqEditors ...RequestEditorFn) (*ListPodSchedulingPolicyResponse, error) {
-	rsp, err := c.ListPodSchedulingPolicy(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+// NewDeleteSessionRequest generates requests for DeleteSession
+func NewDeleteSessionRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/session")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateSessionRequest calls the generic CreateSession builder with application/json body
+func NewCreateSessionRequest(server string, body CreateSessionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateSessionRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateSessionRequestWithBody generates requests for CreateSession with any type of body
+func NewCreateSessionRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/session")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewChangePasswordRequest calls the generic ChangePassword builder with application/json body
+func NewChangePasswordRequest(server string, body ChangePasswordJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewChangePasswordRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewChangePasswordRequestWithBody generates requests for ChangePassword with any type of body
+func NewChangePasswordRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/session/password")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRefreshSessionRequest generates requests for RefreshSession
+func NewRefreshSessionRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/session/refresh")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetSettingsRequest generates requests for GetSettings
+func NewGetSettingsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/settings")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetOIDCClaimMappingRequest generates requests for GetOIDCClaimMapping
+func NewGetOIDCClaimMappingRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/settings/oidc/claims")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateOIDCClaimMappingRequest calls the generic UpdateOIDCClaimMapping builder with application/json body
+func NewUpdateOIDCClaimMappingRequest(server string, body UpdateOIDCClaimMappingJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateOIDCClaimMappingRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewUpdateOIDCClaimMappingRequestWithBody generates requests for UpdateOIDCClaimMapping with any type of body
+func NewUpdateOIDCClaimMappingRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/settings/oidc/claims")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDiffRBACPolicyVersionsRequest generates requests for DiffRBACPolicyVersions
+func NewDiffRBACPolicyVersionsRequest(server string, params *DiffRBACPolicyVersionsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/settings/rbac/policy-diff")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, params.From); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, params.To); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListRBACPolicyVersionsRequest generates requests for ListRBACPolicyVersions
+func NewListRBACPolicyVersionsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/settings/rbac/policy-versions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetRBACPolicyVersionRequest generates requests for GetRBACPolicyVersion
+func NewGetRBACPolicyVersionRequest(server string, version int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, version)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/settings/rbac/policy-versions/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRollbackRBACPolicyRequest generates requests for RollbackRBACPolicy
+func NewRollbackRBACPolicyRequest(server string, version int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, version)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/settings/rbac/policy-versions/%s/rollback", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListTemporaryGrantsRequest generates requests for ListTemporaryGrants
+func NewListTemporaryGrantsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/settings/rbac/temporary-grants")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateTemporaryGrantRequest calls the generic CreateTemporaryGrant builder with application/json body
+func NewCreateTemporaryGrantRequest(server string, body CreateTemporaryGrantJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateTemporaryGrantRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateTemporaryGrantRequestWithBody generates requests for CreateTemporaryGrant with any type of body
+func NewCreateTemporaryGrantRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/settings/rbac/temporary-grants")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetTelemetryRequest generates requests for GetTelemetry
+func NewGetTelemetryRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/telemetry")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewVersionInfoRequest generates requests for VersionInfo
+func NewVersionInfoRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/version")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// ListAccountsWithResponse request
+	ListAccountsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListAccountsResponse, error)
+
+	// CreateAccountWithBodyWithResponse request with any body
+	CreateAccountWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAccountResponse, error)
+
+	CreateAccountWithResponse(ctx context.Context, body CreateAccountJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAccountResponse, error)
+
+	// DeleteAccountWithResponse request
+	DeleteAccountWithResponse(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*DeleteAccountResponse, error)
+
+	// GetAccountWithResponse request
+	GetAccountWithResponse(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*GetAccountResponse, error)
+
+	// UpdateAccountWithBodyWithResponse request with any body
+	UpdateAccountWithBodyWithResponse(ctx context.Context, username string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAccountResponse, error)
+
+	UpdateAccountWithResponse(ctx context.Context, username string, body UpdateAccountJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAccountResponse, error)
+
+	// ResetAccountMFAWithResponse request
+	ResetAccountMFAWithResponse(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*ResetAccountMFAResponse, error)
+
+	// SetAccountPasswordWithBodyWithResponse request with any body
+	SetAccountPasswordWithBodyWithResponse(ctx context.Context, username string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetAccountPasswordResponse, error)
+
+	SetAccountPasswordWithResponse(ctx context.Context, username string, body SetAccountPasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*SetAccountPasswordResponse, error)
+
+	// DeleteUserSessionsWithResponse request
+	DeleteUserSessionsWithResponse(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*DeleteUserSessionsResponse, error)
+
+	// ListUserSessionsWithResponse request
+	ListUserSessionsWithResponse(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*ListUserSessionsResponse, error)
+
+	// DeleteUserSessionWithResponse request
+	DeleteUserSessionWithResponse(ctx context.Context, username string, sessionId string, reqEditors ...RequestEditorFn) (*DeleteUserSessionResponse, error)
+
+	// UnlockAccountWithResponse request
+	UnlockAccountWithResponse(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*UnlockAccountResponse, error)
+
+	// ListAPIKeysWithResponse request
+	ListAPIKeysWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListAPIKeysResponse, error)
+
+	// CreateAPIKeyWithBodyWithResponse request with any body
+	CreateAPIKeyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAPIKeyResponse, error)
+
+	CreateAPIKeyWithResponse(ctx context.Context, body CreateAPIKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAPIKeyResponse, error)
+
+	// DeleteAPIKeyWithResponse request
+	DeleteAPIKeyWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*DeleteAPIKeyResponse, error)
+
+	// GetKubernetesClusterInfoWithResponse request
+	GetKubernetesClusterInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetKubernetesClusterInfoResponse, error)
+
+	// ListDataImportersWithResponse request
+	ListDataImportersWithResponse(ctx context.Context, params *ListDataImportersParams, reqEditors ...RequestEditorFn) (*ListDataImportersResponse, error)
+
+	// CreateDataImporterWithBodyWithResponse request with any body
+	CreateDataImporterWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDataImporterResponse, error)
+
+	CreateDataImporterWithResponse(ctx context.Context, body CreateDataImporterJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDataImporterResponse, error)
+
+	// DeleteDataImporterWithResponse request
+	DeleteDataImporterWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*DeleteDataImporterResponse, error)
+
+	// UpdateDataImporterWithBodyWithResponse request with any body
+	UpdateDataImporterWithBodyWithResponse(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDataImporterResponse, error)
+
+	UpdateDataImporterWithResponse(ctx context.Context, name string, body UpdateDataImporterJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDataImporterResponse, error)
+
+	// ListNamespacesWithResponse request
+	ListNamespacesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListNamespacesResponse, error)
+
+	// ListBackupStoragesWithResponse request
+	ListBackupStoragesWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*ListBackupStoragesResponse, error)
+
+	// CreateBackupStorageWithBodyWithResponse request with any body
+	CreateBackupStorageWithBodyWithResponse(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateBackupStorageResponse, error)
+
+	CreateBackupStorageWithResponse(ctx context.Context, namespace string, body CreateBackupStorageJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateBackupStorageResponse, error)
+
+	// DeleteBackupStorageWithResponse request
+	DeleteBackupStorageWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*DeleteBackupStorageResponse, error)
+
+	// GetBackupStorageWithResponse request
+	GetBackupStorageWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetBackupStorageResponse, error)
+
+	// UpdateBackupStorageWithBodyWithResponse request with any body
+	UpdateBackupStorageWithBodyWithResponse(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateBackupStorageResponse, error)
+
+	UpdateBackupStorageWithResponse(ctx context.Context, namespace string, name string, body UpdateBackupStorageJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateBackupStorageResponse, error)
+
+	// CreateDataImportJobWithBodyWithResponse request with any body
+	CreateDataImportJobWithBodyWithResponse(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDataImportJobResponse, error)
+
+	CreateDataImportJobWithResponse(ctx context.Context, namespace string, body CreateDataImportJobJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDataImportJobResponse, error)
+
+	// DeleteDataImportJobWithResponse request
+	DeleteDataImportJobWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*DeleteDataImportJobResponse, error)
+
+	// GetDataImportJobWithResponse request
+	GetDataImportJobWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDataImportJobResponse, error)
+
+	// CancelDataImportJobWithResponse request
+	CancelDataImportJobWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*CancelDataImportJobResponse, error)
+
+	// GetDataImportJobProgressWithResponse request
+	GetDataImportJobProgressWithResponse(ctx context.Context, namespace string, name string, params *GetDataImportJobProgressParams, reqEditors ...RequestEditorFn) (*GetDataImportJobProgressResponse, error)
+
+	// CreateDatabaseClusterBackupWithBodyWithResponse request with any body
+	CreateDatabaseClusterBackupWithBodyWithResponse(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterBackupResponse, error)
+
+	CreateDatabaseClusterBackupWithResponse(ctx context.Context, namespace string, body CreateDatabaseClusterBackupJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterBackupResponse, error)
+
+	// DeleteDatabaseClusterBackupWithResponse request
+	DeleteDatabaseClusterBackupWithResponse(ctx context.Context, namespace string, name string, params *DeleteDatabaseClusterBackupParams, reqEditors ...RequestEditorFn) (*DeleteDatabaseClusterBackupResponse, error)
+
+	// GetDatabaseClusterBackupWithResponse request
+	GetDatabaseClusterBackupWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterBackupResponse, error)
+
+	// CreateDatabaseClusterRestoreWithBodyWithResponse request with any body
+	CreateDatabaseClusterRestoreWithBodyWithResponse(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterRestoreResponse, error)
+
+	CreateDatabaseClusterRestoreWithResponse(ctx context.Context, namespace string, body CreateDatabaseClusterRestoreJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterRestoreResponse, error)
+
+	// DeleteDatabaseClusterRestoreWithResponse request
+	DeleteDatabaseClusterRestoreWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*DeleteDatabaseClusterRestoreResponse, error)
+
+	// GetDatabaseClusterRestoreWithResponse request
+	GetDatabaseClusterRestoreWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterRestoreResponse, error)
+
+	// UpdateDatabaseClusterRestoreWithBodyWithResponse request with any body
+	UpdateDatabaseClusterRestoreWithBodyWithResponse(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDatabaseClusterRestoreResponse, error)
+
+	UpdateDatabaseClusterRestoreWithResponse(ctx context.Context, namespace string, name string, body UpdateDatabaseClusterRestoreJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDatabaseClusterRestoreResponse, error)
+
+	// ListDatabaseClustersWithResponse request
+	ListDatabaseClustersWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*ListDatabaseClustersResponse, error)
+
+	// CreateDatabaseClusterWithBodyWithResponse request with any body
+	CreateDatabaseClusterWithBodyWithResponse(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterResponse, error)
+
+	CreateDatabaseClusterWithResponse(ctx context.Context, namespace string, body CreateDatabaseClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterResponse, error)
+
+	// ListDatabaseClusterBackupsWithResponse request
+	ListDatabaseClusterBackupsWithResponse(ctx context.Context, namespace string, clusterName string, reqEditors ...RequestEditorFn) (*ListDatabaseClusterBackupsResponse, error)
+
+	// ListDatabaseClusterRestoresWithResponse request
+	ListDatabaseClusterRestoresWithResponse(ctx context.Context, namespace string, clusterName string, reqEditors ...RequestEditorFn) (*ListDatabaseClusterRestoresResponse, error)
+
+	// ListDataImportJobsWithResponse request
+	ListDataImportJobsWithResponse(ctx context.Context, namespace string, dbName string, reqEditors ...RequestEditorFn) (*ListDataImportJobsResponse, error)
+
+	// CreateDatabaseClusterSecretWithBodyWithResponse request with any body
+	CreateDatabaseClusterSecretWithBodyWithResponse(ctx context.Context, namespace string, dbName string, params *CreateDatabaseClusterSecretParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterSecretResponse, error)
+
+	CreateDatabaseClusterSecretWithResponse(ctx context.Context, namespace string, dbName string, params *CreateDatabaseClusterSecretParams, body CreateDatabaseClusterSecretJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterSecretResponse, error)
+
+	// DeleteDatabaseClusterWithResponse request
+	DeleteDatabaseClusterWithResponse(ctx context.Context, namespace string, name string, params *DeleteDatabaseClusterParams, reqEditors ...RequestEditorFn) (*DeleteDatabaseClusterResponse, error)
+
+	// GetDatabaseClusterWithResponse request
+	GetDatabaseClusterWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterResponse, error)
+
+	// UpdateDatabaseClusterWithBodyWithResponse request with any body
+	UpdateDatabaseClusterWithBodyWithResponse(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDatabaseClusterResponse, error)
+
+	UpdateDatabaseClusterWithResponse(ctx context.Context, namespace string, name string, body UpdateDatabaseClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDatabaseClusterResponse, error)
+
+	// GetDatabaseClusterComponentsWithResponse request
+	GetDatabaseClusterComponentsWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterComponentsResponse, error)
+
+	// GetDatabaseClusterCredentialsWithResponse request
+	GetDatabaseClusterCredentialsWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterCredentialsResponse, error)
+
+	// GetDatabaseClusterPitrWithResponse request
+	GetDatabaseClusterPitrWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterPitrResponse, error)
+
+	// ListDatabaseEnginesWithResponse request
+	ListDatabaseEnginesWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*ListDatabaseEnginesResponse, error)
+
+	// GetUpgradePlanWithResponse request
+	GetUpgradePlanWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*GetUpgradePlanResponse, error)
+
+	// CancelUpgradePlanApprovalWithResponse request
+	CancelUpgradePlanApprovalWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*CancelUpgradePlanApprovalResponse, error)
+
+	// ApproveUpgradePlanWithBodyWithResponse request with any body
+	ApproveUpgradePlanWithBodyWithResponse(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApproveUpgradePlanResponse, error)
+
+	ApproveUpgradePlanWithResponse(ctx context.Context, namespace string, body ApproveUpgradePlanJSONRequestBody, reqEditors ...RequestEditorFn) (*ApproveUpgradePlanResponse, error)
+
+	// GetDatabaseEngineWithResponse request
+	GetDatabaseEngineWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseEngineResponse, error)
+
+	// UpdateDatabaseEngineWithBodyWithResponse request with any body
+	UpdateDatabaseEngineWithBodyWithResponse(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDatabaseEngineResponse, error)
+
+	UpdateDatabaseEngineWithResponse(ctx context.Context, namespace string, name string, body UpdateDatabaseEngineJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDatabaseEngineResponse, error)
+
+	// GetEngineVersionPolicyWithResponse request
+	GetEngineVersionPolicyWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*GetEngineVersionPolicyResponse, error)
+
+	// UpdateEngineVersionPolicyWithBodyWithResponse request with any body
+	UpdateEngineVersionPolicyWithBodyWithResponse(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateEngineVersionPolicyResponse, error)
+
+	UpdateEngineVersionPolicyWithResponse(ctx context.Context, namespace string, body UpdateEngineVersionPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateEngineVersionPolicyResponse, error)
+
+	// GetMaintenanceWindowsWithResponse request
+	GetMaintenanceWindowsWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*GetMaintenanceWindowsResponse, error)
+
+	// UpdateMaintenanceWindowsWithBodyWithResponse request with any body
+	UpdateMaintenanceWindowsWithBodyWithResponse(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateMaintenanceWindowsResponse, error)
+
+	UpdateMaintenanceWindowsWithResponse(ctx context.Context, namespace string, body UpdateMaintenanceWindowsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateMaintenanceWindowsResponse, error)
+
+	// ListMonitoringInstancesWithResponse request
+	ListMonitoringInstancesWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*ListMonitoringInstancesResponse, error)
+
+	// CreateMonitoringInstanceWithBodyWithResponse request with any body
+	CreateMonitoringInstanceWithBodyWithResponse(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateMonitoringInstanceResponse, error)
+
+	CreateMonitoringInstanceWithResponse(ctx context.Context, namespace string, body CreateMonitoringInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateMonitoringInstanceResponse, error)
+
+	// DeleteMonitoringInstanceWithResponse request
+	DeleteMonitoringInstanceWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*DeleteMonitoringInstanceResponse, error)
+
+	// GetMonitoringInstanceWithResponse request
+	GetMonitoringInstanceWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetMonitoringInstanceResponse, error)
+
+	// UpdateMonitoringInstanceWithBodyWithResponse request with any body
+	UpdateMonitoringInstanceWithBodyWithResponse(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateMonitoringInstanceResponse, error)
+
+	UpdateMonitoringInstanceWithResponse(ctx context.Context, namespace string, name string, body UpdateMonitoringInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateMonitoringInstanceResponse, error)
+
+	// GetUserPermissionsWithResponse request
+	GetUserPermissionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUserPermissionsResponse, error)
+
+	// ExplainPermissionWithBodyWithResponse request with any body
+	ExplainPermissionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ExplainPermissionResponse, error)
+
+	ExplainPermissionWithResponse(ctx context.Context, body ExplainPermissionJSONRequestBody, reqEditors ...RequestEditorFn) (*ExplainPermissionResponse, error)
+
+	// GetUserPermissionMatrixWithResponse request
+	GetUserPermissionMatrixWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUserPermissionMatrixResponse, error)
+
+	// ListPodSchedulingPolicyWithResponse request
+	ListPodSchedulingPolicyWithResponse(ctx context.Context, params *ListPodSchedulingPolicyParams, reqEditors ...RequestEditorFn) (*ListPodSchedulingPolicyResponse, error)
+
+	// CreatePodSchedulingPolicyWithBodyWithResponse request with any body
+	CreatePodSchedulingPolicyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePodSchedulingPolicyResponse, error)
+
+	CreatePodSchedulingPolicyWithResponse(ctx context.Context, body CreatePodSchedulingPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePodSchedulingPolicyResponse, error)
+
+	// DeletePodSchedulingPolicyWithResponse request
+	DeletePodSchedulingPolicyWithResponse(ctx context.Context, policyName string, reqEditors ...RequestEditorFn) (*DeletePodSchedulingPolicyResponse, error)
+
+	// GetPodSchedulingPolicyWithResponse request
+	GetPodSchedulingPolicyWithResponse(ctx context.Context, policyName string, reqEditors ...RequestEditorFn) (*GetPodSchedulingPolicyResponse, error)
+
+	// UpdatePodSchedulingPolicyWithBodyWithResponse request with any body
+	UpdatePodSchedulingPolicyWithBodyWithResponse(ctx context.Context, policyName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePodSchedulingPolicyResponse, error)
+
+	UpdatePodSchedulingPolicyWithResponse(ctx context.Context, policyName string, body UpdatePodSchedulingPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePodSchedulingPolicyResponse, error)
+
+	// GetKubernetesClusterResourcesWithResponse request
+	GetKubernetesClusterResourcesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetKubernetesClusterResourcesResponse, error)
+
+	// DeleteSessionWithResponse request
+	DeleteSessionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeleteSessionResponse, error)
+
+	// CreateSessionWithBodyWithResponse request with any body
+	CreateSessionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSessionResponse, error)
+
+	CreateSessionWithResponse(ctx context.Context, body CreateSessionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSessionResponse, error)
+
+	// ChangePasswordWithBodyWithResponse request with any body
+	ChangePasswordWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ChangePasswordResponse, error)
+
+	ChangePasswordWithResponse(ctx context.Context, body ChangePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*ChangePasswordResponse, error)
+
+	// RefreshSessionWithResponse request
+	RefreshSessionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*RefreshSessionResponse, error)
+
+	// GetSettingsWithResponse request
+	GetSettingsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSettingsResponse, error)
+
+	// GetOIDCClaimMappingWithResponse request
+	GetOIDCClaimMappingWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOIDCClaimMappingResponse, error)
+
+	// UpdateOIDCClaimMappingWithBodyWithResponse request with any body
+	UpdateOIDCClaimMappingWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateOIDCClaimMappingResponse, error)
+
+	UpdateOIDCClaimMappingWithResponse(ctx context.Context, body UpdateOIDCClaimMappingJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateOIDCClaimMappingResponse, error)
+
+	// DiffRBACPolicyVersionsWithResponse request
+	DiffRBACPolicyVersionsWithResponse(ctx context.Context, params *DiffRBACPolicyVersionsParams, reqEditors ...RequestEditorFn) (*DiffRBACPolicyVersionsResponse, error)
+
+	// ListRBACPolicyVersionsWithResponse request
+	ListRBACPolicyVersionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListRBACPolicyVersionsResponse, error)
+
+	// GetRBACPolicyVersionWithResponse request
+	GetRBACPolicyVersionWithResponse(ctx context.Context, version int, reqEditors ...RequestEditorFn) (*GetRBACPolicyVersionResponse, error)
+
+	// RollbackRBACPolicyWithResponse request
+	RollbackRBACPolicyWithResponse(ctx context.Context, version int, reqEditors ...RequestEditorFn) (*RollbackRBACPolicyResponse, error)
+
+	// ListTemporaryGrantsWithResponse request
+	ListTemporaryGrantsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListTemporaryGrantsResponse, error)
+
+	// CreateTemporaryGrantWithBodyWithResponse request with any body
+	CreateTemporaryGrantWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTemporaryGrantResponse, error)
+
+	CreateTemporaryGrantWithResponse(ctx context.Context, body CreateTemporaryGrantJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTemporaryGrantResponse, error)
+
+	// GetTelemetryWithResponse request
+	GetTelemetryWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetTelemetryResponse, error)
+
+	// VersionInfoWithResponse request
+	VersionInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*VersionInfoResponse, error)
+}
+
+type ListAccountsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AccountList
+	JSON403      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListAccountsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListAccountsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateAccountResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Account
+	JSON400      *Error
+	JSON403      *Error
+	JSON409      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateAccountResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateAccountResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAccountResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+	JSON403      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAccountResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAccountResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAccountResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Account
+	JSON403      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAccountResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAccountResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateAccountResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Account
+	JSON400      *Error
+	JSON403      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateAccountResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateAccountResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ResetAccountMFAResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON403      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ResetAccountMFAResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ResetAccountMFAResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetAccountPasswordResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+	JSON403      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SetAccountPasswordResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetAccountPasswordResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteUserSessionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON403      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteUserSessionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteUserSessionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListUserSessionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UserSessionList
+	JSON403      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListUserSessionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListUserSessionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteUserSessionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON403      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteUserSessionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteUserSessionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UnlockAccountResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON403      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UnlockAccountResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UnlockAccountResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListAPIKeysResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *APIKeyList
+	JSON403      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListAPIKeysResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListAPIKeysResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateAPIKeyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CreatedAPIKey
+	JSON400      *Error
+	JSON403      *Error
+	JSON409      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateAPIKeyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateAPIKeyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAPIKeyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON403      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAPIKeyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAPIKeyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetKubernetesClusterInfoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *KubernetesClusterInfo
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetKubernetesClusterInfoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetKubernetesClusterInfoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListDataImportersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DataImporterList
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListDataImportersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListDataImportersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateDataImporterResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DataImporter
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateDataImporterResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateDataImporterResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteDataImporterResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteDataImporterResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteDataImporterResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateDataImporterResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DataImporter
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateDataImporterResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateDataImporterResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListNamespacesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *NamespaceList
+}
+
+// Status returns HTTPResponse.Status
+func (r ListNamespacesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListNamespacesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListBackupStoragesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BackupStoragesList
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListBackupStoragesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListBackupStoragesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateBackupStorageResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BackupStorage
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateBackupStorageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateBackupStorageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteBackupStorageResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteBackupStorageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteBackupStorageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetBackupStorageResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BackupStorage
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetBackupStorageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetBackupStorageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateBackupStorageResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BackupStorage
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateBackupStorageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateBackupStorageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateDataImportJobResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DataImportJob
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateDataImportJobResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateDataImportJobResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteDataImportJobResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteDataImportJobResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteDataImportJobResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDataImportJobResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DataImportJob
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDataImportJobResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDataImportJobResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CancelDataImportJobResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CancelDataImportJobResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CancelDataImportJobResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDataImportJobProgressResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DataImportJobProgress
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDataImportJobProgressResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDataImportJobProgressResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateDatabaseClusterBackupResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DatabaseClusterBackup
+	JSON201      *DatabaseClusterBackup
+	JSON202      *DatabaseClusterBackup
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateDatabaseClusterBackupResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateDatabaseClusterBackupResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteDatabaseClusterBackupResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *IoK8sApimachineryPkgApisMetaV1StatusV2
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteDatabaseClusterBackupResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteDatabaseClusterBackupResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDatabaseClusterBackupResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DatabaseClusterBackup
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDatabaseClusterBackupResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDatabaseClusterBackupResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateDatabaseClusterRestoreResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DatabaseClusterRestore
+	JSON201      *DatabaseClusterRestore
+	JSON202      *DatabaseClusterRestore
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateDatabaseClusterRestoreResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateDatabaseClusterRestoreResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteDatabaseClusterRestoreResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *IoK8sApimachineryPkgApisMetaV1StatusV2
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteDatabaseClusterRestoreResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteDatabaseClusterRestoreResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDatabaseClusterRestoreResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DatabaseClusterRestore
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDatabaseClusterRestoreResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDatabaseClusterRestoreResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateDatabaseClusterRestoreResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DatabaseClusterRestore
+	JSON201      *DatabaseClusterRestore
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateDatabaseClusterRestoreResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateDatabaseClusterRestoreResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListDatabaseClustersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DatabaseClusterList
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListDatabaseClustersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListDatabaseClustersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateDatabaseClusterResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DatabaseCluster
+	JSON201      *DatabaseCluster
+	JSON202      *DatabaseCluster
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateDatabaseClusterResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateDatabaseClusterResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListDatabaseClusterBackupsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DatabaseClusterBackupList
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListDatabaseClusterBackupsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListDatabaseClusterBackupsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListDatabaseClusterRestoresResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DatabaseClusterRestoreList
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListDatabaseClusterRestoresResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListDatabaseClusterRestoresResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListDataImportJobsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DataImportJobList
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListDataImportJobsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListDataImportJobsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateDatabaseClusterSecretResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Secret
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateDatabaseClusterSecretResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateDatabaseClusterSecretResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteDatabaseClusterResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *IoK8sApimachineryPkgApisMetaV1StatusV2
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteDatabaseClusterResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteDatabaseClusterResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDatabaseClusterResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DatabaseCluster
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDatabaseClusterResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDatabaseClusterResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateDatabaseClusterResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DatabaseCluster
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateDatabaseClusterResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateDatabaseClusterResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDatabaseClusterComponentsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DatabaseClusterComponents
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDatabaseClusterComponentsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDatabaseClusterComponentsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDatabaseClusterCredentialsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DatabaseClusterCredential
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDatabaseClusterCredentialsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDatabaseClusterCredentialsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDatabaseClusterPitrResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DatabaseClusterPitr
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDatabaseClusterPitrResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDatabaseClusterPitrResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListDatabaseEnginesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DatabaseEngineList
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListDatabaseEnginesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListDatabaseEnginesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUpgradePlanResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UpgradePlan
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUpgradePlanResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUpgradePlanResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CancelUpgradePlanApprovalResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CancelUpgradePlanApprovalResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CancelUpgradePlanApprovalResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ApproveUpgradePlanResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UpgradePlanApprovalResult
+	JSON202      *UpgradePlanApprovalResult
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ApproveUpgradePlanResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApproveUpgradePlanResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDatabaseEngineResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DatabaseEngine
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDatabaseEngineResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDatabaseEngineResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateDatabaseEngineResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DatabaseEngine
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateDatabaseEngineResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateDatabaseEngineResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetEngineVersionPolicyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *EngineVersionPolicy
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetEngineVersionPolicyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetEngineVersionPolicyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateEngineVersionPolicyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *EngineVersionPolicy
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateEngineVersionPolicyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateEngineVersionPolicyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetMaintenanceWindowsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MaintenanceWindows
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetMaintenanceWindowsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetMaintenanceWindowsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateMaintenanceWindowsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MaintenanceWindows
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateMaintenanceWindowsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateMaintenanceWindowsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListMonitoringInstancesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MonitoringInstancesList
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListMonitoringInstancesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListMonitoringInstancesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateMonitoringInstanceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MonitoringInstance
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateMonitoringInstanceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateMonitoringInstanceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteMonitoringInstanceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteMonitoringInstanceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteMonitoringInstanceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetMonitoringInstanceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MonitoringInstance
+	JSON400      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetMonitoringInstanceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetMonitoringInstanceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateMonitoringInstanceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MonitoringInstance
+	JSON400      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateMonitoringInstanceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateMonitoringInstanceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUserPermissionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UserPermissions
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUserPermissionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUserPermissionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ExplainPermissionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PermissionExplanation
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ExplainPermissionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ExplainPermissionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUserPermissionMatrixResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UserPermissionMatrix
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUserPermissionMatrixResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUserPermissionMatrixResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListPodSchedulingPolicyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PodSchedulingPolicyList
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListPodSchedulingPolicyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListPodSchedulingPolicyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreatePodSchedulingPolicyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PodSchedulingPolicy
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreatePodSchedulingPolicyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreatePodSchedulingPolicyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeletePodSchedulingPolicyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *IoK8sApimachineryPkgApisMetaV1StatusV2
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeletePodSchedulingPolicyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeletePodSchedulingPolicyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPodSchedulingPolicyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PodSchedulingPolicy
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPodSchedulingPolicyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPodSchedulingPolicyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdatePodSchedulingPolicyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PodSchedulingPolicy
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdatePodSchedulingPolicyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdatePodSchedulingPolicyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetKubernetesClusterResourcesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *KubernetesClusterResources
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetKubernetesClusterResourcesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetKubernetesClusterResourcesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteSessionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON429      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteSessionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteSessionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateSessionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Token *string `json:"token,omitempty"`
+	}
+	JSON400 *Error
+	JSON401 *Error
+	JSON403 *Error
+	JSON429 *Error
+	JSON500 *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateSessionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateSessionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ChangePasswordResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+	JSON403      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ChangePasswordResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ChangePasswordResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RefreshSessionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Token *string `json:"token,omitempty"`
+	}
+	JSON401 *Error
+	JSON403 *Error
+	JSON500 *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r RefreshSessionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RefreshSessionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSettingsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Settings
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSettingsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSettingsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetOIDCClaimMappingResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OIDCClaimMapping
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetOIDCClaimMappingResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetOIDCClaimMappingResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateOIDCClaimMappingResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OIDCClaimMapping
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateOIDCClaimMappingResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateOIDCClaimMappingResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DiffRBACPolicyVersionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RBACPolicyDiff
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DiffRBACPolicyVersionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DiffRBACPolicyVersionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListRBACPolicyVersionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RBACPolicyVersionList
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListRBACPolicyVersionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListRBACPolicyVersionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetRBACPolicyVersionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RBACPolicyVersion
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetRBACPolicyVersionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetRBACPolicyVersionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RollbackRBACPolicyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RBACPolicyVersion
+	JSON400      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r RollbackRBACPolicyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RollbackRBACPolicyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListTemporaryGrantsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TemporaryGrants
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListTemporaryGrantsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListTemporaryGrantsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateTemporaryGrantResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TemporaryGrant
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateTemporaryGrantResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateTemporaryGrantResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTelemetryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Telemetry
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTelemetryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTelemetryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type VersionInfoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Version
+}
+
+// Status returns HTTPResponse.Status
+func (r VersionInfoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r VersionInfoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ListAccountsWithResponse request returning *ListAccountsResponse
+func (c *ClientWithResponses) ListAccountsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListAccountsResponse, error) {
+	rsp, err := c.ListAccounts(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListAccountsResponse(rsp)
+}
+
+// CreateAccountWithBodyWithResponse request with arbitrary body returning *CreateAccountResponse
+func (c *ClientWithResponses) CreateAccountWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAccountResponse, error) {
+	rsp, err := c.CreateAccountWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateAccountResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateAccountWithResponse(ctx context.Context, body CreateAccountJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAccountResponse, error) {
+	rsp, err := c.CreateAccount(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateAccountResponse(rsp)
+}
+
+// DeleteAccountWithResponse request returning *DeleteAccountResponse
+func (c *ClientWithResponses) DeleteAccountWithResponse(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*DeleteAccountResponse, error) {
+	rsp, err := c.DeleteAccount(ctx, username, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAccountResponse(rsp)
+}
+
+// GetAccountWithResponse request returning *GetAccountResponse
+func (c *ClientWithResponses) GetAccountWithResponse(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*GetAccountResponse, error) {
+	rsp, err := c.GetAccount(ctx, username, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAccountResponse(rsp)
+}
+
+// UpdateAccountWithBodyWithResponse request with arbitrary body returning *UpdateAccountResponse
+func (c *ClientWithResponses) UpdateAccountWithBodyWithResponse(ctx context.Context, username string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAccountResponse, error) {
+	rsp, err := c.UpdateAccountWithBody(ctx, username, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateAccountResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateAccountWithResponse(ctx context.Context, username string, body UpdateAccountJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAccountResponse, error) {
+	rsp, err := c.UpdateAccount(ctx, username, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateAccountResponse(rsp)
+}
+
+// ResetAccountMFAWithResponse request returning *ResetAccountMFAResponse
+func (c *ClientWithResponses) ResetAccountMFAWithResponse(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*ResetAccountMFAResponse, error) {
+	rsp, err := c.ResetAccountMFA(ctx, username, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseResetAccountMFAResponse(rsp)
+}
+
+// SetAccountPasswordWithBodyWithResponse request with arbitrary body returning *SetAccountPasswordResponse
+func (c *ClientWithResponses) SetAccountPasswordWithBodyWithResponse(ctx context.Context, username string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetAccountPasswordResponse, error) {
+	rsp, err := c.SetAccountPasswordWithBody(ctx, username, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetAccountPasswordResponse(rsp)
+}
+
+func (c *ClientWithResponses) SetAccountPasswordWithResponse(ctx context.Context, username string, body SetAccountPasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*SetAccountPasswordResponse, error) {
+	rsp, err := c.SetAccountPassword(ctx, username, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetAccountPasswordResponse(rsp)
+}
+
+// DeleteUserSessionsWithResponse request returning *DeleteUserSessionsResponse
+func (c *ClientWithResponses) DeleteUserSessionsWithResponse(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*DeleteUserSessionsResponse, error) {
+	rsp, err := c.DeleteUserSessions(ctx, username, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteUserSessionsResponse(rsp)
+}
+
+// ListUserSessionsWithResponse request returning *ListUserSessionsResponse
+func (c *ClientWithResponses) ListUserSessionsWithResponse(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*ListUserSessionsResponse, error) {
+	rsp, err := c.ListUserSessions(ctx, username, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListUserSessionsResponse(rsp)
+}
+
+// DeleteUserSessionWithResponse request returning *DeleteUserSessionResponse
+func (c *ClientWithResponses) DeleteUserSessionWithResponse(ctx context.Context, username string, sessionId string, reqEditors ...RequestEditorFn) (*DeleteUserSessionResponse, error) {
+	rsp, err := c.DeleteUserSession(ctx, username, sessionId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteUserSessionResponse(rsp)
+}
+
+// UnlockAccountWithResponse request returning *UnlockAccountResponse
+func (c *ClientWithResponses) UnlockAccountWithResponse(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*UnlockAccountResponse, error) {
+	rsp, err := c.UnlockAccount(ctx, username, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUnlockAccountResponse(rsp)
+}
+
+// ListAPIKeysWithResponse request returning *ListAPIKeysResponse
+func (c *ClientWithResponses) ListAPIKeysWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListAPIKeysResponse, error) {
+	rsp, err := c.ListAPIKeys(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListAPIKeysResponse(rsp)
+}
+
+// CreateAPIKeyWithBodyWithResponse request with arbitrary body returning *CreateAPIKeyResponse
+func (c *ClientWithResponses) CreateAPIKeyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAPIKeyResponse, error) {
+	rsp, err := c.CreateAPIKeyWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateAPIKeyResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateAPIKeyWithResponse(ctx context.Context, body CreateAPIKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAPIKeyResponse, error) {
+	rsp, err := c.CreateAPIKey(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateAPIKeyResponse(rsp)
+}
+
+// DeleteAPIKeyWithResponse request returning *DeleteAPIKeyResponse
+func (c *ClientWithResponses) DeleteAPIKeyWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*DeleteAPIKeyResponse, error) {
+	rsp, err := c.DeleteAPIKey(ctx, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAPIKeyResponse(rsp)
+}
+
+// GetKubernetesClusterInfoWithResponse request returning *GetKubernetesClusterInfoResponse
+func (c *ClientWithResponses) GetKubernetesClusterInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetKubernetesClusterInfoResponse, error) {
+	rsp, err := c.GetKubernetesClusterInfo(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetKubernetesClusterInfoResponse(rsp)
+}
+
+// ListDataImportersWithResponse request returning *ListDataImportersResponse
+func (c *ClientWithResponses) ListDataImportersWithResponse(ctx context.Context, params *ListDataImportersParams, reqEditors ...RequestEditorFn) (*ListDataImportersResponse, error) {
+	rsp, err := c.ListDataImporters(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListDataImportersResponse(rsp)
+}
+
+// CreateDataImporterWithBodyWithResponse request with arbitrary body returning *CreateDataImporterResponse
+func (c *ClientWithResponses) CreateDataImporterWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDataImporterResponse, error) {
+	rsp, err := c.CreateDataImporterWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateDataImporterResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateDataImporterWithResponse(ctx context.Context, body CreateDataImporterJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDataImporterResponse, error) {
+	rsp, err := c.CreateDataImporter(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateDataImporterResponse(rsp)
+}
+
+// DeleteDataImporterWithResponse request returning *DeleteDataImporterResponse
+func (c *ClientWithResponses) DeleteDataImporterWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*DeleteDataImporterResponse, error) {
+	rsp, err := c.DeleteDataImporter(ctx, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteDataImporterResponse(rsp)
+}
+
+// UpdateDataImporterWithBodyWithResponse request with arbitrary body returning *UpdateDataImporterResponse
+func (c *ClientWithResponses) UpdateDataImporterWithBodyWithResponse(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDataImporterResponse, error) {
+	rsp, err := c.UpdateDataImporterWithBody(ctx, name, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateDataImporterResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateDataImporterWithResponse(ctx context.Context, name string, body UpdateDataImporterJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDataImporterResponse, error) {
+	rsp, err := c.UpdateDataImporter(ctx, name, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateDataImporterResponse(rsp)
+}
+
+// ListNamespacesWithResponse request returning *ListNamespacesResponse
+func (c *ClientWithResponses) ListNamespacesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListNamespacesResponse, error) {
+	rsp, err := c.ListNamespaces(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListNamespacesResponse(rsp)
+}
+
+// ListBackupStoragesWithResponse request returning *ListBackupStoragesResponse
+func (c *ClientWithResponses) ListBackupStoragesWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*ListBackupStoragesResponse, error) {
+	rsp, err := c.ListBackupStorages(ctx, namespace, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListBackupStoragesResponse(rsp)
+}
+
+// CreateBackupStorageWithBodyWithResponse request with arbitrary body returning *CreateBackupStorageResponse
+func (c *ClientWithResponses) CreateBackupStorageWithBodyWithResponse(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateBackupStorageResponse, error) {
+	rsp, err := c.CreateBackupStorageWithBody(ctx, namespace, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateBackupStorageResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateBackupStorageWithResponse(ctx context.Context, namespace string, body CreateBackupStorageJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateBackupStorageResponse, error) {
+	rsp, err := c.CreateBackupStorage(ctx, namespace, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateBackupStorageResponse(rsp)
+}
+
+// DeleteBackupStorageWithResponse request returning *DeleteBackupStorageResponse
+func (c *ClientWithResponses) DeleteBackupStorageWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*DeleteBackupStorageResponse, error) {
+	rsp, err := c.DeleteBackupStorage(ctx, namespace, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteBackupStorageResponse(rsp)
+}
+
+// GetBackupStorageWithResponse request returning *GetBackupStorageResponse
+func (c *ClientWithResponses) GetBackupStorageWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetBackupStorageResponse, error) {
+	rsp, err := c.GetBackupStorage(ctx, namespace, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetBackupStorageResponse(rsp)
+}
+
+// UpdateBackupStorageWithBodyWithResponse request with arbitrary body returning *UpdateBackupStorageResponse
+func (c *ClientWithResponses) UpdateBackupStorageWithBodyWithResponse(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateBackupStorageResponse, error) {
+	rsp, err := c.UpdateBackupStorageWithBody(ctx, namespace, name, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateBackupStorageResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateBackupStorageWithResponse(ctx context.Context, namespace string, name string, body UpdateBackupStorageJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateBackupStorageResponse, error) {
+	rsp, err := c.UpdateBackupStorage(ctx, namespace, name, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateBackupStorageResponse(rsp)
+}
+
+// CreateDataImportJobWithBodyWithResponse request with arbitrary body returning *CreateDataImportJobResponse
+func (c *ClientWithResponses) CreateDataImportJobWithBodyWithResponse(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDataImportJobResponse, error) {
+	rsp, err := c.CreateDataImportJobWithBody(ctx, namespace, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateDataImportJobResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateDataImportJobWithResponse(ctx context.Context, namespace string, body CreateDataImportJobJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDataImportJobResponse, error) {
+	rsp, err := c.CreateDataImportJob(ctx, namespace, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateDataImportJobResponse(rsp)
+}
+
+// DeleteDataImportJobWithResponse request returning *DeleteDataImportJobResponse
+func (c *ClientWithResponses) DeleteDataImportJobWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*DeleteDataImportJobResponse, error) {
+	rsp, err := c.DeleteDataImportJob(ctx, namespace, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteDataImportJobResponse(rsp)
+}
+
+// GetDataImportJobWithResponse request returning *GetDataImportJobResponse
+func (c *ClientWithResponses) GetDataImportJobWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDataImportJobResponse, error) {
+	rsp, err := c.GetDataImportJob(ctx, namespace, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDataImportJobResponse(rsp)
+}
+
+// CancelDataImportJobWithResponse request returning *CancelDataImportJobResponse
+func (c *ClientWithResponses) CancelDataImportJobWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*CancelDataImportJobResponse, error) {
+	rsp, err := c.CancelDataImportJob(ctx, namespace, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCancelDataImportJobResponse(rsp)
+}
+
+// GetDataImportJobProgressWithResponse request returning *GetDataImportJobProgressResponse
+func (c *ClientWithResponses) GetDataImportJobProgressWithResponse(ctx context.Context, namespace string, name string, params *GetDataImportJobProgressParams, reqEditors ...RequestEditorFn) (*GetDataImportJobProgressResponse, error) {
+	rsp, err := c.GetDataImportJobProgress(ctx, namespace, name, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDataImportJobProgressResponse(rsp)
+}
+
+// CreateDatabaseClusterBackupWithBodyWithResponse request with arbitrary body returning *CreateDatabaseClusterBackupResponse
+func (c *ClientWithResponses) CreateDatabaseClusterBackupWithBodyWithResponse(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterBackupResponse, error) {
+	rsp, err := c.CreateDatabaseClusterBackupWithBody(ctx, namespace, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateDatabaseClusterBackupResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateDatabaseClusterBackupWithResponse(ctx context.Context, namespace string, body CreateDatabaseClusterBackupJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterBackupResponse, error) {
+	rsp, err := c.CreateDatabaseClusterBackup(ctx, namespace, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateDatabaseClusterBackupResponse(rsp)
+}
+
+// DeleteDatabaseClusterBackupWithResponse request returning *DeleteDatabaseClusterBackupResponse
+func (c *ClientWithResponses) DeleteDatabaseClusterBackupWithResponse(ctx context.Context, namespace string, name string, params *DeleteDatabaseClusterBackupParams, reqEditors ...RequestEditorFn) (*DeleteDatabaseClusterBackupResponse, error) {
+	rsp, err := c.DeleteDatabaseClusterBackup(ctx, namespace, name, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteDatabaseClusterBackupResponse(rsp)
+}
+
+// GetDatabaseClusterBackupWithResponse request returning *GetDatabaseClusterBackupResponse
+func (c *ClientWithResponses) GetDatabaseClusterBackupWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterBackupResponse, error) {
+	rsp, err := c.GetDatabaseClusterBackup(ctx, namespace, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDatabaseClusterBackupResponse(rsp)
+}
+
+// CreateDatabaseClusterRestoreWithBodyWithResponse request with arbitrary body returning *CreateDatabaseClusterRestoreResponse
+func (c *ClientWithResponses) CreateDatabaseClusterRestoreWithBodyWithResponse(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterRestoreResponse, error) {
+	rsp, err := c.CreateDatabaseClusterRestoreWithBody(ctx, namespace, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateDatabaseClusterRestoreResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateDatabaseClusterRestoreWithResponse(ctx context.Context, namespace string, body CreateDatabaseClusterRestoreJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterRestoreResponse, error) {
+	rsp, err := c.CreateDatabaseClusterRestore(ctx, namespace, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateDatabaseClusterRestoreResponse(rsp)
+}
+
+// DeleteDatabaseClusterRestoreWithResponse request returning *DeleteDatabaseClusterRestoreResponse
+func (c *ClientWithResponses) DeleteDatabaseClusterRestoreWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*DeleteDatabaseClusterRestoreResponse, error) {
+	rsp, err := c.DeleteDatabaseClusterRestore(ctx, namespace, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteDatabaseClusterRestoreResponse(rsp)
+}
+
+// GetDatabaseClusterRestoreWithResponse request returning *GetDatabaseClusterRestoreResponse
+func (c *ClientWithResponses) GetDatabaseClusterRestoreWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterRestoreResponse, error) {
+	rsp, err := c.GetDatabaseClusterRestore(ctx, namespace, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDatabaseClusterRestoreResponse(rsp)
+}
+
+// UpdateDatabaseClusterRestoreWithBodyWithResponse request with arbitrary body returning *UpdateDatabaseClusterRestoreResponse
+func (c *ClientWithResponses) UpdateDatabaseClusterRestoreWithBodyWithResponse(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDatabaseClusterRestoreResponse, error) {
+	rsp, err := c.UpdateDatabaseClusterRestoreWithBody(ctx, namespace, name, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateDatabaseClusterRestoreResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateDatabaseClusterRestoreWithResponse(ctx context.Context, namespace string, name string, body UpdateDatabaseClusterRestoreJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDatabaseClusterRestoreResponse, error) {
+	rsp, err := c.UpdateDatabaseClusterRestore(ctx, namespace, name, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateDatabaseClusterRestoreResponse(rsp)
+}
+
+// ListDatabaseClustersWithResponse request returning *ListDatabaseClustersResponse
+func (c *ClientWithResponses) ListDatabaseClustersWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*ListDatabaseClustersResponse, error) {
+	rsp, err := c.ListDatabaseClusters(ctx, namespace, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListDatabaseClustersResponse(rsp)
+}
+
+// CreateDatabaseClusterWithBodyWithResponse request with arbitrary body returning *CreateDatabaseClusterResponse
+func (c *ClientWithResponses) CreateDatabaseClusterWithBodyWithResponse(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterResponse, error) {
+	rsp, err := c.CreateDatabaseClusterWithBody(ctx, namespace, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateDatabaseClusterResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateDatabaseClusterWithResponse(ctx context.Context, namespace string, body CreateDatabaseClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterResponse, error) {
+	rsp, err := c.CreateDatabaseCluster(ctx, namespace, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateDatabaseClusterResponse(rsp)
+}
+
+// ListDatabaseClusterBackupsWithResponse request returning *ListDatabaseClusterBackupsResponse
+func (c *ClientWithResponses) ListDatabaseClusterBackupsWithResponse(ctx context.Context, namespace string, clusterName string, reqEditors ...RequestEditorFn) (*ListDatabaseClusterBackupsResponse, error) {
+	rsp, err := c.ListDatabaseClusterBackups(ctx, namespace, clusterName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListDatabaseClusterBackupsResponse(rsp)
+}
+
+// ListDatabaseClusterRestoresWithResponse request returning *ListDatabaseClusterRestoresResponse
+func (c *ClientWithResponses) ListDatabaseClusterRestoresWithResponse(ctx context.Context, namespace string, clusterName string, reqEditors ...RequestEditorFn) (*ListDatabaseClusterRestoresResponse, error) {
+	rsp, err := c.ListDatabaseClusterRestores(ctx, namespace, clusterName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListDatabaseClusterRestoresResponse(rsp)
+}
+
+// ListDataImportJobsWithResponse request returning *ListDataImportJobsResponse
+func (c *ClientWithResponses) ListDataImportJobsWithResponse(ctx context.Context, namespace string, dbName string, reqEditors ...RequestEditorFn) (*ListDataImportJobsResponse, error) {
+	rsp, err := c.ListDataImportJobs(ctx, namespace, dbName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListDataImportJobsResponse(rsp)
+}
+
+// CreateDatabaseClusterSecretWithBodyWithResponse request with arbitrary body returning *CreateDatabaseClusterSecretResponse
+func (c *ClientWithResponses) CreateDatabaseClusterSecretWithBodyWithResponse(ctx context.Context, namespace string, dbName string, params *CreateDatabaseClusterSecretParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterSecretResponse, error) {
+	rsp, err := c.CreateDatabaseClusterSecretWithBody(ctx, namespace, dbName, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateDatabaseClusterSecretResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateDatabaseClusterSecretWithResponse(ctx context.Context, namespace string, dbName string, params *CreateDatabaseClusterSecretParams, body CreateDatabaseClusterSecretJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDatabaseClusterSecretResponse, error) {
+	rsp, err := c.CreateDatabaseClusterSecret(ctx, namespace, dbName, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateDatabaseClusterSecretResponse(rsp)
+}
+
+// DeleteDatabaseClusterWithResponse request returning *DeleteDatabaseClusterResponse
+func (c *ClientWithResponses) DeleteDatabaseClusterWithResponse(ctx context.Context, namespace string, name string, params *DeleteDatabaseClusterParams, reqEditors ...RequestEditorFn) (*DeleteDatabaseClusterResponse, error) {
+	rsp, err := c.DeleteDatabaseCluster(ctx, namespace, name, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteDatabaseClusterResponse(rsp)
+}
+
+// GetDatabaseClusterWithResponse request returning *GetDatabaseClusterResponse
+func (c *ClientWithResponses) GetDatabaseClusterWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterResponse, error) {
+	rsp, err := c.GetDatabaseCluster(ctx, namespace, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDatabaseClusterResponse(rsp)
+}
+
+// UpdateDatabaseClusterWithBodyWithResponse request with arbitrary body returning *UpdateDatabaseClusterResponse
+func (c *ClientWithResponses) UpdateDatabaseClusterWithBodyWithResponse(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDatabaseClusterResponse, error) {
+	rsp, err := c.UpdateDatabaseClusterWithBody(ctx, namespace, name, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateDatabaseClusterResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateDatabaseClusterWithResponse(ctx context.Context, namespace string, name string, body UpdateDatabaseClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDatabaseClusterResponse, error) {
+	rsp, err := c.UpdateDatabaseCluster(ctx, namespace, name, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateDatabaseClusterResponse(rsp)
+}
+
+// GetDatabaseClusterComponentsWithResponse request returning *GetDatabaseClusterComponentsResponse
+func (c *ClientWithResponses) GetDatabaseClusterComponentsWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterComponentsResponse, error) {
+	rsp, err := c.GetDatabaseClusterComponents(ctx, namespace, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDatabaseClusterComponentsResponse(rsp)
+}
+
+// GetDatabaseClusterCredentialsWithResponse request returning *GetDatabaseClusterCredentialsResponse
+func (c *ClientWithResponses) GetDatabaseClusterCredentialsWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterCredentialsResponse, error) {
+	rsp, err := c.GetDatabaseClusterCredentials(ctx, namespace, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDatabaseClusterCredentialsResponse(rsp)
+}
+
+// GetDatabaseClusterPitrWithResponse request returning *GetDatabaseClusterPitrResponse
+func (c *ClientWithResponses) GetDatabaseClusterPitrWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseClusterPitrResponse, error) {
+	rsp, err := c.GetDatabaseClusterPitr(ctx, namespace, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDatabaseClusterPitrResponse(rsp)
+}
+
+// ListDatabaseEnginesWithResponse request returning *ListDatabaseEnginesResponse
+func (c *ClientWithResponses) ListDatabaseEnginesWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*ListDatabaseEnginesResponse, error) {
+	rsp, err := c.ListDatabaseEngines(ctx, namespace, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListDatabaseEnginesResponse(rsp)
+}
+
+// GetUpgradePlanWithResponse request returning *GetUpgradePlanResponse
+func (c *ClientWithResponses) GetUpgradePlanWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*GetUpgradePlanResponse, error) {
+	rsp, err := c.GetUpgradePlan(ctx, namespace, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUpgradePlanResponse(rsp)
+}
+
+// CancelUpgradePlanApprovalWithResponse request returning *CancelUpgradePlanApprovalResponse
+func (c *ClientWithResponses) CancelUpgradePlanApprovalWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*CancelUpgradePlanApprovalResponse, error) {
+	rsp, err := c.CancelUpgradePlanApproval(ctx, namespace, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCancelUpgradePlanApprovalResponse(rsp)
+}
+
+// ApproveUpgradePlanWithBodyWithResponse request with arbitrary body returning *ApproveUpgradePlanResponse
+func (c *ClientWithResponses) ApproveUpgradePlanWithBodyWithResponse(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApproveUpgradePlanResponse, error) {
+	rsp, err := c.ApproveUpgradePlanWithBody(ctx, namespace, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApproveUpgradePlanResponse(rsp)
+}
+
+func (c *ClientWithResponses) ApproveUpgradePlanWithResponse(ctx context.Context, namespace string, body ApproveUpgradePlanJSONRequestBody, reqEditors ...RequestEditorFn) (*ApproveUpgradePlanResponse, error) {
+	rsp, err := c.ApproveUpgradePlan(ctx, namespace, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApproveUpgradePlanResponse(rsp)
+}
+
+// GetDatabaseEngineWithResponse request returning *GetDatabaseEngineResponse
+func (c *ClientWithResponses) GetDatabaseEngineWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetDatabaseEngineResponse, error) {
+	rsp, err := c.GetDatabaseEngine(ctx, namespace, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDatabaseEngineResponse(rsp)
+}
+
+// UpdateDatabaseEngineWithBodyWithResponse request with arbitrary body returning *UpdateDatabaseEngineResponse
+func (c *ClientWithResponses) UpdateDatabaseEngineWithBodyWithResponse(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDatabaseEngineResponse, error) {
+	rsp, err := c.UpdateDatabaseEngineWithBody(ctx, namespace, name, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateDatabaseEngineResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateDatabaseEngineWithResponse(ctx context.Context, namespace string, name string, body UpdateDatabaseEngineJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDatabaseEngineResponse, error) {
+	rsp, err := c.UpdateDatabaseEngine(ctx, namespace, name, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateDatabaseEngineResponse(rsp)
+}
+
+// GetEngineVersionPolicyWithResponse request returning *GetEngineVersionPolicyResponse
+func (c *ClientWithResponses) GetEngineVersionPolicyWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*GetEngineVersionPolicyResponse, error) {
+	rsp, err := c.GetEngineVersionPolicy(ctx, namespace, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetEngineVersionPolicyResponse(rsp)
+}
+
+// UpdateEngineVersionPolicyWithBodyWithResponse request with arbitrary body returning *UpdateEngineVersionPolicyResponse
+func (c *ClientWithResponses) UpdateEngineVersionPolicyWithBodyWithResponse(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateEngineVersionPolicyResponse, error) {
+	rsp, err := c.UpdateEngineVersionPolicyWithBody(ctx, namespace, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateEngineVersionPolicyResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateEngineVersionPolicyWithResponse(ctx context.Context, namespace string, body UpdateEngineVersionPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateEngineVersionPolicyResponse, error) {
+	rsp, err := c.UpdateEngineVersionPolicy(ctx, namespace, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateEngineVersionPolicyResponse(rsp)
+}
+
+// GetMaintenanceWindowsWithResponse request returning *GetMaintenanceWindowsResponse
+func (c *ClientWithResponses) GetMaintenanceWindowsWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*GetMaintenanceWindowsResponse, error) {
+	rsp, err := c.GetMaintenanceWindows(ctx, namespace, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetMaintenanceWindowsResponse(rsp)
+}
+
+// UpdateMaintenanceWindowsWithBodyWithResponse request with arbitrary body returning *UpdateMaintenanceWindowsResponse
+func (c *ClientWithResponses) UpdateMaintenanceWindowsWithBodyWithResponse(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateMaintenanceWindowsResponse, error) {
+	rsp, err := c.UpdateMaintenanceWindowsWithBody(ctx, namespace, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateMaintenanceWindowsResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateMaintenanceWindowsWithResponse(ctx context.Context, namespace string, body UpdateMaintenanceWindowsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateMaintenanceWindowsResponse, error) {
+	rsp, err := c.UpdateMaintenanceWindows(ctx, namespace, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateMaintenanceWindowsResponse(rsp)
+}
+
+// ListMonitoringInstancesWithResponse request returning *ListMonitoringInstancesResponse
+func (c *ClientWithResponses) ListMonitoringInstancesWithResponse(ctx context.Context, namespace string, reqEditors ...RequestEditorFn) (*ListMonitoringInstancesResponse, error) {
+	rsp, err := c.ListMonitoringInstances(ctx, namespace, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListMonitoringInstancesResponse(rsp)
+}
+
+// CreateMonitoringInstanceWithBodyWithResponse request with arbitrary body returning *CreateMonitoringInstanceResponse
+func (c *ClientWithResponses) CreateMonitoringInstanceWithBodyWithResponse(ctx context.Context, namespace string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateMonitoringInstanceResponse, error) {
+	rsp, err := c.CreateMonitoringInstanceWithBody(ctx, namespace, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateMonitoringInstanceResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateMonitoringInstanceWithResponse(ctx context.Context, namespace string, body CreateMonitoringInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateMonitoringInstanceResponse, error) {
+	rsp, err := c.CreateMonitoringInstance(ctx, namespace, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateMonitoringInstanceResponse(rsp)
+}
+
+// DeleteMonitoringInstanceWithResponse request returning *DeleteMonitoringInstanceResponse
+func (c *ClientWithResponses) DeleteMonitoringInstanceWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*DeleteMonitoringInstanceResponse, error) {
+	rsp, err := c.DeleteMonitoringInstance(ctx, namespace, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteMonitoringInstanceResponse(rsp)
+}
+
+// GetMonitoringInstanceWithResponse request returning *GetMonitoringInstanceResponse
+func (c *ClientWithResponses) GetMonitoringInstanceWithResponse(ctx context.Context, namespace string, name string, reqEditors ...RequestEditorFn) (*GetMonitoringInstanceResponse, error) {
+	rsp, err := c.GetMonitoringInstance(ctx, namespace, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetMonitoringInstanceResponse(rsp)
+}
+
+// UpdateMonitoringInstanceWithBodyWithResponse request with arbitrary body returning *UpdateMonitoringInstanceResponse
+func (c *ClientWithResponses) UpdateMonitoringInstanceWithBodyWithResponse(ctx context.Context, namespace string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateMonitoringInstanceResponse, error) {
+	rsp, err := c.UpdateMonitoringInstanceWithBody(ctx, namespace, name, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateMonitoringInstanceResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateMonitoringInstanceWithResponse(ctx context.Context, namespace string, name string, body UpdateMonitoringInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateMonitoringInstanceResponse, error) {
+	rsp, err := c.UpdateMonitoringInstance(ctx, namespace, name, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateMonitoringInstanceResponse(rsp)
+}
+
+// GetUserPermissionsWithResponse request returning *GetUserPermissionsResponse
+func (c *ClientWithResponses) GetUserPermissionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUserPermissionsResponse, error) {
+	rsp, err := c.GetUserPermissions(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUserPermissionsResponse(rsp)
+}
+
+// ExplainPermissionWithBodyWithResponse request with arbitrary body returning *ExplainPermissionResponse
+func (c *ClientWithResponses) ExplainPermissionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ExplainPermissionResponse, error) {
+	rsp, err := c.ExplainPermissionWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExplainPermissionResponse(rsp)
+}
+
+func (c *ClientWithResponses) ExplainPermissionWithResponse(ctx context.Context, body ExplainPermissionJSONRequestBody, reqEditors ...RequestEditorFn) (*ExplainPermissionResponse, error) {
+	rsp, err := c.ExplainPermission(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExplainPermissionResponse(rsp)
+}
+
+// GetUserPermissionMatrixWithResponse request returning *GetUserPermissionMatrixResponse
+func (c *ClientWithResponses) GetUserPermissionMatrixWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUserPermissionMatrixResponse, error) {
+	rsp, err := c.GetUserPermissionMatrix(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUserPermissionMatrixResponse(rsp)
+}
+
+// ListPodSchedulingPolicyWithResponse request returning *ListPodSchedulingPolicyResponse
+func (c *ClientWithResponses) ListPodSchedulingPolicyWithResponse(ctx context.Context, params *ListPodSchedulingPolicyParams, reqEditors ...RequestEditorFn) (*ListPodSchedulingPolicyResponse, error) {
+	rsp, err := c.ListPodSchedulingPolicy(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListPodSchedulingPolicyResponse(rsp)
+}
+
+// CreatePodSchedulingPolicyWithBodyWithResponse request with arbitrary body returning *CreatePodSchedulingPolicyResponse
+func (c *ClientWithResponses) CreatePodSchedulingPolicyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePodSchedulingPolicyResponse, error) {
+	rsp, err := c.CreatePodSchedulingPolicyWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreatePodSchedulingPolicyResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreatePodSchedulingPolicyWithResponse(ctx context.Context, body CreatePodSchedulingPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePodSchedulingPolicyResponse, error) {
+	rsp, err := c.CreatePodSchedulingPolicy(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreatePodSchedulingPolicyResponse(rsp)
+}
+
+// DeletePodSchedulingPolicyWithResponse request returning *DeletePodSchedulingPolicyResponse
+func (c *ClientWithResponses) DeletePodSchedulingPolicyWithResponse(ctx context.Context, policyName string, reqEditors ...RequestEditorFn) (*DeletePodSchedulingPolicyResponse, error) {
+	rsp, err := c.DeletePodSchedulingPolicy(ctx, policyName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeletePodSchedulingPolicyResponse(rsp)
+}
+
+// GetPodSchedulingPolicyWithResponse request returning *GetPodSchedulingPolicyResponse
+func (c *ClientWithResponses) GetPodSchedulingPolicyWithResponse(ctx context.Context, policyName string, reqEditors ...RequestEditorFn) (*GetPodSchedulingPolicyResponse, error) {
+	rsp, err := c.GetPodSchedulingPolicy(ctx, policyName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPodSchedulingPolicyResponse(rsp)
+}
+
+// UpdatePodSchedulingPolicyWithBodyWithResponse request with arbitrary body returning *UpdatePodSchedulingPolicyResponse
+func (c *ClientWithResponses) UpdatePodSchedulingPolicyWithBodyWithResponse(ctx context.Context, policyName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePodSchedulingPolicyResponse, error) {
+	rsp, err := c.UpdatePodSchedulingPolicyWithBody(ctx, policyName, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdatePodSchedulingPolicyResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdatePodSchedulingPolicyWithResponse(ctx context.Context, policyName string, body UpdatePodSchedulingPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePodSchedulingPolicyResponse, error) {
+	rsp, err := c.UpdatePodSchedulingPolicy(ctx, policyName, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdatePodSchedulingPolicyResponse(rsp)
+}
+
+// GetKubernetesClusterResourcesWithResponse request returning *GetKubernetesClusterResourcesResponse
+func (c *ClientWithResponses) GetKubernetesClusterResourcesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetKubernetesClusterResourcesResponse, error) {
+	rsp, err := c.GetKubernetesClusterResources(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetKubernetesClusterResourcesResponse(rsp)
+}
+
+// DeleteSessionWithResponse request returning *DeleteSessionResponse
+func (c *ClientWithResponses) DeleteSessionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeleteSessionResponse, error) {
+	rsp, err := c.DeleteSession(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteSessionResponse(rsp)
+}
+
+// CreateSessionWithBodyWithResponse request with arbitrary body returning *CreateSessionResponse
+func (c *ClientWithResponses) CreateSessionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSessionResponse, error) {
+	rsp, err := c.CreateSessionWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateSessionResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateSessionWithResponse(ctx context.Context, body CreateSessionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSessionResponse, error) {
+	rsp, err := c.CreateSession(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateSessionResponse(rsp)
+}
+
+// ChangePasswordWithBodyWithResponse request with arbitrary body returning *ChangePasswordResponse
+func (c *ClientWithResponses) ChangePasswordWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ChangePasswordResponse, error) {
+	rsp, err := c.ChangePasswordWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseChangePasswordResponse(rsp)
+}
+
+func (c *ClientWithResponses) ChangePasswordWithResponse(ctx context.Context, body ChangePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*ChangePasswordResponse, error) {
+	rsp, err := c.ChangePassword(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseChangePasswordResponse(rsp)
+}
+
+// RefreshSessionWithResponse request returning *RefreshSessionResponse
+func (c *ClientWithResponses) RefreshSessionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*RefreshSessionResponse, error) {
+	rsp, err := c.RefreshSession(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRefreshSessionResponse(rsp)
+}
+
+// GetSettingsWithResponse request returning *GetSettingsResponse
+func (c *ClientWithResponses) GetSettingsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSettingsResponse, error) {
+	rsp, err := c.GetSettings(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSettingsResponse(rsp)
+}
+
+// GetOIDCClaimMappingWithResponse request returning *GetOIDCClaimMappingResponse
+func (c *ClientWithResponses) GetOIDCClaimMappingWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOIDCClaimMappingResponse, error) {
+	rsp, err := c.GetOIDCClaimMapping(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetOIDCClaimMappingResponse(rsp)
+}
+
+// UpdateOIDCClaimMappingWithBodyWithResponse request with arbitrary body returning *UpdateOIDCClaimMappingResponse
+func (c *ClientWithResponses) UpdateOIDCClaimMappingWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateOIDCClaimMappingResponse, error) {
+	rsp, err := c.UpdateOIDCClaimMappingWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateOIDCClaimMappingResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateOIDCClaimMappingWithResponse(ctx context.Context, body UpdateOIDCClaimMappingJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateOIDCClaimMappingResponse, error) {
+	rsp, err := c.UpdateOIDCClaimMapping(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateOIDCClaimMappingResponse(rsp)
+}
+
+// DiffRBACPolicyVersionsWithResponse request returning *DiffRBACPolicyVersionsResponse
+func (c *ClientWithResponses) DiffRBACPolicyVersionsWithResponse(ctx context.Context, params *DiffRBACPolicyVersionsParams, reqEditors ...RequestEditorFn) (*DiffRBACPolicyVersionsResponse, error) {
+	rsp, err := c.DiffRBACPolicyVersions(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDiffRBACPolicyVersionsResponse(rsp)
+}
+
+// ListRBACPolicyVersionsWithResponse request returning *ListRBACPolicyVersionsResponse
+func (c *ClientWithResponses) ListRBACPolicyVersionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListRBACPolicyVersionsResponse, error) {
+	rsp, err := c.ListRBACPolicyVersions(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListRBACPolicyVersionsResponse(rsp)
+}
+
+// GetRBACPolicyVersionWithResponse request returning *GetRBACPolicyVersionResponse
+func (c *ClientWithResponses) GetRBACPolicyVersionWithResponse(ctx context.Context, version int, reqEditors ...RequestEditorFn) (*GetRBACPolicyVersionResponse, error) {
+	rsp, err := c.GetRBACPolicyVersion(ctx, version, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetRBACPolicyVersionResponse(rsp)
+}
+
+// RollbackRBACPolicyWithResponse request returning *RollbackRBACPolicyResponse
+func (c *ClientWithResponses) RollbackRBACPolicyWithResponse(ctx context.Context, version int, reqEditors ...RequestEditorFn) (*RollbackRBACPolicyResponse, error) {
+	rsp, err := c.RollbackRBACPolicy(ctx, version, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRollbackRBACPolicyResponse(rsp)
+}
+
+// ListTemporaryGrantsWithResponse request returning *ListTemporaryGrantsResponse
+func (c *ClientWithResponses) ListTemporaryGrantsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListTemporaryGrantsResponse, error) {
+	rsp, err := c.ListTemporaryGrants(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListTemporaryGrantsResponse(rsp)
+}
+
+// CreateTemporaryGrantWithBodyWithResponse request with arbitrary body returning *CreateTemporaryGrantResponse
+func (c *ClientWithResponses) CreateTemporaryGrantWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTemporaryGrantResponse, error) {
+	rsp, err := c.CreateTemporaryGrantWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTemporaryGrantResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateTemporaryGrantWithResponse(ctx context.Context, body CreateTemporaryGrantJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTemporaryGrantResponse, error) {
+	rsp, err := c.CreateTemporaryGrant(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTemporaryGrantResponse(rsp)
+}
+
+// GetTelemetryWithResponse request returning *GetTelemetryResponse
+func (c *ClientWithResponses) GetTelemetryWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetTelemetryResponse, error) {
+	rsp, err := c.GetTelemetry(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTelemetryResponse(rsp)
+}
+
+// VersionInfoWithResponse request returning *VersionInfoResponse
+func (c *ClientWithResponses) VersionInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*VersionInfoResponse, error) {
+	rsp, err := c.VersionInfo(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseVersionInfoResponse(rsp)
+}
+
+// ParseListAccountsResponse parses an HTTP response from a ListAccountsWithResponse call
+func ParseListAccountsResponse(rsp *http.Response) (*ListAccountsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListAccountsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AccountList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateAccountResponse parses an HTTP response from a CreateAccountWithResponse call
+func ParseCreateAccountResponse(rsp *http.Response) (*CreateAccountResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateAccountResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Account
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteAccountResponse parses an HTTP response from a DeleteAccountWithResponse call
+func ParseDeleteAccountResponse(rsp *http.Response) (*DeleteAccountResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteAccountResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAccountResponse parses an HTTP response from a GetAccountWithResponse call
+func ParseGetAccountResponse(rsp *http.Response) (*GetAccountResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAccountResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Account
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateAccountResponse parses an HTTP response from a UpdateAccountWithResponse call
+func ParseUpdateAccountResponse(rsp *http.Response) (*UpdateAccountResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateAccountResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Account
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseResetAccountMFAResponse parses an HTTP response from a ResetAccountMFAWithResponse call
+func ParseResetAccountMFAResponse(rsp *http.Response) (*ResetAccountMFAResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ResetAccountMFAResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSetAccountPasswordResponse parses an HTTP response from a SetAccountPasswordWithResponse call
+func ParseSetAccountPasswordResponse(rsp *http.Response) (*SetAccountPasswordResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SetAccountPasswordResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteUserSessionsResponse parses an HTTP response from a DeleteUserSessionsWithResponse call
+func ParseDeleteUserSessionsResponse(rsp *http.Response) (*DeleteUserSessionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteUserSessionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListUserSessionsResponse parses an HTTP response from a ListUserSessionsWithResponse call
+func ParseListUserSessionsResponse(rsp *http.Response) (*ListUserSessionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListUserSessionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UserSessionList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteUserSessionResponse parses an HTTP response from a DeleteUserSessionWithResponse call
+func ParseDeleteUserSessionResponse(rsp *http.Response) (*DeleteUserSessionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteUserSessionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUnlockAccountResponse parses an HTTP response from a UnlockAccountWithResponse call
+func ParseUnlockAccountResponse(rsp *http.Response) (*UnlockAccountResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UnlockAccountResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListAPIKeysResponse parses an HTTP response from a ListAPIKeysWithResponse call
+func ParseListAPIKeysResponse(rsp *http.Response) (*ListAPIKeysResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListAPIKeysResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest APIKeyList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateAPIKeyResponse parses an HTTP response from a CreateAPIKeyWithResponse call
+func ParseCreateAPIKeyResponse(rsp *http.Response) (*CreateAPIKeyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateAPIKeyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CreatedAPIKey
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteAPIKeyResponse parses an HTTP response from a DeleteAPIKeyWithResponse call
+func ParseDeleteAPIKeyResponse(rsp *http.Response) (*DeleteAPIKeyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteAPIKeyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetKubernetesClusterInfoResponse parses an HTTP response from a GetKubernetesClusterInfoWithResponse call
+func ParseGetKubernetesClusterInfoResponse(rsp *http.Response) (*GetKubernetesClusterInfoResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetKubernetesClusterInfoResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest KubernetesClusterInfo
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListDataImportersResponse parses an HTTP response from a ListDataImportersWithResponse call
+func ParseListDataImportersResponse(rsp *http.Response) (*ListDataImportersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListDataImportersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DataImporterList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateDataImporterResponse parses an HTTP response from a CreateDataImporterWithResponse call
+func ParseCreateDataImporterResponse(rsp *http.Response) (*CreateDataImporterResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateDataImporterResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DataImporter
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteDataImporterResponse parses an HTTP response from a DeleteDataImporterWithResponse call
+func ParseDeleteDataImporterResponse(rsp *http.Response) (*DeleteDataImporterResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteDataImporterResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateDataImporterResponse parses an HTTP response from a UpdateDataImporterWithResponse call
+func ParseUpdateDataImporterResponse(rsp *http.Response) (*UpdateDataImporterResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateDataImporterResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DataImporter
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListNamespacesResponse parses an HTTP response from a ListNamespacesWithResponse call
+func ParseListNamespacesResponse(rsp *http.Response) (*ListNamespacesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListNamespacesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest NamespaceList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListBackupStoragesResponse parses an HTTP response from a ListBackupStoragesWithResponse call
+func ParseListBackupStoragesResponse(rsp *http.Response) (*ListBackupStoragesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListBackupStoragesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BackupStoragesList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateBackupStorageResponse parses an HTTP response from a CreateBackupStorageWithResponse call
+func ParseCreateBackupStorageResponse(rsp *http.Response) (*CreateBackupStorageResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateBackupStorageResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BackupStorage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteBackupStorageResponse parses an HTTP response from a DeleteBackupStorageWithResponse call
+func ParseDeleteBackupStorageResponse(rsp *http.Response) (*DeleteBackupStorageResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteBackupStorageResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetBackupStorageResponse parses an HTTP response from a GetBackupStorageWithResponse call
+func ParseGetBackupStorageResponse(rsp *http.Response) (*GetBackupStorageResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetBackupStorageResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BackupStorage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateBackupStorageResponse parses an HTTP response from a UpdateBackupStorageWithResponse call
+func ParseUpdateBackupStorageResponse(rsp *http.Response) (*UpdateBackupStorageResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateBackupStorageResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BackupStorage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateDataImportJobResponse parses an HTTP response from a CreateDataImportJobWithResponse call
+func ParseCreateDataImportJobResponse(rsp *http.Response) (*CreateDataImportJobResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateDataImportJobResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DataImportJob
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteDataImportJobResponse parses an HTTP response from a DeleteDataImportJobWithResponse call
+func ParseDeleteDataImportJobResponse(rsp *http.Response) (*DeleteDataImportJobResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteDataImportJobResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetDataImportJobResponse parses an HTTP response from a GetDataImportJobWithResponse call
+func ParseGetDataImportJobResponse(rsp *http.Response) (*GetDataImportJobResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetDataImportJobResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DataImportJob
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCancelDataImportJobResponse parses an HTTP response from a CancelDataImportJobWithResponse call
+func ParseCancelDataImportJobResponse(rsp *http.Response) (*CancelDataImportJobResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CancelDataImportJobResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetDataImportJobProgressResponse parses an HTTP response from a GetDataImportJobProgressWithResponse call
+func ParseGetDataImportJobProgressResponse(rsp *http.Response) (*GetDataImportJobProgressResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetDataImportJobProgressResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DataImportJobProgress
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateDatabaseClusterBackupResponse parses an HTTP response from a CreateDatabaseClusterBackupWithResponse call
+func ParseCreateDatabaseClusterBackupResponse(rsp *http.Response) (*CreateDatabaseClusterBackupResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateDatabaseClusterBackupResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DatabaseClusterBackup
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest DatabaseClusterBackup
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest DatabaseClusterBackup
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteDatabaseClusterBackupResponse parses an HTTP response from a DeleteDatabaseClusterBackupWithResponse call
+func ParseDeleteDatabaseClusterBackupResponse(rsp *http.Response) (*DeleteDatabaseClusterBackupResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteDatabaseClusterBackupResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest IoK8sApimachineryPkgApisMetaV1StatusV2
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetDatabaseClusterBackupResponse parses an HTTP response from a GetDatabaseClusterBackupWithResponse call
+func ParseGetDatabaseClusterBackupResponse(rsp *http.Response) (*GetDatabaseClusterBackupResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetDatabaseClusterBackupResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DatabaseClusterBackup
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateDatabaseClusterRestoreResponse parses an HTTP response from a CreateDatabaseClusterRestoreWithResponse call
+func ParseCreateDatabaseClusterRestoreResponse(rsp *http.Response) (*CreateDatabaseClusterRestoreResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateDatabaseClusterRestoreResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DatabaseClusterRestore
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest DatabaseClusterRestore
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest DatabaseClusterRestore
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteDatabaseClusterRestoreResponse parses an HTTP response from a DeleteDatabaseClusterRestoreWithResponse call
+func ParseDeleteDatabaseClusterRestoreResponse(rsp *http.Response) (*DeleteDatabaseClusterRestoreResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteDatabaseClusterRestoreResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest IoK8sApimachineryPkgApisMetaV1StatusV2
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseListPodSchedulingPolicyResponse(rsp)
+
+	return response, nil
 }
 
-// CreatePodSchedulingPolicyWithBodyWithResponse request with arbitrary body returning *CreatePodSchedulingPolicyResponse
-func (c *ClientWithResponses) CreatePodSchedulingPolicyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePodSchedulingPolicyResponse, error) {
-	rsp, err := c.CreatePodSchedulingPolicyWithBody(ctx, contentType, body, reqEditors...)
+// ParseGetDatabaseClusterRestoreResponse parses an HTTP response from a GetDatabaseClusterRestoreWithResponse call
+func ParseGetDatabaseClusterRestoreResponse(rsp *http.Response) (*GetDatabaseClusterRestoreResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreatePodSchedulingPolicyResponse(rsp)
-}
 
-func (c *ClientWithResponses) CreatePodSchedulingPolicyWithResponse(ctx context.Context, body CreatePodSchedulingPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePodSchedulingPolicyResponse, error) {
-	rsp, err := c.CreatePodSchedulingPolicy(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetDatabaseClusterRestoreResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseCreatePodSchedulingPolicyResponse(rsp)
-}
 
-// DeletePodSchedulingPolicyWithResponse request returning *DeletePodSchedulingPolicyResponse
-func (c *ClientWithResponses) DeletePodSchedulingPolicyWithResponse(ctx context.Context, policyName string, reqEditors ...RequestEditorFn) (*DeletePodSchedulingPolicyResponse, error) {
-	rsp, err := c.DeletePodSchedulingPolicy(ctx, policyName, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DatabaseClusterRestore
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseDeletePodSchedulingPolicyResponse(rsp)
+
+	return response, nil
 }
 
-// GetPodSchedulingPolicyWithResponse request returning *GetPodSchedulingPolicyResponse
-func (c *ClientWithResponses) GetPodSchedulingPolicyWithResponse(ctx context.Context, policyName string, reqEditors ...RequestEditorFn) (*GetPodSchedulingPolicyResponse, error) {
-	rsp, err := c.GetPodSchedulingPolicy(ctx, policyName, reqEditors...)
+// ParseUpdateDatabaseClusterRestoreResponse parses an HTTP response from a UpdateDatabaseClusterRestoreWithResponse call
+func ParseUpdateDatabaseClusterRestoreResponse(rsp *http.Response) (*UpdateDatabaseClusterRestoreResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetPodSchedulingPolicyResponse(rsp)
-}
 
-// UpdatePodSchedulingPolicyWithBodyWithResponse request with arbitrary body returning *UpdatePodSchedulingPolicyResponse
-func (c *ClientWithResponses) UpdatePodSchedulingPolicyWithBodyWithResponse(ctx context.Context, policyName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePodSchedulingPolicyResponse, error) {
-	rsp, err := c.UpdatePodSchedulingPolicyWithBody(ctx, policyName, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &UpdateDatabaseClusterRestoreResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseUpdatePodSchedulingPolicyResponse(rsp)
-}
 
-func (c *ClientWithResponses) UpdatePodSchedulingPolicyWithResponse(ctx context.Context, policyName string, body UpdatePodSchedulingPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePodSchedulingPolicyResponse, error) {
-	rsp, err := c.UpdatePodSchedulingPolicy(ctx, policyName, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DatabaseClusterRestore
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest DatabaseClusterRestore
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseUpdatePodSchedulingPolicyResponse(rsp)
+
+	return response, nil
 }
 
-// GetKubernetesClusterResourcesWithResponse request returning *GetKubernetesClusterResourcesResponse
-func (c *ClientWithResponses) GetKubernetesClusterResourcesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetKubernetesClusterResourcesResponse, error) {
-	rsp, err := c.GetKubernetesClusterResources(ctx, reqEditors...)
+// ParseListDatabaseClustersResponse parses an HTTP response from a ListDatabaseClustersWithResponse call
+func ParseListDatabaseClustersResponse(rsp *http.Response) (*ListDatabaseClustersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetKubernetesClusterResourcesResponse(rsp)
-}
 
-// DeleteSessionWithResponse request returning *DeleteSessionResponse
-func (c *ClientWithResponses) DeleteSessionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeleteSessionResponse, error) {
-	rsp, err := c.DeleteSession(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &ListDatabaseClustersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDeleteSessionResponse(rsp)
-}
 
-// CreateSessionWithBodyWithResponse request with arbitrary body returning *CreateSessionResponse
-func (c *ClientWithResponses) CreateSessionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSessionResponse, error) {
-	rsp, err := c.CreateSessionWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DatabaseClusterList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseCreateSessionResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) CreateSessionWithResponse(ctx context.Context, body CreateSessionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSessionResponse, error) {
-	rsp, err := c.CreateSession(ctx, body, reqEditors...)
+// ParseCreateDatabaseClusterResponse parses an HTTP response from a CreateDatabaseClusterWithResponse call
+func ParseCreateDatabaseClusterResponse(rsp *http.Response) (*CreateDatabaseClusterResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateSessionResponse(rsp)
-}
 
-// GetSettingsWithResponse request returning *GetSettingsResponse
-func (c *ClientWithResponses) GetSettingsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSettingsResponse, error) {
-	rsp, err := c.GetSettings(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &CreateDatabaseClusterResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetSettingsResponse(rsp)
-}
 
-// VersionInfoWithResponse request returning *VersionInfoResponse
-func (c *ClientWithResponses) VersionInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*VersionInfoResponse, error) {
-	rsp, err := c.VersionInfo(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DatabaseCluster
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest DatabaseCluster
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest DatabaseCluster
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseVersionInfoResponse(rsp)
+
+	return response, nil
 }
 
-// ParseGetKubernetesClusterInfoResponse parses an HTTP response from a GetKubernetesClusterInfoWithResponse call
-func ParseGetKubernetesClusterInfoResponse(rsp *http.Response) (*GetKubernetesClusterInfoResponse, error) {
+// ParseListDatabaseClusterBackupsResponse parses an HTTP response from a ListDatabaseClusterBackupsWithResponse call
+func ParseListDatabaseClusterBackupsResponse(rsp *http.Response) (*ListDatabaseClusterBackupsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetKubernetesClusterInfoResponse{
+	response := &ListDatabaseClusterBackupsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest KubernetesClusterInfo
+		var dest DatabaseClusterBackupList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -10588,22 +16662,22 @@ func ParseGetKubernetesClusterInfoResponse(rsp *http.Response) (*GetKubernetesCl
 	return response, nil
 }
 
-// ParseListDataImportersResponse parses an HTTP response from a ListDataImportersWithResponse call
-func ParseListDataImportersResponse(rsp *http.Response) (*ListDataImportersResponse, error) {
+// ParseListDatabaseClusterRestoresResponse parses an HTTP response from a ListDatabaseClusterRestoresWithResponse call
+func ParseListDatabaseClusterRestoresResponse(rsp *http.Response) (*ListDatabaseClusterRestoresResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListDataImportersResponse{
+	response := &ListDatabaseClusterRestoresResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DataImporterList
+		var dest DatabaseClusterRestoreList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -10628,48 +16702,55 @@ func ParseListDataImportersResponse(rsp *http.Response) (*ListDataImportersRespo
 	return response, nil
 }
 
-// ParseListNamespacesResponse parses an HTTP response from a ListNamespacesWithResponse call
-func ParseListNamespacesResponse(rsp *http.Response) (*ListNamespacesResponse, error) {
+// ParseListDataImportJobsResponse parses an HTTP response from a ListDataImportJobsWithResponse call
+func ParseListDataImportJobsResponse(rsp *http.Response) (*ListDataImportJobsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListNamespacesResponse{
+	response := &ListDataImportJobsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest NamespaceList
+		var dest DataImportJobList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON500 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseListBackupStoragesResponse parses an HTTP response from a ListBackupStoragesWithResponse call
-func ParseListBackupStoragesResponse(rsp *http.Response) (*ListBackupStoragesResponse, error) {
+// ParseCreateDatabaseClusterSecretResponse parses an HTTP response from a CreateDatabaseClusterSecretWithResponse call
+func ParseCreateDatabaseClusterSecretResponse(rsp *http.Response) (*CreateDatabaseClusterSecretResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListBackupStoragesResponse{
+	response := &CreateDatabaseClusterSecretResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest BackupStoragesList
+		var dest Secret
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -10694,22 +16775,22 @@ func ParseListBackupStoragesResponse(rsp *http.Response) (*ListBackupStoragesRes
 	return response, nil
 }
 
-// ParseCreateBackupStorageResponse parses an HTTP response from a CreateBackupStorageWithResponse call
-func ParseCreateBackupStorageResponse(rsp *http.Response) (*CreateBackupStorageResponse, error) {
+// ParseDeleteDatabaseClusterResponse parses an HTTP response from a DeleteDatabaseClusterWithResponse call
+func ParseDeleteDatabaseClusterResponse(rsp *http.Response) (*DeleteDatabaseClusterResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateBackupStorageResponse{
+	response := &DeleteDatabaseClusterResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest BackupStorage
+		var dest IoK8sApimachineryPkgApisMetaV1StatusV2
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -10734,20 +16815,27 @@ func ParseCreateBackupStorageResponse(rsp *http.Response) (*CreateBackupStorageR
 	return response, nil
 }
 
-// ParseDeleteBackupStorageResponse parses an HTTP response from a DeleteBackupStorageWithResponse call
-func ParseDeleteBackupStorageResponse(rsp *http.Response) (*DeleteBackupStorageResponse, error) {
+// ParseGetDatabaseClusterResponse parses an HTTP response from a GetDatabaseClusterWithResponse call
+func ParseGetDatabaseClusterResponse(rsp *http.Response) (*GetDatabaseClusterResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteBackupStorageResponse{
+	response := &GetDatabaseClusterResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DatabaseCluster
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -10767,22 +16855,22 @@ func ParseDeleteBackupStorageResponse(rsp *http.Response) (*DeleteBackupStorageR
 	return response, nil
 }
 
-// ParseGetBackupStorageResponse parses an HTTP response from a GetBackupStorageWithResponse call
-func ParseGetBackupStorageResponse(rsp *http.Response) (*GetBackupStorageResponse, error) {
+// ParseUpdateDatabaseClusterResponse parses an HTTP response from a UpdateDatabaseClusterWithResponse call
+func ParseUpdateDatabaseClusterResponse(rsp *http.Response) (*UpdateDatabaseClusterResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetBackupStorageResponse{
+	response := &UpdateDatabaseClusterResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest BackupStorage
+		var dest DatabaseCluster
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -10807,22 +16895,22 @@ func ParseGetBackupStorageResponse(rsp *http.Response) (*GetBackupStorageRespons
 	return response, nil
 }
 
-// ParseUpdateBackupStorageResponse parses an HTTP response from a UpdateBackupStorageWithResponse call
-func ParseUpdateBackupStorageResponse(rsp *http.Response) (*UpdateBackupStorageResponse, error) {
+// ParseGetDatabaseClusterComponentsResponse parses an HTTP response from a GetDatabaseClusterComponentsWithResponse call
+func ParseGetDatabaseClusterComponentsResponse(rsp *http.Response) (*GetDatabaseClusterComponentsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateBackupStorageResponse{
+	response := &GetDatabaseClusterComponentsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest BackupStorage
+		var dest DatabaseClusterComponents
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -10847,41 +16935,27 @@ func ParseUpdateBackupStorageResponse(rsp *http.Response) (*UpdateBackupStorageR
 	return response, nil
 }
 
-// ParseCreateDatabaseClusterBackupResponse parses an HTTP response from a CreateDatabaseClusterBackupWithResponse call
-func ParseCreateDatabaseClusterBackupResponse(rsp *http.Response) (*CreateDatabaseClusterBackupResponse, error) {
+// ParseGetDatabaseClusterCredentialsResponse parses an HTTP response from a GetDatabaseClusterCredentialsWithResponse call
+func ParseGetDatabaseClusterCredentialsResponse(rsp *http.Response) (*GetDatabaseClusterCredentialsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateDatabaseClusterBackupResponse{
+	response := &GetDatabaseClusterCredentialsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DatabaseClusterBackup
+		var dest DatabaseClusterCredential
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest DatabaseClusterBackup
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
-		var dest DatabaseClusterBackup
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON202 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -10901,22 +16975,22 @@ func ParseCreateDatabaseClusterBackupResponse(rsp *http.Response) (*CreateDataba
 	return response, nil
 }
 
-// ParseDeleteDatabaseClusterBackupResponse parses an HTTP response from a DeleteDatabaseClusterBackupWithResponse call
-func ParseDeleteDatabaseClusterBackupResponse(rsp *http.Response) (*DeleteDatabaseClusterBackupResponse, error) {
+// ParseGetDatabaseClusterPitrResponse parses an HTTP response from a GetDatabaseClusterPitrWithResponse call
+func ParseGetDatabaseClusterPitrResponse(rsp *http.Response) (*GetDatabaseClusterPitrResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteDatabaseClusterBackupResponse{
+	response := &GetDatabaseClusterPitrResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest IoK8sApimachineryPkgApisMetaV1StatusV2
+		var dest DatabaseClusterPitr
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -10941,22 +17015,22 @@ func ParseDeleteDatabaseClusterBackupResponse(rsp *http.Response) (*DeleteDataba
 	return response, nil
 }
 
-// ParseGetDatabaseClusterBackupResponse parses an HTTP response from a GetDatabaseClusterBackupWithResponse call
-func ParseGetDatabaseClusterBackupResponse(rsp *http.Response) (*GetDatabaseClusterBackupResponse, error) {
+// ParseListDatabaseEnginesResponse parses an HTTP response from a ListDatabaseEnginesWithResponse call
+func ParseListDatabaseEnginesResponse(rsp *http.Response) (*ListDatabaseEnginesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetDatabaseClusterBackupResponse{
+	response := &ListDatabaseEnginesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DatabaseClusterBackup
+		var dest DatabaseEngineList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -10981,41 +17055,60 @@ func ParseGetDatabaseClusterBackupResponse(rsp *http.Response) (*GetDatabaseClus
 	return response, nil
 }
 
-// ParseCreateDatabaseClusterRestoreResponse parses an HTTP response from a CreateDatabaseClusterRestoreWithResponse call
-func ParseCreateDatabaseClusterRestoreResponse(rsp *http.Response) (*CreateDatabaseClusterRestoreResponse, error) {
+// ParseGetUpgradePlanResponse parses an HTTP response from a GetUpgradePlanWithResponse call
+func ParseGetUpgradePlanResponse(rsp *http.Response) (*GetUpgradePlanResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateDatabaseClusterRestoreResponse{
+	response := &GetUpgradePlanResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DatabaseClusterRestore
+		var dest UpgradePlan
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest DatabaseClusterRestore
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
-		var dest DatabaseClusterRestore
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON202 = &dest
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCancelUpgradePlanApprovalResponse parses an HTTP response from a CancelUpgradePlanApprovalWithResponse call
+func ParseCancelUpgradePlanApprovalResponse(rsp *http.Response) (*CancelUpgradePlanApprovalResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CancelUpgradePlanApprovalResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
 
+	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -11035,27 +17128,34 @@ func ParseCreateDatabaseClusterRestoreResponse(rsp *http.Response) (*CreateDatab
 	return response, nil
 }
 
-// ParseDeleteDatabaseClusterRestoreResponse parses an HTTP response from a DeleteDatabaseClusterRestoreWithResponse call
-func ParseDeleteDatabaseClusterRestoreResponse(rsp *http.Response) (*DeleteDatabaseClusterRestoreResponse, error) {
+// ParseApproveUpgradePlanResponse parses an HTTP response from a ApproveUpgradePlanWithResponse call
+func ParseApproveUpgradePlanResponse(rsp *http.Response) (*ApproveUpgradePlanResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteDatabaseClusterRestoreResponse{
+	response := &ApproveUpgradePlanResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest IoK8sApimachineryPkgApisMetaV1StatusV2
+		var dest UpgradePlanApprovalResult
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest UpgradePlanApprovalResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -11075,22 +17175,22 @@ func ParseDeleteDatabaseClusterRestoreResponse(rsp *http.Response) (*DeleteDatab
 	return response, nil
 }
 
-// ParseGetDatabaseClusterRestoreResponse parses an HTTP response from a GetDatabaseClusterRestoreWithResponse call
-func ParseGetDatabaseClusterRestoreResponse(rsp *http.Response) (*GetDatabaseClusterRestoreResponse, error) {
+// ParseGetDatabaseEngineResponse parses an HTTP response from a GetDatabaseEngineWithResponse call
+func ParseGetDatabaseEngineResponse(rsp *http.Response) (*GetDatabaseEngineResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetDatabaseClusterRestoreResponse{
+	response := &GetDatabaseEngineResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DatabaseClusterRestore
+		var dest DatabaseEngine
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -11115,34 +17215,27 @@ func ParseGetDatabaseClusterRestoreResponse(rsp *http.Response) (*GetDatabaseClu
 	return response, nil
 }
 
-// ParseUpdateDatabaseClusterRestoreResponse parses an HTTP response from a UpdateDatabaseClusterRestoreWithResponse call
-func ParseUpdateDatabaseClusterRestoreResponse(rsp *http.Response) (*UpdateDatabaseClusterRestoreResponse, error) {
+// ParseUpdateDatabaseEngineResponse parses an HTTP response from a UpdateDatabaseEngineWithResponse call
+func ParseUpdateDatabaseEngineResponse(rsp *http.Response) (*UpdateDatabaseEngineResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateDatabaseClusterRestoreResponse{
+	response := &UpdateDatabaseEngineResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DatabaseClusterRestore
+		var dest DatabaseEngine
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest DatabaseClusterRestore
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -11162,22 +17255,22 @@ func ParseUpdateDatabaseClusterRestoreResponse(rsp *http.Response) (*UpdateDatab
 	return response, nil
 }
 
-// ParseListDatabaseClustersResponse parses an HTTP response from a ListDatabaseClustersWithResponse call
-func ParseListDatabaseClustersResponse(rsp *http.Response) (*ListDatabaseClustersResponse, error) {
+// ParseGetEngineVersionPolicyResponse parses an HTTP response from a GetEngineVersionPolicyWithResponse call
+func ParseGetEngineVersionPolicyResponse(rsp *http.Response) (*GetEngineVersionPolicyResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListDatabaseClustersResponse{
+	response := &GetEngineVersionPolicyResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DatabaseClusterList
+		var dest EngineVersionPolicy
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -11202,41 +17295,27 @@ func ParseListDatabaseClustersResponse(rsp *http.Response) (*ListDatabaseCluster
 	return response, nil
 }
 
-// ParseCreateDatabaseClusterResponse parses an HTTP response from a CreateDatabaseClusterWithResponse call
-func ParseCreateDatabaseClusterResponse(rsp *http.Response) (*CreateDatabaseClusterResponse, error) {
+// ParseUpdateEngineVersionPolicyResponse parses an HTTP response from a UpdateEngineVersionPolicyWithResponse call
+func ParseUpdateEngineVersionPolicyResponse(rsp *http.Response) (*UpdateEngineVersionPolicyResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateDatabaseClusterResponse{
+	response := &UpdateEngineVersionPolicyResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DatabaseCluster
+		var dest EngineVersionPolicy
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest DatabaseCluster
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
-		var dest DatabaseCluster
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON202 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -11256,22 +17335,22 @@ func ParseCreateDatabaseClusterResponse(rsp *http.Response) (*CreateDatabaseClus
 	return response, nil
 }
 
-// ParseListDatabaseClusterBackupsResponse parses an HTTP response from a ListDatabaseClusterBackupsWithResponse call
-func ParseListDatabaseClusterBackupsResponse(rsp *http.Response) (*ListDatabaseClusterBackupsResponse, error) {
+// ParseGetMaintenanceWindowsResponse parses an HTTP response from a GetMaintenanceWindowsWithResponse call
+func ParseGetMaintenanceWindowsResponse(rsp *http.Response) (*GetMaintenanceWindowsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListDatabaseClusterBackupsResponse{
+	response := &GetMaintenanceWindowsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DatabaseClusterBackupList
+		var dest MaintenanceWindows
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -11296,22 +17375,22 @@ func ParseListDatabaseClusterBackupsResponse(rsp *http.Response) (*ListDatabaseC
 	return response, nil
 }
 
-// ParseListDatabaseClusterRestoresResponse parses an HTTP response from a ListDatabaseClusterRestoresWithResponse call
-func ParseListDatabaseClusterRestoresResponse(rsp *http.Response) (*ListDatabaseClusterRestoresResponse, error) {
+// ParseUpdateMaintenanceWindowsResponse parses an HTTP response from a UpdateMaintenanceWindowsWithResponse call
+func ParseUpdateMaintenanceWindowsResponse(rsp *http.Response) (*UpdateMaintenanceWindowsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListDatabaseClusterRestoresResponse{
+	response := &UpdateMaintenanceWindowsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DatabaseClusterRestoreList
+		var dest MaintenanceWindows
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -11336,27 +17415,34 @@ func ParseListDatabaseClusterRestoresResponse(rsp *http.Response) (*ListDatabase
 	return response, nil
 }
 
-// ParseListDataImportJobsResponse parses an HTTP response from a ListDataImportJobsWithResponse call
-func ParseListDataImportJobsResponse(rsp *http.Response) (*ListDataImportJobsResponse, error) {
+// ParseListMonitoringInstancesResponse parses an HTTP response from a ListMonitoringInstancesWithResponse call
+func ParseListMonitoringInstancesResponse(rsp *http.Response) (*ListMonitoringInstancesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListDataImportJobsResponse{
+	response := &ListMonitoringInstancesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DataImportJobList
+		var dest MonitoringInstancesList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -11369,22 +17455,22 @@ func ParseListDataImportJobsResponse(rsp *http.Response) (*ListDataImportJobsRes
 	return response, nil
 }
 
-// ParseCreateDatabaseClusterSecretResponse parses an HTTP response from a CreateDatabaseClusterSecretWithResponse call
-func ParseCreateDatabaseClusterSecretResponse(rsp *http.Response) (*CreateDatabaseClusterSecretResponse, error) {
+// ParseCreateMonitoringInstanceResponse parses an HTTP response from a CreateMonitoringInstanceWithResponse call
+func ParseCreateMonitoringInstanceResponse(rsp *http.Response) (*CreateMonitoringInstanceResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateDatabaseClusterSecretResponse{
+	response := &CreateMonitoringInstanceResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Secret
+		var dest MonitoringInstance
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -11409,33 +17495,33 @@ func ParseCreateDatabaseClusterSecretResponse(rsp *http.Response) (*CreateDataba
 	return response, nil
 }
 
-// ParseDeleteDatabaseClusterResponse parses an HTTP response from a DeleteDatabaseClusterWithResponse call
-func ParseDeleteDatabaseClusterResponse(rsp *http.Response) (*DeleteDatabaseClusterResponse, error) {
+// ParseDeleteMonitoringInstanceResponse parses an HTTP response from a DeleteMonitoringInstanceWithResponse call
+func ParseDeleteMonitoringInstanceResponse(rsp *http.Response) (*DeleteMonitoringInstanceResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteDatabaseClusterResponse{
+	response := &DeleteMonitoringInstanceResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest IoK8sApimachineryPkgApisMetaV1StatusV2
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON404 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest Error
@@ -11449,22 +17535,22 @@ func ParseDeleteDatabaseClusterResponse(rsp *http.Response) (*DeleteDatabaseClus
 	return response, nil
 }
 
-// ParseGetDatabaseClusterResponse parses an HTTP response from a GetDatabaseClusterWithResponse call
-func ParseGetDatabaseClusterResponse(rsp *http.Response) (*GetDatabaseClusterResponse, error) {
+// ParseGetMonitoringInstanceResponse parses an HTTP response from a GetMonitoringInstanceWithResponse call
+func ParseGetMonitoringInstanceResponse(rsp *http.Response) (*GetMonitoringInstanceResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetDatabaseClusterResponse{
+	response := &GetMonitoringInstanceResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DatabaseCluster
+		var dest MonitoringInstance
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -11477,6 +17563,13 @@ func ParseGetDatabaseClusterResponse(rsp *http.Response) (*GetDatabaseClusterRes
 		}
 		response.JSON400 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -11489,33 +17582,40 @@ func ParseGetDatabaseClusterResponse(rsp *http.Response) (*GetDatabaseClusterRes
 	return response, nil
 }
 
-// ParseUpdateDatabaseClusterResponse parses an HTTP response from a UpdateDatabaseClusterWithResponse call
-func ParseUpdateDatabaseClusterResponse(rsp *http.Response) (*UpdateDatabaseClusterResponse, error) {
+// ParseUpdateMonitoringInstanceResponse parses an HTTP response from a UpdateMonitoringInstanceWithResponse call
+func ParseUpdateMonitoringInstanceResponse(rsp *http.Response) (*UpdateMonitoringInstanceResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateDatabaseClusterResponse{
+	response := &UpdateMonitoringInstanceResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DatabaseCluster
+		var dest MonitoringInstance
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON404 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest Error
@@ -11529,22 +17629,22 @@ func ParseUpdateDatabaseClusterResponse(rsp *http.Response) (*UpdateDatabaseClus
 	return response, nil
 }
 
-// ParseGetDatabaseClusterComponentsResponse parses an HTTP response from a GetDatabaseClusterComponentsWithResponse call
-func ParseGetDatabaseClusterComponentsResponse(rsp *http.Response) (*GetDatabaseClusterComponentsResponse, error) {
+// ParseGetUserPermissionsResponse parses an HTTP response from a GetUserPermissionsWithResponse call
+func ParseGetUserPermissionsResponse(rsp *http.Response) (*GetUserPermissionsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetDatabaseClusterComponentsResponse{
+	response := &GetUserPermissionsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DatabaseClusterComponents
+		var dest UserPermissions
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -11569,22 +17669,22 @@ func ParseGetDatabaseClusterComponentsResponse(rsp *http.Response) (*GetDatabase
 	return response, nil
 }
 
-// ParseGetDatabaseClusterCredentialsResponse parses an HTTP response from a GetDatabaseClusterCredentialsWithResponse call
-func ParseGetDatabaseClusterCredentialsResponse(rsp *http.Response) (*GetDatabaseClusterCredentialsResponse, error) {
+// ParseExplainPermissionResponse parses an HTTP response from a ExplainPermissionWithResponse call
+func ParseExplainPermissionResponse(rsp *http.Response) (*ExplainPermissionResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetDatabaseClusterCredentialsResponse{
+	response := &ExplainPermissionResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DatabaseClusterCredential
+		var dest PermissionExplanation
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -11609,22 +17709,22 @@ func ParseGetDatabaseClusterCredentialsResponse(rsp *http.Response) (*GetDatabas
 	return response, nil
 }
 
-// ParseGetDatabaseClusterPitrResponse parses an HTTP response from a GetDatabaseClusterPitrWithResponse call
-func ParseGetDatabaseClusterPitrResponse(rsp *http.Response) (*GetDatabaseClusterPitrResponse, error) {
+// ParseGetUserPermissionMatrixResponse parses an HTTP response from a GetUserPermissionMatrixWithResponse call
+func ParseGetUserPermissionMatrixResponse(rsp *http.Response) (*GetUserPermissionMatrixResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetDatabaseClusterPitrResponse{
+	response := &GetUserPermissionMatrixResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DatabaseClusterPitr
+		var dest UserPermissionMatrix
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -11649,22 +17749,22 @@ func ParseGetDatabaseClusterPitrResponse(rsp *http.Response) (*GetDatabaseCluste
 	return response, nil
 }
 
-// ParseListDatabaseEnginesResponse parses an HTTP response from a ListDatabaseEnginesWithResponse call
-func ParseListDatabaseEnginesResponse(rsp *http.Response) (*ListDatabaseEnginesResponse, error) {
+// ParseListPodSchedulingPolicyResponse parses an HTTP response from a ListPodSchedulingPolicyWithResponse call
+func ParseListPodSchedulingPolicyResponse(rsp *http.Response) (*ListPodSchedulingPolicyResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListDatabaseEnginesResponse{
+	response := &ListPodSchedulingPolicyResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DatabaseEngineList
+		var dest PodSchedulingPolicyList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -11689,22 +17789,22 @@ func ParseListDatabaseEnginesResponse(rsp *http.Response) (*ListDatabaseEnginesR
 	return response, nil
 }
 
-// ParseGetUpgradePlanResponse parses an HTTP response from a GetUpgradePlanWithResponse call
-func ParseGetUpgradePlanResponse(rsp *http.Response) (*GetUpgradePlanResponse, error) {
+// ParseCreatePodSchedulingPolicyResponse parses an HTTP response from a CreatePodSchedulingPolicyWithResponse call
+func ParseCreatePodSchedulingPolicyResponse(rsp *http.Response) (*CreatePodSchedulingPolicyResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetUpgradePlanResponse{
+	response := &CreatePodSchedulingPolicyResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest UpgradePlan
+		var dest PodSchedulingPolicy
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -11729,20 +17829,27 @@ func ParseGetUpgradePlanResponse(rsp *http.Response) (*GetUpgradePlanResponse, e
 	return response, nil
 }
 
-// ParseApproveUpgradePlanResponse parses an HTTP response from a ApproveUpgradePlanWithResponse call
-func ParseApproveUpgradePlanResponse(rsp *http.Response) (*ApproveUpgradePlanResponse, error) {
+// ParseDeletePodSchedulingPolicyResponse parses an HTTP response from a DeletePodSchedulingPolicyWithResponse call
+func ParseDeletePodSchedulingPolicyResponse(rsp *http.Response) (*DeletePodSchedulingPolicyResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ApproveUpgradePlanResponse{
+	response := &DeletePodSchedulingPolicyResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest IoK8sApimachineryPkgApisMetaV1StatusV2
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -11762,22 +17869,22 @@ func ParseApproveUpgradePlanResponse(rsp *http.Response) (*ApproveUpgradePlanRes
 	return response, nil
 }
 
-// ParseGetDatabaseEngineResponse parses an HTTP response from a GetDatabaseEngineWithResponse call
-func ParseGetDatabaseEngineResponse(rsp *http.Response) (*GetDatabaseEngineResponse, error) {
+// ParseGetPodSchedulingPolicyResponse parses an HTTP response from a GetPodSchedulingPolicyWithResponse call
+func ParseGetPodSchedulingPolicyResponse(rsp *http.Response) (*GetPodSchedulingPolicyResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetDatabaseEngineResponse{
+	response := &GetPodSchedulingPolicyResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DatabaseEngine
+		var dest PodSchedulingPolicy
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -11802,22 +17909,22 @@ func ParseGetDatabaseEngineResponse(rsp *http.Response) (*GetDatabaseEngineRespo
 	return response, nil
 }
 
-// ParseUpdateDatabaseEngineResponse parses an HTTP response from a UpdateDatabaseEngineWithResponse call
-func ParseUpdateDatabaseEngineResponse(rsp *http.Response) (*UpdateDatabaseEngineResponse, error) {
+// ParseUpdatePodSchedulingPolicyResponse parses an HTTP response from a UpdatePodSchedulingPolicyWithResponse call
+func ParseUpdatePodSchedulingPolicyResponse(rsp *http.Response) (*UpdatePodSchedulingPolicyResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateDatabaseEngineResponse{
+	response := &UpdatePodSchedulingPolicyResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DatabaseEngine
+		var dest PodSchedulingPolicy
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -11842,22 +17949,22 @@ func ParseUpdateDatabaseEngineResponse(rsp *http.Response) (*UpdateDatabaseEngin
 	return response, nil
 }
 
-// ParseListMonitoringInstancesResponse parses an HTTP response from a ListMonitoringInstancesWithResponse call
-func ParseListMonitoringInstancesResponse(rsp *http.Response) (*ListMonitoringInstancesResponse, error) {
+// ParseGetKubernetesClusterResourcesResponse parses an HTTP response from a GetKubernetesClusterResourcesWithResponse call
+func ParseGetKubernetesClusterResourcesResponse(rsp *http.Response) (*GetKubernetesClusterResourcesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListMonitoringInstancesResponse{
+	response := &GetKubernetesClusterResourcesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest MonitoringInstancesList
+		var dest KubernetesClusterResources
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -11882,33 +17989,26 @@ func ParseListMonitoringInstancesResponse(rsp *http.Response) (*ListMonitoringIn
 	return response, nil
 }
 
-// ParseCreateMonitoringInstanceResponse parses an HTTP response from a CreateMonitoringInstanceWithResponse call
-func ParseCreateMonitoringInstanceResponse(rsp *http.Response) (*CreateMonitoringInstanceResponse, error) {
+// ParseDeleteSessionResponse parses an HTTP response from a DeleteSessionWithResponse call
+func ParseDeleteSessionResponse(rsp *http.Response) (*DeleteSessionResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateMonitoringInstanceResponse{
+	response := &DeleteSessionResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest MonitoringInstance
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON429 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest Error
@@ -11922,20 +18022,29 @@ func ParseCreateMonitoringInstanceResponse(rsp *http.Response) (*CreateMonitorin
 	return response, nil
 }
 
-// ParseDeleteMonitoringInstanceResponse parses an HTTP response from a DeleteMonitoringInstanceWithResponse call
-func ParseDeleteMonitoringInstanceResponse(rsp *http.Response) (*DeleteMonitoringInstanceResponse, error) {
+// ParseCreateSessionResponse parses an HTTP response from a CreateSessionWithResponse call
+func ParseCreateSessionResponse(rsp *http.Response) (*CreateSessionResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteMonitoringInstanceResponse{
+	response := &CreateSessionResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Token *string `json:"token,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -11943,12 +18052,26 @@ func ParseDeleteMonitoringInstanceResponse(rsp *http.Response) (*DeleteMonitorin
 		}
 		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest Error
@@ -11962,27 +18085,20 @@ func ParseDeleteMonitoringInstanceResponse(rsp *http.Response) (*DeleteMonitorin
 	return response, nil
 }
 
-// ParseGetMonitoringInstanceResponse parses an HTTP response from a GetMonitoringInstanceWithResponse call
-func ParseGetMonitoringInstanceResponse(rsp *http.Response) (*GetMonitoringInstanceResponse, error) {
+// ParseChangePasswordResponse parses an HTTP response from a ChangePasswordWithResponse call
+func ParseChangePasswordResponse(rsp *http.Response) (*ChangePasswordResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetMonitoringInstanceResponse{
+	response := &ChangePasswordResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest MonitoringInstance
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -11990,12 +18106,12 @@ func ParseGetMonitoringInstanceResponse(rsp *http.Response) (*GetMonitoringInsta
 		}
 		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON403 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest Error
@@ -12009,40 +18125,42 @@ func ParseGetMonitoringInstanceResponse(rsp *http.Response) (*GetMonitoringInsta
 	return response, nil
 }
 
-// ParseUpdateMonitoringInstanceResponse parses an HTTP response from a UpdateMonitoringInstanceWithResponse call
-func ParseUpdateMonitoringInstanceResponse(rsp *http.Response) (*UpdateMonitoringInstanceResponse, error) {
+// ParseRefreshSessionResponse parses an HTTP response from a RefreshSessionWithResponse call
+func ParseRefreshSessionResponse(rsp *http.Response) (*RefreshSessionResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateMonitoringInstanceResponse{
+	response := &RefreshSessionResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest MonitoringInstance
+		var dest struct {
+			Token *string `json:"token,omitempty"`
+		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON403 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest Error
@@ -12056,74 +18174,53 @@ func ParseUpdateMonitoringInstanceResponse(rsp *http.Response) (*UpdateMonitorin
 	return response, nil
 }
 
-// ParseGetUserPermissionsResponse parses an HTTP response from a GetUserPermissionsWithResponse call
-func ParseGetUserPermissionsResponse(rsp *http.Response) (*GetUserPermissionsResponse, error) {
+// ParseGetSettingsResponse parses an HTTP response from a GetSettingsWithResponse call
+func ParseGetSettingsResponse(rsp *http.Response) (*GetSettingsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetUserPermissionsResponse{
+	response := &GetSettingsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest UserPermissions
+		var dest Settings
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParseListPodSchedulingPolicyResponse parses an HTTP response from a ListPodSchedulingPolicyWithResponse call
-func ParseListPodSchedulingPolicyResponse(rsp *http.Response) (*ListPodSchedulingPolicyResponse, error) {
+// ParseGetOIDCClaimMappingResponse parses an HTTP response from a GetOIDCClaimMappingWithResponse call
+func ParseGetOIDCClaimMappingResponse(rsp *http.Response) (*GetOIDCClaimMappingResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListPodSchedulingPolicyResponse{
+	response := &GetOIDCClaimMappingResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest PodSchedulingPolicyList
+		var dest OIDCClaimMapping
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -12136,22 +18233,22 @@ func ParseListPodSchedulingPolicyResponse(rsp *http.Response) (*ListPodSchedulin
 	return response, nil
 }
 
-// ParseCreatePodSchedulingPolicyResponse parses an HTTP response from a CreatePodSchedulingPolicyWithResponse call
-func ParseCreatePodSchedulingPolicyResponse(rsp *http.Response) (*CreatePodSchedulingPolicyResponse, error) {
+// ParseUpdateOIDCClaimMappingResponse parses an HTTP response from a UpdateOIDCClaimMappingWithResponse call
+func ParseUpdateOIDCClaimMappingResponse(rsp *http.Response) (*UpdateOIDCClaimMappingResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreatePodSchedulingPolicyResponse{
+	response := &UpdateOIDCClaimMappingResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest PodSchedulingPolicy
+		var dest OIDCClaimMapping
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12176,33 +18273,33 @@ func ParseCreatePodSchedulingPolicyResponse(rsp *http.Response) (*CreatePodSched
 	return response, nil
 }
 
-// ParseDeletePodSchedulingPolicyResponse parses an HTTP response from a DeletePodSchedulingPolicyWithResponse call
-func ParseDeletePodSchedulingPolicyResponse(rsp *http.Response) (*DeletePodSchedulingPolicyResponse, error) {
+// ParseDiffRBACPolicyVersionsResponse parses an HTTP response from a DiffRBACPolicyVersionsWithResponse call
+func ParseDiffRBACPolicyVersionsResponse(rsp *http.Response) (*DiffRBACPolicyVersionsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeletePodSchedulingPolicyResponse{
+	response := &DiffRBACPolicyVersionsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest IoK8sApimachineryPkgApisMetaV1StatusV2
+		var dest RBACPolicyDiff
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON404 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest Error
@@ -12216,34 +18313,27 @@ func ParseDeletePodSchedulingPolicyResponse(rsp *http.Response) (*DeletePodSched
 	return response, nil
 }
 
-// ParseGetPodSchedulingPolicyResponse parses an HTTP response from a GetPodSchedulingPolicyWithResponse call
-func ParseGetPodSchedulingPolicyResponse(rsp *http.Response) (*GetPodSchedulingPolicyResponse, error) {
+// ParseListRBACPolicyVersionsResponse parses an HTTP response from a ListRBACPolicyVersionsWithResponse call
+func ParseListRBACPolicyVersionsResponse(rsp *http.Response) (*ListRBACPolicyVersionsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetPodSchedulingPolicyResponse{
+	response := &ListRBACPolicyVersionsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest PodSchedulingPolicy
+		var dest RBACPolicyVersionList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -12256,33 +18346,33 @@ func ParseGetPodSchedulingPolicyResponse(rsp *http.Response) (*GetPodSchedulingP
 	return response, nil
 }
 
-// ParseUpdatePodSchedulingPolicyResponse parses an HTTP response from a UpdatePodSchedulingPolicyWithResponse call
-func ParseUpdatePodSchedulingPolicyResponse(rsp *http.Response) (*UpdatePodSchedulingPolicyResponse, error) {
+// ParseGetRBACPolicyVersionResponse parses an HTTP response from a GetRBACPolicyVersionWithResponse call
+func ParseGetRBACPolicyVersionResponse(rsp *http.Response) (*GetRBACPolicyVersionResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdatePodSchedulingPolicyResponse{
+	response := &GetRBACPolicyVersionResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest PodSchedulingPolicy
+		var dest RBACPolicyVersion
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON404 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest Error
@@ -12296,22 +18386,22 @@ func ParseUpdatePodSchedulingPolicyResponse(rsp *http.Response) (*UpdatePodSched
 	return response, nil
 }
 
-// ParseGetKubernetesClusterResourcesResponse parses an HTTP response from a GetKubernetesClusterResourcesWithResponse call
-func ParseGetKubernetesClusterResourcesResponse(rsp *http.Response) (*GetKubernetesClusterResourcesResponse, error) {
+// ParseRollbackRBACPolicyResponse parses an HTTP response from a RollbackRBACPolicyWithResponse call
+func ParseRollbackRBACPolicyResponse(rsp *http.Response) (*RollbackRBACPolicyResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetKubernetesClusterResourcesResponse{
+	response := &RollbackRBACPolicyResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest KubernetesClusterResources
+		var dest RBACPolicyVersion
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12324,6 +18414,13 @@ func ParseGetKubernetesClusterResourcesResponse(rsp *http.Response) (*GetKuberne
 		}
 		response.JSON400 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -12336,26 +18433,26 @@ func ParseGetKubernetesClusterResourcesResponse(rsp *http.Response) (*GetKuberne
 	return response, nil
 }
 
-// ParseDeleteSessionResponse parses an HTTP response from a DeleteSessionWithResponse call
-func ParseDeleteSessionResponse(rsp *http.Response) (*DeleteSessionResponse, error) {
+// ParseListTemporaryGrantsResponse parses an HTTP response from a ListTemporaryGrantsWithResponse call
+func ParseListTemporaryGrantsResponse(rsp *http.Response) (*ListTemporaryGrantsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteSessionResponse{
+	response := &ListTemporaryGrantsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
-		var dest Error
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TemporaryGrants
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON429 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest Error
@@ -12369,24 +18466,22 @@ func ParseDeleteSessionResponse(rsp *http.Response) (*DeleteSessionResponse, err
 	return response, nil
 }
 
-// ParseCreateSessionResponse parses an HTTP response from a CreateSessionWithResponse call
-func ParseCreateSessionResponse(rsp *http.Response) (*CreateSessionResponse, error) {
+// ParseCreateTemporaryGrantResponse parses an HTTP response from a CreateTemporaryGrantWithResponse call
+func ParseCreateTemporaryGrantResponse(rsp *http.Response) (*CreateTemporaryGrantResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateSessionResponse{
+	response := &CreateTemporaryGrantResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest struct {
-			Token *string `json:"token,omitempty"`
-		}
+		var dest TemporaryGrant
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12399,13 +18494,6 @@ func ParseCreateSessionResponse(rsp *http.Response) (*CreateSessionResponse, err
 		}
 		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON429 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -12418,27 +18506,41 @@ func ParseCreateSessionResponse(rsp *http.Response) (*CreateSessionResponse, err
 	return response, nil
 }
 
-// ParseGetSettingsResponse parses an HTTP response from a GetSettingsWithResponse call
-func ParseGetSettingsResponse(rsp *http.Response) (*GetSettingsResponse, error) {
+// ParseGetTelemetryResponse parses an HTTP response from a GetTelemetryWithResponse call
+func ParseGetTelemetryResponse(rsp *http.Response) (*GetTelemetryResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetSettingsResponse{
+	response := &GetTelemetryResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Settings
+		var dest Telemetry
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
 
 	return response, nil
@@ -12473,373 +18575,498 @@ func ParseVersionInfoResponse(rsp *http.Response) (*VersionInfoResponse, error)
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+y9iXMcuZE3+q8g2hsx0mx3U5oZ+635xRf7KFKepa2Dj5Q879tpPQtdhe6GWQWUCyhS",
-	"PbP6318gcdSF6oOHREm5EesRu6pwJBKJ/OWF30eJzAspmNBqdPj7SCUrllP45zOaXFbFhZYlXTLzA01T",
-	"rrkUNDsrZcFKzZkaHS5opth4lDKVlLwwz0eH7lui7MeEi4UscwoPx6Oi8fXvI5pl8pqlr2jOVEET+2PK",
-	"ipIlVLN0dKjLqtf+C640kQsiwlfEtUO0JJViRK+4IvPWMEbjEdcshw70umCjw5HSJRfL0cex/4GWJV2b",
-	"v+dVcsm0GVX09dZwIs8XskzYGdWrC73OmJ3SglaZDgRzn8ylzBgV5hsx1FmYZf/pePRhspQT8+NEXfJi",
-	"Igu7RJNCcqFZaen3cTwq2TI62N1bsN/9PmKiykeHv47Uj6PxiP5WlWz0btwfdVVm0dlcsZIv1m9eXLSo",
-	"Yle5SxQY978qXhpG+NVSqLU27pO6fzn/J0u06afFv8pwjOkwcMC/lWwxOhz94aDeAAeO+w/arB/hjuOS",
-	"Uc1ar53RktqWb75PCtMG06xU/W2SJEypv7F1lKZfxCZq9/5mxUiSySoNs7dvHyRSaMoFK4lorPCn2nzt",
-	"QR4ZMpQkZQsumBmp6QLGZQinV6wh4uDPk1cX9rEVeGSldaEODw4uqzkrBdNMTbk8SGWizDwTVmh1IK9Y",
-	"ecXZ9cG1LC+5WE6uuV5NLCOrA1idgz+kQk0yOmfZBH4YjUfsA82LDOh9rSYpu4qR6va7XrGkZHqI8R6m",
-	"TKg3S3P8G2TFCdX0NC9kqf8q5302aD0mXNmVB2FhFhr+TKmmHN75p5wrcnR2Ou1v4oL/nZXKrUiH1c5O",
-	"3TPHbraXK/ubYT7bH/AdV6Q0u1sxoeFYNT9TQeyMpjNxwUrzJVErWWUpSaS4YqUmJUvkUvDfQnPKbHXT",
-	"T0Y1U5rA2guakSuaVWxMqEhnIqdrUjLTMqlEowl4R01n4qUs7SF/GBh+yfX08j+A2xOZ55Xgeg1bu+Tz",
-	"SstSHaTsimUHii8ntExWXLNEVyU7oAWfwHCFmZea5ukfSqZkVSbA9T3WueQi7VPzb1ykZqGo37Mw1ppo",
-	"5icz7fPnF2+Ib98S1tKwflU1yGkowcWClfbVRSlzaIaJFPYN/JFknAlNVDXPuTYL9a+KKW0oPZ2JYyqE",
-	"1GTOSFWkRjZPZ+JUkGOas+yYKnb/1DQUVBNDtig9c6ap4eXGPq33iSpYYh602TqRYsGX/UU4ht9b7Gxf",
-	"rUrLtM29Q+zmIf+U8+lMvFkxxYgVSorQkhHTNV/wxDNsvSdZSebMLGilWGo4luSV0tCVLHOi5Uw09quX",
-	"5V5gN5r5TpGp6WZqRzmVBRNmW/54AZ9OR13JYaRoLdknwDDlFZtU4lLIazFZcJalKojStNFX/FA86bzh",
-	"ZU2DQIb37OnsqWd/n8YW0/J1v58Ly+9ektm/3IkGfWnZaLa92gXVq36L5rj17Zk3/DKlvGSJluW6brLu",
-	"xewfWGxut9acERq+pmTBM0ZkSWjdypikrGAiNcstRZ82cSr8GKHAj8QpGnbMFz82UUqMM6fDOtlpRAId",
-	"hYcnVq1SjoXXXvZc/EhsC+SSrcnpCeEi48JIgFNtSFmU8oqnhqWNHLsuuWYTKTIjgYpKE2AuGKjd4JyJ",
-	"xHz8y4oJJ57gDa6IYnpsmmDzlZSXtill37Fy0W2GCzgr/VZjKZmvyfukZKkRHzRT9rlhzPczYTYaywtD",
-	"jEZ3fjlD30baGSWp3nLuaOwtkz3C+5R8Br975moqXxc/OqUx2l504BEp1Xmtue9KtmCloatnZ6tNeNZp",
-	"rGSjMyu+PDG9LDLvw8uXbK3I+6NfLv5xdHz8/OLiH397/n/+cXryHiQX/H7x/Pj8+ZvG4/fR+flD5+35",
-	"i/6sntcP4RwU9RllfpKLjl4f7WG7It3u9C+t9x3neXFl9vVEwYO35y8MlU4XpBKB2cZ2w9kOPF8qAh01",
-	"RtfQ2Wvltj2Mc/i9XsOlU5C2s4xd3qMm1uqIjfYLwzvbMUpjg3/ju3uTit+m8d/9mw0GYkJVJSNvXlwc",
-	"XFy8INAYT0BW78pIpqsYH3XwRFxq9EHDxwiM0LRcMn2cVWrwhH/TfWVQ1NjGSGJfjdC0M/CedhGO/9jA",
-	"YihIaaorFdPvDNDULD3SMSUvPPRT0Txn5Noyak+5I6E1oirYHYsqy9Zmfvb4HR2aqbCJaSXGSP+U8zhp",
-	"/2ofDBLUdK5XFIZZViJI784Z3+swo0q/noNml/7MBLPKa7//F9H3/HBMK0S6x2RZP2+qXQ0duEkPLvSf",
-	"fqqHZkDakpVWW1fKmWfbg3lpH/je3XsbOuvLQk3LgTW/8I92W3HX0u5LbBiRRbvVYUZJVZYAs+DHnef1",
-	"caeN3AL83nS4wSYApjR7zDr4CIzW0jAzZ24DvPiBK8CgnQGrz2czIHdoMiBbLAbkcxoMgvlyJ1Nw2zIU",
-	"sXF+AvsDuSvzA+lbH0jL+EAerO1h8y5l5WYsHbYHJSWrFJ1nzCwM1Wy5BiXLbsF6RwoAoKaJOVXsuD6D",
-	"0aCHBr2v0KA3vHUuCpa0GNgb4mo2bRnR+pvEabBnrMy5MryvIlpk75222dA+nlzzlJGi8ZJXgA2W6RuD",
-	"vB2x+QUtmTUUaum1MEYocQM4lxmLGX9Y6fWJcGp07F8y48n6vMoYWcksVS1rEigD9v05CKEC3iZllbEx",
-	"mVeapJJZMOUtBY3PZ4LOZaXJ9crubPMVoUWRATaTRJbkesWTVe3Ii70WFV4/l7IqVFR22Ucxq4t/GNFx",
-	"wsaeEnK6IHmVaV5k8AlZ2gYbtlwD1ahYE5oAldy+MpB4aVrURArTqTXfMlHlsFhp3YvBnKaB0Dy55lkG",
-	"ZkTryJyS2Wg2amx9Z4QuG0MChWU2+r79Hs2yxqinu7s9OzZho/VN/Ata5jwBb6MU524Sb89fRBbgVfsF",
-	"J/kYKJAFLQ08JVWZKbsG1Lop3dmwolfMGx7MoU++t1R3NLEMB6YGaulhANiYLLg5JpRmhYfyBdWrmbjg",
-	"BkMJKSZBrMKQTJOGYwPXAfBuGgdsH4YDEzhxzb5q7DNVQ7TUSt7WNnzGwcw7nQmzqxRJqCCM6xUroU0w",
-	"KJsVqrnhkaqSlZnUbFTIVM1GZmvMnFFHzUaPzd/dicAsW98aGTsbPR4TIBQId6lXd80Cfgzgs4/ZsBqP",
-	"PbRwPlqz3XUNKGABLCPE9j0hRwJMOWtgoJxR4d5mV6xc65U5Onnw/d/XPDfM0bG3n0+9oFYv6s7nu++/",
-	"6+7UWu7c8eivWDmPjPzv5uf2qO1PdjsG9nzxwiolbnhGiVFeYnqTmZtidF7Q/d3OqWM1shOMWYO6QGeL",
-	"ly+cA3X4S8fb5z1v0eO1fzx1vG/9jl+3X/BHlfuZXP3Y0rAj/e3hvIvBj7SNDo6lMJCCu0i6vkYVfzfo",
-	"OQZ8Us3nPON67RWb3LKCSElRMvhNOesuda6FOSOKaq7McToT83UftpA5W5gTD1itrdMYmTp3+tA11yvC",
-	"9ZSAhdcZtmPOx5lgHwy1VO2TbY8WtBX/pRlIhxEEY6njg9oE6K0ghgXgNTWeCS+Ug5oXWrSrM66HwMSS",
-	"i05PamwkvoQzI3xZc5k3p/cpFg4mFaGatS/bcRrdy6gcVzTjRvsPPuVGazPh9RkN2mjSWHy3NEUpE8bA",
-	"qwnLULt1a3r0d4inyl8cp/bla/N5Y4cGoWWp2OEmppvO8SZZwDk+E89psrIuDdPWXy9ev7JOW8cWoGZD",
-	"kwChlHfmglawseG/yJK4sKYxmY2sM94u7NRsP3+i2wdmUawje1rbvr3vXsmcwbxnoz3kZ3yft8PNOhu7",
-	"/is46xs/DYmefkwbV0VG1wNhAfVDS/NVlVOjxtAUFCsfcbZjX/+U84so7vurfRDiArpIbxAU9fwFOY2B",
-	"+GP7IJhw3Z9akrIacObvHmzI86gh/DRvmMHhnV0XJcYLxSYQO4Re7wWwIlJFpIpIFZEqIlVEqohUW5qA",
-	"qgo4CdPnoDpGqHLReSM46R2JmPs5sGr7gHUdqA2nrG34zbpgRGlqiOnP6jC6GpK47qbknC9XZiNfE66/",
-	"c2Kp+JDYcJxC5el8Sv5LXpvtMCZce/xWqDEplnA8mEPGAh67kFEFcLvOW4eC7OmH2+Yst2/c1lfOSvSU",
-	"P1xPuQ1NQUf5g3KUN+D2VvOUF4cX/RQX85bzxmGSC/rEvy2feGOL9NziKVOA60M82vbgEaPGvhWKLthx",
-	"02oZ2TYDbzoA460DLkg2KC0AtYyKkEC6asc2Siqx4Bo2d1HKtLLQtoLVmYmTkDx6SAa7BwzrVrpWaxwm",
-	"W1RmcUjJMkaV1Xf7Idw2CD0S82+D050c8qHqTXtUj5xMGOiWxlQxeGB3yiKjS0srAHq2ZdWc75ScwYgh",
-	"aD2dW1ujfW9q5ElqMN6v76auP9MYMKnMCKPJivh3iGIFLalmBlqKtNtUwXUZa+Ps9M15nFbmi4g55/TN",
-	"eW1Qa66O05/snjWLwnMGwtGAqR755s1k5rgZ8ln3lZjNpfUSuV6x0hp5/DjdlG2ORPtlb4G27BoYSZnW",
-	"a4uRMwVEtlckQ+IGLGEGGqV/VWSSpqfm8Lii2UVMSLztvkJElc9ZaYijWCINDpgzfc1cpOyci0wuFbFN",
-	"q0iIbwcE+RlFw7c9c0bwjn/URoJ+X4UPB+GMWyjP2p196X9u8d/0E7HY8bm3WgZhPBM+LTuTIUngofKb",
-	"z000FBztnpo+RJx+U81scG3PyGNZ8Lido/VCaD8wsVvxxD7WkpTMoLZOsPqPP0SD1cPQBvkzCLJSig0z",
-	"6WyKPl/VSzH2CeKhte0WhCFn78VANuVJeNaIMwW06lQuc8bOpdRKl7QwWhklgl37qLahfTLQ27PG0+5G",
-	"dBqeWRbIygHl7RPtQ9BCYKb2TP00W26/bFRHpwXP2EHIKZ3eiMGg43cDnGJx8CY7iHewdwKPrXFZEPbB",
-	"QZTWysZcbZh6janXmHqNqdeYeo2p15h6janX32Tq9c6p0O+26BEujs/G9/z6e51fuynmzEyR53mlDeQY",
-	"jUclYJyRYtmC/O//TWSWXrBsMfr4zigic6fNWr14QBd51nspJoNPnnkI4SVKX/PvK8xbrUggqiZcTFoG",
-	"o7b+2DuQ02jG7kkjYfftm2NiQySlbxRcLUZgm71aaIsfcqoPyWz0w5Mnf5o8eTp58sObp388fPLT4ZM/",
-	"/reN5RssQhZY246my9zgjPUJy+bf4MG3s5sCZLQ1zNzH1lkQKWO2Wwqx9ekOOYab2mXDBbzFxLlF23dt",
-	"xiJh44f0oJ/m+Ny7aXjbuu08NZ4Dj8/9EePDVmeiEikrMxDIPkY2IifYFTOcOmmH0dqigw4P+r4cGmw0",
-	"NhOvXr95fkjeFmavguS3Yt3Qak0KCU4epWmWWZ3SaLgZo6lVbk3HtAwO5mQDvCwZxARFTSX2Sd9G4ugf",
-	"Po3YRnIueG647WnMTrJTIAp1dtXg18k4eGLMuQV26PYw7BLAmWHOrO5XPkTK6NsKzCYdzisqULvF+vUC",
-	"BGNv1L2Aj3fd/Xd89tYTy/wzDKEZPG6BtcG+o8PR//doNvv3/5k8/s9Hj359Mvnzu39/NJtN4V/fP/7P",
-	"x/8T/vr3x48fPfr1by9/fnP2/B1//D+/iiq/tH/9z6Nf2fN3u7fz+PF//lv3TDDSUJYTNy+PKHOWy3J9",
-	"a6K8hGbqMg3w1xdNmng4Sagi3C3p4MpntkSXt95uPnKSjKpoKilVYVeGluDHDnovjIBRmglNrmRW5fAa",
-	"j56aiv/Gbr3WF/y3Gvibf3sPzeA4vpQFbypfQKphI+vvG05lt/zwYn0eFx8SQwqp9LJk6l+Z+UPl6Txe",
-	"YVSx0iqPKq5bvW2/EDWhR5GmDVy1Xw5o2fHDtHOUukn617fZHuu6u4PVS3MpuJZ2RXp1YMKzIGPqXzbv",
-	"r/pFq1/E6fky8laXqJR02yLH5w6rd7+/exPxTsept5S2D0bnKfcCo55FLMud8jwujniuwOVWE0W1okfH",
-	"TcsowAz/yH48ngkbrekzASB3gNfxmVYnAnhoDQ40K1Y+5cbAScdQzvvqOHomTtaC5jzxVDjKvLuMLBgF",
-	"7/2SalY3HrBnQDtTcmqjEAE/u+whB53t0DYFSZ43p9lMupLC7BNtDkZBzmR6YYjSejsS/7fBTwY8lVOd",
-	"rFp82eqmkOk0QvwQ1n8m0+DObtLCrAiQIaeXPmQ0cBG9ojwzhJoJLhRPGaGNVYtzK0TSxLO5mGob45KV",
-	"VMyaTKmPwfEbphGyDrxpNUAIrx43A6pDfA+8RcAenDZGPrbxpNdcsZmAZXZqp4H4daAW9L3dlSKGio9t",
-	"jQ7OaTG5ZGvVbGUwhjinBZTuAu12uCj73gf6F6Kcdgu9g45fp/WALKMfDAQhNJeVgIVMZF5UupEaEwLt",
-	"o+Fam0qatw6Wg5wKumQhl0FNauFwMIqwgg8m+9bX7dwH1XVWzoLHjSvnt5zd9KEhrojMuXaWlqYsgnBy",
-	"Z0ABRdkxDV+Emnnsg0GSXGfrRlrUTATpAOBaGAiZAWKBxZ/4ow2MgdN6KImNEWQfEsZS19unZbTd7DgF",
-	"NQI+5nWDo7gV0aG0LJomhXgYl0xduAMXS5uMF9eszuIvxjTWyKu9uJgS4n/MsjfshoVM7TZ35z5NSqnU",
-	"VrNIUcoPERP9mfk5mBHhj5ZBa0qaNgijpxTmCC851WwmIh/UWXKQVVPXDljyKyacKj0lRzORyDy34Ysk",
-	"oQ7jKaZr61A4rxuxdqAEBVd7SETr5K4PxW/uZo2zs9pqjGMfCqli5kL4vd2YfXeL9s5diMg5FcuY6nt6",
-	"1nzeTYA5PfOu6dI+f3R8enJu1g56ezyDAmnmePBkA4dya301KEvgqWhq08PqYGtIzQSj0zNC07RkStlM",
-	"ytZYIKuU65WsNMTV6Jyqyx3SXmJ2Yx8ZvtF27Mhvvh77DJwQUg4Z7KERD2Eb7Yan73ZKOL6JAdJyyee2",
-	"P7ZGgeZHND9+PvPjdsuTZdaO4SmXYinNxFfUHnju4HM2qOVcViJh5Y47Wa1omUZtNBfuSbAN+r/b8bTk",
-	"7OLlyTPwVA+cRTaDY+hEsk+7KebxzoiyL7sjVEVqj+wql5pqaj2MvcVSB0eG/t9FfW9b4nC9TsQXbRrU",
-	"8elR1Q3eUwML2K750Ij7th/dbrqt9W1Gt7rW321ziTt35Oay35szXuC11iRDOes9kl4Sza/YxZA/4Kj5",
-	"uGvEtwq3CMrrIzADg+npcdTBKYUFjyq6JdyzdjBamFL9cXC39+c2oMiExuu2U6Ypz+zxKAUj1Oi5tQuy",
-	"X8yaQ3pdSMjuUzKjSr8pqVDQ0xsegxD9d1rlyMHB72JD3YB1eNuXOpDgkIG1B4AHeM9Ho7jUu3mj+nfD",
-	"/1s3m6yMTpfaYhseUJoTH6I1QVc0yru3tbfriRs6WPXdNQOoC0IGwAa5c13xwWrpeV0t3RXXIaG4Tngm",
-	"UkAlYhkWs650VZOtG1QZKhpobzfO6YcXTCz1anT44w//15/+IzJQuUO5+f47XdE+9Wlu00a5+ZAdVi/O",
-	"NbXBPoa5U1IVMIm/yNL60EXCxkZQRlvjyvNutiZPf7AVO6BvyzLTehv9+uHdVEbL4/953BkQV8QQVi4g",
-	"YGQmILigZHbLOHwWrf/uBxytnh/E7ZO40ktVjMz292bxrKKUy5LmOdU8IRwilhaclU0GsYoxfOgRa5jd",
-	"d8ptvibLnEEGHitB2IR468a2XBfM8pSVvwaEsESH/FQbe82oMIe169OD3rENKbteMagLAAm37qPSViPj",
-	"KStZSihZVrSkQjOWQjCZ9dDYMK56p9M6kdNzdcs/YEbpkgKB9Ts8//TJDz/BYoQfWprlr0eT/6aT3949",
-	"cv94MvnzP8aH775v/PnOqoLRawNiB5mTXl7WeqKOXdUe8qas2Jj8BcIqyVsbQN4MCDLPR+MRvDAaj9wb",
-	"UfdjXNP00UYNDm9kwxLYaWQh5dQVP5smMj+os2U7MuPpn9qq+K+WLO8e/Tpx//re//T4P0GF3vTC4+8P",
-	"QP0O5H3366Qm9dQo4o1nj/9tq4U/ci7Vkjfss7BaG/yavQqUewQshXO8H7FUVzvsHFchwihaoK15EcC2",
-	"FAJ/FQn4YFQ/b+KvjatIfPaui9Cv6883jXC1dw9KK4lsbY/HLVGJaiDY1h1gsXJ29mRzIbIKKi6R9gaq",
-	"CqVLRvOgh0EYbZFBlDX7EO9xJZWOO+j+yz3xK+ffbOSO+o6csaVkNFk1VfQdTvjGfSjsgy5pK+WgPsd7",
-	"htv9zuTh619yqaAygTmympe/eDYNIjuiZe5wD0w83ejMsYGN6iz1LiTdIY/PqEbrGPCj6bpvjYK3wdC8",
-	"a+uJzHMmUpaGXR3rrP9WHXYeng0GLFqDlLdTmt8FYyls1bpsgd24XIVWXLnOqliWNPUHfS/KsdEoVKuy",
-	"FPBFr/qDm26KOBoOIdJS06xp9tuZxEMHpYN4AXa1js2hnbH7jToNtn42kPcffW23ciQu7fDzFiX5ZmoD",
-	"YTWfh1SNxCXZ7luTxH42/VwJwlHNZL7x+ryQGdHsUpZ8CSUhuz47GMzN0nvb47iF2czTYH/j2dDqhAv0",
-	"NlzGF7+Y7Z9yDmA/tLC76cRF40Vz+GinQ6VpXvS0RUvl75QN7HPH3m6dp0xpLuhgBWb/0A8ClNZ+3neU",
-	"4ZY0Vlb2Z1qoGtt7Q3HJADKbT0jKtAXgLtwKMmgyuVRRy7GV8ueQm0PnGYub615E3qoNdnBOOJMd1a3a",
-	"7WZXwQBc9s+d3rTn2fKZz1qkeodNBXR9d3PdYLiQYPTVG1cUbMmLhmRC/eGB1Rbsa49YZPABFxk89qt4",
-	"7GOw+hfLeoNAJAjWIcxY5jEkbzVrk7aRTemOqQ3mwR28tUOziZwVNb+SkmXUV3Ztuod6zloXlXbTDRAh",
-	"bmQz7EzeVqz8XVO3NopuI/t49GGylBDCO7FjH1yG2HS774Zs4v6S1TEEJPTdWyPBoCTe2xIacKbZ0WHI",
-	"RDk8OKgUKw9tTsj//fTJk2nj/w//+FMTfTcr1ih1Lcu03WgppR4N5LP4ddz29g58vNOpemfnKR6kD/wg",
-	"xSP0IR+hZ9FU/YH0/M7R06mNScuMM6VPnLZfS5Ifnvzw4+TpD5Mfn7754cfDP/758I9//u+d0UMcOzk/",
-	"aBc1FVyXAJA6+IkutF9/V8XAQFRNL5nYAKXa5RMid7bru57uDgt27tDXNgHr3tvNrukgHRo20bD57Rk2",
-	"3U7Z27LpvpvG6pTcro6j3Y6bK5x+6ZUbv5BCi1hK59sopbOXTyBybbhd6XpBt/NhQ0rcoSvAC7Mb+AIG",
-	"5VnLGbB3FOSu9uDGyFuJOWG4Hal4Fy5i1+dOiLXx7t0Ygr3ShQrXwwawXuNGHPsQcezzgRpo7edbYJC/",
-	"iwsvm8HLZr61y2bsBvF38lKIDHeZ+53KgQPXy7DUbYG2hN2aGmtt2n+DchvxQqzmWftkhU3Gm1ePXNGS",
-	"y0q58qe2NootpmLzt0+eOQkQLtTzca7N4MxEK5LxS0Y8IYOIeG6LCJK3p3A5bsVTFko1qZngwgAQKHcT",
-	"4jtlacCIL8gKBYFda7zcYLY2LcZrSflqUdBU865eix0sYWxQrY+IUJur4gT6NlCo4mKZscawI8h2j2uq",
-	"ezdIR+6sbvfV45j9bqXY2NjHG93IEA+1f8D3LnYwxmDY+zY04YTCPiji+ZCM8EV+mlIiWr3M6Ppl1ZLi",
-	"dYkgf6Yql7LTKmlaK3FD9pJNdV768U3QVi15mqKiUUY/OoLpTHiKkOedZ35NOx+P6x9sjrDhJikz5e4S",
-	"L6i9cLeDykqueWI9j30LNnz5X1StoqIYnp45a8vOOS6BMo4vOiCtjuMdJs5uG3OgW/WSFlay5LTYzgYb",
-	"yuUiJ3zbnBBqywyWfkIG+aYZpP+DITJyDHLMjhwT69kn8byF1J6IYvm6/UIb+rSpEGqa2zyhiN7lipOf",
-	"ZVScs0WkElLruZ1670KUxkseYvuaqV7n7Y1kJmbiF0ZSCRm6zVwkKMV1FcplNRu3DpxsXaPzv9XxUz5P",
-	"2GYnzllCbRH3ThsG59NMST8Spyz7ASofRt2o8CpSBxjN5lnRK0YqwYW2w02kUOxfFZQL9f3P2YpecVmV",
-	"vrgAJfPKFbh0UNEmqFNBKrOzdSWMolwXpzIr+PrFyykQSVXLpUGXdVkCjzePzk4PLOZcUZFmfTqrMble",
-	"8WRl65cVrITrsChRrORMzYRckGTFkkubt63ogmXrQBmaZRvosqnuqffZ9Pw1rxpQzPGR7l0owhYLBuU3",
-	"snWoH2jplVbAdEZbv4ZKJ2a/Uc3nPON6TbiaCWdtcPlzNu/bMoAt6OpsbOAsgtzbUBjB2pF8mIhpCXIl",
-	"E1aa/ZWtyXUpIcV8z9KA8oqVV5xdH1zL8pKL5cR0O7EbRR0APQ/+AP/ZQTZ1Cg/ltPCVRqmWOU+2+VWK",
-	"FY1Vd3PC5Mw87VZvgE82iZSY+Db49kjv7gvStFwyPWhCfdN87HG9T4bU0jF5a4B1nQA31HRH2e9baAym",
-	"T0Z7/1hHFrdtW3uI7XgOMIpvFN8ovr858f2ARGHPGj+gl9eWwLhX3mnHXBBKLv9DbSjpup+H3va72TNf",
-	"v3M7j7y30aIj/mE64p2PFx3wD8kB/7wsZcRfBT9DIS1z/PYv/BhUYGN91EqEi8U4FQu5MdXGB9cYKkbu",
-	"z4CHb+K5QuEKIbjd5xWIfeiqKFliE5Nj9xm+cKKlfQ2QvYEjXKlRuzHcYV0X3YF6vS52/NfRsvhhNB4t",
-	"ix9H78b7+FIbI2e7b7CLxmdRj1irPmSDejFavdtlAc+H6/5GVrEpSwa8SpHUt6J6ybOMNylnK5g0s79G",
-	"h6PK1rr5OB6lXF1euGIou31hy9g+W2u2cze75KIF8hyF+X0cjxJa0ITr9Vc612M/vR7H+QfjxnrH2Ky+",
-	"4Mfrky46wVUt3rQH+t8+o4r9wvUKYjEj9Ywb1x25L5oobxRxwY5HVZmNnEP7XXTAz6LgfXtf0YCMVx4K",
-	"7CXBAoAIt3L428zgwMv7YxntI6O8Mz3cuZXn/XDdJp+oS15M7CXxNJvAGcvKUJ26sjmT7SJ/N22sc33t",
-	"be6rjd9BuwPLttjuluwLhbl3ubroyN455m/QcPpS66YyXyjX3aj/6sI+tkx4dzgrFWqS0TnLJh5xNdJh",
-	"83zS4Lm7WfPA7n3u3bWR/sLeQFrswBq2AMoZLWmu7k6yjff9/Ozlyx1naK1MdyAWTZe9U89Ijt6PtODu",
-	"Tu+ab2jBL9n6zjgmnlYdfr2FLHOhX42RpzkXN25xl+P37OXLPrkvCpbsKq/gZtw7Ysp7ZUaLtlrMGJ2Q",
-	"8taGnXTniM4ROfTCSdxre+t5+fr05Ph44P4Xb2Y07/hCmuXWu0wNND2N4GVoBa6/cVfiuFdPohBeqYqV",
-	"b89fDLQTRmP3dh9kJbKIqf/wsXu4u1rRwyhujs1xhj5jqmPkWqOdrkkaiCg/kympXyXuXYwrx7jybyWu",
-	"PLJXtqfWRj6KbJgFBH+vh4TiUeu5XfD2jSN+l/qWwt0jJGXO70ek6N4T3B9J46LgyPzh2cX/8yLcTuJ7",
-	"iw+m8UGdIhoxRu922/+Wzk6e+cChQqaxC05lyjwdh0K850wR816DjLXEq6+As8mpaYR64F8qWXpSGT6r",
-	"F/50KWT4+fkHllTxSPM3BpbYr5i71d+2CTHx7oG9d05LGKozxSmquVqsbX5AGD37YDa3i0D2tw6GC3Bt",
-	"fXtwcnENez5ZSanYTFBLBWj5iksQmrbee0lys22DwyG0b/Nn68+4mgnwZQWahIKTgtUFxJegTisjRnLT",
-	"6jXjy5VWY8KnRkaE+7DqhnPGtLJ+QgfjGkvUuHKJPPLybiacbBoH1153faIkGxOmk+njMdyPB1dEUhjm",
-	"fE24hhrUIF1LWS3tZFjmuvaHV32HME3NFpyJ2cjOcDbyJ5Jp0d2kA5OEi3Z9Cq4srb0Z9i88eV6P73/Z",
-	"K/jMV4/U45qmK75ceZL6i8baS7Ehf+LIuybrdWsQWLMyDyOENbBQ13bOc3vHpVtF8mQmHpl1tHkBhqkm",
-	"sng8JUdEVIGzN/UgZOjANaSsIz20NbAFmUiiJgGgsGIZpNRDX2NClZIJh9CBQMI24e10+n11FyTWo/fP",
-	"tXtuMep8DU/haos5yzZltxwNt+PUgDC3lqfQqjBjQsklW49dzkXwtbobsm0RHMt5l2wNbzndpzf1S7aO",
-	"Sy+YAnwe7koJY7L3UIKGEK0R74YTvRUrpE2Ytr9zxeIM0Vccyg1QW9t/UWtrf6cZTxvBBGYrnIoxeSW1",
-	"+c/zD1wZQXMimXolNfw5JT9rS50X8UL8tvHorgG13bpLak1MTe2VPQ2/NsSGGEFqx2EldrhSxLTh73AX",
-	"Ukx8MEG/ETt+01BzBpvaG27rZ23aeeEqr9uPZ6LxNUSghEQqJ+dacR7+GseihAq6FLzWrvqdj7awDVql",
-	"PqMJS0kKctiqr1SzJU9IzkobvJusprvDpQ23WfsghQ6gsuaTwHM3ulW7H8Vmhv0XI/VvLwzg8EBhgMIA",
-	"hcGXKAxuFEZlNY0+S/1iFaquqgLixmP8ts5iRMOF22tvQM9xbg64kpg8nTx98mS6y4UXHUo19Ksw3LuR",
-	"nUO6+a7YybFy0ORbYnUA/YS7c3OmCdUz0dREec7GHutZvnYmDQ/EUiL9VUOG3PYKk/3HkDBq73+fQ5b2",
-	"TBg8J3NXAMlvCzMI5mdPHrHpcupjE6lwVpbHdrxqrTTLrUHLIDZ3pZgu1+Diu2JCVzTL1oRd8USHKYKZ",
-	"h2sLgeMAuslR0dtL3cX5ZOisMyq3w4rwT1iA1+ebIYmFCwYKAjLptxgBDLaPFv3lAuShBUVHr07AKGXe",
-	"eiMLmcnlujk7G60ZruOH47Sau2PFUOxVhxwID1AjQI0ANQKEBygMUBigMLgPeHDLafQ1uHf7jyIWQlHI",
-	"dBfXilEyhz0rVqVN5CSTCZTnNGxgPnHARdHc6tlj8psUzFrnDfOArmxTqgqZPlKPH6NnBj0zd++ZWVFl",
-	"F9iKsmFHTWM7mG12L34as6ZuSSAooqa6HVdKrM2ApWft0TjFwQbvpylLScHKiV1FSRYcMg+7AyFu8BF/",
-	"cavxzZCwtf9v63wB5cFLs6g2BdrFvypWrgkU+Q3Hfgi0cEYRrkgCZSRkqiyIB4eVQZ1j+7hLQ7/2MGYh",
-	"zXN1EwDYfcMqZl4PtDOIKoIReFuj2k064XCbt1AKXa7qrZVC81G4se0edMMw3vLelESYdEtP3Ec3dLqY",
-	"zfn7YrTEnRW2mfjy4dsLMMJsKowTu4Gxu+dtK62yLL+bnQVk/kgKyktlRKbTopvPnDrUaGYmclvixRDg",
-	"imZmM1uzoDv3TPNdUWM0cqnsRg1p0DNDuNlobE+sJnPMRqfCPKDufGjxQxATUPtvZtl4NtompLbl4u1U",
-	"NyKQIV5v82XruZdx2l35XIsZUNushHHnuz3qeZbNxJzZK1Xs1fKJFIqn7hJyO8de/cpMysuq8FTyAXQz",
-	"wY3G4s251p5niO0WYmLD9+zv0B7sF3c2vm8dee+N6vseJKYgj+DDx+9nop6FVeJkBcwVUoMbCkyYINkw",
-	"P6vp2XoP9dC/s5r5Iyo0fxzO9CkBGoPATqX4TttuPcf6BmainnwNCK0ebsnpsvldNKNhbBA01loLOMCd",
-	"FAtZznmaMkgiD53NpfeN1Atv+LZFv+lMHGVKjrsvJiFy0bAJ3P7a+o5wZWammL5bATYe5Vxt5ebuK18l",
-	"QwupkaejPN1b/g1s3Xv383F2SEjaS1+3Ol83gS+og+D4aaiClpLwq9kU8CD1WK4SjSp0jdYsX3Whty1d",
-	"6yCxAn28vqu48TW8PJ0J8E/V6qlIux6r+hPQ7XNGhTlSvYnjO1W/MhuZJfRReKHRR79/fNyKvKvbROCB",
-	"wAOBBwIPBB6fEniITiZ6k9LNA8YZd22ODtU8qd18odxXo6bGnZ1szUNr4FxrHn69I9ofa4OHWDjmep9u",
-	"O9/uWLvQLnzjb3E/o/Mi1PWkgovBKHtOzXts5mm0o9ZDofmkfiMYKEHJ9LFXVkp1FCnnsQiG/Zp2hvtZ",
-	"2RoEVyFLnSpSVkK4bB1r7J8Ju1+s4ugW2p5SMCI4qmoSNOzSVNt8ORcyI4VTkqHc/sJutcADMCke+p/O",
-	"xHNY9mbTvrScraGwQ5X+xsrEJOFQuNv13uFuHTv0GO6vuItwt459G2PeHkrMWwPtNoPfZsJGv5FbBb/N",
-	"xC8GHtX37+ZVpnlR+7PVOFRfUz5kQ3V40nRHkxVAw5b3gJbOAa5g61mXGij1NibOaznWdcg3KtYn9S0n",
-	"wQigyCMjcLK1A+KtfdOSVE515lehsKa9WybIq0fq8WN/MHUF6UzcRpKOjVzbTxKStiBsSN5aEs6qJ09+",
-	"TBqCB35g26XiTFiW8r7LBjVrqYheKASDCAYRDCIYRDCIXij0QqEXCr1Q6IVCLxR6oRB4IPBA4IHAA4EH",
-	"eqHQC4VeqC/IC3Xr1C2XASU03zkLqrmmQ6lQ9ErylBSV1uFmqq8tHapFBsyJ2jknaohumBiFiVHokkJk",
-	"iMgQkSEiQ3RJoUsKzffokkKXFLqk0CWFLikEHgg8EHgg8EDggS4pdEmhSwoTo776xKiWo+RzZkftPxBM",
-	"kcIUKUyRQn8UwkKEhQgLERaiPwr9UeiPQn8U+qPQH4X+KPRHIfBA4IHAA4EHAg/0R6E/Cv1RDztFKpo0",
-	"VcoPEU44Mz/7Uz74TBIpFnxZWWBAPC44eUbs60XUsGvIuUtOFpB9OB/L91bIFK+Wwqul7j6Dajhlqnso",
-	"30vOVEAx9bo13Z5NRwqsgb1i13bO8yLjCdduFcmTmXgEzjpwzRimmsjisdFU4Aza3kN9hy9xDZlelazb",
-	"GtiCcCn11mswb5tehbf64kWeeJEnXuSJt/qiMEBhgMLg9rf6DgX7/bJ3sF/3gt8xuaNgv1q/wgLoD6UA",
-	"umgF9REb0zcTtwrqiwLo9pXRGwsZxM86CNmzWNFG75kFeH2+xQ/RMWr1WowAhog50cXA5Q27orXSvXEm",
-	"j+bsiOFPQDTeGElUNXfHiqHYqw45EB6gRoAaAWoECA9QGKAwQGFwH/DgltPoa3Dv9h/FUMm7Xcvdbal0",
-	"F3xsX2eVO/TMfLmeGaxth7XtMJcIQ/owpA9D+jCkD3OJMJcIc4kwlwhziTCXCHOJMJcIgQcCDwQeCDww",
-	"lwhziTCXCHOJsLYdxrxhRTusaIcV7dALhWAQwSCCQQSD6IVCLxR6odALhV4o9EKhFwq9UAg8EHgg8EDg",
-	"gcADvVDohUIv1Jda0c5mQAnNd86Cat0BNJAKRa8kT0lRaZfO8hWmQ7XIgDlRO+dEDdENE6MwMQpdUogM",
-	"ERkiMkRkiC4pdEmh+R5dUuiSQpcUuqTQJYXAA4EHAg8EHgg80CWFLil0SWFi1FefGNVylHzO7Kj9B4Ip",
-	"UpgihSlS6I9CWIiwEGEhwkL0R6E/Cv1R6I9CfxT6o9Afhf4oBB4IPBB4IPBA4IH+KPRHoT/qYadI7fLL",
-	"eFSoPJ33eePs4uXJM3/uBy9KIsWCLysLFYhHCvbdk2ckySqlWRnRLOyHF6y8YhEV4LjxdMc+T54R+xVx",
-	"nxVRM7NZ3F0yxIAJhrPDfK+FTPGiK7zo6u7zuYYTuLoqwr1kcAVMVa9b0wnbdOvAGtgLf23nPC8ynnDt",
-	"VpE8mYlH4DoER5FhqoksHhu9CU7E7T3UNwoT15DpVcm6rYEtCFdkb72U87bJXnjHMF4riteK4rWieMcw",
-	"CgMUBigMbn/H8FDo4S97hx52rxsekzsKPaz1KyzH/lDKsYtWiCGxEYYzcasQwyiAbl9gvbGsQvysgwBC",
-	"ixVtLKFZgNfnW7wiHRNbr8UIYIgYN11EXt6wclqb4RtngGnOjhj+BETjTaNEVXN3rBiKveqQA+EBagSo",
-	"EaBGgPAAhQEKAxQG9wEPbjmNvgb3bv9RDBXg27X43pa6e8Hj93XW3EPPzJfrmcFKe1hpDzObMMAQAwwx",
-	"wBADDDGzCTObMLMJM5swswkzmzCzCTObEHgg8EDggcADM5swswkzmzCzCSvtYcwb1tfD+npYXw+9UAgG",
-	"EQwiGEQwiF4o9EKhFwq9UOiFQi8UeqHQC4XAA4EHAg8EHgg80AuFXij0Qn2p9fVsBpTQfOcsqNaNRAOp",
-	"UPRK8pQUlXbpLF9hOlSLDJgTtXNO1BDdMDEKE6PQJYXIEJEhIkNEhuiSQpcUmu/RJYUuKXRJoUsKXVII",
-	"PBB4IPBA4IHAA11S6JJClxQmRn31iVEtR8nnzI7afyCYIoUpUpgihf4ohIUICxEWIixEfxT6o9Afhf4o",
-	"9EehPwr9UeiPQuCBwAOBBwIPBB7oj0J/FPqjHnaKVCxpioklF5F7+p/D7/6cD16TRIoFX1YWGhCPDE6e",
-	"Efd+EbXtGorukpYFlB9OyfLdFTLF26Xwdqm7T6Iazprqnsv3kjYVgEy9bk3PZ9OXAmtgb9m1nfO8yHjC",
-	"tVtF8mQmHoG/DrwzhqkmsnhslBU4hrb3UF/jS1xDplcl67YGtiDcS731JszbZljhxb54lyfe5Yl3eeLF",
-	"vigMUBigMLj9xb5D8X6/7B3v173jd0zuKN6v1q+wBvpDqYEuWnF9xIb1zcSt4vqiALp9a/TGWgbxsw6i",
-	"9ixWtAF8ZgFen29xRXTsWr0WI4AhYlF0YXB5w7RoDXVvnNWjOTti+BMQjbdHElXN3bFiKPaqQw6EB6gR",
-	"oEaAGgHCAxQGKAxQGNwHPLjlNPoa3Lv9RzFU9W7Xindbit0FN9vXWegOPTNfrmcGy9theTtMJ8KoPozq",
-	"w6g+jOrDdCJMJ8J0IkwnwnQiTCfCdCJMJ0LggcADgQcCD0wnwnQiTCfCdCIsb4cxb1jUDovaYVE79EIh",
-	"GEQwiGAQwSB6odALhV4o9EKhFwq9UOiFQi8UAg8EHgg8EHgg8EAvFHqh0Av1pRa1sxlQQvOds6Ba1wAN",
-	"pELRK8lTUlTapbN8helQLTJgTtTOOVFDdMPEKEyMQpcUIkNEhogMERmiSwpdUmi+R5cUuqTQJYUuKXRJ",
-	"IfBA4IHAA4EHAg90SaFLCl1SmBj11SdGtRwlnzM7av+BYIoUpkhhihT6oxAWIixEWIiwEP1R6I9CfxT6",
-	"o9Afhf4o9EehPwqBBwIPBB4IPBB4oD8K/VHoj3rYKVLRpKlSfohwwpn52Z/ywWeSSLHgy8oCA+Jxwckz",
-	"Yl8vooZdQ85dcrKA7MP5WL63QqZ4tRReLXX3GVTDKVPdQ/lecqYCiqnXren2bDpSYA3sFbu2c54XGU+4",
-	"dqtInszEI3DWgWvGMNVEFo+NpgJn0PYe6jt8iWvI9Kpk3dbAFoRLqbdeg3nb9Cq81Rcv8sSLPPEiT7zV",
-	"F4UBCgMUBre/1Xco2O+XvYP9uhf8jskdBfvV+hUWQH8oBdBFK6iP2Ji+mbhVUF8UQLevjN5YyCB+1kHI",
-	"nsWKNnrPLMDr8y1+iI5Rq9diBDBEzIkuBi5v2BWtle6NM3k0Z0cMfwKi8cZIoqq5O1YMxV51yIHwADUC",
-	"1AhQI0B4gMIAhQEKg/uAB7ecRl+De7f/KIZK3u1a7m5LpbvgY/s6q9yhZ+bL9cxgbTusbYe5RBjShyF9",
-	"GNKHIX2YS4S5RJhLhLlEmEuEuUSYS4S5RAg8EHgg8EDggblEmEuEuUSYS4S17TDmDSvaYUU7rGiHXigE",
-	"gwgGEQwiGEQvFHqh0AuFXij0QqEXCr1Q6IVC4IHAA4EHAg8EHuiFQi8UeqG+1Ip2NgNKaL5zFlTrDqCB",
-	"VCh6JXlKikq7dJavMB2qRQbMido5J2qIbpgYhYlR6JJCZIjIEJEhIkN0SaFLCs336JJClxS6pNAlhS4p",
-	"BB4IPBB4IPBA4IEuKXRJoUsKE6O++sSolqPkc2ZH7T8QTJHCFClMkUJ/FMJChIUICxEWoj8K/VHoj0J/",
-	"FPqj0B+F/ij0RyHwQOCBwAOBBwIP9EehPwr9UQ87RWqXX8aj4kPS54yz//fYn/nBg5JIseDLysIE4lGC",
-	"efPkGUmySmlWRnQKJpZcsH4Xz+H3HXs5eUbc+0XUmmzWcJdEMFjr4SQw310hU7zPCu+zuvu0reE8ra4m",
-	"cC+JWgE61evW9LU2vTewBvZeX9s5z4uMJ1y7VSRPZuIReAjBH2SYaiKLx0Y9goNvew/1xcHENWR6VbJu",
-	"a2ALwk3YW+/evG1OF14ljLeH4u2heHsoXiWMwgCFAQqD218lPBRh+MveEYbdW4XH5I4iDGv9CquuP5Sq",
-	"66IVSUhsIOFM3CqSMAqg2/dUb6yeED/rIE7QYkUbMmgW4PX5FudHx5LWazECGCI2TBd4lzeMmdY0+MbZ",
-	"WZqzI4Y/AdF4CyhR1dwdK4ZirzrkQHiAGgFqBKgRIDxAYYDCAIXBfcCDW06jr8G9238UQ3X2dq2xt6W8",
-	"XnDsfZ2l9dAz8+V6ZrCgHhbUwwQmjCPEOEKMI8Q4QkxgwgQmTGDCBCZMYMIEJkxgwgQmBB4IPBB4IPDA",
-	"BCZMYMIEJkxgwoJ6GPOGZfSwjB6W0UMvFIJBBIMIBhEMohcKvVDohUIvFHqh0AuFXij0QiHwQOCBwAOB",
-	"BwIP9EKhFwq9UF9qGT2bASU03zkLqnXx0EAqFL2SPCVFpV06y1eYDtUiA+ZE7ZwTNUQ3TIzCxCh0SSEy",
-	"RGSIyBCRIbqk0CWF5nt0SaFLCl1S6JJClxQCDwQeCDwQeCDwQJcUuqTQJYWJUV99YlTLUfI5s6P2Hwim",
-	"SGGKFKZIoT8KYSHCQoSFCAvRH4X+KPRHoT8K/VHoj0J/FPqjEHgg8EDggcADgQf6o9Afhf6oh50iFU2a",
-	"KuWHCCecmZ/9KR98JokUC76sLDAgHhecPCP29SJq2DXk3CUnC8g+nI/leytkildL4dVSd59BNZwy1T2U",
-	"7yVnKqCYet2abs+mIwXWwF6xazvneZHxhGu3iuTJTDwCZx24ZgxTTWTx2GgqcAZt76G+w5e4hkyvStZt",
-	"DWxBuJR66zWYt02vwlt98SJPvMgTL/LEW31RGKAwQGFw+1t9h4L9ftk72K97we+Y3FGwX61fYQH0h1IA",
-	"XbSC+oiN6ZuJWwX1RQF0+8rojYUM4mcdhOxZrGij98wCvD7f4ofoGLV6LUYAQ8Sc6GLg8oZd0Vrp3jiT",
-	"R3N2xPAnIBpvjCSqmrtjxVDsVYccCA9QI0CNADUChAcoDFAYoDC4D3hwy2n0Nbh3+49iqOTdruXutlS6",
-	"Cz62r7PKHXpmvlzPDNa2w9p2mEuEIX0Y0ochfRjSh7lEmEuEuUSYS4S5RJhLhLlEmEuEwAOBBwIPBB6Y",
-	"S4S5RJhLhLlEWNsOY96woh1WtMOKduiFQjCIYBDBIIJB9EKhFwq9UOiFQi8UeqHQC4VeKAQeCDwQeCDw",
-	"QOCBXij0QqEX6kutaGczoITmO2dBte4AGkiFoleSp6SotEtn+QrToVpkwJyonXOihuiGiVGYGIUuKUSG",
-	"iAwRGSIyRJcUuqTQfI8uKXRJoUsKXVLokkLggcADgQcCDwQe6JJClxS6pDAx6qtPjGo5Sj5ndtT+A8EU",
-	"KUyRwhQp9EchLERYiLAQYSH6o9Afhf4o9EehPwr9UeiPQn8UAg8EHgg8EHgg8EB/FPqj0B/1sFOkbvbL",
-	"eMTEkgv2Bn7usszz8Ax0AvNfuSAnz4j9qGWUz3iyNoq14at6YxrKMFHl4NH6kBgdRCq9LJn6V2b+UHk6",
-	"bxBkgHqNMcaIZ6RJ5YQPQAvzTy7eKjY6XNBMsd4BcCbT2uV1BmO/gEYc/7nUpLli5RVLQVzB1CPf9fUq",
-	"13NjNDCI7hhOzWv2+FlkdOnypETKE9DgXP6PIyxXFn/O18CzJ89IklVKs7LBenMpM0aFoUhGlX7tRv8z",
-	"Ew7t9Rf4RfQ9rwBCJk7JEnN8LeungSwWO1qnQIwsTZfnn36Kuzx34NBI6y+4ijhvB150upxtsKNUewda",
-	"ncJWI+lmKhksA49p0bTgf2elipL36OzUPWvx1ZX9jdkechpyw4JO7Ai9qMc9JReG6KXy4juR4oqVsD5y",
-	"KfhvoTXlz8PMptKBl0/QzIpNqz7kdE1KBvSoRKMFr9++lOAeXMhDstK6UIcHB0uup5f/oaZcHiQyzytz",
-	"EhwYOpZ8Xhll/CBlVyw7UHw5oWWy4poluirZAS34BAYrQLef5ukfgtspppiHAzH8499Kthgdjv5gOi6k",
-	"MFrMgZvrQWTNe/L043h0yUXaX5+/cZE6zNXQ7+tl8P7K8+cXb4KvzC6V46YaxdQLZIjLBaRqgr7hLESE",
-	"idR6liGIIONmW6lqnnOtiEtJBCWHHAfzhPUqp1ODLo5pzrJjqti9L48hnpoYkkUXKGeaplTThtKyafte",
-	"sKRkkd1qfycrmaVGAYM/TLPA9iRhpdmhcOi466ylphmZryH5dNHUgJ2ScWI+tnq0R0cZU3D8C/KSfrAd",
-	"XvDfmG0F9/K972XPJkM4LZwQZkGiDbQDDcwKt2R3g2+m5DlNrBIIyw+GTivZaVasqKhyVvKEJCta0kSz",
-	"Uo3Jd5PvxuS7f3xndN7vpt9ZRlOs5DQDGprx1d74mkVBZsypYn/6iTCRyBSUBDPocV960HLOdUnLNXlU",
-	"SKX4PFuDGcB+8Ni2aCWPUYinxKeyA2bxa6alzNSUM72YynJ5sNJ5dlAukp/+9NN//EGxxFBo8tMosv94",
-	"nleazrOIfnfqH42NuqEYYFZdGs5iQlWl151hhErLsrb9ud2bdEUVeQQA1IlHLyq8YpjLFGDAY7B+mC9b",
-	"nZqGXWxO+31CNeg9mudAH9CrLPITPIvrQCjy70fkd6S4piKlZeqo850Ka37vYw6DikICM/STLeJni7ip",
-	"G7FAz9sw1oZJzA6ec2G2dUsyCM9YRnZMySmon0Upr3jqrmIm1yXXbAL7hIui0o7njTptp8iZSNiUHGXO",
-	"f1VbcZueI+4j4dL64DPI1rQ+BseB+actZ7CuNVt/LoCoq2cYDFCCXbGSyEqbkYFvpGQUgskCWx+dnU5H",
-	"gyi2yyJvneNsQROecYBSRSmXJc1zsAKtqEhByZaLtjyP8E8Niw0LpTJRhnsSVmj4x4IvK4tSDmxLB3+w",
-	"/wX8rKIwPaKwQEGQiDXr+RUrzTG8zOScZkZ22Re7eoTkaXIMo9mmvr4+PTl2b3ZBb6ORGOi90LKkS3ac",
-	"UaVi27J+StJQGgUQJS1pzsz5BxxHSQIvgZEbPoKfrX3kzGg3SjOh/y6zKmfKC+Z0LWjOEwhiBOa2StB0",
-	"Jmai2bfjWLNZguUn/V/BQhfOVtezHQpNElmG8EWdAFtyQV7D5F8yTaevaM4i+pvZpXakzz8UVMQ1udhb",
-	"RhO7Vobdoa5LZEzmI3IFXxFmPkvjx84XJipjG+AtHEHPaHJZFW4xzwzTbDC5Ry0ctoVAyJrx+guXJEwp",
-	"Z7bsSWVnZXvVsTMXJQOz4egQtIeuaaNrW1beWme4qlLuUJ+3xri7PfbjeDSvkkumzajiRVKSTFZpmL19",
-	"+8Bpr6yEgW1VeSPDWMgyYWdUry702ip1fSYs2XLocysPh0hdlVn09ytW8sX6zYuLWH9xHlqWNGW2FlJz",
-	"qZOqLI08GcJZQDn7Tu0acygrRi4Rpf+rhnAJLqjI15qWS7Z5MIJ90H4A3SaBlexMrXtitxPGEecso2LP",
-	"LfU6uD59t4VppFcch0H49xHAgt2NKW5cb6i6jDG863Lv9vptbSHKUWHOFJoNODGEnMjCq+PeMgoggi+X",
-	"TnqHFfJ04uBF8MKgtVS9MQABepybM6WMjIjtj+1caMSvQYzecBvjRrdsvvuOdZO5kH6qLokP24m06s3t",
-	"Rmtbj8YjIfW5+6dRXmipR2EprYE/boDvE0ex8rhkqTlXaKb6BCqoUteyTOOSRbHSU2nHzs5YmfM6bqPd",
-	"GRMGuKZx+Ve0v+ybFLcK9x6/tv0Rtu+YXjYoS7zy6EWJOe17G3dRZdmxzHOu+6Mcjz5MlhI02Ym65MVE",
-	"FlZqTABjstIehB+hTTOcV1Fy797MVT2VmzXRTWlqDKtufdycdIyiXIIeRAue02Rlzs71tLhcmh/U1Gg2",
-	"06unU3PcG80w4uNwTxpqcDBL2Ip5a6FXzCCRYMuyFqQVvWJjwkWSVbDzshBdckVLLitFrOfJiSKIFggm",
-	"g5yuoQHrkIdqeQvye63Cjokf2Me+Imu0BC6qiEjxT6B9F8DmXEVmh8HflGQ859qAQVC0q3xuEN2CAPuT",
-	"kumqFCy15sPa49SI8ikNBFxRZcv7AanoFeWZYXuLHEPwnizovyoWLJHzOlCSKwUPbKlEZ+7wBs2GZcQs",
-	"gXUogUYG7hgtzTBLzq5sdTo4hF00UBhJTfdjSxUb6+IMf0aBsDFmLv1qzoizvzFPMjfTFnKEeScrKgzG",
-	"9hUOwYZMyYJdk5yLypALFteIPB/X6Jfem4ktovbUtlC6UqHUZFhJS8oQKgnyNaGZp5SjtHDWsRJ8cqqQ",
-	"QrExqQSYuNeysuMpWcJ4IKWWl0xY2E4FYWUJEQlwikVjokqWUy64WJ5qlh/LSkTM9v13vLuw5jNVzZVZ",
-	"bvMMWM6NHpbDed5dFqDdXY3wDHjHTzAESblfLQt5HdrH+MrS0dqHp9nMuC73h5H7QSlSiUshr0UIqbHN",
-	"+KXI2EKTSsCWEimROde6DqryZmIXK9wcKKxuXmRMM/KIceD/OUuoQR1c++CBZFWJS7B71E+BBCH+TrmX",
-	"HtfzcbmAQlq+7M7JTiSkON5oJt6oKbMUlCkqyNXT6dM/klTWJtvQh+V9I/WFWUYzCafxxDnle6Y0z6FQ",
-	"5vd2D/LfmPP5yCyzluwpOQZjafCQmH5LBoJ0qG2byAkyonR/sA800Tt5okE3au7eGHwv7d6l2m1SCGaq",
-	"xch3quGfaeKF2nZsnc/WhOL9+YmbqZYkNeg454JZYeHEm93ZTiJNyd9BHngPl4aKqWBYdJK40aRZayuh",
-	"SCWCLd1A3lBpFEY+JWeyqDIawn8ZsRmsU2JURzBV3ruNIpHC4r5kPYEmZDahIp0EcZ6sYzJLsWzxgouI",
-	"wuyfWHP/2/MXXSt/WJed5j8TM3Hy/Oz8+fHRm+cn5G/BEml3mdKyIOYUp0tat++sqoI8nf7wxHAw5By3",
-	"xQ1XAOKEPTXnwNzyivnPnvrPpruBy53UJRvtcmxkTtRQ5R96y7XTBMzqm51kWJvOZaUhSLbgrj2yoDyr",
-	"ypbSlFDFlOXnOoHZnETWMshEYnYvczVnO9qwoU8clTtzt5c0wU9DtT2/qdVCzBpAb2OzQwz+SG2tXkX+",
-	"evH6VVf0vQQ/DpxIJJVWWBZS6QX/YESQnbjBXoJBTCHVltOZ0f0MVLCT+o2VcsJFyj6YDUv+YuveGj2E",
-	"FgWjTZ1CisRi00awMQxe+SxzVzV3Ra8MOTs0nJLXTvUG/nz+gZpjRx3OBCEzQKWzEZk0mC386ASpN7XU",
-	"1ZHNh3CY/Prk3XSHFqxKYgfPhC4hDcs1MRvFvUkBSHdj41dVTsXEQFdQ8BqP/Vrbc9KH6xgiTIkNf3au",
-	"f6uEuo0OknECqhCh4MhohUw1VR+qom5/4nbR3oM6daK/nebiznBQAdrbKejXd77NT5imPFP/uPphaK+7",
-	"N1o5VLVVitS70u6wl0f/x5+1XlxaRVpLLzCan0ekRkPDM7v5HKhfb2pKLprIKkRcXJve600X9BvFdK0y",
-	"wNFoM4785nFJS7buBNWJjV71saY+sBFKi4fWLTxy+gdVqsqdfKFiXb/l+Q0W18i9K5rxdGx0kEoY/cl1",
-	"EsF4sMvj0u3YSgAX0G8Fkgdjbqli9ast0TwxrSyeklc2ULX11Eojv1a2TZY6ydMKS95k39v7qIkYWiCJ",
-	"LU4Fm99Wk7or7WMkcIi8Odfofo9HB0DCHxfpHXRKXgt3U0DhAictzVO+WLCy9qU6UMPSuou/cZF+bo+/",
-	"GHRrgN/u1vQhj65rRGPFjs2zgOYtRvS+Rh8O83hAcutyfbTQrLxgiTTTiRWrCRHoNspE8xyOXWU/IXO2",
-	"kK4QflivRqC8tUWkU3JhVtSpLzbow1pPmgEeIH80vWRwqGeACDQjFJANmTjbrVShId0+vUKbK3lNMmnd",
-	"oNeU6zBKehliizrN71RpaDyqeIT5356edFdzOrhMYb2HlqrLv3HnfaVYOVlWPGUHAVOV6g8VT9WdH4Mb",
-	"zj87NWuqcQc2RGPQLGtlvLo3rEXLW58wjPC+wwgTmcZgSrVcWsn5X2/enPm1Me/WkelW8ozJE8JDvNmO",
-	"e8QdtHd4Bjb0MIxPu+P4tFsgCm/E96YaL/+n2yLhbs0WwWlxKwByvVp3Ru7iZczkZqO/WD1wNnITvQUy",
-	"IUdeU08yWrpkPmG3n6MibL95ZQQms2ZOecXK0miZPJ6I28zeiUjmlsedW8XKaB2HZDa6qCBuxGDRsjnT",
-	"e2dHo02AccoNfoejyoZeVCXX6wvDI/aoeMZoycqjSq/MX8A84MqEn+tmzRxGH00b4DXs0eoPxDRhHQe2",
-	"rsNRljV3MPHex6OzU58OSt6bj2TprB+HxA4mlC+7ZMIWLntPVgCcrUIHsco8dc4FLkiRUS4mmn3QYIOw",
-	"sfrmmVMK5NxZ6+dr5/94z+xoEp25V43E0u+dMgF/2HPRPgUzTMkNuOPBg6SSkjHhHPlcZwx85GUiBQ2z",
-	"tbux4Ww8HD2dPpk+cTnqghZ8dDj6cfpkas6AguoVrMqB86ZPPLWXsQQGMDoYei79aN1nFlB6I18rjsxs",
-	"mOC+clvUZ3LBTAKfn6ajw9HPTNd2xmP73qn1G3sADQP+4ckT7zZk1mkDKXiWGQ7+6QSLo8YWyRXvEJiv",
-	"e/7C7ltUWb07DWF/usPBPDcacqzzt0INdP/HT9H9qdegnOGDuRfHI1XlOS3Xo8ORI5939Gu6VKPDX0c1",
-	"fUfvzAcH5jiZ8LyQJcTGbWU354bOMhdx7L/0/FSr2ZtYy5w9J1TT09Cx2QAhQu/w127/f+EGa3T7nK+J",
-	"qgr4K62jUXx+KCTvHCUQnwsOnjynE8VMP+b9zBVn4KZ9qHcy8shzFFq1MSpmePWa7R7HoWyQHCh8o4/v",
-	"7nHfNIkJ+Ym4ZfbeMhC02eawxs6BOHVP4tG7j+PRh4k7SSZeFZ449ulsKrPP2nUKNu8xCyaalWAacaQ5",
-	"FXRpzzN30AxtsEbI6j1yXuhlP7ZrUf6lm5NojtgT3qYGW0PuFro3vm/T/OD38O+PBzbqduKOxr1kXjtg",
-	"F9B3n+6t2OWtki3Qzyub/aBg85pRD2r5FGYzagY52Ujketm6auF9CqD2pFEE3UIEdZissRVcTLujMmyG",
-	"QqpNrJvYO00JJYJdd1oGhfn7773b7vvvwXH3/v1785/fzf8QMguYczY69D/W3j2Dg9SPfivNRuP2C67S",
-	"iHnLbdnwysex78DAmU7jhnF9461G66h3+9j+/bT1Tgjnt6/YP/9h69rUb4VIdNcP/Nl7y4ayuxlUk4QJ",
-	"XdJs8nQ2as7iY6DbjQhIf6tKdo80hPY3kjHkBWykpBvhP2gCXvN/2BlsoGnn/SZxu4TrCdJjYNyWVHlo",
-	"khTw7TOZru9MdkQm7XJfIvLkTW+GIdAHAjns1k978/r4qU4BPABuANtg0fqcu+EEGFaHuorO7jqRffbR",
-	"HiwZ02zDEWNfUJEd1738gJH3ptn3fbXpBNrYe7fvu9H32uPjB6Wp/RRzQeBe2rSXLFPttZd2NLXF2Dzh",
-	"PT73NhF7pcT7wAqRDfAz08j9nxyn4Am1/676mem9thTU+tywqaxLb6/jg7wW2bpT2s/FWvmYLO8ojGiW",
-	"kQRj3G13r8sO53HvpsvCgqh91ho13S9Jjlj++PSarncTTLybzX5rkzz3MaZ0s1/9VHpVR5sH/yDSPXGt",
-	"Ob+Nnf0+cqm5+R+6bIhPdkAuDNH5s4PdnWcxJAp+ePL00w/m2OXsOAFhx/HDpx+HdcyxFGViD/0PcHxP",
-	"OO7ghYpKuhtIx5saBIY274BqB3ELW+SlhXUPU16O9ynC4GgB8W1Ghi1kJVIXuP/SGY1/9Ybid76V6MR9",
-	"UOZ9qaOnUKBv7JLDgkLKUlIVrgJYKfOudtpxqicZo6Iqupp3bxh1aZf7BIJ7xu6ihndT+8te0mxHA8w9",
-	"iJWfmUaZco8y5d1D1sRwy9bGnYekfZiWZcnuAJy5lu4GnZ3bxr4ReOZnuys+86R+aABtwzw+A0LbMJpP",
-	"C9E2DAQx2u4YrQwywYtJT9g95WSQeTcRlHeG0/wmvmug9lBE535alaPG7dSq85Zc/BL0KsRInwsjbZYm",
-	"N0VJd7Cp+zAJd/SXi5RuoBLhzt0AlTZv26LSOzrC72PnWocbbt5PsHm/DEjm/OYIyfaHZIsqQ1nY8+U/",
-	"LEy0dzJjc+hqw+WhmxIaG9ykHoZ56NNsZEz4uWXOYYv5OmmH8MwRev+kn96u3I+zowbQb8TyufP5+tBM",
-	"nQ/kQN3tJM3W92zhRNPmrUyb26TR7uf4fuf3we/++Aeb5kEjUO+mx7rzZam93UCR8/2ZG84XBZ1uB5k2",
-	"Y6Xmaj1s1zBqK3eorfg99TkcxD0Z0XQY31hI+EbcFXW957cwwkTkyLkfMgqSL0iQuFVDSXKXkqSst8Ln",
-	"MBgc/J7OX4EYaRRwmfxTzm9aF4mYbwevy7oLOWIL0vzVDBHFh2eG+auHJjjCMu0rLx5scaSatekdA4bW",
-	"vrvZ9lXh1v+dLSXu7t/b7tVdDSj2BvN99myEyHfD++PPLyn8NRLta3ntirRsKuHmdn+n9ZhQUlKRytzV",
-	"7nY5gUsoGuWyAqMV3qB1R6xPbmdyyz9gXnLX2392o9LwKFG92cmS0hMrthLAfvJyPxF4R+Ffdx32hdoJ",
-	"JuNgoNnDCzTbpqrdNNLsTiPMUHh8CbFkuCvvJohsq/N3pyiyuzVbRmPHcFs+8Cixm7mvH0BYGIqSO4vB",
-	"+nzOW+uQqae5x1UG/obz+uPBUNA7VTSO68GibPsCVI7GeqHEuJsI9qS5BT6v5CgZXMpGs31ER+Ore3G8",
-	"RIRGY5woNb4EqREWDKXGXUmN1h64I7ExabZ6EwlScF3uITrOJBd6wsXkDc8Z3GN4xeBC6YX8RKLkzAwY",
-	"ZcgXIENgpVB63Eh6bNlrn1rvYO6Kp5tEjLlvbxVOWl8x9fVni9i5YtDUXQRNscA3ve1iybzrbvEN7bFZ",
-	"DqpiWdKUTYqMil13TsFEysXSEVeWxDWi2nXSm9koM3EUbvLP1mPCNaGZkpEbsnzj7qJruKPNXp4sGEud",
-	"aatg5UKWOUvJTLjrrM05TRfmLLKjgTZqIvux+rGw1Az26un06fQJDAduSU5knjOR2n4q5a7ENTM3ekNv",
-	"vlN7T6XM0tAtM28rQuGO/aJkCeRImMH5iAbr7fPd/zB9Etco3trmzsy6fM0SpTlPFCU3Ooc95xWWV7wU",
-	"ee3YVX0q+XFAi6KUVzTbIWAriIzIMRw22pbkzS9gIx8BRdiD28z3USY+TPHIs0GEp89t17AMtaBuIZIu",
-	"E+zqwEDBsZ+bwXL5JrJ/UklSRzztG6vgRn43CN6pXF8GeGd+sF8K6nbUxYP+dua6sO6bEMMNqtTcfie1",
-	"Awy+8c10f4EBw/voYccF4P6/q7CAnUTA3RzVuRRcS8PYEy6UpiLZz8pWf0/C90Zrpj1DQdS+9jJ8fhp6",
-	"/zbuYo7MHE1utzC5xRixsYNqcu9fnCXStEWosSdeHjsuU+S94ar3Tj4rpqcz8YwqlhJp8a9/vjKo05zO",
-	"ml8xcsnW5JrrFUmkWPBlZckOdjLVauuiSlaEqjHhC9vUISny/P3YNCjIe/NvaKz5pU/MsT3Qdh/D9WX6",
-	"LPv13/bbn7OlxeZr0l4O88XnyxSKLB8Km5tmDUV2/rC0GT6qo8fvnsf1TfOJYsJrz8uAbyYRvDCI0/DT",
-	"XNX4cp++v627gX+yY73f7mMSUkhtQxQeYlJOh1kF3bThd7Ry3WoH/sz07bbfy29p++Exins7bnjb6yTf",
-	"56LkW+1uaxLA8/Vza/t2HTZr+/k2bf+zXH6McurrkVPOQHjfoKNgZc6V4lLsYAOMhfeEz0MsbqVYaUN8",
-	"uCJJVZZM6GxNMrlcgnsdDCnfP/9A8yJjh9/PxJFSVW4T5hcyy+S1me35s6NjUsiMJ+sxeCpMs4q8pxlP",
-	"vO9iLufvD2fi/fv3M1GMSSkzdpiyq3FtglRjUjKajsn3nTe6BtMx+X5Mvj8YfM2HLrbem8v5xleWYwLD",
-	"rVt0gzUixBAUYg8sVTvT7xLWzdvP9veZIGQ2arw1Gx2SX82vxP/H/N9sBN/NRuPmbzV5Og8MrTo/fT8b",
-	"2T/fjXdsvUvafoPtvw9u0YWn+R59mP+8m4mPjpJHIt1G+iab7U74uZzf36ijIWaKlWeN7XyfUV6drtCo",
-	"dLNILyMpi9aSecl+VOkVE9oNjMyqJ09++BMxv8qS/2anY2vQFDKdmBGlVWbEO4hMvp9Hp5ApqZsgvgkf",
-	"rnVZzVkpwIjk0wsGYqfPZHoR2jkD4b1Nez3pOKuN2mdPjzOZkro1YpszZ4pbsXnGiJZDhaRsc2+MEtnU",
-	"KpmockPf4kNiRqbydD6yvoFlydS/stG78XbV99xKbH8IxgcKc1hRRagmGaNKk6ekrDI2NOAVVedVBh6M",
-	"z1TtJbJ66J+6hX9qYFs1dnmUc/b3VsU6Wg87deK79D7AVaynAUQVncPn96DsOAPcDzu5UKKLvNN+GIY2",
-	"Q+ffhrPx4Hfb8+RmXpQ4qw7ZeQZLsd3gsGyaeuKbfr+8v8gQNuf+NeiGt2F+a0XKbr57d3SO3Hpj/cw0",
-	"7io8+B4YzLv5vtm1ptitN46zeX9re+eha7yfI7YXN/5d2u8/tcbr392rNg8taML12ibdXlGegW0lNOX3",
-	"5t92sgP9zHT9Yn0rhxvVPTLuhl6Rf/dHbPXdH2HpPNPWlHY2SMXAgLkTkuLiimbcnlzPLYfD73/95Q3R",
-	"8pKJYcR04bq5VaTVD3++fwK/kZLkVKwJ1ZrlhVYPammbVH8hl7LSexuetxqouFJVsE+FpQV/SiaXS+vP",
-	"tAWxjWhpDMkl74aAZTCS55XSZEVdWe33mVxy8R4E15xnXG8wdjV55h7SZFW70NjAUQ9zaBdjutsDvSjN",
-	"3LWz+wOto0Ec/herZXxJ0QHf7LZlSVVyvR4d/vpuwybm4kbOI8W05mK5h+/fXgFiv/KKgR8LhBZkmc0p",
-	"iCkGF767e70dw/WxM3NvoHJjwJ64P8MVJpmtiWSpeMVKf/ztTkT3UZeG5jXLBDGZ9nf70amtx3RvNHTd",
-	"7EfCQDT/9TDN2hT/ffSM0ZKVhkHNAhhsZklgEWdVZqPD0cHV05F54trs0tjQb61X5mApWQbVHbTsqq2N",
-	"K8CdLt1QZfp+vuE2uxWwGi32imPdqN26+lS3WZ9zeYvRksbdhq75cMf+bZqtr151rboLJ/dp9Fk3XajV",
-	"FPFXYuzaZB34VDfViJratRnalqgAlFriNDS+i+zt99rcIGXuOpnLSg/K17rH1ua6BbOR141aEa7t+qdd",
-	"Gw7BA3AHWZZJQwixJCfPQvpyIW1ampBpkwXjUPjju4//fwAAAP//rSHhsbZiBQA=",
+	"H4sIAAAAAAAC/+z9i3MbN5YojP8rKM5Wxc6SlJ1k5rfjW7f2J0tOVhM/dCV58t0N/UVgN0hi3AR6ALRk",
+	"Ttb/+1d4NrobTTb1sGXnbNVOLHY3HgfnHJz3+X2U8XXJGWFKjp79PpLZiqyx+efh6cnPZKP/lROZCVoq",
+	"ytno2eglZ8tJQa9IjhR/TxiiUlbmD4TRvKKFmlCGKkkEWnCBSsGXAq/XWNEM4SwjUo7Go1LwkghFiZkq",
+	"EwQrkh+q7mwXK4IUXROkVgQdnp6g92SDrrFE7huE1Wg8WnCxxmr0bJRjRSb6/dF4pDYlGT0bSSUoW44+",
+	"jkfkQ0kFkYOnce8jrKbozZoqPR1dmFf0Y0auiPAvTQevosBSvZX9u8VlKfgHusaqZ+d6AA3fvG9h+iW7",
+	"OP3W8JUxvCbdNb1l9J8VQfoh4ovmaqhaUWZ+wlnGK6a6w34cjwT5Z0UFyUfPfrVzjKMTfxe+4PN/kEzp",
+	"hVjUe0mlAVETV6gi6+Y//k2QxejZ6E8HNSofODw+cEj8MUyChcCbzqrsWP1LOSP/rIhMHNgpFnhNFBFS",
+	"wwYjRq49dDpYfhv0u0jhnD5zbo//Ux8y+YDXZaFHzuiwM08C143XWc7zBhupp22xDVziOS1oBzUIq9Z6",
+	"4oIvKRuNR7ikGgveJcDRRIvxiDA8L0jeXdIxleaJX41EGWaMK1TwJaJsjDDLNZCosGxRIiwIEkTvleQ1",
+	"jOacFwQzwwl49j411YWoiKdnNxuiEtnXEV4ooufgaI3ZBi0w1asyW5XJadYL/KJvVz1TESZ4oYelDK2r",
+	"QtHJAmeKC4QrtSJM0QybAVLTlVjKay7yt2U+iKn792velq0wW+7H2jWeeMzejozhzfqsx01MCgfTAN0W",
+	"/D0yzCziEU009RtMrO2GCw8jblnUnXBPR3i3YJ92hFO34JvAqDXXkL1b1Otl2hr9LI5Zrs086ls+61gq",
+	"qldoSLkgC4Ww1Di70T9MPzU/apPaxwQAnuPsfVWeKy7w0uAUznOqt42L02itC1xIMm4zXfMtkvZjRJkl",
+	"PUvnzZ3iouDXJH+N10SWOLM/5qQUJNM0P3qmRNUZX2OkhjcLXyE3DlJcc3qkVlSieWMZo3ENxp3AmlfZ",
+	"e6Jep8mptZzE8wUXGTnFanWuNoW7JBe4KlQAWJfbsb7Jwi67T8ejD5Mln+gfJ/I9LSe8tEc0KTlliggL",
+	"P4P4y+Rih49gv6tRUH6v8e9flSBJ9KtEkdzNFRF0sbl4ed6Aij3lBF4mJL7obNwn73bhr/Q8bBCzanya",
+	"wg7LphuvGfFN3o5OyiACdsnEqDtOi+rA9IsgogTnLHiVh93btw8yzhSmjAjEcPqGvk/iay7y0EqNOVlQ",
+	"RnJkp2iItjWLM38evz63jy3DQyulSvns4OB9NSeCEUXklPKDnGdS7zMjpZIH/IqIK0quD665eE/ZcqLF",
+	"5IlFZHlgTufgTzmTkwLPSTExPzTkZnwtJzm5SoHq9lQvSSaI6kO8h8kTamKJ17+FVxw5HbLHTnGolbFi",
+	"EwwFXp/BBWdLo9UgqqQV1ruUa2/o4dqlGSUt6OpHLaVqik6U1x/mBAmiBCXaolJgRcR0p0blF90RJGro",
+	"HGOFT9YlF+pvfJ5QZ+LHiEpLF2Zfxmyj/8yxwtS88w8+l3rt0xSg/k6EdPjaOoHTE/fMEaOd5cr+RnI/",
+	"n4ENlUiQUhBJmDJChxPN7I6mM3ZOhP4SyRWvihxlnF0RoZAgGV8y+q8wnD5SM4+GpVTIUAbDBbrCRUWM",
+	"njZja7xxuhmqWDSEeUdOZ+wVF1YEehbYwZKq6fv/MLwg4+t1xajaGMYn6LxSXMiDnFyR4kDS5QSLbEUV",
+	"yVQlyAEu6cQsl+l9yek6/5MgklciIzJF/+8pS6hqP1OW64PCnqOZtdZA0z/pbZ+9OL9AfnwLWAvD+lUZ",
+	"gVNDgrIFEfbVheBrMwxhueEq5o+soIQpJKv5WtOMsJK1hvR0xo4CHldW5ZvO2AlDR3hNiiMsyf1DU0NQ",
+	"TjTYkvBcE4U1LkdcrKYTWZKsq49knC3osnsIR+b3BjrbVythkTamHWSJB/2Dz6czdrEikiDLsq1Goaem",
+	"C5p5hK1pkgg0J/pArZmP5WhdSWWm4mKNFJ+xiF79TUdZZ5hvJJrqaaZ2lVNeEqbJ8vtz82nEaRxE9B1T",
+	"33sTgzDiikwq9p7xazZZUFLkMlw0eTRXWmQ4br3heU0EIGPO0FsN0LO/T1OHafG6O8+5+d2Pbt/yXNfM",
+	"pXg0bFv7VKuUbU+t/Hj6DX9MORUkU1xs6iHrWTT9mMOmlrTmBOHwNUYLWhDEBcL1KGOUk5KwXB83Z13Y",
+	"pKHwfQIC3yMnhtk1n38f63ApzJz2S6wnCQ50GB4eW6FTOhTeeN5z/r0z8Zub9uQYUVZQpjnAibErlYJf",
+	"0VyjtOZj14IqMuGs0ByorBQyyGUWagmcEpbpj39ZEebYk3mDSiSJGushyHzF+Xs7lLTvWL7oiOHcSBKe",
+	"1EiO5ht0mQmSE6YoLqR9rhHzcsY0oZF1qagfykznjzPMrbmdVFzUJOeuxs4xWQEnZd/Uv3vkikXT8++d",
+	"SJ0cL7nwBJdqvRbTnSALIjRcPTpbWcujTnSS0WSWfXlgel6k3/dOB4kuD385/+3w6OjF+flvP7/4v7+d",
+	"HF8azmV+P39xdPbiInp8mdyfv3Tenr3s7upF/dDcg6y+o/RPfNHSepIz7FYzmpP+2HjfYZ5nV5quJ9I8",
+	"eHv2UkPpZIEqFpBtbAnOTuDxUiIz0TRpPK1F/+Yyzszv9RkunYC0G2Xs8R7GmmiLbTRf6KdshygRgf/B",
+	"qXubAtSE8d/9mxECESYrQdDFy/OD8/OXyAzm7OpDEUlPlcKjlraQ5hpdpSFlUFRYLIk6KirZe8NftF/p",
+	"ZTV2MJTZV3erOR3pIlz/qYWltCCpsKpkSr7TaniPe+Kofui3YrwV1xZRO8IdCqMhWRnqWFRFsRnuk/sH",
+	"n6dB+zf7oBegenK1wmaZomKBe7fu+KQP+s3cSHb5T4QRK7wmfP3J9/xy9CiIu8doWT/ni/YqjAwcw4My",
+	"9Zcf6qVRpsiSCCutS+mM183FvLIP/OzuvS2TdXmhwqLnzM/9o2En7kYafsQaEUlyWhV2lFVCGDXL/Dh4",
+	"Xx8HEXJD4feG1S02Af2Ku2btIBbRGhJm4YyR+t/kA5VGB20tWH4+mwG6Q5MB2mExQJ/TYLCfV69xzCkL",
+	"8CewP6C7Mj+grvUBNYwP6MHaHnZS6angS0Gk7J5F6Z4YfG9TXIfe5htF5KngGZHSuhIHsGHz0QVXuBj4",
+	"QetKrS3d3z357vvJ0+8m3z+9+O77Z3/+67M///W/h0dL8WVi/2suDRkTZuMvCsMoHCdykCh5vpffI7p3",
+	"Ou+WROi5vGDQXRCRykRs5V4W0MzIfYWXZIyMHCyJqq+UYPsQRP/D3Toa4BEisWo9t+AtV1gmJvZ3hnnc",
+	"c2ek4FryPC1yxMpoyfOGWGGH3Hmz3tHRK+353htv7VfDEXc7FRKx3aIVLimMBKlMhBCSSmBFlhuj6liQ",
+	"1fciM2YgPcQcS3JUS8JgVgez+ldoVu8nnfOSZA0E9ubwGk0bpuwukTg98pSINZUa9xM3xVHnncacbojJ",
+	"Nc0JKqOXvBqqLQpdk6y35sdfYEGsuV5xrwsRhJFbwBkvSMoES4SX6sNN1bJC84Jmm7OqIGjFi1w2bLpG",
+	"JLfvzw0TKs3bSFQFGaN5pVDOiTVpeHtd9PmM4Tmv9JVkKVt/hXBZFsZCwhEX6HpFs1UdbJB6Lcm8fhK8",
+	"KmWSd9lHKdunf5jQNAJhTxE6WdiYxLIwn6ClHTDyqJBc86MNwpmBkqMrkiO81CMqxJme1DpRtBfcHFZe",
+	"z4IoMwOE4dE1LQpjzLfBFlM0G81GEek7V5CIlmTUhtno2+Z7uCiiVU+Hiygtz4zWvSb+BcXXNNNfMM7O",
+	"3Ca0RbJ7AK+bLzjOR4waV2KhjUSoEoW0Z4BtKIW7G1b4injznxa90bcW6g4mFuGMoGPD5JA2g4zRgupr",
+	"QipSeoOatpvO2DllGUGMs0lgq2ZJekiNsQHr8rFjot5EZ+fQGJjhuaOriM5kbSjJLedtkOFzapwt0xnT",
+	"VGXieBGhakWEGdO4dfQJ1djwSFbZSm9qpuUmORtp0pg506qcjR7rv9sbMbtsfKt57Gz0eIwMoAxz52p1",
+	"1yjg12DiilKW5OixV/BdHIkmd1Wr9eYALCKk6B6hQ2YMqlawXRPM3NvkioiNWumrk4b4pPva55Y9OvT2",
+	"+6kP1MpF7f188+03bUqt+c4dr/6KiHli5X/XPzdXbX+y5BjQ8+VLK5S45WkhRnqO6Q3XbovJfZnp73ZP",
+	"Ldut3WDKJttWvHb42sM9UIfotXzu3v+dvF6711PLB96d+E3zBX9VuZ/R1fcNCTsx3x4u9JT6kTe1gyPO",
+	"pBKYuhStrkSVfjfIOVojxYqayOSNF2zWFhVYjkpBzG/S+Viwc/DNCZJYUamv0xmbb7pqC5qTBRdOGG7K",
+	"NJqnzp085EKtdHC15wbpEIAZIx9KY9YIkRHN1RppxX+pF9JCBEZI7vCgNsS7GZBGAfOaHM+YZ8pBzAsj",
+	"2tMZ10sgbElZayY5Rlwgbu6M8GWNZd6p1YVYuJhkAmrWy2PXyYUVOa5wQXOTCrYi7dFmzMszykijWXT4",
+	"7mhKwTNCTGyBOYbIPhLg0aUQD5UfHaZ2+Wv8PKLQwLQsFFvYRFQcohKDxYSozNgLnK2sY1GP9bfzN69t",
+	"6IRDCyNmmyGNCiV9SIWRCrYO/CMXyFklxmg2siEx9mCnmvz8jW4f6EOx4STT2gPlI2gkXxOz79loD/6Z",
+	"pvNmSGyLsOu/QshM9FMf6+ksI6eyLPCmJzinfmhhvqrWWIsxODeClY+KHTjXP/j8PKn3/c0+8BvpaHq9",
+	"SlHHa7fGKSX+yD7w47v3NH6IqiekZrhhkK6T7qiTdeSMMu8MPZQULpTblNg+7fVeFFbQVEFTBU0VNFXQ",
+	"VEFTBU21IQnIqjQ3Yf7CiI4JqJy33gihMg5ExP0cULV5wboJ5JZb1g58sSkJkgprYPq7OqyuVkncdFN0",
+	"RpcrTcjXiKpvHFsqP2Q2KK6U63w+Rf/FrzU5jBFVXn8r5RiVS3M96EvGKjz2IJMC4G6Ztw7I2ssbTsSu",
+	"kBX7xm0jVoiAeJWHG6/iPLwQrvKQwlUidXunecqzw/Nuopl+y3njINUMfOJ/LJ94RCIdt3hOpNHrQ1To",
+	"7uARLca+ZRIvyFFstUyQTc+bToHx1gEXqh6EFqNqaRHB5ta2bKOoYguqfPGvvLKqbWVOZ8aOQ4L7M9Q7",
+	"vdFh3UnXYo3TyRaVPhwkSEGwtPJuN5HCpoIkMm/M754P2bea9qgOOHtLArnSMJZSFgVeWljpH93IMt7v",
+	"FJ2aFWtQoHxubY32vanmJ7nW8X59N3Xz6cEMkvICEW0Y9e8gSUossCJatWR5e6iSKpEa4/Tk4iwNK/1F",
+	"wpxzcnFWG9Ti0wnRYZpmKbOh0oJkXCtT3ejDuOBC2gz5vP1KyubSeEmH0Qlr5PHrdFu2mUrNl70F2qeC",
+	"O0SSeG2nsBYjZwpIkFciT+kGKKEXmoR/VRYc5ydMEXGFi/MUk3jbfgXZyEANHEkyrvWAOVHXxAUXzinT",
+	"kZPIDi3TcW+xEkS2lDkKyJnQd/yjpibo6Sp82KvOuINyL7bp0v/cwL/pJ0KxozNvtQzMeMZ86YiCh1Sd",
+	"h4pvPkNYQ3A0vHxGH3C6Q9XrE0TZO/KIlzRt52i8EMYPSOxOPLOPFUeCaK2tlTLy/XfJmM+wtF78DIxM",
+	"cLZlJy2i6OJVXDTMFbEIo+22IPQ5e897cpqPw7MozlR/4POb9R0751xJJXCppTJbgdDJ0X100jPb8+hp",
+	"mxDtj+ZYNAUQI7x9Ijo0UojZqflZfhqS2y8n3MFpQQtyEDK7pzdCMDPxux5MsXrwNjuId7C3Ao+tcZkh",
+	"8sGpKI2TTbnaoAACFECAAghQAAEKIEABBCiAAAUQ/pAFEAYXJHi3Q45wcXw2vufX3+tsw20xZ3qLdL2u",
+	"TE7baDwSRscZSVIs0P/+34gX+TkpFqOP77QgMnfSrJWLe2SR552XUjz4+LlXITxH6Ur+XYF5pxXJsKoJ",
+	"ZZOGwagpP3Yu5DyZN38cpc2/vTjSd7pTT8ygxtVyYWtak1JZ/WGN1TM0G3335MlfJk+eTp58d/H0z8+e",
+	"/PDsyZ//28by9RZKDKhtV9NGbuOMdYvRn1gPvt3ddDQOdRbdx9ZZkCi1OCyR3/p0+xzDsXQZuYB3mDh3",
+	"SPtuzFQkbPqS7vXTHJ25R4g2rdvOU+Mx8OjMXzE+bHXGKpYTURiG7GNkE3yCXBFBpJo0w2htYVSnD/q5",
+	"nDYYDTZjr99cvHiGTAFpy/ktW9ew2qCSGyePVLgozO6NhFsQ7Lpf6ImxCA7mbIt6KYiJCUqaSuyTro3E",
+	"wT98mrCNrCmja41tT1N2kkGBKNjZVf3LqKDGE6PvLWOHbi7DHoG5M/Sd1f7Kh0hpeVsas0kL88pK/wez",
+	"zZuFYYydVXcCPt616e/o9K0Hlv5nWEIcPG4Va0WE/uD/fTSb/fv/TB7/56NHvz6Z/PXdvz+azabmX98+",
+	"/s/H/xP++vfHjx89+vXnVz9dnL54Rx//z6+sWr+3f/3Po1/Ji3fDx3n8+D//rX0naG7IxcTty2uUa7Lm",
+	"YnNroLwyw9TFUsxfXzRo0uEkodJ5u7CKedBiXe71HVdOVmCZTCXFMlBlGMn82NLeSyIklYowha54Ua3N",
+	"azR5a0r6L3Lrsz6n/wo71QMGD03vOr6UA4+FLwOqfiPr71tuZXf85sX6Pi4/ZBoUXKqlIPKfhf5Dh0Kl",
+	"qyBLIqzwKNOy1dvmC0kTelLTtIGr9sseKTt9mbauUrdJ//ou22NdG7y3wvKaM6q4PZFONabwLPCY+pft",
+	"9FW/aOWLNDxfJd5qAxWj9ljo6Mzp6u3v795EPOg69ZbS5sXoPOWeYdS7SGW5Y7pOsyO6lsblVgNFNqJH",
+	"x7Fl1KgZ/pH9eDxjNlrTZwKY3AFax2damcioh9bggIty5VNutDrpEMp5Xx1Gz9jxhuE1zTwUtJ/fJXss",
+	"CDbe+yVWpB486J5B2/EVsm2oossecqqzXdq2IMmzeJtx0hVnBBGm9MXI0CnPdbTFtPF2Iv5vi5/M4NQa",
+	"q2zVwMvGNCXPpwngh7D+U54Hd3YMC9O8S4Nhjd/7kNGARfgK00IDasYokzQnCEenlsbWnnYork9Kg7ay",
+	"FZfEmkyxj8HxBBOFrBvctBKgCa8exwHVIb7HvIWMPTiPVj628aTXVJIZM8dsR5daxa8Dtczc05s3mdoZ",
+	"HbzG5UQb8OJRemOI17jUg1rptr9xxN4X+hcinLabURgZv07rMbwMf9AqCMJr002KL0wCaqWi1JgQaJ8M",
+	"19rWdqFxsRysMcNLEnIZ5KRmDgejBCo4ZPrDn5uj+M7JUbbz5DzJWaIPA1EZ2iZZnhFOwoSTOwOKEZQd",
+	"0tBFqFxJPmhNkqpiE6VFzVjgDvorzLQKWRiNxRz+xF9txhg4rZfi2iqQDxkhuZvt0yLaMDtOiSuZCuk4",
+	"Nb83Izqk4mVsUkiHcfHchTtQtrTJeGnJ6jT9YkpiTbzaiYsRJv5HH3tkNyx5bsnc3fs4E1zKnWYR3foy",
+	"YaI/1T/79Zl3mgatKYptEJjZJpqloFiRGUt8UGfJmayaunbAkl4R5kTpKTqcMR0xasMXUYadjieJqq1D",
+	"4b6OYu2MEBRc7SERrZW73he/OcwaZ3e10xhHPpQ8VTjuhfm9OZh9d4f0Tl2IyJlpoNYd+OQ0ft5OgDk5",
+	"9a5pYZ8/Ojo5PtNnZ2Z7PDMF0vT14MFmHMqN81VGWDKeilia7hcHG0uKE4xOThHOc0GktJmUjbWYrFKq",
+	"VrxSJq5GrbF8PyDtJWU39pHhW23HDvz667HPwPEfIpPBHgbxKmw0bnj6blDC8U0MkBZLPrf9sbEKMD+C",
+	"+fHzmR93W54ssrYMT2vOllxvfIXN85G7+JwNajnnFcuIGEjJcoVFnrTRnLsnfjH+zVY8LTo9f3X83Hiq",
+	"e+4im8HRdyPZp+0U8/RkSNqX3RXa7Zk3nC/FYmq9jL3ZUkuPDPO/S/redsThepmILpowqOPTk6KbeU/2",
+	"HGCz5kPNjd1Ht9tu43zj6FY3+rtdLnHnjtxefH97xot5rbHJUFR+j6SXTNErct7nDziMH7eN+FbgZkF4",
+	"fWTMwMb09Djp4OTMKo8ySRLuWTMYLWyp/ji427t76xFkwuD12DlRmBb2euSMICxLktUuyG5JeWrS60JC",
+	"dheSBZbqQmAmzUwXNKVCdN9pNAUIzZ3DZpEKb/tSB9w4ZMzZGwXP6Hs+GsWl3s2jGvyR/7ce1vWKtsU2",
+	"vEKpb3wTrWlkRS28e1t7s6q/hoMV390w+mMbMmBskINLFff2LFjXPQtccR0UiuuEZyw3WglbhsOsK13V",
+	"YGsHVYaKBsrbjdf4w0vClmo1evb9d/+/v/xHYqF8QNOH7jtt1j71aW7TqOlDyA6rD0d39JZEIY3cOapK",
+	"zlwtJuNDZxkZa0aZHI1Kj7vFBj39zlbsMHNblJnWZPTrh3dTnmxS8ddxa0FUIg1YvjABIzNmggsEsSTj",
+	"9LNkFwa/4GQPi8Bun6SFXixTYLa/x8WzTFV3vF5jRTNETcTSghIRI4gVjM2HXmMNu/vGtU9voMypycAj",
+	"wjCbEG8dkeWmJBanLP/VSgjJVMhPtbHXBGtvlfdXeKV3bEPKrldEU65NuHUfCbMuSXMiSI4wWlZYYKaI",
+	"7cPpPDTm5YjScZ3I6bG64R/Qq3RJgQb1Wzj/9Ml3P5jDCD80JMtfDyf/jSf/evfI/ePJ5K+/jZ+9+zb6",
+	"850VBZPNO1IXmf098FoP1LGr2oN0d/0x+tGEVaK3NoA8DgjSz0fjkXlhNB65N7b0BW8vwEcbRRgeZcMi",
+	"Q2lowfnUFT+bZnx9EJ63ecbTvzRF8V8tWN49+nXi/vWt/+nxfxoRetsLj789MOJ3AO+7Xyc1qKdaEI+e",
+	"Pf63nRb+xL1Uc95AZ+G0tvg1OxUo9whYCvd4N2KprnbYuq5ChFEKufK45cOuFAL3ivXByG7exN+ihkA+",
+	"e9dF6Nf152MjXO3dk8SVRDLX446oRNkTbOsusMQW7AMfIitNxSXUJKCqlEoQvPaLs2G0ZWGirMmH9Iwr",
+	"LlXaQfdf7ok/Of9mlDvqJ3LGFqHtCyRPTTOkKxH5oARupBzU93jHcLvfndzfhCluhRHdnwFNA8tOSJkD",
+	"2imk041OHRrYqE6hhoB0QB6fIDjfpBQ/nG+61ijztjE0Dx1d23IJy0keqDo1WfctP3c0Qm/AojVIeTul",
+	"/p0RkhtSrcsWWMKlMoziynVW5VLg3F/0nSjHaFBTrcpCAKu+xU23RRz1hxCZJiSx2W8wiPsuSqfiBbWr",
+	"cW32UcbwvlYRWj/vyftPvjasHIlLO/y8RUn+MLWBoJrPQ6pG4pJs961JYj+bfq4E4aRkMt/axPL4efTY",
+	"T8kFXZqSkG2fnVnMzdJ7m+u4hdnMw2B/41nf6YQOXltaYqbbI+qWiFrZDyMMN524aLzElPZBPKFUeF12",
+	"pEUL5W+kDexz196wyXMiFWW4twKzf+gXYYTWbt53EuGWOFVW9idcylq394ZiQYzKrD9BOVFWAXfhViaD",
+	"xrRBS1mOLZc/I8aUOS9I2lz3MvFWbbDTz7zJDqtG7XZNVWYBLvvnTvtderR87rMWsRpAVAau724uG/QX",
+	"Eky+euOKgg1+EXEmkB8eWG3BrvQIRQYfcJHBI3+KRz4Gq9ve2RsEOlMHDTOVeWySt+LapE3NRrhraot5",
+	"cIC3tm83ibuixlckSIF9ZdfYPdRx1lqI3JgAEsBNEMNg8MZP7hy6tVF0F9i1d3/JTQjvxK699xhS222/",
+	"G7KJu0dWxxCgMHfnjBgxJfHeiqLZLdNnojw7OKgkEc9sTsj//+mTJ9Po/5/9+YdY+44r1kh5zUXeHFRw",
+	"nuzYqWfw57jr7QF4POhWvbP7FC7SB36RwhX6kK/Q02Sqfk96fuvqaVIdwaKgRKpjrFqc5Fatf9O6k/OD",
+	"trWmkiphFKSW/oQXyp+/q2KgVVSF3xO2RZVqlk/orMy+dKfbHXBgZ0772sVg3XvD7JpOpQPDJhg2/3iG",
+	"TUcpe1s23XfTVJ2S29VxtOS4vcLpl1658QsptAildP4YpXT28gkk2obbk64PdDceRlziDl0BnpndwBfQ",
+	"y88azoC9oyCH2oOjlTcSc8JyW1zxLlzEbs5BGmv07t0Ygr3QBQLXw1ZgvcQNeuxD1GNf9NRAaz7foQb5",
+	"XlzQbAaazfzRms1YAvE9ebGJDHeZ+63KgT3tZUjuSKDJYXemxlqb9s+m3Ea6EKt+1rxZDZHRuPXIFRaU",
+	"V9KVP5XmNp6xOn/7+LnjAKGhno9zjYMzMyVRQd8T5AEZWMQLW0QQvT0xzXErmpNQqknqiitaATHlbkJ8",
+	"JxdC46JdkS0I7EajYovZWo+YriWFZDRU3KvX6g4WMDaoli/q1W3JHgrwjbRQSdmyINGyu0vcp011p4N0",
+	"omd1c64OxuzXlWLrYB9v1JEhHWr/gPsutnSM3rD3XdqEYwr7aBEv+niEL/ITc4lk9TKJpBJVg4vXJYL8",
+	"nSpdyk4MXVQLcX32km11XrrxTWasmvPErCIqo59cwXTGPETQi9Yzf6atj8f1DzZHWGMT54V0vcS1daK7",
+	"r0xQRTPreexasM2X/4XlKsmKzdNTrNJP+5AjQMbhRUtJq+N4+4EzjDB7ppWvcGk5yxqXu9FgS7lcwIQ/",
+	"NiaE2jJ9iAAI8sdGkO4PGsiAMYAxAzEmNbNP4nlrUnsSguWb5gtN1acJBT+WyxNKyF2uOPlpgdkZWXQn",
+	"O2k8t1vvNESJXvIqtq+Z6mXezkp0Kc9fCMq5ydCNc5FMKa6rUC4rHtw6cIpNrZ3/XMdP+Txhm504Jxm2",
+	"RdxbY2g9HxeS+5U4YdkvUPow6qjCK8udwqiJZ4WvCKoYZcouN+NMajMAy0jQGudkha8or4QvLoDRvHIF",
+	"Lp2qaBPUMdPNT7lQFcMqLvWqT/DNy1dTAyRZLZdEqqgsgRtE7/nA6pwrzPKiC2c5Rtcrmq1s/bKSCM1G",
+	"EEaSCErkjPEFylYke2/ztiVekGITIKPb6ffDZVvdU++zGY1TapnDTodHqtNQhCwWxJTfKDahfqCFV14Z",
+	"pNPS+rWpdKLpDSs6pwVVG0TljDlrg3nN531bBLAFXZ2NzTiLTO5tKIxg7Ug+TESPZHIlMyI0felEV8HZ",
+	"Mm3F2VYaUDujrii5Prjm4j1ly4medmIJRR4YeB78yfxnNB4UmlhPZmqRuhew4mua7fKrlCucqu7mmMmp",
+	"ftqu3mA+2cZSUuxbKJIfquG+IIXFkqheE+pF/Njr9T4ZUnGH5I0F1nUC3FLzgbzfjxAtpgtG23+sxYub",
+	"tq092HY6BxjYN7BvYN9/OPb9gFhhxxrfI5fXlsC0V95Jx5QhjN7/h9xS0nU/D72dd7tnvn7ndh55b6MF",
+	"R/zDdMTbcwYH/INywNtDcSRw6msF9RlCkg0lX2GVrYhstSvpFoIkweGS4JnNni7oUfkhGyNXtU+guqXL",
+	"Yx9AqBfqij3LENLW/d1csj4wgC4QDfXkJFF7NWc5RHJFiiLMYZpEeBz0mx4jMl1O0X9Mn0y/HY2jcHL/",
+	"y3ZPj5/83c6TMpW79zwoHQIjaKZS3WVcOwpXi87XT8S1ONLnNU6fpXsYRp/aan4+wp9xD0brdcMs2Ln0",
+	"eWmaC+vCIgw3Gg/jOEmkTvCdnDDatwP7LNrAhWnaUQqSkdxI5zaYMrHZu15mJL2/0SVdu6ipF4BCx43m",
+	"kWr4RSOkm2t2sU0InvBjm5+RILLkTHZxol+xTc1RKxcuRuuELfjWFDwfdKe5a6Kvjnl4kc4hDK3FTNev",
+	"10YcNFPpE7UFC1J9Tl86kaPZHsxQRQ3e2r3phPi6GFfMBH4dLUud6Lcsvx+9i3Bkd4xFtHIy/OI9jz5L",
+	"esobdWMj6KVg9W7IAZ711wNPnGIsY/R4mxMpsWX1SodqxJCzlY3irNDRs1Fla2BpMqfy/bkrkjTsC1ve",
+	"+vlGkcHTDMlRDeA5DPvTBTNwiTOqNl/pXo/89joY5x+Mo/NOodkrbKwBWsH8hbKcX+957x0iQbJKGBmy",
+	"JILy3IjzdE1QXplfrUqWUymqUivGTjNL3D/NA8qrvvpuuijqil+jgjsJYV1vAl2bXSCp8EbqqZgTGy5/",
+	"WF0Oj6B5y+g/q2bwTHeS1HDSNgBJV/NgORY5ygRnunKoIFKGWrBeQQpVYhJ70rvxUtDlE/Qd+hZ9i55c",
+	"ugKhfmZjhdDivO/bpvMUKlYQKRFGl0dnb17/dvHf//sSlYIs6Af9emgkY5nqgN5R0UbH9UkNQjCZlgm6",
+	"+5W2aV3bmGVCxOKys11bDvmg7FwvWN4nD+etqs/FxsAXOaOfHiN95MNMuvUazhUWKr2KZgPce1mHHqs7",
+	"+S+uCm0/Vdar8RJY24aWTAv9Z0Uqkkfuu2136P9pvPxxPLquEWTQJdxlXrtuYj+DA8wwhD130aF7sMVh",
+	"GN3B3E8HgOTOQ2NFb3N0uojrbLF1JZ1vn2NJfqFqZfJ1Ej0vwgehXnTsCRglwvTGo0oUI8ey3yUX/Dzp",
+	"4Nk9V1L9eu3PaS9pNpxu6NzmO94ao8i6u5bRPvKqD7gMfVnX625KVywzyPe0nPDSIu7E2GGICB1MKltX",
+	"o1kI+qaDXRFBF5uLl+fJAEb7yFfPVRwRJitB0MXL84Pz85fIfO17VA1UpXag3S3R1zRvGdLe8tD2pfVd",
+	"1izgmt1sfTMFy0WPX5/bxxYJ784WnzM5KfCcFBNvlY9KpqzXkwjn7ubMa2b27PcbDtI92BtwiwGoYYvk",
+	"nWKB1/LuONt4389PX70auEPribwDtqin7GhAmnN0fsQl/ZlsmuUacEnfk82dYUy69E749Ra8zKUHRCvP",
+	"15SNxneFlwlV7PTVqy64jcAwkF+9LfM7Q8p7RUZrkW8gY3JD0nukhkkwne9Tl164iTtj77wv35wcH5ke",
+	"wq9wWSYbP4UOw4Yz6/eR4u+Jt/H5tp8ha6QjLiwFr0p5tL31tBlrxQujwiD7yRhd2n9cIuqaAu8lC9iP",
+	"T40el+oDqX9HpSCldfc7x1hofV0vZFvNK7P+nm3Vu5IBPPqbMbqU1byxq97x912+/06Og4Ndz7O2eT6m",
+	"CbY7RVc+30Qy+GT/VMH6FKEatOlpLekjGJqTbC8ngKucmjiLVLclVysgvJPARWPkd22j1Yq4X0Obdipl",
+	"FYWsOB/byfHYtWinIoyOQqIVrtSKC+P5LLHYE/fsFCcJ/dqs2niJXPtQ9+pxsnjRENrpI80WOT0c2jHH",
+	"Id6eveyBTkAae3Pvk9YVw8IPkzZE8TKFbeZD93AfeA1iB9sOqskhHjxHaNrvHa7HJxtAnFKl35h/nRKx",
+	"prInpcuWCXEWF6cocuZSyPTXsnaDYu/SS3Zyc8NHvhBBsF6svbL384e4PSSXa5/5tfjTPnt+eIRK6zSN",
+	"1Y31ZhKUg4Pd/tkgY/ktpeB66qTEI9OC7Kzu0t8y7lvr1WkkaXZpjFxved7GgNaAzc+TKw1n/+JDWeC6",
+	"bnavp7drTcsJ27y5IkLQnPQb8XCNJ/oD02IaqV6Pao1Uemrzdrpadm//Rj+xOfi6WeMUHRZFHc8R2fbr",
+	"4ICcyv7GjgaHkkkhJv7AYJhdL3pUPm4GCbhpR6kAmoE2lfpvwYu+VRieryd161jqoAnBq+Uqij2TlSUU",
+	"ylZEUBcTYAatnQlu7fGu7mLxnYaTHtyRm8WD2W+0hWiDsbmX/CwFt4qlWpbU2Q5WNlBne45Qqhh9m5eG",
+	"gTyQHbsysCY5wktMmWy13QsvxwfhfCx1UM3Y228uTJklgYyJRU5n1ZMn32fvycb8g8TsrxmTM6qjbEwl",
+	"JvO1Ing9ejbKyVUyoarmxD081fl6J09TcPUO4Ob3PqBv4r5NSi8OfdMEoC/bsSUDDQiNQcaW98G1ntKg",
+	"DMiiBdIpOrYxtbLdNFAPZZw6mkSCMFUvFhc0IzvvjbBRf3OMAuiSmNxt0j+o6X9PfZRTnqP6VeTehSop",
+	"UCXlj1IlJUEruwtFJj5KEMzClDLZ9Onhh43n9sCb/bM9lfqRQidtlBOXxeJl7ihCsruSiHsn9m+enf+f",
+	"l6HXtp8tvZjog7rgYSK0mvTUbWrWa9ox2fFznwZb8jwxCeM58XDsK1gyJxLp9yIw1hzPykF+upLnCeiZ",
+	"bAlB8mMTEVIf/MmS8fDziw8kq9IBH3F4g3DpIGZMpHh4YDaof9BLdXqnxIrKxcZWuwmrr2MvotAHNN/E",
+	"3VpNyga1QZvZinOpkyosFMzIV5Qbpmm7lwqrbIbw+TC+DZ2tP9NZHiYzI8DEn6MeJ7TDXBrDv9RsxCjV",
+	"14QuV0qOEZ1qHqGhTXC2igZeE6KkzXqxi4iPyN6Wa5OW8MjzuxlzvKnWmtvnkwTZGBGVTR+PZ0ybcytF",
+	"NJut1hp+VJkYArYMMrEBR+Gm5osIwrZeS65JcMZmI7vD2cjfSHpE1xfebHLtAqFD+SBZcku/5smLen3/",
+	"S78zY/qrR/JxDdMVXa48SLGrCdQ8ii3VgA59ok19bhGAFRHrsEJzBk6BN5PTtTaFUeVOET2ZsUf6HG2V",
+	"G41UE14+nqJDxKqiGDAD42ECN5C0aWFhrB4SJCxLOi8NhCUpSKY0HROx1nZDyTNqYogCCJuAt9vpztU+",
+	"kNSMPtukOXMDUecb89Q0ajbi8pbT6R/HiQFhb428FyvCjHVeDtnY1BDMXCQMF5prYOVKulvMe0825i0n",
+	"+3S2/p5s0tzLbMF8Hjp/hzVFgfY9ETxmOal6oHURID32N671iQb6ipriudh2ql3U0trfcUHzKDVOk8IJ",
+	"G6PXXOn/vNCpP3KMjjmRr7kyf07RT8pC52W6rawdPEk1Ri21Qb61JBZC1sM6kMl0RFy4dViOHRpk6zG8",
+	"qZtxNvGpcd1B7Pr1QPEOto3XP9ZPSo/z0vURtR/PWPS1yacMZcEcn2tkLc6JFapLQYyFXyLMkOvl4nMH",
+	"7YBWqC9wRnIfOWnEV6zIkmZoTYQtRZGtpsPttq2MO0117ZS7ljZlHb0B53Y2hB4ww9hyhB811789MzCX",
+	"BzADYAbADL5EZnCjpGAraSQM0eb3jqjSMAw3ZRbNGs4drV0YOcfZrIT2J6CnE903akj75hakIvkqLPdu",
+	"eGefbD5Ud3KoHCT5Blvt0X5cHplCa6IQVjMWS6J0TcZe17N47Uwa7iXjxvLuUp67Ht/7ryEjWBKXCr8m",
+	"asawQpKvXTl/TxZ6EcTvHj0ydluXaY+Zs7I8tuuVG6nI2hq0tMaGN2blSmz020RbSSpcFBtErmimwhaN",
+	"mYcqqwKnFegYo2SKNdsj1CJ++q7TIrfTFc0/zQG8Oduuklh1gQunmXRHTCgMdo4G/PnC8EOrFB2+PjZG",
+	"Kf3WBS95wZebeHc2g1RrNO5rrC1d7lrREHvdAgeoByARgEQAEgGoB8AMgBkAM7gP9eCW2+hKcO/2X0XK",
+	"gV/yfIhrRQuZ/Z4VK9JmfFLwDCvnpdSfOMVF4rWVs8foX5wRa51HWFpZ2RYIK3n+SD5+DJ4Z8MzcvWdm",
+	"haU9YMvK+h01ETloMrsXP40+U3ckelMR1H0UkLUZkPy0uRq7dRe1luckRyURE3uKHC0oyxMLQW7xXbpq",
+	"Dr5dJWzQ/22dL0Z48NwsKU3pF9A/KyI2NiYwXPse/aQzilCJMiyd49go8cZhpbXOsX3chqE/e7NmxvVz",
+	"eRMFsP2GFcy8HGh3kBQEE+ptrdVukwn7x7yFUOgqL95aKNQfOV50L7Khf9LoKnG3QqLZdENO3Ec2tL+7",
+	"CnZfjJQ4WGCbsS9ffXtpjDC3COGMRmkUGf9dU5YB80dUYiqkZplOio6fUVazeTuMtvSVeiwNgCtcEKac",
+	"WdDde3r4NqvREjmXllBDUc+ZBtxsNLY3Vowcs9EJ0w9caYomPgQ2YapHzSwaz0a7mNSuynKDqiAHMKS7",
+	"R71qPPc8zkBEX0eBzRixzXIYd7/bq54WxYzNbZi5UVK43q2kuSsiYffY6cZUcK67ujoo+QA63SMq42tv",
+	"zjWTSw1sdxAT87773Yxn6MXdjZeNK+8SYYkuDcdk6JH58PHljNW7CIk7eq+h0GUkwIQNoi37s5KerV5c",
+	"L/0bK5k/wkzRx+FOnyIDY5tMyNk3yk7rMdYPMGP15sP81MrhFpyuNq0Fn0Fsw2hc+Re8tlhLTTTWnOY5",
+	"YTYy10025943Uh88Zm5KD7/pjB0Wko/bL2YhclESZavUNL5DVOqdSaLuloHppGO5E5vbr3yVCM24ApxO",
+	"4jSVw9GaygeD2SGSfy953cp87VIjQRw0jp9IFLSQNL9S6R7UmXMs6qkSjWbxqq1620ZsTiWWRh5P1AVy",
+	"L09nzPinavGU5W2PVf2JHgutCWb6SvUmjm9k/cpspI/QR+GFQR/9/vFxI/KuHhMUD1A8QPEAxQMUj0+p",
+	"eLBWzawY0vWzYNy1OTpY0ax28/m34kqwd3azxZdWz70WX36dK9pfa72XWLjmOp/uut/uWLpQLnzj57Sf",
+	"0S4h6o4QXAxa2HNi3mO9T8ZV8yFTdFK/EQyURsj0sVczFm6NWpByHotg2K9hp7GfiMYiqAz1tLBEomLM",
+	"ZetYY/+MWXqxgqM7aDOfXZG5qmoQRHZprGy+nAuZ4cwJyfoXO86MBRwwm6Jh/umMvTDHHg/tG6XYDNsB",
+	"PWfrb5OcsC/c7XrvcLeWHXqsFZM7CXdrjgsxbw8m5i3SduPgtxmz0W/oVsFvM/aLK0/ras2vq0LRsvZn",
+	"y3HoJSJ9yIZs4aSeDmerGWshkRnQOMClIT3rUjNCvY2J81KOdR3SrYL1cd2zOxgBJHqkGY4p5M4ladJN",
+	"g1M50ZlehTZRtlN64Ffam+ovpjYjnbGIie3NScear+3HCVGTEUact+aENlE9YjzmB7KbK2rfaslDsdwY",
+	"mjVXBC8UKIOgDIIyCMogKIPghQIvFHihwAsFXijwQoEXChQPUDxA8QDFAxQP8EKBFwq8UF+QF+rWqVsu",
+	"A4opOjgLKj7TvlQofMVpjspKuXSWrzAdqgEGyIkanBPVBzdIjILEKHBJgWYImiFohqAZgksKXFJgvgeX",
+	"FLikwCUFLilwSYHiAYoHKB6geIDiAS4pcEmBSwoSo776xKgYUT9rdtT+C4EUKUiRghQp8EeBWghqIaiF",
+	"oBaCPwr8UeCPAn8U+KPAHwX+KPBHgeIBigcoHqB4gOIB/ijwR4E/6mGnSCWTpgT/kMCEU/2zv+X9qWoO",
+	"sqDLyioGyOsFx8+Rfb1MGnY1OIfkZOn3trSm8rOVPIfWUtBa6u4zqPpTptqX8r3kTAUtJrwcA7jRYdec",
+	"gaFg51Sh67KgGVXuFNGTGXukz9G6ZjRSTXj5WEsq5g7aPUPdwxe5gfSsktdj9ZCgaUq9sw3mbdOroKsv",
+	"NPKERp7QyBO6+gIzAGYAzOD2XX37gv1+2TvYr93gd4zuKNivlq+gAPpDKYDOGkF9yMb0zditgvqSCnSz",
+	"ZfTWQgbpu86E7Fld0fzTHMCbsx1+iJZRqzNiQmFImBNdDNw6sitaK92FM3nEu0MaP41G477GSFZzd61o",
+	"iL1ugQPUA5AIQCIAiQDUA2AGwAyAGdyHenDLbXQluHf7r6Kv5N3Qcnc7Kt0FH9vXWeUOPDNfrmcGattB",
+	"bTvIJYKQPgjpg5A+COmDXCLIJYJcIsglglwiyCWCXCLIJQLFAxQPUDxA8YBcIsglglwiyCWC2nYQ8wYV",
+	"7aCiHVS0Ay8UKIOgDIIyCMogeKHACwVeKPBCgRcKvFDghQIvFCgeoHiA4gGKByge4IUCLxR4ob7UinY2",
+	"A4opOjgLKj7TvlQofMVpjspKuXSWrzAdqgEGyIkanBPVBzdIjILEKHBJgWYImiFohqAZgksKXFJgvgeX",
+	"FLikwCUFLilwSYHiAYoHKB6geIDiAS4pcEmBSwoSo776xKgYUT9rdtT+C4EUKUiRghQp8EeBWghqIaiF",
+	"oBaCPwr8UeCPAn8U+KPAHwX+KPBHgeIBigcoHqB4gOIB/ijwR4E/6mGnSA35ZTwq5Tqfd3Hj9PzV8XN/",
+	"7/tz1jxlQZeVVRWQ1xTsu8fPUVZUUhGRkCzsh+dEXJGECHAUPR045/FzZL9C7rMyaWbWhzskQ0y/t6VR",
+	"lp+15Dk0uoJGV3efz9WfwNUWEe4lgyvoVOHlGMCNfr/mDAz3cC4eui4LmlHlThE9mbFH+hyto0gj1YSX",
+	"j7XcZG7E3TPUHYWRG0jPKnk9Vg8JmhbZO5ty3jbZC3oMQ1tRaCsKbUWhxzAwA2AGwAxu32O4L/Twl71D",
+	"D9vthsfojkIPa/kKyrE/lHLsrBFiiGyE4YzdKsQwqUA3G1hvLauQvutMAKHVFc0/zQG8OdvhFWmZ2Doj",
+	"JhSGhHHTReStIyuntRleOANMvDuk8dNoNO5rjGQ1d9eKhtjrFjhAPQCJACQCkAhAPQBmAMwAmMF9qAe3",
+	"3EZXgnu3/yr6CvANLb63o+5e8Ph9nTX3wDPz5XpmoNIeVNqDzCYIMIQAQwgwhABDyGyCzCbIbILMJshs",
+	"gswmyGyCzCZQPEDxAMUDFA/IbILMJshsgswmqLQHMW9QXw/q60F9PfBCgTIIyiAog6AMghcKvFDghQIv",
+	"FHihwAsFXijwQoHiAYoHKB6geIDiAV4o8EKBF+pLra9nM6CYooOzoOIz7UuFwlec5qislEtn+QrToRpg",
+	"gJyowTlRfXCDxChIjAKXFGiGoBmCZgiaIbikwCUF5ntwSYFLClxS4JIClxQoHqB4gOIBigcoHuCSApcU",
+	"uKQgMeqrT4yKEfWzZkftvxBIkYIUKUiRAn8UqIWgFoJaCGoh+KPAHwX+KPBHgT8K/FHgjwJ/FCgeoHiA",
+	"4gGKByge4I8CfxT4ox52itTHxKiELSlL9Ol/YX7397w/V81DFnRZWdUAec3g+Dly75dJ266G6JC0LP3e",
+	"lu5UfrqS59BdCrpL3X0SVX/WVPtevpe0qaDIhJdjADea7JozMETs/Cp0XRY0o8qdInoyY4/0OVrvjEaq",
+	"CS8fa2HFXEO7Z6jb+CI3kJ5V8nqsHhI0fal3dsK8bYYVNPaFXp7QyxN6eUJjX2AGwAyAGdy+sW9fvN8v",
+	"e8f7tXv8jtEdxfvV8hXUQH8oNdBZI64P2bC+GbtVXF9SgW52jd5ayyB915moPasrmn+aA3hztsMV0bJr",
+	"dUZMKAwJi6ILg1tHpkVrqLtwVo94d0jjp9Fo3NcYyWrurhUNsdctcIB6ABIBSAQgEYB6AMwAmAEwg/tQ",
+	"D265ja4E927/VfRVvRta8W5HsbvgZvs6C92BZ+bL9cxAeTsobwfpRBDVB1F9ENUHUX2QTgTpRJBOBOlE",
+	"kE4E6USQTgTpRKB4gOIBigcoHpBOBOlEkE4E6URQ3g5i3qCoHRS1g6J24IUCZRCUQVAGQRkELxR4ocAL",
+	"BV4o8EKBFwq8UOCFAsUDFA9QPEDxAMUDvFDghQIv1Jda1M5mQDFFB2dBxWfalwqFrzjNUVkpl87yFaZD",
+	"NcAAOVGDc6L64AaJUZAYBS4p0AxBMwTNEDRDcEmBSwrM9+CSApcUuKTAJQUuKVA8QPEAxQMUD1A8wCUF",
+	"LilwSUFi1FefGBUj6mfNjtp/IZAiBSlSkCIF/ihQC0EtBLUQ1ELwR4E/CvxR4I8CfxT4o8AfBf4oUDxA",
+	"8QDFAxQPUDzAHwX+KPBHPewUqWTSlOAfEphwqn/2t7w/Vc1BFnRZWcUAeb3g+Dmyr5dJw64G55CcLP3e",
+	"ltZUfraS59BaClpL3X0GVX/KVPtSvpecqaDFhJdjADc67JozMBTsnCp0XRY0o8qdInoyY4/0OVrXjEaq",
+	"CS8fa0nF3EG7Z6h7+CI3kJ5V8nqsHhI0Tal3tsG8bXoVdPWFRp7QyBMaeUJXX2AGwAyAGdy+q29fsN8v",
+	"ewf7tRv8jtEdBfvV8hUUQH8oBdBZI6gP2Zi+GbtVUF9SgW62jN5ayCB915mQPasrmn+aA3hztsMP0TJq",
+	"dUZMKAwJc6KLgVtHdkVrpbtwJo94d0jjp9Fo3NcYyWrurhUNsdctcIB6ABIBSAQgEYB6AMwAmAEwg/tQ",
+	"D265ja4E927/VfSVvBta7m5HpbvgY/s6q9yBZ+bL9cxAbTuobQe5RBDSByF9ENIHIX2QSwS5RJBLBLlE",
+	"kEsEuUSQSwS5RKB4gOIBigcoHpBLBLlEkEsEuURQ2w5i3qCiHVS0g4p24IUCZRCUQVAGQRkELxR4ocAL",
+	"BV4o8EKBFwq8UOCFAsUDFA9QPEDxAMUDvFDghQIv1Jda0c5mQDFFB2dBxWfalwqFrzjNUVkpl87yFaZD",
+	"NcAAOVGDc6L64AaJUZAYBS4p0AxBMwTNEDRDcEmBSwrM9+CSApcUuKTAJQUuKVA8QPEAxQMUD1A8wCUF",
+	"LilwSUFi1FefGBUj6mfNjtp/IZAiBSlSkCIF/ihQC0EtBLUQ1ELwR4E/CvxR4I8CfxT4o8AfBf4oUDxA",
+	"8QDFAxQPUDzAHwX+KPBHPewUqSG/jEflh6yLGaf/z5G/8/0Za36yoMvKqgnIawn6zePnKCsqqYhIyBSE",
+	"LSkj3SlemN8HznL8HLn3y6Q1WZ/hkEQw/d6Wflh+upLn0M8K+lndfdpWf55WWxK4l0StoDqFl2MAN9r6",
+	"mjMwTMJ5cui6LGhGlTtF9GTGHulztP4gjVQTXj7W4pG5+HbPUDcORm4gPavk9Vg9JGg6Ye/svXnbnC5o",
+	"JQzdQ6F7KHQPhVbCwAyAGQAzuH0r4b4Iw1/2jjBsdxUeozuKMKzlK6i6/lCqrrNGJCGygYQzdqtIwqQC",
+	"3exTvbV6QvquM3GCVlc0/zQH8OZsh/OjZUnrjJhQGBI2TBd4t46MmdY0eOHsLPHukMZPo9G4rzGS1dxd",
+	"Kxpir1vgAPUAJAKQCEAiAPUAmAEwA2AG96Ee3HIbXQnu3f6r6KuzN7TG3o7yesGx93WW1gPPzJfrmYGC",
+	"elBQDxKYII4Q4gghjhDiCCGBCRKYIIEJEpgggQkSmCCBCRKYQPEAxQMUD1A8IIEJEpgggQkSmKCgHsS8",
+	"QRk9KKMHZfTACwXKICiDoAyCMgheKPBCgRcKvFDghQIvFHihwAsFigcoHqB4gOIBigd4ocALBV6oL7WM",
+	"ns2AYooOzoKKz7QvFQpfcZqjslIuneUrTIdqgAFyogbnRPXBDRKjIDEKXFKgGYJmCJohaIbgkgKXFJjv",
+	"wSUFLilwSYFLClxSoHiA4gGKBygeoHiASwpcUuCSgsSorz4xKkbUz5odtf9CIEUKUqQgRQr8UaAWgloI",
+	"aiGoheCPAn8U+KPAHwX+KPBHgT8K/FGgeIDiAYoHKB6geIA/CvxR4I962ClSyaQpwT8kMOFU/+xveX+q",
+	"moMs6LKyigHyesHxc2RfL5OGXQ3OITlZ+r0tran8bCXPobUUtJa6+wyq/pSp9qV8LzlTQYsJL8cAbnTY",
+	"NWdgKNg5Vei6LGhGlTtF9GTGHulztK4ZjVQTXj7Wkoq5g3bPUPfwRW4gPavk9Vg9JGiaUu9sg3nb9Cro",
+	"6guNPKGRJzTyhK6+wAyAGQAzuH1X375gv1/2DvZrN/gdozsK9qvlKyiA/lAKoLNGUB+yMX0zdqugvqQC",
+	"3WwZvbWQQfquMyF7Vlc0/zQH8OZshx+iZdTqjJhQGBLmRBcDt47sitZKd+FMHvHukMZPo9G4rzGS1dxd",
+	"Kxpir1vgAPUAJAKQCEAiAPUAmAEwA2AG96Ee3HIbXQnu3f6r6Ct5N7Tc3Y5Kd8HH9nVWuQPPzJfrmYHa",
+	"dlDbDnKJIKQPQvogpA9C+iCXCHKJIJcIcokglwhyiSCXCHKJQPEAxQMUD1A8IJcIcokglwhyiaC2HcS8",
+	"QUU7qGgHFe3ACwXKICiDoAyCMgheKPBCgRcKvFDghQIvFHihwAsFigcoHqB4gOIBigd4ocALBV6oL7Wi",
+	"nc2AYooOzoKKz7QvFQpfcZqjslIuneUrTIdqgAFyogbnRPXBDRKjIDEKXFKgGYJmCJohaIbgkgKXFJjv",
+	"wSUFLilwSYFLClxSoHiA4gGKBygeoHiASwpcUuCSgsSorz4xKkbUz5odtf9CIEUKUqQgRQr8UaAWgloI",
+	"aiGoheCPAn8U+KPAHwX+KPBHgT8K/FGgeIDiAYoHKB6geIA/CvxR4I962ClSN/tlPCJsSRm5MD+3UeZF",
+	"eKY3rD/V0Dp+juxHDaN8QbONFqw1XtWEqSFDWLU2Hq0PmZZBuFRLQeQ/C/2HXOfz0btd0IvWmAKe5iaV",
+	"Yz5GtdD/pOytJKNnC1xI0rkATnleu7xOzdrPzSAO/1xq0lwScUVyw67M1hPfdeUqN3O0GrOI9hpO9Gv2",
+	"+lkUeGmBSVlOMyPBufwfB1gqrf453xicPX6OsqKSiogI9eacFwQzDZECS/XGrf4nwpy21z3gl8n3vABo",
+	"MnEEyQhTaFk/DWCxuiOVfWCJXZ5/+SHt8hyAoYnRX1KZcN72vOhkOTtgS6j2DrQ6ha3WpONUMnMMNCVF",
+	"45L+nQiZBO/h6Yl71sCrK/sbsTOsccgNCzKxA/SiXvcUnWugC+nZd8bZFRHmfPiS0X+F0aS/DwubSqeh",
+	"LRguLNu04oP2SApi4FGxaAQv377ixj244M/QSqlSPjs4WFI1ff8fckr5QcbX60rfBAcajoLOK8WFPMjJ",
+	"FSkOJF1OsMhWVJFMVYIc4JJOzGKZMpmB6/xPwe2UEszDhRj+8W+CLEbPRn/SE5ecEabkgdvrQeLMO/z0",
+	"43j0nrK8ez4/U5Y7nSuS7+tj8P7KsxfnF8FXZo/KYVN4VdYHpIFLmUnVXNHaQoQIy61nWf+RFZQwpVse",
+	"r6mSyKUkGiEHHQXzhPUq51OtXRxpd+oRluTej0cDT040yJIHtCYK51jhSGjZRr7/pyIVyd+WS4Fzku7W",
+	"WZaCa4YSpN3Kvm2J9RprCHlDFSMfFFpjyhRhmGUEXVOW8+sOXTqIkvxQpXMYFV0TKze6ya6xDEuJuZc+",
+	"gol+OwWMMM3znh6slSRCy/n1LqM5k3JDB4Jnzw+PLGof08UiwcUpI5M5liRHOV247vFoTtQ1IQypa+45",
+	"jvRsTo/orpbpjJ2RtVlYYb34gpj0S/rBWye/mXwzdhmb9hX7679/Y3hJxbIVZsvmQ4yMkDedsc7BaHro",
+	"7uF1tZ4T4dfn1os0wWNBbGhE4gIZj8ycDW6xXY7Wf/O9p1d8d8COXyIf+VW923qW/beG4elCg9svpHts",
+	"3XuoUisuduDg2hIVQfbIUghNGJ4XJMEsf1kRk/NuFqFpxb+ZEkDcIjuDHHGmnDZcSzepZWh6kwqvyx3E",
+	"azdi1hOghtVg8r3SBqX+vdZrRCWWkgTFm+ZWokrt/arvYJNIthux6hfdGcfQqQ/Mb2YQ1qUFqIuW0LeF",
+	"bXSl3r2u7S4ZdAi1BQU7bHJz7mI+JWJN+8y8ems4U2Y3TmtDnDkxX49kNonDLd/Zn3tr8A7fmPfjNSVY",
+	"UZjt2e8j8gGvy4JYjMWanU+cjC93qpfRqv06U5A6J5kgiXO3v6MVL3KJpP1DL8KCJCNCYcqM/mftSYor",
+	"XKD5RpGAGt5sakF6rD+2Ji1vqCyINJo4Q6/wBzvhOf0XsaOAWH3vYrWX2PpMpoFf6gNJDtCM+dMn3FCj",
+	"IryZohc4s/YYc/zG52iVLFyUK8yqNRE008xb4EwRIcdWyPjmt28QF+ib6TcW0SQRFBcGhnp9dWBcjaJG",
+	"fNfU8pcfEGEZz42+rhc97gryWMypElhs0KOSS0nnxcZY5O0Hj+2IVglYEUGmyFeVMeZDf2aK80JOKVGL",
+	"KRfLg5VaFwdikf3wlx/+40+SGCYz+WGUoD+6XldKc+tEPK1/NNaavyTGfKyExizCZCW8GcusUCouajec",
+	"o96srTWgR8YWbKdHXmr3Npo1z41F7rFxROgvG5PqgV2YbPN9hJUxQegrSMPHmDisEZbRIm2OAO3rfrSv",
+	"FhdXmOVY5A4638hw5ve+5rCopHVOL/14B/vZwW7qQezt7d0JG40kmoLnlGmybnAG5hFL844pOjGWIK2E",
+	"0dxamDG6FlSRiaETyspKOZzXyqbdIiUsI1N0WLhQktqhGgdxUB+UntcXH2d29LHx4et/2spCm9rI5O8F",
+	"w+rqHQZfECPa+88rVVYuTEEQbOK6A1ofnp5MR70G5TaKvHUxLAuc0YIaq2Yp+FLg9do4ZFaY5cbexRcx",
+	"KJP4U1uoNQrlPJMaezJSKvOPBV1W1mB4YEc6+JP9rzFly2Ga7zkxtbkS8tyLKyKIVGhZ8DkukPQvdsQ2",
+	"mmdHZjU7BbaT4yP3pvZg0zw7tagiUl78orCMxG2U5Eh/77FLSM9BhVSIM+JNqia8BUsULWs8UJ5sLG+r",
+	"qBwNnpQCFRd4SY4KLGWKi9RPUR6KqhlNCAu8JooIa43BKDMvGfe4+cj8bD0rp0RIKhVh6u+8qNZE+nsk",
+	"3zC8pplJfzDQsjLbdMZmLJ7bEZim7eAzyv9X8O0FUcDNbJeCM60C+sQHlRkqogxZYfwVUXj6Gq9JQtzU",
+	"TMWu9MWHErO04Jl6SwuO1zroqtYYW2vSH6Er85UuJYZZnr4lvzDOnqLXC+PtViJlC/OPUIk3Bcd5wmJX",
+	"crGHhhVGPDMf7iQLP/67bQt/RZSgWUJYCSF8a/tGTzRNrcV19PtW7Eni1ktGUNiXty7aASBhSXLBCyoA",
+	"3wKhs/pMEKzIBV2Thi6w1XZiDSfdn5lUmGXkJE9r4SfHnnY9DzdfFEXLotIQeQTNboAY7jATincpeF5l",
+	"6ke8pkXr3E7P3hy/Pbr47cfDVycv/+9vL/7+QgugO1VwqhE6AmMDEO0J6z2lz3Vdcq2l/CQwSx2rlHTJ",
+	"fFQJZtYuI3jhstKMuc8waBshWjFFC1+hkQoru3dQwDwjcqe5HNezG93a2o73sLkt9a4G2OXNe8ayZ8F6",
+	"k0l2WuX90GHCtJEfy9R98LdKKrqgWbArbB+FFyS9GgtSkpszTH0qK4sc/Xvhwh22XoJBBSrrcRXvjtrC",
+	"Xz+FW+c4wocYmvHx7cbdF/oq2WrhdvZbBzvlv7YobabqynTWjpcGhtab/GjByO0jENzSw+byROjBWA+/",
+	"ryF97CKDjFhUKW6laftM9qLnbj7W4APOKr6Fatr7HkIqLTRwLzkQ+4XuPukzq0J/dczqD076uw++x9ae",
+	"pOQQuriiUnHhY6+oiEilec7LMMXAm79DMa2L3818wxEtP9slaAa25SdLQfGtMS49x9n7qnR6z6nWr7bE",
+	"tSbDiOwIQeeodbQE28yIlC42sMv1rFPkdSuYsxTExOaNnhm7YMfzLNtZCW4cTdyVdOa6eWONw4MeP45H",
+	"8yp7T5ReVRrPsoJXedi9ffvA2aWJMAvbacxOLGPBtUMJq9W52hSxqB7pa4Is+z63lo4+UFeiSP5+RQRd",
+	"bC5enqfm+5jEoRBU0RLnKyG06t3nQTGQs+/UQRdbFBaWhP/rSA/3o6S+VlgsyfbFmKiOlrc7LEyjkg8I",
+	"4TakYIDtyAHnZF3iTO1JVPajzkL8KkxEqvfS+Ui87h21JbLywsVSepuhGch+kHbK6yeDjrMFxH0HP6/K",
+	"kgtFdjjF/Wz22zAplUj6AWwiEUH29LegWURSRCq61uzmjEiFhdLVBNLbDW8iFrzqthq+CRlyeWfCDhMH",
+	"KUSxI2vK6Lpav9gNXPdme7ue6Q/e6j4UlcCv3jybi90U1ktciakC/NaY4aXdH14od/a9sUtGWso32zGn",
+	"MxeVHpuKDbIDjJPc1sBa2tPqDSeLp2qdFiPEdkuYhz3kaE4WXJAmSDobTCzDIeiee+3gpfVCCCJ1QqQ7",
+	"mu3Tmw/PjFTaQxpWZJWRMXbYWuJ72WtMmXBIZcWVkecWHv4p/al9hcfh2X1cy74zHPW3MPzTArM92f2b",
+	"kFDmOXypB+mEuISrZJ/bQiLObNeKLuq38iiH+gKaV1vKvEVM+Z9DG/AyWNh1415g+T41qt/QvuMlBeZt",
+	"x3doQiVx0ZPEYr8JMfHGZ02XSyKS8NdQxjWMUyGJvrpUI2hfe+tJTi3Wd2icxaQapdSURGjF0jg0LsMI",
+	"lzUyxEuUSNhyY9fYpmouMC1C6H9Yst4pr5SkubkcqJKJAFidHnkZ/fyL+XXIxHRhMuDaA5pZS6KzGU2z",
+	"m2sqm/GyVOoc5Yrkkc7eE51rge6ZSgzYzorT2SBDsOXMcNE+nug5bJwz63eCPb71IUavsdKwzrr/SReG",
+	"IdMowMpHGzv2GxBmsEmi5qceoDUDt5PsB0ND7h0VYk2k1MpaSlG5G+HFMSk/fSuXwz5ECsv3IfY7MaoH",
+	"gRccGFdn7p/uYhsFxmVFh6HAkUQcCZITpiguZAJAC3zEkxHt6OLNxSnKTKaZMNd7xq+I2Jifpsg3pvF0",
+	"rp2WlTVQESZ4URAT2mPKvU0W2OZbV2qlV2LtTUkdaDxi5PoUS3nNRZ5aFSPXqHTPrZjs8oRlQ6Q33mrN",
+	"ZUzbIWcobXpWrTPYjRTCyG18hSv14DfnRw2v60EZV37gnq2U0T46DytJhEfBgSdZx2G+wkrQD93jjOKe",
+	"k2KXi6wbHOCaCErdZTeqI3nr+d7t3JDccy9l88tu3O7uAPohm+hb+LlNfk5kMfAlZUjaxzbydF7RQk0o",
+	"M3bOG9uAPQoq/p6wOoTQzuMG2ccknAoYPzluDewKKqrg8KRKNleSHDoR7n5yinCeCxs+Wy9c+8W8EtHM",
+	"joiGk7Ia4GjbCiA9jx1nHxgV+jB3TqyPFRV8aUOo9hlff3m4TDqXNJIhvCRM9cJLp6gMc+j6fUSw3GUe",
+	"j5DcR9nfJlI+ppmbx8j3mga8Dz5YcNiCd1N2qqI44us1VV3+oJOVl9wEdU3ke1pOeGm1rokJtyTCWo6t",
+	"z10v53WScw8fJkqvuNkQLaDFyxpHURvRplMQpdzE2OCSrnG2ooyIzbR8v9Q/yOmaKDy9ejrVCKCjjlI5",
+	"W/ZJFGIVInTN3Sw3TK2IolldpNMGU6/wFRkjyrKiMvdxEWqeXGFBeSWDMm3WampY+CFMdKwewJaJ4MwI",
+	"bL/X4VFj5Bf2sRsklXGmKKsSIo9/YsZ3ZZWcAGBoXP+NUUHXVPmsi9psZ7AWCaIqwUhuI+nrPOio9oy4",
+	"IsLID6bppAEVvsK00BeODaIMJaV4if9ZkRCUP6/Ldxk6RtjqND7y1ws1UZAwVnbG3LowCmrfEkQJSq5I",
+	"re24GjVhJTXcjyxUbAUWFwNPmLJj+aLAWgWwoejEg8zttBFEafbtE+18302TToHRglyjNWWVBpc5XJur",
+	"5Gt/2KP3GRM2uNRD20aVVjI0QA0naUEZCnjlVvosPKTsY8qiMEdbdliSMaqYyfbY8MquR5CM0ABKe82Y",
+	"CFbMEBFCb8dqG9O0UVErVro2tSLrIy0qdxGw+46PuKzxTFZzqY+bKYdybvXmOJyW5mpTW+qKioYUNNpg",
+	"KN3jfrUo5J1OvvIcFw7WvmiSjQltY39YuV+URBV7z/g1C95SO4w/ioIsFKqYISmWI76mStWlfnzGhKtg",
+	"Fy/UnK4OclIEPSLU4P+cZLiSBFHlS1pkq4q91yPx+qkBQagKJd1Lj+v9uArVjFu8bO/JboTK2+zEx/fz",
+	"IjeGHszQ1dPp0z+jnNfZC2EOi/tGHdfHqDfh5Jo0pnzr/AmULb81r0mdm2TTn7RGltlFHJm8gZAspOcV",
+	"xDDSvrGtudnwCOH+IB9wpgbVRxiPWtSbCg0VlPniEYZITYmdmo18I6NUpdgHUBvSzMcuPNdXmcjcThVH",
+	"OVFErCkjllnYjxyncRxpiv5uYyNdspfyAVuBE0dD6rN22ZQVC2kl2kfsmYtd+RSd8rIqcORLsnXVtQqN",
+	"cxO1f+/xrxlnVjzONhMzBC8mmOWTwM7T6auSFIuXlCUMG/6JzXx5e/aynfASzmXQ/nXY9PGL07MXR4cX",
+	"L47RzyEo31KZVLxE+hbHS1yPb8mQMvR0+t0TjcEES9JiN1QaI7gNIbF+Ahs8Yz976j+bDjPODxKXbA2W",
+	"I81zkkHQ/qEPs3eSAGWWkjRq4zmvlCndVlI3nrGqVqIhNGVYEmnxuS6rL4SvKUeYMckQ1wm5JQ1r+KQ1",
+	"G/Oo5jQhZQlbY4rhpo4VmtnGmkIYXtsTpkqiv52/ed1mfa/wxi2doJxbZllyqRb0A2LcZTVqGxkj0lCd",
+	"sphOtOynFQW7qX8RwSeU5eSDJlj0o+3GrOUQXJYExzIFZ5m1m0cl8Mzipe994Ho5r/CVBmcLhlP0xone",
+	"Bj9f2Nha+WzGEJoZ6+FshCYRsoUfHSP1Gmnds1t/aC6TX5+8mw4YwYokdvGEKaEh6IeYjdJRxsHg2bac",
+	"rao1ZhNBcG4EvOixP2t7T7o/DBCmyBbls8tzQqgjdMMZJ0YUMuZBnDcK+eyOPjtEjor2XtSJY/3N4qvu",
+	"DjciQJOcgnx952R+TJT2dvx29V0frbs3GpV9a68eqqnSUtirw//r79r5JrpHNJQdw4g/T3CNSMLT1Gy9",
+	"rDVRY3Qea1Yh+fhaz14TXZBvJFG1yGCuRmsc9cTjSunabihYeUetq4Dmy20Z12EY3apHTv7AUlZrx18w",
+	"29RveXwzh6v5nikNMEZcoIrlRPhJEjqeofI0dzO8N5SZtAzJK2PuqFJd1S3QPDAtL57qSpmmemv81HIj",
+	"f1Z2TJI7zjMd6h3d+6pJmDhNQGUaCuZRBOo2t0+BwGnk8V6T9J5OlA1xzbefFL1htseN9YFRD3NbN6ZO",
+	"KwxlbeopdLru505+Zb1xgMwk1t0WPujRda3RWLZjE2jM8FZH9HlsPjP8cQ/nVmJzuFDaeJdxlgpjOlnU",
+	"dRFtuqAxjBojuPmkG5zi8s2cr9naIvIpOudrx+B9/rO1nsS5zob/KPyemEu9MBqB8jUx0MT52LgMA6nm",
+	"7RXGXPFrVHDrCNKlmcIq8fuQZt8aflD/q/GoSlnW354ct09z2ntM4bz7jqqNv+k81koSMVlWNCcHQacS",
+	"8k8VzeWdX4Nb7j+7NWuqcRe2PiWdO9mow+7esBYtb32Cihr3XVEjS3p/z6vl0nLO/7q4OPVno9+t6yVa",
+	"zjNGT7TFzxkvBtKIu2jv8A6M5DAo1XDHpRpuoVHEEXFU1vx/uqsoxK3RIjgtbqWAXK82rZW7XGy9udno",
+	"RysHzkZuo7fQTNChl9SzAgtXYppZ8nNQNOQ3rzTDJNbMya+IEDQniKbLw/cFLZ43AhXrU0FvjC/lGZqN",
+	"ziuTaKF1URHv9N7RUZYkM8Ypt/gBV5XNVagEVRtdRnNtr4rnBAsiDiu18kFQWuwazc3P9bB6D6OPH03O",
+	"7yJRVO9P6LARtqIbchQxBYcM4MPTEx9MjS4PTZUzZ/14huxiQlO994SZf5JLtDKKsy84aFQc51ygTBuv",
+	"KJso8kEZG4QtW6WfOaGAz521fr5x/o9LYleTqcK9Kogk6tIJE+YPey/ap8YMIyhTEtHgQZKZIMQH6FBl",
+	"M4qJyDjDYbeWGiNn47PR0+mT6RMX0c1wSUfPRt9Pn0z1HVBitTKncuAChcwfS6J6oiMtLPWtYxfbCKLw",
+	"wUZGPgjYe5I7j+Shn2E88qqwme27J0+8A9D53E2JZ3usB/9wLMLtawcPcnPo6SzytO9PQz2LqqipSwPm",
+	"hyff39kSXgjBRWryH33HGT3jn588uf8ZT7zQ42wVxL2oE//Wayw27mTCwWm8wkupPdfhtN59tHW1t2CE",
+	"zXPWNzgj12mcqDUjE/WAMlziOS1o6M0TYrhcUa91WWzqj7oBYR0UOzKLcMseheKpz3m+uTNIu9HtVD4r",
+	"9WPT6e8iCe4bxfdD70+AbG+ZfDDU9cOTv97/jLq4cBu5TQkeH0KIcGEiR21NJvmgyN6iMMJhD32k/2Hi",
+	"bq6JF70n1lAyCkzj47i+Pg5+97v/aBlGQRTZwjrsC7Idj+dX1b1Jjs0HNZlHOanPft0WNezC/Kj+XV97",
+	"I2/xqUM+22Q8jk6iLe2865D4Dyl9DQjSzfjDJ9iwJLa7yIJXLH9Q5GaxdgC5DRO9BlPLT0Q9RFL5zLch",
+	"YP+nxf6fiBqA+qZr2Bbkt8HfEnGBcirtv3sIwTijQgKCiY728iYl0kmcRtFylZndgLkfwPi0rW2wkYLU",
+	"SKdwXywxZSmB1GY1PhDyuzdZ2O4SZGG4eh8o87EIeq+S7sF6gQdJuzbMyTVA6k/BSiSoBM42Y2S6nNro",
+	"Is3Y+DUzeQ7SWrSjgXRExkXEszLMbEzlnNS5YL3c64zIIDu8+vHwy5W0gd4+Lb0ZxNmJ3fdJjXF+X1lt",
+	"k6eN0RU3kxdt9dm0fH0zU5Wv5yt9F+CIToyg4UJojJMpQYrngRBDBubXKk34De4lT4DiDdxoOzdq0OV9",
+	"sx/ZaAe+UyK44u9dr/HaOh5zijYv0uVy8tw5xanwaoxzEc0Lnr0vdF/qBCOxVogo6U/CtQ6ENJSQNKIG",
+	"PG1iqEONW1i0lGuhdDWABFKord1YDxax7+4I2/m/YPZ6wCRjXKv7EcsdXDsHv7t/neQf97uCmpS3/e6h",
+	"yuda3uji+YzkOd5ZXiE9WYAq3HJ3QbJcBER72Bdekx7axNvUbHXw0Hd/QY0go9vSdcU0ZRlddnsUhn2x",
+	"1yaO9FNt8bG1FjlHa53WsbBpEIb0E4E7b82gX7y7Fa6+T2x0NWhzh3pWSSe6RdZ+0Wn6b/2Vx8kmXdi0",
+	"5rhAaKgW0/EQ6a/NSNYv5OobuDoXGRkjnzNQv+WaP/QJq4enJz/rDd2nd8RMAbFw+wlsHmluwOV3MGhT",
+	"HcTbG01i7tgku0wKU7/ib79c+PIVXDRbp9nq6jPGtfF+hYvFTTHavBYqvZghLnFJfyabyygkz6VH+Oaq",
+	"fozQfdtdiGaxbmDdjtN2lPNFr1ydDsziXgtxk7wtQXwGce8rhs8M/pkclnZ/udsguC0/fwifI/dWCN+X",
+	"E77n1r83t4pv1YPfhwfseW0xul/3ZkYzdjtFMvCHwdJoDaWEQArC6F2QksOFh63M3Y5eXGXYic9I2S6J",
+	"Ln1Gh/vMJt37QiiNPo5ERiW+KIu/SlHBT0TVtViO7Hsntrbevd1c6Qm/nBvs4bDuUJ99wSMsrOHrkC3H",
+	"Ck/o2vS7EAMUH1eqryhcg2L/pcenOhV5G2ppEVj3CT4JE+/gsj/SQu+mNed8E/XqaLUJcb3+DzPTztcU",
+	"wVqv8UQSPY9+3zB/z6r/WRGxiaxwflRbb1kvrz6z4VVmpe28Y5JiR/dqsY+Bub8qBiQT9LImhkWUoyGM",
+	"PIiH6GGCLKk0aGpVscbI+5GLlcPiM74nraUxRQKMFyvS2oevv2bqazljxOhT6jq7lgxYP0jGb5zqVrTv",
+	"t6W17pLu9bKPBuBTdlQH5ULFEV8j6VKPejmdsePm9eALAVI2MXYOImU8FPoHn5ue3i4B386Y9ysELQIc",
+	"rBY0lm9S/vV0Rnx1tYxeueT3X30NsHf+23hOX57iYegXQD5Rys1w8tkeKmiLQOyH9T0pAV8jtj64G8+e",
+	"F9x4XxLJulD9e7rxWKP76NBEu6LbhTRqXOeqLvRpUlHD03tEuzDLfvpFA/Sv3J5YvGIP+J8II8JVNdwB",
+	"9+j7JswPfg///nhge7ZOnA1kL+W22e61p9REo/PtoFgws7BgyOy0lE2zSd9X7WHEhjU3DbrmLXTNFpJF",
+	"pGCBjByUb1AcozGyqR7z7be+hu2335oqtpeXl/o/v+v/QWgWCjDNRs/8j3Wp22doNpLfe1KajcbNFwyK",
+	"2rccyYZXPo79BFqCaQ2uEdcP3hi07plsH9u/nzbeCc2g7Sv2z9/ek03jrdDH2M1j/uy8ZRshux1Uk4ww",
+	"JXAxeTobxbv4GOB2IwDif1WC3CMMzfhbwRi6Sm+FpFvhby424je7gy0wbb0fA7cNuB7bRoOrPDROevdS",
+	"Z2LTrnN6jwja3OHnt7o0zwsugJuaXTqYu+UG6BeH2oLOcJnophaZFj72Kac9hpS9qX1fQr9dqO5nldTA",
+	"BnNTG8w+tDTQp5pC84x28Nxb85f0ijB0GVDhMlkqBbD/k+spcEPdrJzKPiS1q7BKbNoceH2gN6ywP9Rv",
+	"uMYDvkGBr5rbawYFartnWTYB5r1kWXMgcp+zBkn3CzS3fnJJN7LNTrSnb0BuS9OI0nIV+ku+Rs/4orfx",
+	"yeY184X3NDLfllFHd+pIxHYDcCTIggjCMsv9Lqd6/KnCYkmUC+LRPOJyxnyLw7ZDIjlAHjkJXvc5itpx",
+	"BX/j8304ZMyGHjqXam5yt6PHHOUDCm7oWTUwn32jG/TBtrw9hhwdrQ13+FimsgcDuqmuvaCMyhXJ27vo",
+	"E5tM8GeTNx23ox5svyVBkFT6cqUMhQgJn5CRYZYR18lfKoIHBUY8BA4yHujd1pC4sX/7b4E9QDjGgw7H",
+	"GELvA60BN6e/lBkAiOZeiAYu3wdlQXhIN++BvdKGKALmRUv1W6IH9+AAppdz/aF+gSqJSp5Ldw/zsiR5",
+	"SNxoz0SlK+uY++s8tGT1CWRzQpj7hOS15tFROFyXbFeaUWtUSd3AgACYFNzsD0SSN/j4sPiJ5wv71Qvw",
+	"XwVaX3PTgz8jpnzGUvZg9B7cJuTVuO5zZghbyC3MPt/Y1j/aqFCYfnluWp2tMmOXL/7+QvdC++3k1emb",
+	"s4vfTs/e/HT24vwc/T4bzTeKyFPBNcaQXAcBPH3y3Q9j5J5ccIUL/esPT/76F/2rMmW0mx/Uv9evf7yc",
+	"ea4lFWY5FjnilSorNUW6vKWzB2p+yV135nEXgpSZBHAd/T1E9Dr1hwjc7Y5M2tV6ToSfuoXcTVRzGyq5",
+	"6dBuiWSKjskCV4Vtr/X0yZO+JC2FafGyk521xh/oulqPnv35yZMn49GaMvvn024rwk8nPQYcAynyLqTI",
+	"wMQ+HfvXQ098Zq61Qt/MotwQxexAuyzLW+y2ejS3YWtH/5rtt93NbrHjpuD8IOy5g3bRxxS+e/L00y/G",
+	"lRNBjlXYdXz36ddhc3lJDtwxaeBOYHzHzTaAKyY53Q24422S/VLEewtrW22lfnj8cqfUl4DFDaS/zsbv",
+	"WwrUreGJGjurRQht0H1uSnufm2bJrTiHloiXFQSzqmzHcHSWMedcv3nPIt2eLdFB1ruN+X4wN9vDeH/H",
+	"bMVpksBT7omnvHvIkhiQbFM9eyjShx6ZC3IHypkb6W60szM72B9EPfO7HaqfeVA/NAVtyz4+g4a2ZTWf",
+	"VkXbshDQ0YbraCLwBM8mPWD35JOB592EUd6ZnuaJ+K4VtYfCOveTqhw0bidWnTX44pcgV4GO9Ll0pO3c",
+	"5KZa0h0QdVdNAor+cjWlG4hEQLlbVKXtZLtftai7pty6kBQQ7z0T75ehkn2ucldfgUq2qArghckiXA9H",
+	"J9q7/nG8dNk1FIWpttVAjrBJPgzz0KchZCgddcsyxQ3k2xUJcztT6H6YnTSA/kEsn4Pv14dm6nwgF+qw",
+	"m7TY3LOFE0ybtzJt3i4ur3kl73N/H/zur38boB0F6t30Wne+LLm3Gyhxvz93y/miVKfbqUzbdaX4tB62",
+	"axiklTuUVjxNfQ4HcYdHxA7jGzMJP4hpqoe7z29hhEnwkTO/ZGAkXxAjcacGnOQuOYmoSeFzGAwOfs/n",
+	"r/HaPWqXm7lBKyVbnkFzkaQZ8w74SEhKAfYRlm8P8WFmnu/LLx5sP6UatfEdKww3TeSJyNeWNN4raMx+",
+	"cmtaHWpAObcr3LOTRwvId4P748/PKd6Yf+ACsWhqdyINm8oUnSxMvrtvCDxGGAnMcr623/rqckvCiPD1",
+	"5ZJN4czoDlif3M7kjr/HvGSffn6jUv8qQbwZ1mu3zVZsTdn9+OV+LPCOwr/uOuwLpBNIxoFAs4cXaHaH",
+	"xbTuin90I8yAeXwJsWRAlXcTRLbT+TsoiuxuzZbJ2DEgywceJXYz9/UDCAsDVnJnMVifz3nrqvSFbe62",
+	"oQZx4goLyiuJ6o/7qPpuBY2jerHA274AkSM6L+AYdxPBnsUk8Hk5hyA5YYriYh/WEX11L46XBNOI1glc",
+	"40vgGuHAgGvcFddo0MAdsY1JPOpNOEhJldiDdZxyytSEsskFXRMkSMaviNiYDsafiJWc6gUDD/kCeIg5",
+	"KeAeN+IeO2jtU8sdhC0pu2HEmPv2VuGkL9z8f4RsEbtXCJq6i6ApEvCmQy4WzEOpxQ+0B7EcVOVS4JxM",
+	"ygKzoZRTEpZTtnTA5QK5QWSz42acjTJjh3lObXBAsRkjqhAuJA8VuLEZWpOFHxxn+m1EFVm7xjiMkNyZ",
+	"tkoiFlysSY5mbE4WXBBzT+OFIn41ZowayH6tfi2mFj+6ejp9On1ilmNK+Wd8vSYst/NUkiDld67lhs5+",
+	"XQcBXuRhWqLftsWwc1IKkpkcCb04H9HgGga46b+bPklLFG/tcKf6XL5mjhLvE1jJje5hj3mlxRXPRd44",
+	"dJWfin8c4FKH8+BiUNhC3M3D76AtnNpZAuE5RkAl+mdFKu0nZ4oW5hNGPii0xlSfhx4YXVOW8+v+HhoR",
+	"3h36ZT88OoOWFDdtSYEDjgzErV7K2RF6GC6/hEBZj749WfMLuJIskZAHdy3dR+vcLmdI4OKZndocQy1y",
+	"7EKxT+eKS2zjjMiqUPvllH73eRZ0Ed0Ke/B7YISxI9GCb3+Od0+yQh3TuG80klv53djonFL1ZZjniF/s",
+	"l2JXc9AFUf52Bvlw7ttsAjeoQ3V7SmqGEP3Bien+Qn/66ehhR/4A/d9V4M8gFnA3V7V9ZXJFhKScTUpe",
+	"0GyzZ/88841V0PV6BM3cLW4HR25wp8PrDngaU3XjOTbfJAvc2F587vO2jTGtSB3Wf6Frqla8Ugj7teGi",
+	"4NdWT8NXmBa6z11YVo/QYEH9d/vSqYXL12yOS+0XaHlvWn7RwHmHgBEp/2TS2grrJ9t9lQtSFppoE/Tk",
+	"kZsvtpDFiw9UmpaSCRITxCTi4cWCZCrWsahoT0UlylaYLdMtHC3/erAEc/dX9UBaudh1Zv2b+giU/oXc",
+	"2mRfgu+/uOs7etuVHdk+Jtb2sWfD267xRG5nIm867j59PXdDiAyHcNd8hitJEDYSARbKcBvOCncXG5Oj",
+	"pLl+I2m7T13nqXWvsESMI1llqyB8bLnUX9VD/OJA9zXf6YntAqHvTeivunh3Rxf63pTYc/U+VLS++5u3",
+	"u9PzkmR9l+8W+H6eqxcI8g5v3vV+dHnre5czqrhG7wllUulp9wo5q79H4XtEGcKdqJlksNmr8PlJmH0A",
+	"kZsRPdL7HnvNvPIHf4t1dw7xZ7eIP0shYkQ4Nbj3r1ScGNo6uVNPvOnSYZlElxqrLp0pUxI1nbHnWJIc",
+	"cWv58c9XBGlkI5miVwS9JxsjIqKMswVdVhbsJmhMNsY610IilmNEF3aoZ6hcry/HekCGLvW/zWDxl75K",
+	"jZ0BN+foL7bcRdmHRqv3cDV39mxhcaq3Lfuu6Ff9ePH5yuYkjg+YzU1L6CQov5/b9F/Syet3z+v6psV1",
+	"Usyrx5E27ammczOO4JlBGob3Upumw4he7TP3Hyv07YcnP9z/9CkOybiy+ToPsUJNC1kZ3kbwAwNCbkWB",
+	"2vBzK/J79UciP7hGgbbTMSp73eQlVtlqYJDKrajbmcDgfv3M0r49h+3S/nqXtO8CWKYg7gOfupVt8J6V",
+	"jpKINZUmfmS48y3OdQufh8T0ShIR0lyySgjCVLFBBV8ujbvMGFK+ffEBr8uCPPt2xg6lrNa2euSCa6+a",
+	"3u3Z88Mj54QcGzedHlaiS1zQzIf5zfn88tmMXV5ezlg5RoIX5FlOrsa1CVKOkSA4H6NvW2+0Y4vG6Nsx",
+	"+vag9zUfbdB4b87nW19ZjpFZbj2iW6xmIRqgJn3BQrW1/TZg3b79bn+fMYRmo+it2egZ+lX/ivx/9P/N",
+	"Rua72Wgc/1aDp/VAw6r107ezkf3z3Xjg6G3Qdgds/n1wiyk8zPeYQ//n3Yx9dJA8ZPku0MdoNhzwcz6/",
+	"v1Un8y0lEaf1ukb3mZnRmgqMSjdLe5RExOgWcfbDSq0IU25haFY9efLdX5D+lQv6L/OjK8gcfX9APpQF",
+	"pmxAuXn3pkTXK6JWxHJuWVkRhsoQ3aC4T1U2b7icZmfHdhKPk//8nTOdsROViqwUVUFC8KTKVu4rI9ON",
+	"7R+8IIiyFRHU3s3ZClOGHl0uL+3Xj1FBXJoS11+sxzNm8sDcLjDKCbMzIYXfE4lKQTKSEz2YLQkSLYiY",
+	"iDGXcOY3n5MFrgol3QxDrrMXFpg+eypmIHyBuFmZG17WXgIDz3xNmdm2W4UD6eW3l+iRZfvF5WMkFWa5",
+	"5UbaA1fDXiaAr4fBSgk6rxQJL7iBsSAW+CRHeKkxwFbByDiz2e3hg/jQUg4Ct+maDYzuR0CvJzAzMjOG",
+	"S12zxPfpBOzkWoD73SC61CIPwhGx3Jr7rbES9MN+MWSWoclBlJ7mi+MZK4kIBGgk07LOZyix0uDwVNUQ",
+	"a8l0OUWXenffZ0EmM3+Sg/pX+8OlH0nOmOYD4f08TG3D2S77vzQMZFnwOS7qjxzHsMAzO+frslIkt7Xb",
+	"O/wbS0mXzIIgQE1PTJVES8GrUo5RTgXJNPCMTiB4tVwZLqdn+4UWeYZFe93+JFx0vJtMEH1VYZ0+vLfe",
+	"ENSGtvR8U12hIeHn5OqWMr4Deb94T5gO8M+1hGmsI/bXALZI8vzd/id+rJ9ulzlnI3eJRAPZwdwDN4TZ",
+	"6GisZXF7Rvb9kfVo2idOc0CzkbV82H9b39Ns9O6jH/2d/cfH8Y51J1WUgQtOLtYusLuQlmB9srAYRCXK",
+	"qTTgH9t8C4eeGiM9E8BOd/DacI3QVCKyLtVmOkRUf2X51ieT1918cG3dhdDuqPhmlxfPJ3pdeVVoy4zh",
+	"WnS/YKyS56geAvkhPBd9X82JYMb/68vk9dQAO+X5eRhnWNbDcSslU1ts7f15ynNUj4bscOb6tOem05YU",
+	"72uIZIe70Pbf2CBMWLXW8C0/ZHplcp3PRzasZymI/GcxejfebbU+s4zYU2x6oWYPKywRVlrfkAo9NfdR",
+	"34JXWJ7p6+rzdS1JnB6Elt0itKyHrCIqT2LO/oFmqYk2/fFYaSq9F7UrMVOPMyS5h88f/DRwB0APg6Kf",
+	"koc8iB76vRJ999+Wu/Hgdzvz5GYBUGlU7XPR9rYUu8FlGXtp00S/X/3axBK217CN4PZgAiug2dYnCmW6",
+	"OfUOjGu6NWH9RBRQFVx8D0zZuzndDO2NdWvCceEqfzTaeegS7+eoYAOEf5ehN59a4vXv7tVjBpc4o8qa",
+	"uuuSMGEoT5s/D7ID/URU/aIrdH8WVnWPiLtlVsDf/TU2C8MaCyKkrSHtbJCSWOfbEE2KsitcUHtzvbAY",
+	"bn7/2y8XSPH3hPVrTOek9hHfOEniu7/eP4AvOEdrzDYIK6VN+PJh+U0jqL/kS16pvQ3POw1UVMoq2KfC",
+	"0Ro3lXaF2lDE2jkYLcm5EkOuoTGVryupjamuPfRlwZeUXRrGNacFVRv3Ec4yXjHjei24aSGtJ8ToekUL",
+	"4uriK382C0wLkiMzlnYpnjgpBkt5zUVubLfkQ6lvXTOwiEJG6rdCdKHZafjZLDhKmazHj9ZImOBFYd3C",
+	"66pQdLLAmdIrbhyCHvzizcUpynhOkNlQ6DBifkpM1mP6iynoHkoGy2b7sL6CtrLTYuluxZtS6L0r5wUx",
+	"mJeMRve/WJnriwpzfvopWEnGhSCZis9qbPHP+K3YUv/h+Dl69eOhwUa7vu8/AZNtElPtaXWkv43cx6jA",
+	"2Xsr/phfIl4ynjG9LSWTrGD2B79FSFYJqjajZ7++23Kn0JsF4jg54sAD3hDzVh24LrMU8WSfNF7RQjdl",
+	"GhJ9p5HJPeln4uPgrO/yeg3ewuXeJy4IXcXJBd25TQaxWq9ujCjLiirUTvdL4YyMTbRALTWlubsBw6kH",
+	"2z3ptm54O9le0XMPLYs1edxUIhp4Hhfdg845kSbTY8dhf1IWaAUraheGPXa5n/kC4SYdjP3OOrLSw1I/",
+	"DI41gBtzFLv2lvpxIMhCELkaELncKDzVAdlezMMXw9CYosl1xrCGKFsiLF1UrVmVq8rjx3eTjrVsmq2Q",
+	"qQsnEWf2Ohq7Wm4WOw3NX6yIX3ZeH/oOvnBm5+5VnB6eXPX001DN1kNpiP5cdB7NCWFIkCvuqOaLoXVd",
+	"blh/WnCtfj0ogneYGsP6hiKEUpQt96wC6b/yiODFGZPnVhS2wE3K1HXup7tHw1aYYzAhbRHUogX3FAeL",
+	"oXjAaZ4dZAWm6z0har/x8Hxzcnxk0dTlbqx4kQfpR9s1g2hlo39j4SgJeD3ikZ7jFS5LzXfu8QA6c+3B",
+	"0R4MgZkjMKeC1gFkN6zbGKer3+FBuzwcZcw/ZEE/hHu3FKQM7Z9CxUL/rR1J59DocFm/IpPvUnc6thk5",
+	"7uEYXcpqftlINw2Lu7Tj1U/D+D1+syQu3r38nUbDT+cZug0ZgFm94Rbajxj7XUHhtmswbTHH2YFzjOZ0",
+	"sdiPcxe6B8LclJrTHxNh8t7mRF0TwpC65nUPg24+SqLiE10s9AvWteUKbe+u1lit50T4CdyEmvj1gWBB",
+	"jOm4J5LWPdrpC6ZMkSURyboUu6ZXvGdyxfeb+j5jOGqw60PYj1w/QR2F1w+yZkKEzBH+3xd5elLas2g5",
+	"lwoJkhGmthHjuHaw8CInUoXbk1wTqUxl8aipgSDayUFyRIxrQdE1qUc8MrUeX+HSm9ym6MKmfOrzauV7",
+	"Uon4mqoepVQHRyc5wicgBDfbvnHtDxI7r2rI3StuHvzu/vVxT6UqxB95JBtyX/xEusix323RBE86bKh+",
+	"+OB4td8zsOsbEsSno4cD7b/VtWn3NTnGqw5W5F56CS6Ei1WjC00w/SHX/d13y5WKC5JP0S+uooHPRnS5",
+	"oN6CtKWLzZnbWI2WQINQ+eqBsgCu+zfj7H2btO6cEWg3LBdYbCZLgZnaU2oLX9s12iF8nuCVrSHmrc4b",
+	"oiJryIpqit7UdTqM5Od9n3zRGt6O3Cd6Xfj3frJ7uEeCak/1JUpcF6lT22o6234P2NIHEmFmBzTlahRH",
+	"2HqaTHiFMThFbZ4NVliJ3Ec6mVHWhDmObzuX40rxNVY0w4Uum8AycyWYr03VhEOGiO+BZjYSHB/a9uVX",
+	"En6ISvl4z1N/aFHzrO/JBNac5DMVcGntFKxgN00HxEmWeAdcW5GCrIkSm30ZtPsMlXhTcJwj8gGbCiRY",
+	"akK65lWR2xYKLOjS9Ud6wzRUTxKk5MI6jXWQn2kqyFldqkpyE2NEbQUIm9t6oVVua3SIVHdGogpNelB3",
+	"aWBhV9IThX0RgHCvtOAnATK4wdXiUcee680wv0Z2jfpeqN4L8Vv6RhzMZZefQjEnI5/oFd4jhu0tijdA",
+	"/PddKmHLV/r76DnBggjtWtauU61vWBBYnacSxejZ6ODq6ejjuzBmG8Yafhu10resIIVR0ByziFIoXIC9",
+	"rPWh+uHo43j4mKGCRnfE9qObjfvCdU7uDmuf3Gq16Mxqq9Hw7pfbDfvcdIyJRrU/7DXo83bXmcZQ6Nz9",
+	"PnTIun5uPVRUfHfoMM3gcJu00wiECIMPiZrYBx7NmCjsg7Dq+fwv3UFjqhNrt/I5r1RvuEU9bPztbTAY",
+	"+dad0ZLrn4YOHKrjuEKKXEOXLdHx89D7s+S2ZRLjeYzX6Vyvj+8+/n8DAPZpFZJzQQYA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -19,6 +19,7 @@ package config
 import (
 	"crypto/aes"
 	"path/filepath"
+	"time"
 
 	"github.com/kelseyhightower/envconfig"
 )
@@ -49,14 +50,42 @@ type EverestConfig struct {
 	TelemetryURL string `envconfig:"TELEMETRY_URL"`
 	// TelemetryInterval Everest telemetry sending frequency.
 	TelemetryInterval string `envconfig:"TELEMETRY_INTERVAL"`
+	// TelemetryFile path to a local file the telemetry reports are appended to.
+	// If it is set, the reports are written to the file instead of being sent to TelemetryURL.
+	TelemetryFile string `envconfig:"TELEMETRY_FILE"`
 	// DisableTelemetry disable Everest and the upstream operators telemetry
 	DisableTelemetry bool `default:"false" envconfig:"DISABLE_TELEMETRY"`
 	// APIRequestsRateLimit allowed amount of API requests per second
 	APIRequestsRateLimit int `default:"100" envconfig:"API_REQUESTS_RATE_LIMIT"`
 	// CreateSessionRateLimit allowed amount of API requests per second to the /session method
 	CreateSessionRateLimit int `default:"1" envconfig:"CREATE_SESSION_RATE_LIMIT"`
+	// LoginLockoutThreshold amount of failed logins within LoginLockoutWindow that locks a built-in account.
+	// Setting it to 0 disables the lockout.
+	LoginLockoutThreshold int `default:"5" envconfig:"LOGIN_LOCKOUT_THRESHOLD"`
+	// LoginLockoutWindow period of time in which the failed logins of a built-in account are counted.
+	LoginLockoutWindow time.Duration `default:"15m" envconfig:"LOGIN_LOCKOUT_WINDOW"`
+	// LoginLockoutDuration period of time a locked built-in account stays locked for.
+	LoginLockoutDuration time.Duration `default:"30m" envconfig:"LOGIN_LOCKOUT_DURATION"`
+	// SessionTokenExpiry validity period of the session tokens issued to the built-in users on login and on refresh.
+	SessionTokenExpiry time.Duration `default:"24h" envconfig:"SESSION_TOKEN_EXPIRY"`
+	// SessionRefreshWindow period of time after login in which a session can be refreshed.
+	// The session tokens never outlive it.
+	SessionRefreshWindow time.Duration `default:"24h" envconfig:"SESSION_REFRESH_WINDOW"`
+	// JWTKeyRotationInterval period of time after which the key for signing the JWT tokens is replaced by a new one.
+	// Setting it to 0 disables the scheduled rotation, the keys can still be rotated with everestctl.
+	JWTKeyRotationInterval time.Duration `default:"0" envconfig:"JWT_KEY_ROTATION_INTERVAL"`
+	// JWTKeyRotationGracePeriod period of time the tokens signed with a rotated key remain valid for.
+	// It should not be shorter than SessionTokenExpiry, so that the rotation does not end the sessions.
+	JWTKeyRotationGracePeriod time.Duration `default:"48h" envconfig:"JWT_KEY_ROTATION_GRACE_PERIOD"`
 	// VersionServiceURL contains the URL of the version service.
 	VersionServiceURL string `default:"https://check.percona.com" envconfig:"VERSION_SERVICE_URL"`
+	// VersionServiceBundle contains the path to an offline version service metadata bundle,
+	// or a reference to a ConfigMap that holds it in the form configmap://<namespace>/<name>.
+	// If it is set, it is used instead of VersionServiceURL.
+	VersionServiceBundle string `envconfig:"VERSION_SERVICE_BUNDLE"`
+	// VersionServicePublicKeyPath contains the path to the PEM encoded ed25519 public key
+	// used to verify the signature of VersionServiceBundle. It is required if VersionServiceBundle is set.
+	VersionServicePublicKeyPath string `envconfig:"VERSION_SERVICE_PUBLIC_KEY_PATH"`
 	// TLSCertsPath contains the path to the directory with the TLS certificates.
 	// Setting this will enable HTTPS on ListenPort.
 	TLSCertsPath string `envconfig:"TLS_CERTS_PATH"`
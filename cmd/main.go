@@ -70,14 +70,21 @@ func main() {
 		}
 	}()
 
+	go server.RunMaintenanceWindowsJob(tCtx)
+	go server.RunJWTKeysJob(tCtx)
+
 	if !c.DisableTelemetry {
 		// To prevent leaking test data to prod,
 		// the prod TelemetryURL is set for the release builds during the build time.
 		// The dev TelemetryURL is set only when running `make run-debug`.
-		if c.TelemetryURL != "" {
+		switch {
+		case c.TelemetryFile != "":
+			l.Infof("Telemetry is running, the reports are written to %s", c.TelemetryFile)
+			go server.RunTelemetryJob(tCtx, c)
+		case c.TelemetryURL != "":
 			l.Info("Telemetry is running")
 			go server.RunTelemetryJob(tCtx, c)
-		} else {
+		default:
 			l.Info("Telemetry is not running, the TELEMETRY_URL is not set")
 		}
 	}
@@ -38,5 +38,12 @@ func init() {
 	accountsCmd.AddCommand(accounts.GetDeleteCmd())
 	accountsCmd.AddCommand(accounts.GetSetPasswordCmd())
 	accountsCmd.AddCommand(accounts.GetResetJWTKeysCmd())
+	accountsCmd.AddCommand(accounts.GetRotateJWTKeysCmd())
 	accountsCmd.AddCommand(accounts.GetInitAdminPasswordCmd())
+	accountsCmd.AddCommand(accounts.GetSetCapabilitiesCmd())
+	accountsCmd.AddCommand(accounts.GetUnlockCmd())
+	accountsCmd.AddCommand(accounts.GetPasswordPolicyCmd())
+	accountsCmd.AddCommand(accounts.GetMFACmd())
+	accountsCmd.AddCommand(accounts.GetSessionsCmd())
+	accountsCmd.AddCommand(accounts.GetAPIKeysCmd())
 }
@@ -0,0 +1,125 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package accounts
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	accountscli "github.com/percona/everest/pkg/accounts/cli"
+	"github.com/percona/everest/pkg/cli"
+	"github.com/percona/everest/pkg/logger"
+	"github.com/percona/everest/pkg/output"
+)
+
+var (
+	accountsAPIKeysCmd = &cobra.Command{
+		Use:   "api-keys <command> [flags]",
+		Args:  cobra.ExactArgs(1),
+		Long:  "Manage long-lived API keys of Everest user accounts for programmatic access",
+		Short: "Manage API keys of Everest user accounts",
+		Run:   func(_ *cobra.Command, _ []string) {},
+	}
+	accountsAPIKeysCreateCmd = &cobra.Command{
+		Use:     "create [flags]",
+		Args:    cobra.NoArgs,
+		Example: "everestctl accounts api-keys create --username ci --name pipeline --expires-in 2160h",
+		Long: "Create a new API key for an Everest user account with the apiKey capability and print its token.\n" +
+			"The key never expires unless --expires-in is provided.",
+		Short:  "Create a new API key",
+		PreRun: accountsAPIKeysPreRun,
+		Run:    accountsAPIKeysCreateRun,
+	}
+	accountsAPIKeysListCmd = &cobra.Command{
+		Use:     "list [flags]",
+		Args:    cobra.NoArgs,
+		Example: "everestctl accounts api-keys list --username ci",
+		Long:    "List the API keys of an Everest user account",
+		Short:   "List API keys",
+		PreRun:  accountsAPIKeysPreRun,
+		Run:     accountsAPIKeysListRun,
+	}
+	accountsAPIKeysRevokeCmd = &cobra.Command{
+		Use:     "revoke [flags]",
+		Args:    cobra.NoArgs,
+		Example: "everestctl accounts api-keys revoke --username ci --name pipeline",
+		Long:    "Revoke an API key of an Everest user account",
+		Short:   "Revoke an API key",
+		PreRun:  accountsAPIKeysPreRun,
+		Run:     accountsAPIKeysRevokeRun,
+	}
+	accountsAPIKeysCfg  = &accountscli.Config{}
+	accountsAPIKeysOpts = &accountscli.APIKeyOptions{}
+)
+
+func init() {
+	for _, cmd := range []*cobra.Command{accountsAPIKeysCreateCmd, accountsAPIKeysListCmd, accountsAPIKeysRevokeCmd} {
+		cmd.Flags().StringVarP(&accountsAPIKeysOpts.Username, cli.FlagAccountsUsername, "u", "", "Username of the account")
+		_ = cmd.MarkFlagRequired(cli.FlagAccountsUsername)
+		accountsAPIKeysCmd.AddCommand(cmd)
+	}
+	for _, cmd := range []*cobra.Command{accountsAPIKeysCreateCmd, accountsAPIKeysRevokeCmd} {
+		cmd.Flags().StringVar(&accountsAPIKeysOpts.Name, cli.FlagAccountsAPIKeyName, "", "Name of the API key")
+		_ = cmd.MarkFlagRequired(cli.FlagAccountsAPIKeyName)
+	}
+	accountsAPIKeysCreateCmd.Flags().DurationVar(&accountsAPIKeysOpts.ExpiresIn, cli.FlagAccountsAPIKeyExpiresIn, 0,
+		"Validity period of the API key, e.g. 720h")
+}
+
+func accountsAPIKeysPreRun(cmd *cobra.Command, _ []string) { //nolint:revive
+	// Copy global flags to config
+	accountsAPIKeysCfg.Pretty = !(cmd.Flag(cli.FlagVerbose).Changed || cmd.Flag(cli.FlagJSON).Changed)
+	accountsAPIKeysCfg.KubeconfigPath = cmd.Flag(cli.FlagKubeconfig).Value.String()
+}
+
+func accountsAPIKeysCreateRun(cmd *cobra.Command, _ []string) { //nolint:revive
+	cliA := newAPIKeysAccounts()
+	if err := cliA.CreateAPIKey(cmd.Context(), *accountsAPIKeysOpts); err != nil {
+		output.PrintError(err, logger.GetLogger(), accountsAPIKeysCfg.Pretty)
+		os.Exit(1)
+	}
+}
+
+func accountsAPIKeysListRun(cmd *cobra.Command, _ []string) { //nolint:revive
+	cliA := newAPIKeysAccounts()
+	if err := cliA.ListAPIKeys(cmd.Context(), accountsAPIKeysOpts.Username); err != nil {
+		output.PrintError(err, logger.GetLogger(), accountsAPIKeysCfg.Pretty)
+		os.Exit(1)
+	}
+}
+
+func accountsAPIKeysRevokeRun(cmd *cobra.Command, _ []string) { //nolint:revive
+	cliA := newAPIKeysAccounts()
+	if err := cliA.RevokeAPIKey(cmd.Context(), *accountsAPIKeysOpts); err != nil {
+		output.PrintError(err, logger.GetLogger(), accountsAPIKeysCfg.Pretty)
+		os.Exit(1)
+	}
+}
+
+func newAPIKeysAccounts() *accountscli.Accounts {
+	cliA, err := accountscli.NewAccounts(*accountsAPIKeysCfg, logger.GetLogger())
+	if err != nil {
+		output.PrintError(err, logger.GetLogger(), accountsAPIKeysCfg.Pretty)
+		os.Exit(1)
+	}
+	return cliA
+}
+
+// GetAPIKeysCmd returns the command to manage API keys of accounts.
+func GetAPIKeysCmd() *cobra.Command {
+	return accountsAPIKeysCmd
+}
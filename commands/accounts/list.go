@@ -46,8 +46,9 @@ func init() {
 	// local command flags
 	accountsListCmd.Flags().BoolVar(&accountsListOpts.NoHeaders, "no-headers", false, "If set, hide table headers")
 	accountsListCmd.Flags().StringSliceVar(&accountsListOpts.Columns, "columns", nil,
-		fmt.Sprintf("Comma-separated list of column names to display. Supported columns: %s, %s, %s.",
-			accountscli.ColumnUser, accountscli.ColumnCapabilities, accountscli.ColumnEnabled,
+		fmt.Sprintf("Comma-separated list of column names to display. Supported columns: %s, %s, %s, %s, %s.",
+			accountscli.ColumnUser, accountscli.ColumnCapabilities, accountscli.ColumnEnabled, accountscli.ColumnLocked,
+			accountscli.ColumnMFA,
 		),
 	)
 }
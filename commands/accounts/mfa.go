@@ -0,0 +1,103 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package accounts
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	accountscli "github.com/percona/everest/pkg/accounts/cli"
+	"github.com/percona/everest/pkg/cli"
+	"github.com/percona/everest/pkg/logger"
+	"github.com/percona/everest/pkg/output"
+)
+
+var (
+	accountsMFACmd = &cobra.Command{
+		Use:   "mfa <command> [flags]",
+		Args:  cobra.ExactArgs(1),
+		Long:  "Manage TOTP multi-factor authentication of Everest user accounts",
+		Short: "Manage multi-factor authentication of Everest user accounts",
+		Run:   func(_ *cobra.Command, _ []string) {},
+	}
+	accountsMFAEnrollCmd = &cobra.Command{
+		Use:     "enroll [flags]",
+		Args:    cobra.NoArgs,
+		Example: "everestctl accounts mfa enroll --username user1",
+		Long: "Enroll an Everest user account in TOTP multi-factor authentication.\n" +
+			"The TOTP secret and the single-use recovery codes are printed once and cannot be retrieved later.",
+		Short:  "Enroll an account in multi-factor authentication",
+		PreRun: accountsMFAPreRun,
+		Run:    accountsMFAEnrollRun,
+	}
+	accountsMFAResetCmd = &cobra.Command{
+		Use:     "reset [flags]",
+		Args:    cobra.NoArgs,
+		Example: "everestctl accounts mfa reset --username user1",
+		Long:    "Remove the multi-factor authentication of an Everest user account, so that it can be enrolled again",
+		Short:   "Reset multi-factor authentication of an account",
+		PreRun:  accountsMFAPreRun,
+		Run:     accountsMFAResetRun,
+	}
+	accountsMFACfg      = &accountscli.Config{}
+	accountsMFAUsername string
+)
+
+func init() {
+	for _, cmd := range []*cobra.Command{accountsMFAEnrollCmd, accountsMFAResetCmd} {
+		cmd.Flags().StringVarP(&accountsMFAUsername, cli.FlagAccountsUsername, "u", "", "Username of the account")
+		_ = cmd.MarkFlagRequired(cli.FlagAccountsUsername)
+		accountsMFACmd.AddCommand(cmd)
+	}
+}
+
+func accountsMFAPreRun(cmd *cobra.Command, _ []string) { //nolint:revive
+	// Copy global flags to config
+	accountsMFACfg.Pretty = !(cmd.Flag(cli.FlagVerbose).Changed || cmd.Flag(cli.FlagJSON).Changed)
+	accountsMFACfg.KubeconfigPath = cmd.Flag(cli.FlagKubeconfig).Value.String()
+}
+
+func accountsMFAEnrollRun(cmd *cobra.Command, _ []string) { //nolint:revive
+	cliA, err := accountscli.NewAccounts(*accountsMFACfg, logger.GetLogger())
+	if err != nil {
+		output.PrintError(err, logger.GetLogger(), accountsMFACfg.Pretty)
+		os.Exit(1)
+	}
+
+	if err := cliA.EnrollMFA(cmd.Context(), accountsMFAUsername); err != nil {
+		output.PrintError(err, logger.GetLogger(), accountsMFACfg.Pretty)
+		os.Exit(1)
+	}
+}
+
+func accountsMFAResetRun(cmd *cobra.Command, _ []string) { //nolint:revive
+	cliA, err := accountscli.NewAccounts(*accountsMFACfg, logger.GetLogger())
+	if err != nil {
+		output.PrintError(err, logger.GetLogger(), accountsMFACfg.Pretty)
+		os.Exit(1)
+	}
+
+	if err := cliA.ResetMFA(cmd.Context(), accountsMFAUsername); err != nil {
+		output.PrintError(err, logger.GetLogger(), accountsMFACfg.Pretty)
+		os.Exit(1)
+	}
+}
+
+// GetMFACmd returns the command to manage multi-factor authentication of accounts.
+func GetMFACmd() *cobra.Command {
+	return accountsMFACmd
+}
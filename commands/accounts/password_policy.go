@@ -0,0 +1,145 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package accounts
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/percona/everest/pkg/accounts"
+	accountscli "github.com/percona/everest/pkg/accounts/cli"
+	"github.com/percona/everest/pkg/cli"
+	"github.com/percona/everest/pkg/logger"
+	"github.com/percona/everest/pkg/output"
+)
+
+var (
+	accountsPasswordPolicyCmd = &cobra.Command{
+		Use:   "password-policy <command> [flags]",
+		Args:  cobra.ExactArgs(1),
+		Long:  "Manage the password policy of Everest user accounts",
+		Short: "Manage the password policy of Everest user accounts",
+		Run:   func(_ *cobra.Command, _ []string) {},
+	}
+	accountsPasswordPolicyShowCmd = &cobra.Command{
+		Use:     "show [flags]",
+		Args:    cobra.NoArgs,
+		Example: "everestctl accounts password-policy show",
+		Long:    "Show the password policy of Everest user accounts",
+		Short:   "Show the password policy",
+		PreRun:  accountsPasswordPolicyPreRun,
+		Run:     accountsPasswordPolicyShowRun,
+	}
+	accountsPasswordPolicySetCmd = &cobra.Command{
+		Use:     "set [flags]",
+		Args:    cobra.NoArgs,
+		Example: "everestctl accounts password-policy set --min-length 12 --require-digit --reject-breached --history-size 5 --max-age-days 90",
+		Long: "Update the password policy of Everest user accounts.\n" +
+			"Only the provided settings are changed. The policy applies to the passwords set afterwards,\n" +
+			"except for the maximum age which is checked at login.",
+		Short:  "Update the password policy",
+		PreRun: accountsPasswordPolicyPreRun,
+		Run:    accountsPasswordPolicySetRun,
+	}
+	accountsPasswordPolicyCfg = &accountscli.Config{}
+	accountsPasswordPolicy    = accounts.PasswordPolicy{}
+)
+
+func init() {
+	flags := accountsPasswordPolicySetCmd.Flags()
+	flags.IntVar(&accountsPasswordPolicy.MinLength, cli.FlagPasswordPolicyMinLength, 0, "Minimum number of characters of a password")
+	flags.BoolVar(&accountsPasswordPolicy.RequireUppercase, cli.FlagPasswordPolicyRequireUppercase, false, "Require an uppercase letter")
+	flags.BoolVar(&accountsPasswordPolicy.RequireLowercase, cli.FlagPasswordPolicyRequireLowercase, false, "Require a lowercase letter")
+	flags.BoolVar(&accountsPasswordPolicy.RequireDigit, cli.FlagPasswordPolicyRequireDigit, false, "Require a digit")
+	flags.BoolVar(&accountsPasswordPolicy.RequireSpecial, cli.FlagPasswordPolicyRequireSpecial, false, "Require a special character")
+	flags.BoolVar(&accountsPasswordPolicy.RejectBreached, cli.FlagPasswordPolicyRejectBreached, false, "Reject commonly used passwords known from public breaches")
+	flags.IntVar(&accountsPasswordPolicy.HistorySize, cli.FlagPasswordPolicyHistorySize, 0, "Number of previous passwords that cannot be reused")
+	flags.IntVar(&accountsPasswordPolicy.MaxAgeDays, cli.FlagPasswordPolicyMaxAgeDays, 0,
+		"Number of days after which a password must be changed at login, 0 to never expire")
+
+	accountsPasswordPolicyCmd.AddCommand(accountsPasswordPolicyShowCmd)
+	accountsPasswordPolicyCmd.AddCommand(accountsPasswordPolicySetCmd)
+}
+
+func accountsPasswordPolicyPreRun(cmd *cobra.Command, _ []string) { //nolint:revive
+	// Copy global flags to config
+	accountsPasswordPolicyCfg.Pretty = !(cmd.Flag(cli.FlagVerbose).Changed || cmd.Flag(cli.FlagJSON).Changed)
+	accountsPasswordPolicyCfg.KubeconfigPath = cmd.Flag(cli.FlagKubeconfig).Value.String()
+}
+
+func accountsPasswordPolicyShowRun(cmd *cobra.Command, _ []string) { //nolint:revive
+	cliA, err := accountscli.NewAccounts(*accountsPasswordPolicyCfg, logger.GetLogger())
+	if err != nil {
+		output.PrintError(err, logger.GetLogger(), accountsPasswordPolicyCfg.Pretty)
+		os.Exit(1)
+	}
+
+	if err := cliA.PrintPasswordPolicy(cmd.Context()); err != nil {
+		output.PrintError(err, logger.GetLogger(), accountsPasswordPolicyCfg.Pretty)
+		os.Exit(1)
+	}
+}
+
+func accountsPasswordPolicySetRun(cmd *cobra.Command, _ []string) { //nolint:revive
+	cliA, err := accountscli.NewAccounts(*accountsPasswordPolicyCfg, logger.GetLogger())
+	if err != nil {
+		output.PrintError(err, logger.GetLogger(), accountsPasswordPolicyCfg.Pretty)
+		os.Exit(1)
+	}
+
+	policy, err := cliA.GetPasswordPolicy(cmd.Context())
+	if err != nil {
+		output.PrintError(err, logger.GetLogger(), accountsPasswordPolicyCfg.Pretty)
+		os.Exit(1)
+	}
+	// Only override the settings that were provided.
+	flags := cmd.Flags()
+	if flags.Changed(cli.FlagPasswordPolicyMinLength) {
+		policy.MinLength = accountsPasswordPolicy.MinLength
+	}
+	if flags.Changed(cli.FlagPasswordPolicyRequireUppercase) {
+		policy.RequireUppercase = accountsPasswordPolicy.RequireUppercase
+	}
+	if flags.Changed(cli.FlagPasswordPolicyRequireLowercase) {
+		policy.RequireLowercase = accountsPasswordPolicy.RequireLowercase
+	}
+	if flags.Changed(cli.FlagPasswordPolicyRequireDigit) {
+		policy.RequireDigit = accountsPasswordPolicy.RequireDigit
+	}
+	if flags.Changed(cli.FlagPasswordPolicyRequireSpecial) {
+		policy.RequireSpecial = accountsPasswordPolicy.RequireSpecial
+	}
+	if flags.Changed(cli.FlagPasswordPolicyRejectBreached) {
+		policy.RejectBreached = accountsPasswordPolicy.RejectBreached
+	}
+	if flags.Changed(cli.FlagPasswordPolicyHistorySize) {
+		policy.HistorySize = accountsPasswordPolicy.HistorySize
+	}
+	if flags.Changed(cli.FlagPasswordPolicyMaxAgeDays) {
+		policy.MaxAgeDays = accountsPasswordPolicy.MaxAgeDays
+	}
+
+	if err := cliA.SetPasswordPolicy(cmd.Context(), *policy); err != nil {
+		output.PrintError(err, logger.GetLogger(), accountsPasswordPolicyCfg.Pretty)
+		os.Exit(1)
+	}
+}
+
+// GetPasswordPolicyCmd returns the command to manage the password policy of accounts.
+func GetPasswordPolicyCmd() *cobra.Command {
+	return accountsPasswordPolicyCmd
+}
@@ -32,10 +32,11 @@ var (
 		Use:     "reset-jwt-keys [flags]",
 		Args:    cobra.NoArgs,
 		Example: "everestctl accounts reset-jwt-keys",
-		Long:    "Reset the JWT keys used for Everest user authentication",
-		Short:   "Reset the JWT keys used for Everest user authentication",
-		PreRun:  accountsResetJWTKeysPreRun,
-		Run:     accountsResetJWTKeysRun,
+		Long: "Reset the JWT keys used for Everest user authentication. " +
+			"All the issued tokens become invalid immediately, use rotate-jwt-keys to keep them valid for a grace period",
+		Short:  "Reset the JWT keys used for Everest user authentication",
+		PreRun: accountsResetJWTKeysPreRun,
+		Run:    accountsResetJWTKeysRun,
 	}
 	accountsResetJWTKeysCfg = &accountscli.Config{}
 )
@@ -0,0 +1,76 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package accounts
+
+import (
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	accountscli "github.com/percona/everest/pkg/accounts/cli"
+	"github.com/percona/everest/pkg/cli"
+	"github.com/percona/everest/pkg/logger"
+	"github.com/percona/everest/pkg/output"
+)
+
+var (
+	accountsRotateJWTKeysCmd = &cobra.Command{
+		Use:     "rotate-jwt-keys [flags]",
+		Args:    cobra.NoArgs,
+		Example: "everestctl accounts rotate-jwt-keys --grace-period 48h",
+		Short:   "Rotate the JWT keys used for Everest user authentication",
+		Long: "Rotate the JWT keys used for Everest user authentication. " +
+			"New tokens are signed with a new key, while the tokens signed with the previous keys " +
+			"remain valid for the grace period. The API keys remain valid until they expire or are revoked. " +
+			"Unlike reset-jwt-keys, the existing sessions are kept " +
+			"and Everest is not restarted",
+		PreRun: accountsRotateJWTKeysPreRun,
+		Run:    accountsRotateJWTKeysRun,
+	}
+	accountsRotateJWTKeysCfg         = &accountscli.Config{}
+	accountsRotateJWTKeysGracePeriod time.Duration
+)
+
+func init() {
+	// local command flags
+	accountsRotateJWTKeysCmd.Flags().DurationVar(&accountsRotateJWTKeysGracePeriod, cli.FlagAccountsGracePeriod, 48*time.Hour, //nolint:mnd
+		"Period of time the tokens signed with the previous keys remain valid for")
+}
+
+func accountsRotateJWTKeysPreRun(cmd *cobra.Command, _ []string) { //nolint:revive
+	// Copy global flags to config
+	accountsRotateJWTKeysCfg.Pretty = !(cmd.Flag(cli.FlagVerbose).Changed || cmd.Flag(cli.FlagJSON).Changed)
+	accountsRotateJWTKeysCfg.KubeconfigPath = cmd.Flag(cli.FlagKubeconfig).Value.String()
+}
+
+func accountsRotateJWTKeysRun(cmd *cobra.Command, _ []string) { //nolint:revive
+	cliA, err := accountscli.NewAccounts(*accountsRotateJWTKeysCfg, logger.GetLogger())
+	if err != nil {
+		output.PrintError(err, logger.GetLogger(), accountsRotateJWTKeysCfg.Pretty)
+		os.Exit(1)
+	}
+
+	if err := cliA.RotateJWTKeys(cmd.Context(), accountsRotateJWTKeysGracePeriod); err != nil {
+		output.PrintError(err, logger.GetLogger(), accountsRotateJWTKeysCfg.Pretty)
+		os.Exit(1)
+	}
+}
+
+// GetRotateJWTKeysCmd returns the command to rotate the JWT keys used for Everest user authentication.
+func GetRotateJWTKeysCmd() *cobra.Command {
+	return accountsRotateJWTKeysCmd
+}
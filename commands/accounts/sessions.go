@@ -0,0 +1,106 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package accounts
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	accountscli "github.com/percona/everest/pkg/accounts/cli"
+	"github.com/percona/everest/pkg/cli"
+	"github.com/percona/everest/pkg/logger"
+	"github.com/percona/everest/pkg/output"
+)
+
+var (
+	accountsSessionsCmd = &cobra.Command{
+		Use:   "sessions <command> [flags]",
+		Args:  cobra.ExactArgs(1),
+		Long:  "Manage login sessions of Everest user accounts",
+		Short: "Manage login sessions of Everest user accounts",
+		Run:   func(_ *cobra.Command, _ []string) {},
+	}
+	accountsSessionsListCmd = &cobra.Command{
+		Use:     "list [flags]",
+		Args:    cobra.NoArgs,
+		Example: "everestctl accounts sessions list --username user1",
+		Long:    "List the active login sessions of an Everest user account",
+		Short:   "List login sessions",
+		PreRun:  accountsSessionsPreRun,
+		Run:     accountsSessionsListRun,
+	}
+	accountsSessionsRevokeCmd = &cobra.Command{
+		Use:     "revoke [flags]",
+		Args:    cobra.NoArgs,
+		Example: "everestctl accounts sessions revoke --username user1 --id 0a1b2c3d-...\neverestctl accounts sessions revoke --username user1 --all",
+		Long:    "Revoke a login session, or all the login sessions, of an Everest user account",
+		Short:   "Revoke login sessions",
+		PreRun:  accountsSessionsPreRun,
+		Run:     accountsSessionsRevokeRun,
+	}
+	accountsSessionsCfg  = &accountscli.Config{}
+	accountsSessionsOpts = &accountscli.RevokeSessionsOptions{}
+	accountsSessionsAll  bool
+)
+
+func init() {
+	for _, cmd := range []*cobra.Command{accountsSessionsListCmd, accountsSessionsRevokeCmd} {
+		cmd.Flags().StringVarP(&accountsSessionsOpts.Username, cli.FlagAccountsUsername, "u", "", "Username of the account")
+		_ = cmd.MarkFlagRequired(cli.FlagAccountsUsername)
+		accountsSessionsCmd.AddCommand(cmd)
+	}
+	accountsSessionsRevokeCmd.Flags().StringVar(&accountsSessionsOpts.ID, cli.FlagAccountsSessionID, "", "ID of the session to revoke")
+	accountsSessionsRevokeCmd.Flags().BoolVar(&accountsSessionsAll, cli.FlagAccountsAllSessions, false, "Revoke all the sessions of the account")
+	accountsSessionsRevokeCmd.MarkFlagsMutuallyExclusive(cli.FlagAccountsSessionID, cli.FlagAccountsAllSessions)
+	accountsSessionsRevokeCmd.MarkFlagsOneRequired(cli.FlagAccountsSessionID, cli.FlagAccountsAllSessions)
+}
+
+func accountsSessionsPreRun(cmd *cobra.Command, _ []string) { //nolint:revive
+	// Copy global flags to config
+	accountsSessionsCfg.Pretty = !(cmd.Flag(cli.FlagVerbose).Changed || cmd.Flag(cli.FlagJSON).Changed)
+	accountsSessionsCfg.KubeconfigPath = cmd.Flag(cli.FlagKubeconfig).Value.String()
+}
+
+func accountsSessionsListRun(cmd *cobra.Command, _ []string) { //nolint:revive
+	cliA := newSessionsAccounts()
+	if err := cliA.ListSessions(cmd.Context(), accountsSessionsOpts.Username); err != nil {
+		output.PrintError(err, logger.GetLogger(), accountsSessionsCfg.Pretty)
+		os.Exit(1)
+	}
+}
+
+func accountsSessionsRevokeRun(cmd *cobra.Command, _ []string) { //nolint:revive
+	cliA := newSessionsAccounts()
+	if err := cliA.RevokeSessions(cmd.Context(), *accountsSessionsOpts); err != nil {
+		output.PrintError(err, logger.GetLogger(), accountsSessionsCfg.Pretty)
+		os.Exit(1)
+	}
+}
+
+func newSessionsAccounts() *accountscli.Accounts {
+	cliA, err := accountscli.NewAccounts(*accountsSessionsCfg, logger.GetLogger())
+	if err != nil {
+		output.PrintError(err, logger.GetLogger(), accountsSessionsCfg.Pretty)
+		os.Exit(1)
+	}
+	return cliA
+}
+
+// GetSessionsCmd returns the command to manage login sessions of accounts.
+func GetSessionsCmd() *cobra.Command {
+	return accountsSessionsCmd
+}
@@ -0,0 +1,74 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package accounts
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	accountscli "github.com/percona/everest/pkg/accounts/cli"
+	"github.com/percona/everest/pkg/cli"
+	"github.com/percona/everest/pkg/logger"
+	"github.com/percona/everest/pkg/output"
+)
+
+var (
+	accountsSetCapabilitiesCmd = &cobra.Command{
+		Use:     "set-capabilities [flags]",
+		Args:    cobra.NoArgs,
+		Example: "everestctl accounts set-capabilities --username user1 --capabilities login,apiKey",
+		Long:    "Set the capabilities of an existing Everest user account",
+		Short:   "Set the capabilities of an existing Everest user account",
+		PreRun:  accountsSetCapabilitiesPreRun,
+		Run:     accountsSetCapabilitiesRun,
+	}
+	accountsSetCapabilitiesCfg  = &accountscli.Config{}
+	accountsSetCapabilitiesOpts = &accountscli.SetCapabilitiesOptions{}
+)
+
+func init() {
+	// local command flags
+	accountsSetCapabilitiesCmd.Flags().StringVarP(&accountsSetCapabilitiesOpts.Username, cli.FlagAccountsUsername, "u", "", "Username of the account")
+	accountsSetCapabilitiesCmd.Flags().StringSliceVar(&accountsSetCapabilitiesOpts.Capabilities, cli.FlagAccountsCapabilities, nil,
+		"Comma-separated list of capabilities of the account (login, apiKey)")
+	_ = accountsSetCapabilitiesCmd.MarkFlagRequired(cli.FlagAccountsUsername)
+	_ = accountsSetCapabilitiesCmd.MarkFlagRequired(cli.FlagAccountsCapabilities)
+}
+
+func accountsSetCapabilitiesPreRun(cmd *cobra.Command, _ []string) { //nolint:revive
+	// Copy global flags to config
+	accountsSetCapabilitiesCfg.Pretty = !(cmd.Flag(cli.FlagVerbose).Changed || cmd.Flag(cli.FlagJSON).Changed)
+	accountsSetCapabilitiesCfg.KubeconfigPath = cmd.Flag(cli.FlagKubeconfig).Value.String()
+}
+
+func accountsSetCapabilitiesRun(cmd *cobra.Command, _ []string) { //nolint:revive
+	cliA, err := accountscli.NewAccounts(*accountsSetCapabilitiesCfg, logger.GetLogger())
+	if err != nil {
+		output.PrintError(err, logger.GetLogger(), accountsSetCapabilitiesCfg.Pretty)
+		os.Exit(1)
+	}
+
+	if err := cliA.SetCapabilities(cmd.Context(), *accountsSetCapabilitiesOpts); err != nil {
+		output.PrintError(err, logger.GetLogger(), accountsSetCapabilitiesCfg.Pretty)
+		os.Exit(1)
+	}
+}
+
+// GetSetCapabilitiesCmd returns the command to set the capabilities of an account.
+func GetSetCapabilitiesCmd() *cobra.Command {
+	return accountsSetCapabilitiesCmd
+}
@@ -0,0 +1,87 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package accounts
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	accountscli "github.com/percona/everest/pkg/accounts/cli"
+	"github.com/percona/everest/pkg/cli"
+	"github.com/percona/everest/pkg/logger"
+	"github.com/percona/everest/pkg/output"
+)
+
+var (
+	accountsUnlockCmd = &cobra.Command{
+		Use:     "unlock [flags]",
+		Args:    cobra.NoArgs,
+		Example: "everestctl accounts unlock --username user1",
+		Short:   "Unlock an Everest user account locked after too many failed logins",
+		Long:    "Unlock an Everest user account locked after too many failed logins and reset its failed logins",
+		PreRun:  accountsUnlockPreRun,
+		Run:     accountsUnlockRun,
+	}
+	accountsUnlockCfg      = &accountscli.Config{}
+	accountsUnlockUsername string
+)
+
+func init() {
+	// local command flags
+	accountsUnlockCmd.Flags().StringVarP(&accountsUnlockUsername, cli.FlagAccountsUsername, "u", "", "Username of the account")
+}
+
+func accountsUnlockPreRun(cmd *cobra.Command, _ []string) { //nolint:revive
+	// Copy global flags to config
+	accountsUnlockCfg.Pretty = !(cmd.Flag(cli.FlagVerbose).Changed || cmd.Flag(cli.FlagJSON).Changed)
+	accountsUnlockCfg.KubeconfigPath = cmd.Flag(cli.FlagKubeconfig).Value.String()
+
+	// Check username
+	if accountsUnlockUsername != "" {
+		// Validate provided username to be unlocked.
+		if err := accountscli.ValidateUsername(accountsUnlockUsername); err != nil {
+			output.PrintError(err, logger.GetLogger(), accountsUnlockCfg.Pretty)
+			os.Exit(1)
+		}
+	} else {
+		// Ask user in interactive mode to provide username to unlock.
+		if username, err := accountscli.PopulateUsername(cmd.Context()); err != nil {
+			output.PrintError(err, logger.GetLogger(), accountsUnlockCfg.Pretty)
+			os.Exit(1)
+		} else {
+			accountsUnlockUsername = username
+		}
+	}
+}
+
+func accountsUnlockRun(cmd *cobra.Command, _ []string) { //nolint:revive
+	cliA, err := accountscli.NewAccounts(*accountsUnlockCfg, logger.GetLogger())
+	if err != nil {
+		output.PrintError(err, logger.GetLogger(), accountsUnlockCfg.Pretty)
+		os.Exit(1)
+	}
+
+	if err := cliA.Unlock(cmd.Context(), accountsUnlockUsername); err != nil {
+		output.PrintError(err, logger.GetLogger(), accountsUnlockCfg.Pretty)
+		os.Exit(1)
+	}
+}
+
+// GetUnlockCmd returns the command to unlock an account.
+func GetUnlockCmd() *cobra.Command {
+	return accountsUnlockCmd
+}
@@ -0,0 +1,70 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importjobs
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/percona/everest/pkg/cli"
+	"github.com/percona/everest/pkg/cli/dataimport"
+	"github.com/percona/everest/pkg/logger"
+	"github.com/percona/everest/pkg/output"
+)
+
+var (
+	importJobsCancelCmd = &cobra.Command{
+		Use:     "cancel <name> [flags]",
+		Args:    cobra.ExactArgs(1),
+		Example: "everestctl import-jobs cancel import-1 --namespace ns-1",
+		Short:   "Cancel an in-progress data import job",
+		Long:    "Cancel an in-progress data import job. The data that has already been imported is not rolled back",
+		PreRun:  importJobsCancelPreRun,
+		Run:     importJobsCancelRun,
+	}
+	importJobsCancelCfg       = &dataimport.Config{}
+	importJobsCancelNamespace string
+)
+
+func init() {
+	// local command flags
+	importJobsCancelCmd.Flags().StringVarP(&importJobsCancelNamespace, cli.FlagImportJobNamespace, "n", "", "Namespace of the data import job")
+}
+
+func importJobsCancelPreRun(cmd *cobra.Command, _ []string) { //nolint:revive
+	// Copy global flags to config
+	importJobsCancelCfg.Pretty = !(cmd.Flag(cli.FlagVerbose).Changed || cmd.Flag(cli.FlagJSON).Changed)
+	importJobsCancelCfg.KubeconfigPath = cmd.Flag(cli.FlagKubeconfig).Value.String()
+}
+
+func importJobsCancelRun(cmd *cobra.Command, args []string) { //nolint:revive
+	cliD, err := dataimport.NewDataImport(*importJobsCancelCfg, logger.GetLogger())
+	if err != nil {
+		output.PrintError(err, logger.GetLogger(), importJobsCancelCfg.Pretty)
+		os.Exit(1)
+	}
+
+	if err := cliD.Cancel(cmd.Context(), importJobsCancelNamespace, args[0]); err != nil {
+		output.PrintError(err, logger.GetLogger(), importJobsCancelCfg.Pretty)
+		os.Exit(1)
+	}
+}
+
+// GetCancelCmd returns the command to cancel a data import job.
+func GetCancelCmd() *cobra.Command {
+	return importJobsCancelCmd
+}
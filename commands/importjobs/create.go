@@ -0,0 +1,87 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package importjobs holds commands for import-jobs command.
+package importjobs
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/percona/everest/pkg/cli"
+	"github.com/percona/everest/pkg/cli/dataimport"
+	"github.com/percona/everest/pkg/logger"
+	"github.com/percona/everest/pkg/output"
+)
+
+var (
+	importJobsCreateCmd = &cobra.Command{
+		Use:   "create <name> [flags]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Import data into an existing database cluster",
+		Long:  "Import data into an existing database cluster",
+		Example: "everestctl import-jobs create import-1 --namespace ns-1 --db-name mysql-1 --importer everest-percona-pxc-operator " +
+			"--path /backups/dump --bucket my-bucket --region us-east-1 --endpoint-url https://s3.us-east-1.amazonaws.com " +
+			"--credentials-secret my-s3-creds",
+		PreRun: importJobsCreatePreRun,
+		Run:    importJobsCreateRun,
+	}
+	importJobsCreateCfg  = &dataimport.Config{}
+	importJobsCreateOpts = dataimport.CreateOptions{}
+)
+
+func init() {
+	// local command flags
+	importJobsCreateCmd.Flags().StringVarP(&importJobsCreateOpts.Namespace, cli.FlagImportJobNamespace, "n", "", "Namespace of the target database cluster")
+	importJobsCreateCmd.Flags().StringVar(&importJobsCreateOpts.TargetClusterName, cli.FlagImportJobDBName, "", "Name of the database cluster to import data into")
+	importJobsCreateCmd.Flags().StringVar(&importJobsCreateOpts.DataImporterName, cli.FlagImportJobImporter, "", "Name of the data importer to use")
+	importJobsCreateCmd.Flags().StringVar(&importJobsCreateOpts.Path, cli.FlagImportJobPath, "", "Path to the data in the S3 bucket")
+	importJobsCreateCmd.Flags().StringVar(&importJobsCreateOpts.Bucket, cli.FlagImportJobBucket, "", "Name of the S3 bucket")
+	importJobsCreateCmd.Flags().StringVar(&importJobsCreateOpts.Region, cli.FlagImportJobRegion, "", "Region of the S3 bucket")
+	importJobsCreateCmd.Flags().StringVar(&importJobsCreateOpts.EndpointURL, cli.FlagImportJobEndpointURL, "", "Endpoint URL of the S3 storage")
+	importJobsCreateCmd.Flags().BoolVar(&importJobsCreateOpts.VerifyTLS, cli.FlagImportJobVerifyTLS, true, "If set, verifies the TLS certificate of the S3 storage")
+	importJobsCreateCmd.Flags().BoolVar(&importJobsCreateOpts.ForcePathStyle, cli.FlagImportJobForcePathStyle, false, "If set, uses path-style URLs to access the S3 storage")
+	importJobsCreateCmd.Flags().StringVar(&importJobsCreateOpts.CredentialsSecretName, cli.FlagImportJobCredentialsSecret, "", "Name of the secret containing the S3 credentials")
+	importJobsCreateCmd.Flags().StringVar(&importJobsCreateOpts.AccessKeyID, cli.FlagImportJobAccessKeyID, "", "S3 access key ID. If set together with --secret-access-key, the credentials secret is created automatically")
+	importJobsCreateCmd.Flags().StringVar(&importJobsCreateOpts.SecretAccessKey, cli.FlagImportJobSecretAccessKey, "", "S3 secret access key")
+	importJobsCreateCmd.Flags().StringVar(&importJobsCreateOpts.Config, cli.FlagImportJobConfig, "", "Data importer specific configuration in JSON format")
+}
+
+func importJobsCreatePreRun(cmd *cobra.Command, args []string) { //nolint:revive
+	// Copy global flags to config
+	importJobsCreateCfg.Pretty = !(cmd.Flag(cli.FlagVerbose).Changed || cmd.Flag(cli.FlagJSON).Changed)
+	importJobsCreateCfg.KubeconfigPath = cmd.Flag(cli.FlagKubeconfig).Value.String()
+
+	importJobsCreateOpts.Name = args[0]
+}
+
+func importJobsCreateRun(cmd *cobra.Command, _ []string) { //nolint:revive
+	cliD, err := dataimport.NewDataImport(*importJobsCreateCfg, logger.GetLogger())
+	if err != nil {
+		output.PrintError(err, logger.GetLogger(), importJobsCreateCfg.Pretty)
+		os.Exit(1)
+	}
+
+	if _, err := cliD.Create(cmd.Context(), importJobsCreateOpts); err != nil {
+		output.PrintError(err, logger.GetLogger(), importJobsCreateCfg.Pretty)
+		os.Exit(1)
+	}
+}
+
+// GetCreateCmd returns the command to create a data import job.
+func GetCreateCmd() *cobra.Command {
+	return importJobsCreateCmd
+}
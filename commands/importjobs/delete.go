@@ -0,0 +1,70 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importjobs
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/percona/everest/pkg/cli"
+	"github.com/percona/everest/pkg/cli/dataimport"
+	"github.com/percona/everest/pkg/logger"
+	"github.com/percona/everest/pkg/output"
+)
+
+var (
+	importJobsDeleteCmd = &cobra.Command{
+		Use:     "delete <name> [flags]",
+		Args:    cobra.ExactArgs(1),
+		Example: "everestctl import-jobs delete import-1 --namespace ns-1",
+		Short:   "Delete a finished data import job",
+		Long:    "Delete a finished data import job. Use cancel to stop a job that is still in progress",
+		PreRun:  importJobsDeletePreRun,
+		Run:     importJobsDeleteRun,
+	}
+	importJobsDeleteCfg       = &dataimport.Config{}
+	importJobsDeleteNamespace string
+)
+
+func init() {
+	// local command flags
+	importJobsDeleteCmd.Flags().StringVarP(&importJobsDeleteNamespace, cli.FlagImportJobNamespace, "n", "", "Namespace of the data import job")
+}
+
+func importJobsDeletePreRun(cmd *cobra.Command, _ []string) { //nolint:revive
+	// Copy global flags to config
+	importJobsDeleteCfg.Pretty = !(cmd.Flag(cli.FlagVerbose).Changed || cmd.Flag(cli.FlagJSON).Changed)
+	importJobsDeleteCfg.KubeconfigPath = cmd.Flag(cli.FlagKubeconfig).Value.String()
+}
+
+func importJobsDeleteRun(cmd *cobra.Command, args []string) { //nolint:revive
+	cliD, err := dataimport.NewDataImport(*importJobsDeleteCfg, logger.GetLogger())
+	if err != nil {
+		output.PrintError(err, logger.GetLogger(), importJobsDeleteCfg.Pretty)
+		os.Exit(1)
+	}
+
+	if err := cliD.Delete(cmd.Context(), importJobsDeleteNamespace, args[0]); err != nil {
+		output.PrintError(err, logger.GetLogger(), importJobsDeleteCfg.Pretty)
+		os.Exit(1)
+	}
+}
+
+// GetDeleteCmd returns the command to delete a data import job.
+func GetDeleteCmd() *cobra.Command {
+	return importJobsDeleteCmd
+}
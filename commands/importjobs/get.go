@@ -0,0 +1,73 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importjobs
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/percona/everest/pkg/cli"
+	"github.com/percona/everest/pkg/cli/dataimport"
+	"github.com/percona/everest/pkg/logger"
+	"github.com/percona/everest/pkg/output"
+)
+
+var (
+	importJobsGetCmd = &cobra.Command{
+		Use:     "get <name> [flags]",
+		Args:    cobra.ExactArgs(1),
+		Example: "everestctl import-jobs get import-1 --namespace ns-1",
+		Short:   "Show the status of a data import job",
+		Long:    "Show the status of a data import job",
+		PreRun:  importJobsGetPreRun,
+		Run:     importJobsGetRun,
+	}
+	importJobsGetCfg       = &dataimport.Config{}
+	importJobsGetNamespace string
+)
+
+func init() {
+	// local command flags
+	importJobsGetCmd.Flags().StringVarP(&importJobsGetNamespace, cli.FlagImportJobNamespace, "n", "", "Namespace of the data import job")
+}
+
+func importJobsGetPreRun(cmd *cobra.Command, _ []string) { //nolint:revive
+	// Copy global flags to config
+	importJobsGetCfg.Pretty = !(cmd.Flag(cli.FlagVerbose).Changed || cmd.Flag(cli.FlagJSON).Changed)
+	importJobsGetCfg.KubeconfigPath = cmd.Flag(cli.FlagKubeconfig).Value.String()
+}
+
+func importJobsGetRun(cmd *cobra.Command, args []string) { //nolint:revive
+	cliD, err := dataimport.NewDataImport(*importJobsGetCfg, logger.GetLogger())
+	if err != nil {
+		output.PrintError(err, logger.GetLogger(), importJobsGetCfg.Pretty)
+		os.Exit(1)
+	}
+
+	job, err := cliD.Get(cmd.Context(), importJobsGetNamespace, args[0])
+	if err != nil {
+		output.PrintError(err, logger.GetLogger(), importJobsGetCfg.Pretty)
+		os.Exit(1)
+	}
+	printImportJobsTable([]everestv1alpha1.DataImportJob{*job})
+}
+
+// GetGetCmd returns the command to get a data import job.
+func GetGetCmd() *cobra.Command {
+	return importJobsGetCmd
+}
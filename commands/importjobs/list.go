@@ -0,0 +1,143 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importjobs
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/rodaine/table"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/percona/everest/pkg/cli"
+	"github.com/percona/everest/pkg/cli/dataimport"
+	"github.com/percona/everest/pkg/logger"
+	"github.com/percona/everest/pkg/output"
+)
+
+var (
+	importJobsListCmd = &cobra.Command{
+		Use:     "list [flags]",
+		Args:    cobra.NoArgs,
+		Example: "everestctl import-jobs list --namespace ns-1 --db-name mysql-1",
+		Short:   "List data import jobs of a database cluster",
+		Long:    "List data import jobs of a database cluster",
+		PreRun:  importJobsListPreRun,
+		Run:     importJobsListRun,
+	}
+	importJobsListCfg       = &dataimport.Config{}
+	importJobsListNamespace string
+	importJobsListDBName    string
+)
+
+func init() {
+	// local command flags
+	importJobsListCmd.Flags().StringVarP(&importJobsListNamespace, cli.FlagImportJobNamespace, "n", "", "Namespace of the database cluster")
+	importJobsListCmd.Flags().StringVar(&importJobsListDBName, cli.FlagImportJobDBName, "", "Name of the database cluster")
+}
+
+func importJobsListPreRun(cmd *cobra.Command, _ []string) { //nolint:revive
+	// Copy global flags to config
+	importJobsListCfg.Pretty = !(cmd.Flag(cli.FlagVerbose).Changed || cmd.Flag(cli.FlagJSON).Changed)
+	importJobsListCfg.KubeconfigPath = cmd.Flag(cli.FlagKubeconfig).Value.String()
+}
+
+func importJobsListRun(cmd *cobra.Command, _ []string) { //nolint:revive
+	cliD, err := dataimport.NewDataImport(*importJobsListCfg, logger.GetLogger())
+	if err != nil {
+		output.PrintError(err, logger.GetLogger(), importJobsListCfg.Pretty)
+		os.Exit(1)
+	}
+
+	list, err := cliD.List(cmd.Context(), importJobsListNamespace, importJobsListDBName)
+	if err != nil {
+		output.PrintError(err, logger.GetLogger(), importJobsListCfg.Pretty)
+		os.Exit(1)
+	}
+	printImportJobsTable(list.Items)
+}
+
+// GetListCmd returns the command to list data import jobs.
+func GetListCmd() *cobra.Command {
+	return importJobsListCmd
+}
+
+const (
+	columnName      = "name"
+	columnDatabase  = "database"
+	columnImporter  = "importer"
+	columnState     = "state"
+	columnStartedAt = "started"
+	columnEndedAt   = "completed"
+	columnMessage   = "message"
+)
+
+// Print data import jobs to console.
+func printImportJobsTable(jobs []everestv1alpha1.DataImportJob) {
+	// Prepare table headings.
+	headings := []interface{}{columnName, columnDatabase, columnImporter, columnState, columnStartedAt, columnEndedAt, columnMessage}
+	// Prepare table header.
+	tbl := table.New(headings...)
+	tbl.WithHeaderFormatter(func(format string, vals ...interface{}) string {
+		// Print all in caps.
+		return strings.ToUpper(fmt.Sprintf(format, vals...))
+	})
+
+	formatTime := func(t *metav1.Time) string {
+		if t.IsZero() {
+			return ""
+		}
+		return t.Format(time.RFC3339)
+	}
+
+	// Return a table row for the given job.
+	row := func(job everestv1alpha1.DataImportJob) []any {
+		var row []any
+		for _, heading := range headings {
+			switch heading {
+			case columnName:
+				row = append(row, job.GetName())
+			case columnDatabase:
+				row = append(row, job.Spec.TargetClusterName)
+			case columnImporter:
+				importer := ""
+				if job.Spec.DataImportJobTemplate != nil {
+					importer = job.Spec.DataImporterName
+				}
+				row = append(row, importer)
+			case columnState:
+				row = append(row, job.Status.State)
+			case columnStartedAt:
+				row = append(row, formatTime(job.Status.StartedAt))
+			case columnEndedAt:
+				row = append(row, formatTime(job.Status.CompletedAt))
+			case columnMessage:
+				row = append(row, job.Status.Message)
+			}
+		}
+		return row
+	}
+
+	for _, job := range jobs {
+		tbl.AddRow(row(job)...)
+	}
+
+	tbl.Print()
+}
@@ -59,6 +59,8 @@ func init() {
 	installCmd.Flags().StringVar(&namespacesToAdd, cli.FlagNamespaces, common.DefaultDBNamespaceName, "Comma-separated namespaces list Percona Everest can manage")
 	installCmd.Flags().BoolVar(&installCfg.NamespaceAddConfig.SkipWizard, cli.FlagSkipWizard, false, "Skip installation wizard")
 	installCmd.Flags().StringVar(&installCfg.VersionMetadataURL, cli.FlagVersionMetadataURL, "https://check.percona.com", "URL to retrieve version metadata information from")
+	installCmd.Flags().StringVar(&installCfg.VersionMetadataBundle, cli.FlagVersionMetadataBundle, "", "Path to an offline version metadata bundle, or configmap://<namespace>/<name>, to use instead of the version service")
+	installCmd.Flags().StringVar(&installCfg.VersionMetadataPublicKey, cli.FlagVersionMetadataPublicKey, "", "Path to the PEM encoded ed25519 public key to verify the signature of the version metadata bundle, required with --"+cli.FlagVersionMetadataBundle)
 	installCmd.Flags().StringVar(&installCfg.Version, cli.FlagVersion, "", "Everest version to install. By default the latest version is installed")
 	installCmd.Flags().BoolVar(&installCfg.DisableTelemetry, cli.FlagDisableTelemetry, false, "Disable telemetry")
 	_ = installCmd.Flags().MarkHidden(cli.FlagDisableTelemetry)
@@ -33,17 +33,24 @@ import (
 
 var (
 	namespacesUpdateCmd = &cobra.Command{
-		Use:   "update <namespaces> [flags] ",
-		Args:  cobra.ExactArgs(1),
-		Long:  "Add database operator to existing namespace managed by Everest",
+		Use:  "update <namespaces> [flags] ",
+		Args: cobra.ExactArgs(1),
+		Long: "Add database operator to existing namespace managed by Everest or update the engine versions allowed in it.\n" +
+			"Versions are shell patterns optionally prefixed with the engine type, e.g. pxc:8.0.3* or 8.0.*",
 		Short: "Add database operator to existing namespace managed by Everest",
-		Example: fmt.Sprintf("everestctl namespaces update ns-1,ns-2 --%s --%s=true --%s=false --%s=false",
+		Example: fmt.Sprintf("everestctl namespaces update ns-1,ns-2 --%s --%s=true --%s=false --%s=false\n"+
+			"everestctl namespaces update ns-1 --%s --%s=pxc:8.0.35,psmdb:7.0.1*",
 			cli.FlagSkipWizard, cli.FlagOperatorMySQL, cli.FlagOperatorPostgresql, cli.FlagOperatorMongoDB,
+			cli.FlagRecommendedVersionsOnly, cli.FlagDeniedVersions,
 		),
 		PreRun: namespacesUpdatePreRun,
 		Run:    namespacesUpdateRun,
 	}
 	namespacesUpdateCfg = namespaces.NewNamespaceAddConfig()
+
+	namespacesUpdateRecommendedOnly bool
+	namespacesUpdateAllowedVersions []string
+	namespacesUpdateDeniedVersions  []string
 )
 
 func init() {
@@ -68,6 +75,11 @@ func init() {
 	namespacesUpdateCmd.Flags().BoolVar(&namespacesUpdateCfg.Operators.PXC, cli.FlagOperatorXtraDBCluster, true, "Install XtraDB Cluster operator")
 	_ = namespacesUpdateCmd.Flags().MarkDeprecated(cli.FlagOperatorXtraDBCluster, fmt.Sprintf("please use --%s instead", cli.FlagOperatorMySQL))
 	namespacesUpdateCmd.Flags().BoolVar(&namespacesUpdateCfg.Operators.PXC, cli.FlagOperatorMySQL, true, "Install MySQL operator")
+
+	// engine version policy flags
+	namespacesUpdateCmd.Flags().BoolVar(&namespacesUpdateRecommendedOnly, cli.FlagRecommendedVersionsOnly, false, "Allow only the recommended engine versions")
+	namespacesUpdateCmd.Flags().StringSliceVar(&namespacesUpdateAllowedVersions, cli.FlagAllowedVersions, []string{}, "Allow only the engine versions matching the patterns (pass an empty value to allow all)")
+	namespacesUpdateCmd.Flags().StringSliceVar(&namespacesUpdateDeniedVersions, cli.FlagDeniedVersions, []string{}, "Deny the engine versions matching the patterns (pass an empty value to deny none)")
 }
 
 func namespacesUpdatePreRun(cmd *cobra.Command, args []string) { //nolint:revive
@@ -86,13 +98,33 @@ func namespacesUpdatePreRun(cmd *cobra.Command, args []string) { //nolint:revive
 		namespacesUpdateCfg.NamespaceList = nsList
 	}
 
+	{
+		// Parse and validate the engine version policy changes
+		if cmd.Flags().Lookup(cli.FlagRecommendedVersionsOnly).Changed {
+			namespacesUpdateCfg.EngineVersionPolicy.RecommendedOnly = &namespacesUpdateRecommendedOnly
+		}
+		if cmd.Flags().Lookup(cli.FlagAllowedVersions).Changed {
+			namespacesUpdateCfg.EngineVersionPolicy.Allowed = append([]string{}, namespacesUpdateAllowedVersions...)
+		}
+		if cmd.Flags().Lookup(cli.FlagDeniedVersions).Changed {
+			namespacesUpdateCfg.EngineVersionPolicy.Denied = append([]string{}, namespacesUpdateDeniedVersions...)
+		}
+		if err := namespacesUpdateCfg.EngineVersionPolicy.Validate(); err != nil {
+			output.PrintError(err, logger.GetLogger(), namespacesUpdateCfg.Pretty)
+			os.Exit(1)
+		}
+	}
+
 	// If user doesn't pass any --operator.* flags - need to ask explicitly.
 	askOperators := !(cmd.Flags().Lookup(cli.FlagOperatorMongoDB).Changed ||
 		cmd.Flags().Lookup(cli.FlagOperatorPostgresql).Changed ||
 		cmd.Flags().Lookup(cli.FlagOperatorXtraDBCluster).Changed ||
 		cmd.Flags().Lookup(cli.FlagOperatorMySQL).Changed)
 
-	if askOperators {
+	if askOperators && namespacesUpdateCfg.EngineVersionPolicy.IsSet() {
+		// Only the engine version policy is updated, keep the installed operators as they are.
+		namespacesUpdateCfg.SkipOperators = true
+	} else if askOperators {
 		// need to ask user to provide operators to be installed in interactive mode.
 		if err := namespacesUpdateCfg.PopulateOperators(cmd.Context()); err != nil {
 			output.PrintError(err, logger.GetLogger(), namespacesUpdateCfg.Pretty)
@@ -35,12 +35,14 @@ var (
 		Args:    cobra.NoArgs,
 		Long:    "Configure OIDC settings",
 		Short:   "Configure OIDC settings",
-		Example: `everestctl settings oidc configure --issuer-url https://example.com --client-id 123456 --scopes openid,profile,email,groups`,
+		Example: `everestctl settings oidc configure --issuer-url https://example.com --client-id 123456 --scopes openid,profile,email,groups --username-claim email --groups-claims groups,roles --groups-prefix oidc:`,
 		PreRun:  settingsOIDCConfigurePreRun,
 		Run:     settingsOIDCConfigureRun,
 	}
 	settingsOIDCConfigureCfg = &oidc.Config{}
 	scopes                   string
+	groupsClaims             string
+	audiences                string
 )
 
 func init() {
@@ -48,6 +50,12 @@ func init() {
 	settingsOIDCConfigureCmd.Flags().StringVar(&settingsOIDCConfigureCfg.IssuerURL, cli.FlagOIDCIssuerURL, "", "OIDC issuer url")
 	settingsOIDCConfigureCmd.Flags().StringVar(&settingsOIDCConfigureCfg.ClientID, cli.FlagOIDCClientID, "", "OIDC application client ID")
 	settingsOIDCConfigureCmd.Flags().StringVar(&scopes, cli.FlagOIDCScopes, strings.Join(common.DefaultOIDCScopes, ","), "Comma-separated list of scopes")
+	settingsOIDCConfigureCmd.Flags().StringVar(&settingsOIDCConfigureCfg.UsernameClaim, cli.FlagOIDCUsernameClaim, common.DefaultOIDCUsernameClaim, "Claim of the OIDC tokens that holds the username")
+	settingsOIDCConfigureCmd.Flags().StringVar(&settingsOIDCConfigureCfg.UsernamePrefix, cli.FlagOIDCUsernamePrefix, "", "Prefix prepended to the usernames, required if more than one OIDC provider is configured")
+	settingsOIDCConfigureCmd.Flags().StringVar(&groupsClaims, cli.FlagOIDCGroupsClaims, strings.Join(common.DefaultOIDCGroupsClaims, ","), "Comma-separated list of claims of the OIDC tokens that hold the groups")
+	settingsOIDCConfigureCmd.Flags().StringVar(&settingsOIDCConfigureCfg.GroupsPrefix, cli.FlagOIDCGroupsPrefix, "", "Prefix prepended to the names of the groups")
+	settingsOIDCConfigureCmd.Flags().StringVar(&settingsOIDCConfigureCfg.Name, cli.FlagOIDCProviderName, common.DefaultOIDCProviderName, "Name of the OIDC provider, another name adds a provider to the configured ones")
+	settingsOIDCConfigureCmd.Flags().StringVar(&audiences, cli.FlagOIDCAudiences, "", "Comma-separated list of accepted audiences of the OIDC tokens, the client ID if not set")
 }
 
 func settingsOIDCConfigurePreRun(cmd *cobra.Command, _ []string) { //nolint:revive
@@ -92,6 +100,13 @@ func settingsOIDCConfigurePreRun(cmd *cobra.Command, _ []string) { //nolint:revi
 		os.Exit(1)
 	}
 	settingsOIDCConfigureCfg.Scopes = scopesList
+
+	if groupsClaims != "" {
+		settingsOIDCConfigureCfg.GroupsClaims = strings.Split(groupsClaims, ",")
+	}
+	if audiences != "" {
+		settingsOIDCConfigureCfg.Audiences = strings.Split(audiences, ",")
+	}
 }
 
 func settingsOIDCConfigureRun(cmd *cobra.Command, _ []string) {
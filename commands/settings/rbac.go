@@ -32,6 +32,15 @@ var settingsRBACCmd = &cobra.Command{
 func init() {
 	settingsRBACCmd.AddCommand(rbac.GetSettingsRBACValidateCmd())
 	settingsRBACCmd.AddCommand(rbac.GetSettingsRBACCanCmd())
+	settingsRBACCmd.AddCommand(rbac.GetSettingsRBACRoleCmd())
+	settingsRBACCmd.AddCommand(rbac.GetSettingsRBACGrantCmd())
+	settingsRBACCmd.AddCommand(rbac.GetSettingsRBACRevokeCmd())
+	settingsRBACCmd.AddCommand(rbac.GetSettingsRBACAssignCmd())
+	settingsRBACCmd.AddCommand(rbac.GetSettingsRBACEnableCmd())
+	settingsRBACCmd.AddCommand(rbac.GetSettingsRBACDisableCmd())
+	settingsRBACCmd.AddCommand(rbac.GetSettingsRBACApplyCmd())
+	settingsRBACCmd.AddCommand(rbac.GetSettingsRBACTemporaryGrantsCmd())
+	settingsRBACCmd.AddCommand(rbac.GetSettingsRBACHistoryCmd())
 }
 
 // GetSettingsRBACCmd returns the command to manage RBAC settings.
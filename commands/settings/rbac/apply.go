@@ -0,0 +1,86 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package rbac provides RBAC settings CLI commands.
+package rbac
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/percona/everest/pkg/cli"
+	rbaccli "github.com/percona/everest/pkg/cli/rbac"
+	"github.com/percona/everest/pkg/logger"
+	"github.com/percona/everest/pkg/output"
+	readeradapter "github.com/percona/everest/pkg/rbac/io-reader-adapter"
+)
+
+var (
+	settingsRBACApplyCmd = &cobra.Command{
+		Use:  "apply [flags]",
+		Args: cobra.NoArgs,
+		Long: "Replace the RBAC policy with the policy from a file. " +
+			"The file may contain either the policy in CSV format or the RBAC ConfigMap. " +
+			"The difference to the current policy is shown first and the policy is validated before it is written.",
+		Short:   "Replace the RBAC policy with the policy from a file",
+		Example: "everestctl settings rbac apply -f policy.csv",
+		PreRun:  settingsRBACEditPreRun,
+		Run:     settingsRBACApplyRun,
+	}
+	rbacApplyFilePath string
+	rbacApplyDryRun   bool
+)
+
+func init() {
+	settingsRBACApplyCmd.Flags().StringVarP(&rbacApplyFilePath, cli.FlagRBACFile, "f", "", "Path to the policy file to apply")
+	_ = settingsRBACApplyCmd.MarkFlagRequired(cli.FlagRBACFile)
+	settingsRBACApplyCmd.Flags().BoolVar(&rbacApplyDryRun, cli.FlagRBACDryRun, false, "If set, only show the difference and validate the policy")
+}
+
+func settingsRBACApplyRun(cmd *cobra.Command, _ []string) {
+	f, err := os.Open(rbacApplyFilePath)
+	if err != nil {
+		output.PrintError(err, logger.GetLogger(), rbacEditCfg.Pretty)
+		os.Exit(1)
+	}
+	defer f.Close() //nolint:errcheck
+
+	adapter, err := readeradapter.New(f)
+	if err != nil {
+		output.PrintError(err, logger.GetLogger(), rbacEditCfg.Pretty)
+		os.Exit(1)
+	}
+
+	cliR, err := rbaccli.NewRBAC(*rbacEditCfg, logger.GetLogger())
+	if err != nil {
+		output.PrintError(err, logger.GetLogger(), rbacEditCfg.Pretty)
+		os.Exit(1)
+	}
+
+	opts := rbaccli.ApplyOptions{
+		Policy: adapter.Content(),
+		DryRun: rbacApplyDryRun,
+	}
+	if err := cliR.Apply(cmd.Context(), opts, os.Stdout); err != nil {
+		output.PrintError(err, logger.GetLogger(), rbacEditCfg.Pretty)
+		os.Exit(1)
+	}
+}
+
+// GetSettingsRBACApplyCmd returns the command to apply an RBAC policy from a file.
+func GetSettingsRBACApplyCmd() *cobra.Command {
+	return settingsRBACApplyCmd
+}
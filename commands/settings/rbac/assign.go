@@ -0,0 +1,44 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package rbac provides RBAC settings CLI commands.
+package rbac
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/percona/everest/pkg/rbac"
+)
+
+var settingsRBACAssignCmd = &cobra.Command{
+	Use:     "assign <subject> <role> [flags]",
+	Args:    cobra.ExactArgs(2),
+	Long:    "Assign an RBAC role to a user or a group",
+	Short:   "Assign an RBAC role to a user or a group",
+	Example: "everestctl settings rbac assign alice role:dev",
+	PreRun:  settingsRBACEditPreRun,
+	Run:     settingsRBACAssignRun,
+}
+
+func settingsRBACAssignRun(cmd *cobra.Command, args []string) {
+	runRBACEdit(cmd, func(p *rbac.Policy) error {
+		return p.Assign(args[0], args[1])
+	})
+}
+
+// GetSettingsRBACAssignCmd returns the command to assign RBAC roles.
+func GetSettingsRBACAssignCmd() *cobra.Command {
+	return settingsRBACAssignCmd
+}
@@ -49,6 +49,12 @@ $ everestctl settings rbac can bob delete database-cluster-backups prod-namespac
 # Check if user 'alice' can perform all/any actions on all backups in all namespaces
 $ everestctl settings rbac can alice '*' database-cluster-backups '*'
 
+# Explain which policy rules and roles let user 'alice' read 'cluster-1' in namespace 'my-namespace'
+$ everestctl settings rbac can alice read database-clusters my-namespace/cluster-1 --explain
+
+# Check if user 'alice' can create 'cluster-1' running PostgreSQL in namespace 'my-namespace'
+$ everestctl settings rbac can alice create database-clusters my-namespace/cluster-1 --attributes engineType=postgresql
+
 NOTE: The asterisk character (*) holds a special meaning in the unix shell.
 To prevent misinterpretation, you need to add single quotes around it.
 `
@@ -66,11 +72,16 @@ var (
 	rbacCanPolicyFilePath string
 	rbacCanKubeconfigPath string
 	rbacCanPretty         bool
+	rbacCanExplain        bool
+	rbacCanAttributes     map[string]string
 )
 
 func init() {
 	// local command flags
 	settingsRBACCanCmd.Flags().StringVar(&rbacCanPolicyFilePath, cli.FlagRBACPolicyFile, "", "Path to the policy file to use, otherwise use policy from Everest deployment.")
+	settingsRBACCanCmd.Flags().BoolVar(&rbacCanExplain, cli.FlagRBACExplain, false, "If set, show the policy rules and roles that lead to the decision.")
+	settingsRBACCanCmd.Flags().StringToStringVar(&rbacCanAttributes, cli.FlagRBACAttributes, nil,
+		"Attributes of the object matched against the conditions of the policy, e.g. engineType=postgresql,labels.team=dev.")
 }
 
 func settingsRBACCanPreRunE(cmd *cobra.Command, args []string) error { //nolint:revive
@@ -106,7 +117,17 @@ func settingsRBACCanRun(cmd *cobra.Command, args []string) {
 		k = client
 	}
 
-	can, err := rbac.Can(cmd.Context(), rbacCanPolicyFilePath, k, args...)
+	if rbacCanExplain {
+		explanation, err := rbac.ExplainRequest(cmd.Context(), rbacCanPolicyFilePath, k, rbacCanAttributes, args...)
+		if err != nil {
+			output.PrintError(err, logger.GetLogger(), rbacCanPretty)
+			os.Exit(1)
+		}
+		printExplanation(explanation)
+		return
+	}
+
+	can, err := rbac.CanWithAttributes(cmd.Context(), rbacCanPolicyFilePath, k, rbacCanAttributes, args...)
 	if err != nil {
 		output.PrintError(err, logger.GetLogger(), rbacCanPretty)
 		os.Exit(1)
@@ -119,6 +140,32 @@ func settingsRBACCanRun(cmd *cobra.Command, args []string) {
 	_, _ = fmt.Fprintln(os.Stdout, "No")
 }
 
+func printExplanation(e *rbac.Explanation) {
+	if e.Allowed {
+		_, _ = fmt.Fprintln(os.Stdout, "Yes")
+	} else {
+		_, _ = fmt.Fprintln(os.Stdout, "No")
+	}
+
+	if len(e.Policies) == 0 {
+		_, _ = fmt.Fprintln(os.Stdout, "\nNo policy rule matches the request.")
+		return
+	}
+	_, _ = fmt.Fprintln(os.Stdout, "\nMatching policy rules:")
+	for _, p := range e.Policies {
+		_, _ = fmt.Fprintf(os.Stdout, "  p, %s\n", strings.Join(p, ", "))
+	}
+	if len(e.Roles) > 0 {
+		_, _ = fmt.Fprintln(os.Stdout, "\nRole inheritance:")
+		for _, g := range e.Roles {
+			_, _ = fmt.Fprintf(os.Stdout, "  g, %s\n", strings.Join(g, ", "))
+		}
+	}
+	if e.DenyOverride {
+		_, _ = fmt.Fprintln(os.Stdout, "\nA matching deny rule takes precedence over the matching allow rules.")
+	}
+}
+
 // GetSettingsRBACCanCmd returns the command to test RBAC policy.
 func GetSettingsRBACCanCmd() *cobra.Command {
 	return settingsRBACCanCmd
@@ -0,0 +1,75 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package rbac provides RBAC settings CLI commands.
+package rbac
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	rbaccli "github.com/percona/everest/pkg/cli/rbac"
+	"github.com/percona/everest/pkg/logger"
+	"github.com/percona/everest/pkg/output"
+)
+
+var (
+	settingsRBACEnableCmd = &cobra.Command{
+		Use:     "enable [flags]",
+		Args:    cobra.NoArgs,
+		Long:    "Enable RBAC. The policy is validated before RBAC is enabled.",
+		Short:   "Enable RBAC",
+		Example: "everestctl settings rbac enable",
+		PreRun:  settingsRBACEditPreRun,
+		Run: func(cmd *cobra.Command, _ []string) {
+			runRBACSetEnabled(cmd, true)
+		},
+	}
+	settingsRBACDisableCmd = &cobra.Command{
+		Use:     "disable [flags]",
+		Args:    cobra.NoArgs,
+		Long:    "Disable RBAC",
+		Short:   "Disable RBAC",
+		Example: "everestctl settings rbac disable",
+		PreRun:  settingsRBACEditPreRun,
+		Run: func(cmd *cobra.Command, _ []string) {
+			runRBACSetEnabled(cmd, false)
+		},
+	}
+)
+
+func runRBACSetEnabled(cmd *cobra.Command, enabled bool) {
+	cliR, err := rbaccli.NewRBAC(*rbacEditCfg, logger.GetLogger())
+	if err != nil {
+		output.PrintError(err, logger.GetLogger(), rbacEditCfg.Pretty)
+		os.Exit(1)
+	}
+
+	if err := cliR.SetEnabled(cmd.Context(), enabled); err != nil {
+		output.PrintError(err, logger.GetLogger(), rbacEditCfg.Pretty)
+		os.Exit(1)
+	}
+}
+
+// GetSettingsRBACEnableCmd returns the command to enable RBAC.
+func GetSettingsRBACEnableCmd() *cobra.Command {
+	return settingsRBACEnableCmd
+}
+
+// GetSettingsRBACDisableCmd returns the command to disable RBAC.
+func GetSettingsRBACDisableCmd() *cobra.Command {
+	return settingsRBACDisableCmd
+}
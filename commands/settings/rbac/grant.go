@@ -0,0 +1,93 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package rbac provides RBAC settings CLI commands.
+package rbac
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/percona/everest/pkg/rbac"
+)
+
+const grantCmdExamples = `
+Examples:
+# Allow role 'role:dev' to read all database clusters in namespace 'ns-dev'
+$ everestctl settings rbac grant role:dev read database-clusters 'ns-dev/*'
+
+# Allow user 'alice' to create MySQL database clusters in namespace 'ns-dev'
+$ everestctl settings rbac grant alice create database-clusters 'ns-dev/*;engineType=pxc'
+
+NOTE: The asterisk character (*) holds a special meaning in the unix shell.
+To prevent misinterpretation, you need to add single quotes around it.
+`
+
+var (
+	settingsRBACGrantCmd = &cobra.Command{
+		Use:     "grant <subject> <action> <resource> <object> [flags]",
+		Args:    cobra.ExactArgs(4),
+		Long:    "Add an RBAC policy rule" + "\n" + grantCmdExamples,
+		Short:   "Add an RBAC policy rule",
+		Example: "everestctl settings rbac grant role:dev read database-clusters 'ns-dev/*'",
+		PreRunE: settingsRBACPolicyRulePreRunE,
+		Run:     settingsRBACGrantRun,
+	}
+	settingsRBACRevokeCmd = &cobra.Command{
+		Use:     "revoke <subject> <action> <resource> <object> [flags]",
+		Args:    cobra.ExactArgs(4),
+		Long:    "Remove an RBAC policy rule",
+		Short:   "Remove an RBAC policy rule",
+		Example: "everestctl settings rbac revoke role:dev read database-clusters 'ns-dev/*'",
+		PreRunE: settingsRBACPolicyRulePreRunE,
+		Run:     settingsRBACRevokeRun,
+	}
+)
+
+func settingsRBACPolicyRulePreRunE(cmd *cobra.Command, args []string) error { //nolint:revive
+	// validate action
+	if !rbac.ValidateAction(args[1]) {
+		return errors.New(fmt.Sprintf("invalid action '%s'. Supported actions: %s",
+			args[1], strings.Join(rbac.SupportedActions, `,`),
+		))
+	}
+	settingsRBACEditPreRun(cmd, args)
+	return nil
+}
+
+func settingsRBACGrantRun(cmd *cobra.Command, args []string) {
+	runRBACEdit(cmd, func(p *rbac.Policy) error {
+		return p.Grant(args[0], args[2], args[1], args[3])
+	})
+}
+
+func settingsRBACRevokeRun(cmd *cobra.Command, args []string) {
+	runRBACEdit(cmd, func(p *rbac.Policy) error {
+		return p.Revoke(args[0], args[2], args[1], args[3])
+	})
+}
+
+// GetSettingsRBACGrantCmd returns the command to add RBAC policy rules.
+func GetSettingsRBACGrantCmd() *cobra.Command {
+	return settingsRBACGrantCmd
+}
+
+// GetSettingsRBACRevokeCmd returns the command to remove RBAC policy rules.
+func GetSettingsRBACRevokeCmd() *cobra.Command {
+	return settingsRBACRevokeCmd
+}
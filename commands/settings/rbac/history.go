@@ -0,0 +1,142 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package rbac provides RBAC settings CLI commands.
+package rbac
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	rbaccli "github.com/percona/everest/pkg/cli/rbac"
+	"github.com/percona/everest/pkg/logger"
+	"github.com/percona/everest/pkg/output"
+	"github.com/percona/everest/pkg/rbac"
+)
+
+var (
+	settingsRBACHistoryCmd = &cobra.Command{
+		Use:   "history <command> [flags]",
+		Args:  cobra.ExactArgs(1),
+		Long:  "Manage the versions of the RBAC policy",
+		Short: "Manage the versions of the RBAC policy",
+		Run:   func(_ *cobra.Command, _ []string) {},
+	}
+	settingsRBACHistoryListCmd = &cobra.Command{
+		Use:     "list [flags]",
+		Args:    cobra.NoArgs,
+		Long:    "List the most recent versions of the RBAC policy",
+		Short:   "List the versions of the RBAC policy",
+		Example: "everestctl settings rbac history list",
+		PreRun:  settingsRBACEditPreRun,
+		Run:     settingsRBACHistoryListRun,
+	}
+	settingsRBACHistoryDiffCmd = &cobra.Command{
+		Use:     "diff <from-version> <to-version> [flags]",
+		Args:    cobra.ExactArgs(2),
+		Long:    "Show the difference between two versions of the RBAC policy",
+		Short:   "Show the difference between two versions of the RBAC policy",
+		Example: "everestctl settings rbac history diff 3 4",
+		PreRunE: settingsRBACHistoryPreRunE,
+		Run:     settingsRBACHistoryDiffRun,
+	}
+	settingsRBACHistoryRollbackCmd = &cobra.Command{
+		Use:     "rollback <version> [flags]",
+		Args:    cobra.ExactArgs(1),
+		Long:    "Replace the RBAC policy with the given version of the policy. Whether RBAC is enabled is not changed.",
+		Short:   "Roll back the RBAC policy to the given version",
+		Example: "everestctl settings rbac history rollback 3",
+		PreRunE: settingsRBACHistoryPreRunE,
+		Run:     settingsRBACHistoryRollbackRun,
+	}
+	rbacHistoryVersions []int
+)
+
+func init() {
+	settingsRBACHistoryCmd.AddCommand(settingsRBACHistoryListCmd)
+	settingsRBACHistoryCmd.AddCommand(settingsRBACHistoryDiffCmd)
+	settingsRBACHistoryCmd.AddCommand(settingsRBACHistoryRollbackCmd)
+}
+
+func settingsRBACHistoryPreRunE(cmd *cobra.Command, args []string) error {
+	rbacHistoryVersions = make([]int, 0, len(args))
+	for _, arg := range args {
+		version, err := strconv.Atoi(arg)
+		if err != nil || version <= 0 {
+			return fmt.Errorf("invalid version '%s', expected a positive number", arg)
+		}
+		rbacHistoryVersions = append(rbacHistoryVersions, version)
+	}
+	settingsRBACEditPreRun(cmd, args)
+	return nil
+}
+
+func newRBACHistoryCLI() *rbaccli.RBAC {
+	cliR, err := rbaccli.NewRBAC(*rbacEditCfg, logger.GetLogger())
+	if err != nil {
+		output.PrintError(err, logger.GetLogger(), rbacEditCfg.Pretty)
+		os.Exit(1)
+	}
+	return cliR
+}
+
+func settingsRBACHistoryListRun(cmd *cobra.Command, _ []string) {
+	history, err := newRBACHistoryCLI().ListPolicyVersions(cmd.Context())
+	if err != nil {
+		output.PrintError(err, logger.GetLogger(), rbacEditCfg.Pretty)
+		os.Exit(1)
+	}
+	printPolicyVersionsTable(history.Versions)
+}
+
+func settingsRBACHistoryDiffRun(cmd *cobra.Command, _ []string) {
+	if err := newRBACHistoryCLI().DiffPolicyVersions(cmd.Context(), rbacHistoryVersions[0], rbacHistoryVersions[1], os.Stdout); err != nil {
+		output.PrintError(err, logger.GetLogger(), rbacEditCfg.Pretty)
+		os.Exit(1)
+	}
+}
+
+func settingsRBACHistoryRollbackRun(cmd *cobra.Command, _ []string) {
+	if err := newRBACHistoryCLI().Rollback(cmd.Context(), rbacHistoryVersions[0]); err != nil {
+		output.PrintError(err, logger.GetLogger(), rbacEditCfg.Pretty)
+		os.Exit(1)
+	}
+}
+
+const (
+	columnVersion   = "version"
+	columnTimestamp = "timestamp"
+	columnAuthor    = "author"
+	columnEnabled   = "enabled"
+	columnValid     = "valid"
+)
+
+// Print the versions of the policy to console.
+func printPolicyVersionsTable(versions []rbac.PolicyVersion) {
+	tbl := newRBACTable(columnVersion, columnTimestamp, columnAuthor, columnEnabled, columnValid)
+	for _, v := range versions {
+		tbl.AddRow(v.Version, v.Timestamp.Format(time.RFC3339), v.Author, v.Enabled, v.Valid)
+	}
+	tbl.Print()
+}
+
+// GetSettingsRBACHistoryCmd returns the command to manage the versions of the RBAC policy.
+func GetSettingsRBACHistoryCmd() *cobra.Command {
+	return settingsRBACHistoryCmd
+}
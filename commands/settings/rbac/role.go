@@ -0,0 +1,140 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package rbac provides RBAC settings CLI commands.
+package rbac
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/percona/everest/pkg/cli"
+	rbaccli "github.com/percona/everest/pkg/cli/rbac"
+	"github.com/percona/everest/pkg/logger"
+	"github.com/percona/everest/pkg/output"
+	"github.com/percona/everest/pkg/rbac"
+)
+
+const roleAddCmdExamples = `
+Examples:
+# Add role 'role:dev' that can manage database clusters and read backups in namespace 'ns-dev', and assign it to user 'alice'
+$ everestctl settings rbac role add role:dev --permission 'database-clusters,*,ns-dev/*' --permission 'database-cluster-backups,read,ns-dev/*' --assign alice
+
+NOTE: The asterisk character (*) holds a special meaning in the unix shell.
+To prevent misinterpretation, you need to add single quotes around it.
+`
+
+var (
+	settingsRBACRoleCmd = &cobra.Command{
+		Use:   "role <command> [flags]",
+		Args:  cobra.ExactArgs(1),
+		Long:  "Manage RBAC roles",
+		Short: "Manage RBAC roles",
+		Run:   func(_ *cobra.Command, _ []string) {},
+	}
+	settingsRBACRoleAddCmd = &cobra.Command{
+		Use:     "add <role> [flags]",
+		Args:    cobra.ExactArgs(1),
+		Long:    "Add a new RBAC role with the given permissions and assign it to users or groups" + "\n" + roleAddCmdExamples,
+		Short:   "Add a new RBAC role",
+		Example: "everestctl settings rbac role add role:dev --permission 'database-clusters,read,ns-dev/*' --assign alice",
+		PreRunE: settingsRBACRoleAddPreRunE,
+		Run:     settingsRBACRoleAddRun,
+	}
+	settingsRBACRoleRemoveCmd = &cobra.Command{
+		Use:     "remove <role> [flags]",
+		Args:    cobra.ExactArgs(1),
+		Long:    "Remove an RBAC role together with its permissions and assignments",
+		Short:   "Remove an RBAC role",
+		Example: "everestctl settings rbac role remove role:dev",
+		PreRun:  settingsRBACEditPreRun,
+		Run:     settingsRBACRoleRemoveRun,
+	}
+	rbacRolePermissions []string
+	rbacRoleSubjects    []string
+	rbacEditCfg         = &rbaccli.Config{}
+)
+
+func init() {
+	settingsRBACRoleAddCmd.Flags().StringArrayVar(&rbacRolePermissions, cli.FlagRBACPermission, nil,
+		"Permission of the role in the form <resource>,<action>,<object>. May be repeated.")
+	_ = settingsRBACRoleAddCmd.MarkFlagRequired(cli.FlagRBACPermission)
+	settingsRBACRoleAddCmd.Flags().StringArrayVar(&rbacRoleSubjects, cli.FlagRBACAssign, nil,
+		"User or group to assign the role to. May be repeated.")
+	_ = settingsRBACRoleAddCmd.MarkFlagRequired(cli.FlagRBACAssign)
+
+	settingsRBACRoleCmd.AddCommand(settingsRBACRoleAddCmd)
+	settingsRBACRoleCmd.AddCommand(settingsRBACRoleRemoveCmd)
+}
+
+func settingsRBACEditPreRun(cmd *cobra.Command, _ []string) { //nolint:revive
+	// Copy global flags to config
+	rbacEditCfg.Pretty = !(cmd.Flag(cli.FlagVerbose).Changed || cmd.Flag(cli.FlagJSON).Changed)
+	rbacEditCfg.KubeconfigPath = cmd.Flag(cli.FlagKubeconfig).Value.String()
+}
+
+func settingsRBACRoleAddPreRunE(cmd *cobra.Command, args []string) error { //nolint:revive
+	for _, perm := range rbacRolePermissions {
+		terms := strings.Split(perm, ",")
+		if len(terms) != 3 { //nolint:mnd
+			return fmt.Errorf("invalid permission '%s', expected <resource>,<action>,<object>", perm)
+		}
+		if !rbac.ValidateAction(terms[1]) {
+			return errors.New(fmt.Sprintf("invalid action '%s'. Supported actions: %s",
+				terms[1], strings.Join(rbac.SupportedActions, `,`),
+			))
+		}
+	}
+	settingsRBACEditPreRun(cmd, args)
+	return nil
+}
+
+func settingsRBACRoleAddRun(cmd *cobra.Command, args []string) {
+	permissions := make([][]string, 0, len(rbacRolePermissions))
+	for _, perm := range rbacRolePermissions {
+		permissions = append(permissions, strings.Split(perm, ","))
+	}
+	runRBACEdit(cmd, func(p *rbac.Policy) error {
+		return p.AddRole(args[0], permissions, rbacRoleSubjects)
+	})
+}
+
+func settingsRBACRoleRemoveRun(cmd *cobra.Command, args []string) {
+	runRBACEdit(cmd, func(p *rbac.Policy) error {
+		return p.RemoveRole(args[0])
+	})
+}
+
+func runRBACEdit(cmd *cobra.Command, change func(p *rbac.Policy) error) {
+	cliR, err := rbaccli.NewRBAC(*rbacEditCfg, logger.GetLogger())
+	if err != nil {
+		output.PrintError(err, logger.GetLogger(), rbacEditCfg.Pretty)
+		os.Exit(1)
+	}
+
+	if err := cliR.Edit(cmd.Context(), change); err != nil {
+		output.PrintError(err, logger.GetLogger(), rbacEditCfg.Pretty)
+		os.Exit(1)
+	}
+}
+
+// GetSettingsRBACRoleCmd returns the command to manage RBAC roles.
+func GetSettingsRBACRoleCmd() *cobra.Command {
+	return settingsRBACRoleCmd
+}
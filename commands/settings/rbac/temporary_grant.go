@@ -0,0 +1,202 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package rbac provides RBAC settings CLI commands.
+package rbac
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/rodaine/table"
+	"github.com/spf13/cobra"
+
+	"github.com/percona/everest/pkg/cli"
+	rbaccli "github.com/percona/everest/pkg/cli/rbac"
+	"github.com/percona/everest/pkg/logger"
+	"github.com/percona/everest/pkg/output"
+	"github.com/percona/everest/pkg/rbac"
+)
+
+const temporaryGrantCreateCmdExamples = `
+Examples:
+# Grant role 'role:prod-dba' to user 'bob' until the given time
+$ everestctl settings rbac temporary-grants create bob role:prod-dba --until 2026-10-20T18:00Z
+
+# Grant role 'role:prod-dba' to user 'bob' for the next 2 hours
+$ everestctl settings rbac temporary-grants create bob role:prod-dba --for 2h --reason 'incident 1234'
+`
+
+// temporaryGrantTimeLayouts are the accepted layouts of the --until flag.
+var temporaryGrantTimeLayouts = []string{time.RFC3339, "2006-01-02T15:04Z07:00"}
+
+var (
+	settingsRBACTemporaryGrantsCmd = &cobra.Command{
+		Use:   "temporary-grants <command> [flags]",
+		Args:  cobra.ExactArgs(1),
+		Long:  "Manage RBAC role assignments that expire automatically",
+		Short: "Manage temporary RBAC grants",
+		Run:   func(_ *cobra.Command, _ []string) {},
+	}
+	settingsRBACTemporaryGrantsCreateCmd = &cobra.Command{
+		Use:     "create <subject> <role> [flags]",
+		Args:    cobra.ExactArgs(2),
+		Long:    "Assign an RBAC role to a user or a group until the expiry time" + "\n" + temporaryGrantCreateCmdExamples,
+		Short:   "Create a temporary RBAC grant",
+		Example: "everestctl settings rbac temporary-grants create bob role:prod-dba --for 2h",
+		PreRunE: settingsRBACTemporaryGrantsCreatePreRunE,
+		Run:     settingsRBACTemporaryGrantsCreateRun,
+	}
+	settingsRBACTemporaryGrantsListCmd = &cobra.Command{
+		Use:     "list [flags]",
+		Args:    cobra.NoArgs,
+		Long:    "List the temporary RBAC grants that have not expired yet",
+		Short:   "List temporary RBAC grants",
+		Example: "everestctl settings rbac temporary-grants list --history",
+		PreRun:  settingsRBACEditPreRun,
+		Run:     settingsRBACTemporaryGrantsListRun,
+	}
+	rbacTemporaryGrantUntil   string
+	rbacTemporaryGrantFor     time.Duration
+	rbacTemporaryGrantReason  string
+	rbacTemporaryGrantHistory bool
+	rbacTemporaryGrantExpiry  time.Time
+)
+
+func init() {
+	settingsRBACTemporaryGrantsCreateCmd.Flags().StringVar(&rbacTemporaryGrantUntil, cli.FlagRBACUntil, "",
+		"Time the grant expires at, e.g. 2026-10-20T18:00Z")
+	settingsRBACTemporaryGrantsCreateCmd.Flags().DurationVar(&rbacTemporaryGrantFor, cli.FlagRBACFor, 0,
+		"Duration the grant is valid for, e.g. 2h")
+	settingsRBACTemporaryGrantsCreateCmd.MarkFlagsMutuallyExclusive(cli.FlagRBACUntil, cli.FlagRBACFor)
+	settingsRBACTemporaryGrantsCreateCmd.MarkFlagsOneRequired(cli.FlagRBACUntil, cli.FlagRBACFor)
+	settingsRBACTemporaryGrantsCreateCmd.Flags().StringVar(&rbacTemporaryGrantReason, cli.FlagRBACReason, "",
+		"Justification of the grant")
+
+	settingsRBACTemporaryGrantsListCmd.Flags().BoolVar(&rbacTemporaryGrantHistory, cli.FlagRBACHistory, false,
+		"If set, show the history of the changes of the temporary grants")
+
+	settingsRBACTemporaryGrantsCmd.AddCommand(settingsRBACTemporaryGrantsCreateCmd)
+	settingsRBACTemporaryGrantsCmd.AddCommand(settingsRBACTemporaryGrantsListCmd)
+}
+
+func settingsRBACTemporaryGrantsCreatePreRunE(cmd *cobra.Command, args []string) error {
+	if rbacTemporaryGrantUntil != "" {
+		expiry, err := parseTemporaryGrantTime(rbacTemporaryGrantUntil)
+		if err != nil {
+			return err
+		}
+		rbacTemporaryGrantExpiry = expiry
+	} else {
+		if rbacTemporaryGrantFor <= 0 {
+			return errors.New("the duration of the grant must be greater than 0")
+		}
+		rbacTemporaryGrantExpiry = time.Now().Add(rbacTemporaryGrantFor)
+	}
+	settingsRBACEditPreRun(cmd, args)
+	return nil
+}
+
+func parseTemporaryGrantTime(value string) (time.Time, error) {
+	for _, layout := range temporaryGrantTimeLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid time '%s', expected RFC 3339 format, e.g. 2026-10-20T18:00Z", value)
+}
+
+func settingsRBACTemporaryGrantsCreateRun(cmd *cobra.Command, args []string) {
+	cliR, err := rbaccli.NewRBAC(*rbacEditCfg, logger.GetLogger())
+	if err != nil {
+		output.PrintError(err, logger.GetLogger(), rbacEditCfg.Pretty)
+		os.Exit(1)
+	}
+
+	if err := cliR.CreateTemporaryGrant(cmd.Context(), rbaccli.TemporaryGrantOptions{
+		Subject:   args[0],
+		Role:      args[1],
+		ExpiresAt: rbacTemporaryGrantExpiry,
+		Reason:    rbacTemporaryGrantReason,
+	}); err != nil {
+		output.PrintError(err, logger.GetLogger(), rbacEditCfg.Pretty)
+		os.Exit(1)
+	}
+}
+
+func settingsRBACTemporaryGrantsListRun(cmd *cobra.Command, _ []string) {
+	cliR, err := rbaccli.NewRBAC(*rbacEditCfg, logger.GetLogger())
+	if err != nil {
+		output.PrintError(err, logger.GetLogger(), rbacEditCfg.Pretty)
+		os.Exit(1)
+	}
+
+	grants, err := cliR.ListTemporaryGrants(cmd.Context())
+	if err != nil {
+		output.PrintError(err, logger.GetLogger(), rbacEditCfg.Pretty)
+		os.Exit(1)
+	}
+	if rbacTemporaryGrantHistory {
+		printTemporaryGrantsHistoryTable(grants.History)
+		return
+	}
+	printTemporaryGrantsTable(grants.Grants)
+}
+
+const (
+	columnSubject   = "subject"
+	columnRole      = "role"
+	columnExpiresAt = "expires"
+	columnGrantedBy = "granted by"
+	columnReason    = "reason"
+	columnTime      = "time"
+	columnAction    = "action"
+	columnActor     = "actor"
+)
+
+func newRBACTable(headings ...interface{}) table.Table {
+	tbl := table.New(headings...)
+	tbl.WithHeaderFormatter(func(format string, vals ...interface{}) string {
+		// Print all in caps.
+		return strings.ToUpper(fmt.Sprintf(format, vals...))
+	})
+	return tbl
+}
+
+// Print temporary grants to console.
+func printTemporaryGrantsTable(grants []rbac.TemporaryGrant) {
+	tbl := newRBACTable(columnSubject, columnRole, columnExpiresAt, columnGrantedBy, columnReason)
+	for _, g := range grants {
+		tbl.AddRow(g.Subject, g.Role, g.ExpiresAt.Format(time.RFC3339), g.GrantedBy, g.Reason)
+	}
+	tbl.Print()
+}
+
+// Print the history of the temporary grants to console.
+func printTemporaryGrantsHistoryTable(history []rbac.GrantEvent) {
+	tbl := newRBACTable(columnTime, columnAction, columnActor, columnSubject, columnRole, columnExpiresAt)
+	for _, e := range history {
+		tbl.AddRow(e.Time.Format(time.RFC3339), e.Action, e.Actor, e.Grant.Subject, e.Grant.Role, e.Grant.ExpiresAt.Format(time.RFC3339))
+	}
+	tbl.Print()
+}
+
+// GetSettingsRBACTemporaryGrantsCmd returns the command to manage temporary RBAC grants.
+func GetSettingsRBACTemporaryGrantsCmd() *cobra.Command {
+	return settingsRBACTemporaryGrantsCmd
+}
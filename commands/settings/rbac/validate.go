@@ -17,6 +17,7 @@
 package rbac
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"strings"
@@ -31,10 +32,28 @@ import (
 	"github.com/percona/everest/pkg/rbac"
 )
 
+const validateCmdLong = `
+The object of a policy rule may be followed by conditions on the attributes of the object,
+separated by semicolons. A condition has the form <attribute>=<value>[|<value>...], where
+the values may contain wildcards. The supported attributes are:
+  database-clusters, database-cluster-credentials: engineType, labels.<key>
+  backup-storages: storageType
+
+Example:
+# Allow role 'role:dev' to manage PostgreSQL clusters in namespace 'ns-dev'
+p, role:dev, database-clusters, *, ns-dev/*;engineType=postgresql
+
+With --lint, the policy is also checked for likely mistakes:
+  roles that are never assigned, users assigned to non-existent roles,
+  rules shadowed by broader rules or overridden by deny rules,
+  rules granting all actions on all resources to roles other than role:admin,
+  and unknown resources.
+`
+
 var (
 	settingsRBACValidateCmd = &cobra.Command{
 		Use:     "validate [flags]",
-		Long:    "Validate RBAC settings",
+		Long:    "Validate RBAC settings" + "\n" + validateCmdLong,
 		Short:   "Validate RBAC settings",
 		Example: "everestctl settings rbac validate --policy-file <file_path>",
 		PreRun:  settingsRBACValidatePreRun,
@@ -43,11 +62,13 @@ var (
 	rbacValidatePolicyFilePath string
 	rbacValidateKubeconfigPath string
 	rbacValidatePretty         bool
+	rbacValidateLint           bool
 )
 
 func init() {
 	// local command flags
 	settingsRBACValidateCmd.Flags().StringVar(&rbacValidatePolicyFilePath, cli.FlagRBACPolicyFile, "", "Path to the policy file to use, otherwise use policy from Everest deployment.")
+	settingsRBACValidateCmd.Flags().BoolVar(&rbacValidateLint, cli.FlagRBACLint, false, "If set, also report likely mistakes in the policy.")
 }
 
 func settingsRBACValidatePreRun(cmd *cobra.Command, _ []string) { //nolint:revive
@@ -75,7 +96,19 @@ func settingsRBACValidateRun(cmd *cobra.Command, _ []string) {
 		k = client
 	}
 
-	err := rbac.ValidatePolicy(cmd.Context(), k, rbacValidatePolicyFilePath)
+	var opts []rbac.ValidateOption
+	if rbacValidateLint {
+		opts = append(opts, rbac.WithLint())
+	}
+	err := rbac.ValidatePolicy(cmd.Context(), k, rbacValidatePolicyFilePath, opts...)
+	var lintErr *rbac.LintError
+	if errors.As(err, &lintErr) && !errors.Is(err, rbac.ErrPolicySyntax) {
+		_, _ = fmt.Fprint(os.Stdout, output.Failure("Found %d issue(s)", len(lintErr.Issues)))
+		for _, issue := range lintErr.Issues {
+			_, _ = fmt.Fprintf(os.Stdout, "  %s\n", issue)
+		}
+		os.Exit(1)
+	}
 	if err != nil {
 		_, _ = fmt.Fprint(os.Stdout, output.Failure("Invalid"))
 		msg := err.Error()
@@ -0,0 +1,65 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package telemetry holds commands for telemetry command.
+package telemetry
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/percona/everest/pkg/cli"
+	telemetrycli "github.com/percona/everest/pkg/cli/telemetry"
+	"github.com/percona/everest/pkg/logger"
+	"github.com/percona/everest/pkg/output"
+)
+
+var (
+	telemetryShowCmd = &cobra.Command{
+		Use:     "show [flags]",
+		Args:    cobra.NoArgs,
+		Example: "everestctl telemetry show",
+		Long:    "Show the telemetry report exactly as Everest would send it to the telemetry service",
+		Short:   "Show the telemetry report",
+		PreRun:  telemetryShowPreRun,
+		Run:     telemetryShowRun,
+	}
+	telemetryShowCfg = &telemetrycli.Config{}
+)
+
+func telemetryShowPreRun(cmd *cobra.Command, _ []string) { //nolint:revive
+	// Copy global flags to config
+	telemetryShowCfg.Pretty = !(cmd.Flag(cli.FlagVerbose).Changed || cmd.Flag(cli.FlagJSON).Changed)
+	telemetryShowCfg.KubeconfigPath = cmd.Flag(cli.FlagKubeconfig).Value.String()
+}
+
+func telemetryShowRun(cmd *cobra.Command, _ []string) { //nolint:revive
+	cliT, err := telemetrycli.NewTelemetry(*telemetryShowCfg, logger.GetLogger())
+	if err != nil {
+		output.PrintError(err, logger.GetLogger(), telemetryShowCfg.Pretty)
+		os.Exit(1)
+	}
+
+	if err := cliT.Show(cmd.Context(), os.Stdout); err != nil {
+		output.PrintError(err, logger.GetLogger(), telemetryShowCfg.Pretty)
+		os.Exit(1)
+	}
+}
+
+// GetShowCmd returns the command to show the telemetry report.
+func GetShowCmd() *cobra.Command {
+	return telemetryShowCmd
+}
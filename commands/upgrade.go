@@ -46,6 +46,8 @@ func init() {
 
 	// local command flags
 	upgradeCmd.Flags().StringVar(&upgradeCfg.VersionMetadataURL, cli.FlagVersionMetadataURL, "https://check.percona.com", "URL to retrieve version metadata information from")
+	upgradeCmd.Flags().StringVar(&upgradeCfg.VersionMetadataBundle, cli.FlagVersionMetadataBundle, "", "Path to an offline version metadata bundle, or configmap://<namespace>/<name>, to use instead of the version service")
+	upgradeCmd.Flags().StringVar(&upgradeCfg.VersionMetadataPublicKey, cli.FlagVersionMetadataPublicKey, "", "Path to the PEM encoded ed25519 public key to verify the signature of the version metadata bundle, required with --"+cli.FlagVersionMetadataBundle)
 	upgradeCmd.Flags().BoolVar(&upgradeCfg.SkipEnvDetection, cli.FlagSkipEnvDetection, false, "Skip detecting Kubernetes environment where Everest is installed")
 	upgradeCmd.Flags().BoolVar(&upgradeCfg.DryRun, cli.FlagUpgradeDryRun, false, "If set, only executes the pre-upgrade checks")
 	upgradeCmd.Flags().BoolVar(&upgradeCfg.InCluster, cli.FlagUpgradeInCluster, false, "If set, uses the in-cluster Kubernetes client configuration")
@@ -0,0 +1,141 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/percona/everest/api"
+)
+
+// ListAccounts lists the built-in accounts.
+func (e *EverestServer) ListAccounts(c echo.Context) error {
+	result, err := e.handler.ListAccounts(c.Request().Context())
+	if err != nil {
+		e.l.Errorf("ListAccounts failed: %v", err)
+		return err
+	}
+	return c.JSON(http.StatusOK, result)
+}
+
+// CreateAccount creates a new built-in account.
+func (e *EverestServer) CreateAccount(c echo.Context) error {
+	req := &api.AccountCreateRequest{}
+	if err := e.getBodyFromContext(c, req); err != nil {
+		return errors.Join(errFailedToReadRequestBody, err)
+	}
+	result, err := e.handler.CreateAccount(c.Request().Context(), req)
+	if err != nil {
+		e.l.Errorf("CreateAccount failed: %v", err)
+		return err
+	}
+	return c.JSON(http.StatusOK, result)
+}
+
+// GetAccount retrieves a built-in account.
+func (e *EverestServer) GetAccount(c echo.Context, username string) error {
+	result, err := e.handler.GetAccount(c.Request().Context(), username)
+	if err != nil {
+		e.l.Errorf("GetAccount failed: %v", err)
+		return err
+	}
+	return c.JSON(http.StatusOK, result)
+}
+
+// UpdateAccount updates the state and the capabilities of a built-in account.
+func (e *EverestServer) UpdateAccount(c echo.Context, username string) error {
+	req := &api.AccountUpdateRequest{}
+	if err := e.getBodyFromContext(c, req); err != nil {
+		return errors.Join(errFailedToReadRequestBody, err)
+	}
+	result, err := e.handler.UpdateAccount(c.Request().Context(), username, req)
+	if err != nil {
+		e.l.Errorf("UpdateAccount failed: %v", err)
+		return err
+	}
+	return c.JSON(http.StatusOK, result)
+}
+
+// DeleteAccount deletes a built-in account.
+func (e *EverestServer) DeleteAccount(c echo.Context, username string) error {
+	if err := e.handler.DeleteAccount(c.Request().Context(), username); err != nil {
+		e.l.Errorf("DeleteAccount failed: %v", err)
+		return err
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// SetAccountPassword sets the password of a built-in account.
+func (e *EverestServer) SetAccountPassword(c echo.Context, username string) error {
+	req := &api.AccountPasswordRequest{}
+	if err := e.getBodyFromContext(c, req); err != nil {
+		return errors.Join(errFailedToReadRequestBody, err)
+	}
+	if err := e.handler.SetAccountPassword(c.Request().Context(), username, req); err != nil {
+		e.l.Errorf("SetAccountPassword failed: %v", err)
+		return err
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// UnlockAccount unlocks a built-in account locked after repeated failed logins.
+func (e *EverestServer) UnlockAccount(c echo.Context, username string) error {
+	if err := e.handler.UnlockAccount(c.Request().Context(), username); err != nil {
+		e.l.Errorf("UnlockAccount failed: %v", err)
+		return err
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// ResetAccountMFA removes the MFA enrollment of a built-in account.
+func (e *EverestServer) ResetAccountMFA(c echo.Context, username string) error {
+	if err := e.handler.ResetAccountMFA(c.Request().Context(), username); err != nil {
+		e.l.Errorf("ResetAccountMFA failed: %v", err)
+		return err
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// ListUserSessions returns the active login sessions of a built-in user.
+func (e *EverestServer) ListUserSessions(c echo.Context, username string) error {
+	result, err := e.handler.ListUserSessions(c.Request().Context(), username)
+	if err != nil {
+		e.l.Errorf("ListUserSessions failed: %v", err)
+		return err
+	}
+	return c.JSON(http.StatusOK, result)
+}
+
+// DeleteUserSessions revokes all the login sessions of a built-in user.
+func (e *EverestServer) DeleteUserSessions(c echo.Context, username string) error {
+	if err := e.handler.DeleteUserSessions(c.Request().Context(), username); err != nil {
+		e.l.Errorf("DeleteUserSessions failed: %v", err)
+		return err
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// DeleteUserSession revokes a login session of a built-in user.
+func (e *EverestServer) DeleteUserSession(c echo.Context, username, sessionID string) error {
+	if err := e.handler.DeleteUserSession(c.Request().Context(), username, sessionID); err != nil {
+		e.l.Errorf("DeleteUserSession failed: %v", err)
+		return err
+	}
+	return c.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,129 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/AlekSi/pointer"
+	"github.com/labstack/echo/v4"
+
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/accounts"
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/session"
+)
+
+var errAPIKeysRequireLogin = errors.New("API keys can be managed only by built-in users logged in with a session token")
+
+// ListAPIKeys lists the API keys of the current user.
+func (e *EverestServer) ListAPIKeys(c echo.Context) error {
+	username, err := apiKeysUser(c)
+	if err != nil {
+		return apiKeyErrToHTTPRes(c, err)
+	}
+	keys, err := e.sessionMgr.APIKeys().List(c.Request().Context(), username)
+	if err != nil {
+		e.l.Errorf("ListAPIKeys failed: %v", err)
+		return apiKeyErrToHTTPRes(c, err)
+	}
+	result := api.APIKeyList{Items: make([]api.APIKey, 0, len(keys))}
+	for _, key := range keys {
+		result.Items = append(result.Items, apiKeyToAPI(key))
+	}
+	return c.JSON(http.StatusOK, result)
+}
+
+// CreateAPIKey issues a new API key for the current user.
+func (e *EverestServer) CreateAPIKey(c echo.Context) error {
+	username, err := apiKeysUser(c)
+	if err != nil {
+		return apiKeyErrToHTTPRes(c, err)
+	}
+	req := &api.APIKeyRequest{}
+	if err := e.getBodyFromContext(c, req); err != nil {
+		return errors.Join(errFailedToReadRequestBody, err)
+	}
+
+	token, key, err := e.sessionMgr.APIKeys().Issue(c.Request().Context(), username, req.Name, req.ExpiresAt)
+	if err != nil {
+		e.l.Errorf("CreateAPIKey failed: %v", err)
+		return apiKeyErrToHTTPRes(c, err)
+	}
+	return c.JSON(http.StatusOK, api.CreatedAPIKey{
+		Token:  token,
+		ApiKey: apiKeyToAPI(*key),
+	})
+}
+
+// DeleteAPIKey revokes an API key of the current user.
+func (e *EverestServer) DeleteAPIKey(c echo.Context, name string) error {
+	username, err := apiKeysUser(c)
+	if err != nil {
+		return apiKeyErrToHTTPRes(c, err)
+	}
+	if err := e.sessionMgr.APIKeys().Revoke(c.Request().Context(), username, name); err != nil {
+		e.l.Errorf("DeleteAPIKey failed: %v", err)
+		return apiKeyErrToHTTPRes(c, err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// apiKeysUser returns the user whose API keys are managed by the request.
+// API keys cannot be used to manage API keys, so that a leaked key cannot be used to issue new ones.
+func apiKeysUser(c echo.Context) (string, error) {
+	token, err := common.ExtractToken(c.Request().Context())
+	if err != nil {
+		return "", err
+	}
+	username, ok := session.LoginUsername(token)
+	if !ok {
+		return "", errAPIKeysRequireLogin
+	}
+	return username, nil
+}
+
+func apiKeyToAPI(key accounts.APIKey) api.APIKey {
+	return api.APIKey{
+		Name:       key.Name,
+		CreatedAt:  key.CreatedAt,
+		ExpiresAt:  key.ExpiresAt,
+		LastUsedAt: key.LastUsedAt,
+	}
+}
+
+func apiKeyErrToHTTPRes(c echo.Context, err error) error {
+	status := 0
+	switch {
+	case errors.Is(err, session.ErrInvalidAPIKeyName),
+		errors.Is(err, session.ErrAPIKeyExpiryInPast):
+		status = http.StatusBadRequest
+	case errors.Is(err, errAPIKeysRequireLogin),
+		errors.Is(err, accounts.ErrAccountDisabled),
+		errors.Is(err, accounts.ErrInsufficientCapabilities):
+		status = http.StatusForbidden
+	case errors.Is(err, accounts.ErrAPIKeyNotFound):
+		status = http.StatusNotFound
+	case errors.Is(err, accounts.ErrAPIKeyAlreadyExists):
+		status = http.StatusConflict
+	default:
+		return err
+	}
+	return c.JSON(status, api.Error{
+		Message: pointer.To(err.Error()),
+	})
+}
@@ -17,7 +17,13 @@
 package server
 
 import (
+	"errors"
+	"net/http"
+
 	"github.com/labstack/echo/v4"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/percona/everest/api"
 )
 
 // ListDataImportJobs lists all DataImportJobs for the specified database clusters.
@@ -28,3 +34,57 @@ func (e *EverestServer) ListDataImportJobs(c echo.Context, namespace, dbName str
 	}
 	return c.JSON(200, result)
 }
+
+// CreateDataImportJob creates a new DataImportJob.
+func (e *EverestServer) CreateDataImportJob(c echo.Context, namespace string) error {
+	job := &everestv1alpha1.DataImportJob{}
+	if err := e.getBodyFromContext(c, job); err != nil {
+		return errors.Join(errFailedToReadRequestBody, err)
+	}
+	job.SetNamespace(namespace)
+
+	result, err := e.handler.CreateDataImportJob(c.Request().Context(), job)
+	if err != nil {
+		e.l.Errorf("CreateDataImportJob failed: %v", err)
+		return err
+	}
+	return c.JSON(http.StatusOK, result)
+}
+
+// GetDataImportJob retrieves a DataImportJob by name.
+func (e *EverestServer) GetDataImportJob(c echo.Context, namespace, name string) error {
+	result, err := e.handler.GetDataImportJob(c.Request().Context(), namespace, name)
+	if err != nil {
+		e.l.Errorf("GetDataImportJob failed: %v", err)
+		return err
+	}
+	return c.JSON(http.StatusOK, result)
+}
+
+// CancelDataImportJob stops an in-progress DataImportJob.
+func (e *EverestServer) CancelDataImportJob(c echo.Context, namespace, name string) error {
+	if err := e.handler.CancelDataImportJob(c.Request().Context(), namespace, name); err != nil {
+		e.l.Errorf("CancelDataImportJob failed: %v", err)
+		return err
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// DeleteDataImportJob deletes a finished DataImportJob.
+func (e *EverestServer) DeleteDataImportJob(c echo.Context, namespace, name string) error {
+	if err := e.handler.DeleteDataImportJob(c.Request().Context(), namespace, name); err != nil {
+		e.l.Errorf("DeleteDataImportJob failed: %v", err)
+		return err
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// GetDataImportJobProgress returns the progress and the most recent logs of a DataImportJob.
+func (e *EverestServer) GetDataImportJobProgress(c echo.Context, namespace, name string, params api.GetDataImportJobProgressParams) error {
+	result, err := e.handler.GetDataImportJobProgress(c.Request().Context(), namespace, name, &params)
+	if err != nil {
+		e.l.Errorf("GetDataImportJobProgress failed: %v", err)
+		return err
+	}
+	return c.JSON(http.StatusOK, result)
+}
@@ -17,9 +17,13 @@
 package server
 
 import (
+	"errors"
+	"net/http"
+
 	"github.com/AlekSi/pointer"
 	"github.com/labstack/echo/v4"
 
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
 	"github.com/percona/everest/api"
 )
 
@@ -31,3 +35,43 @@ func (e *EverestServer) ListDataImporters(c echo.Context, params api.ListDataImp
 	}
 	return c.JSON(200, list)
 }
+
+// CreateDataImporter registers a new data importer.
+func (e *EverestServer) CreateDataImporter(c echo.Context) error {
+	di := &everestv1alpha1.DataImporter{}
+	if err := e.getBodyFromContext(c, di); err != nil {
+		return errors.Join(errFailedToReadRequestBody, err)
+	}
+
+	result, err := e.handler.CreateDataImporter(c.Request().Context(), di)
+	if err != nil {
+		e.l.Errorf("CreateDataImporter failed: %v", err)
+		return err
+	}
+	return c.JSON(http.StatusOK, result)
+}
+
+// UpdateDataImporter updates an existing data importer.
+func (e *EverestServer) UpdateDataImporter(c echo.Context, name string) error {
+	di := &everestv1alpha1.DataImporter{}
+	if err := e.getBodyFromContext(c, di); err != nil {
+		return errors.Join(errFailedToReadRequestBody, err)
+	}
+	di.SetName(name)
+
+	result, err := e.handler.UpdateDataImporter(c.Request().Context(), di)
+	if err != nil {
+		e.l.Errorf("UpdateDataImporter failed: %v", err)
+		return err
+	}
+	return c.JSON(http.StatusOK, result)
+}
+
+// DeleteDataImporter deletes a data importer.
+func (e *EverestServer) DeleteDataImporter(c echo.Context, name string) error {
+	if err := e.handler.DeleteDataImporter(c.Request().Context(), name); err != nil {
+		e.l.Errorf("DeleteDataImporter failed: %v", err)
+		return err
+	}
+	return c.NoContent(http.StatusNoContent)
+}
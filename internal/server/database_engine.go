@@ -20,9 +20,11 @@ import (
 	"errors"
 	"net/http"
 
+	"github.com/AlekSi/pointer"
 	"github.com/labstack/echo/v4"
 
 	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/percona/everest/api"
 )
 
 // ListDatabaseEngines List of the available database engines on the specified namespace.
@@ -77,11 +79,30 @@ func (e *EverestServer) GetUpgradePlan(
 	return ctx.JSON(http.StatusOK, result)
 }
 
-// ApproveUpgradePlan starts the upgrade of operators in the provided namespace.
+// ApproveUpgradePlan starts the upgrade of operators in the provided namespace,
+// or queues it until the next maintenance window.
 func (e *EverestServer) ApproveUpgradePlan(ctx echo.Context, namespace string) error {
-	if err := e.handler.ApproveUpgradePlan(ctx.Request().Context(), namespace); err != nil {
+	req := &api.UpgradePlanApproval{}
+	if err := e.getBodyFromContext(ctx, req); err != nil {
+		return errors.Join(errFailedToReadRequestBody, err)
+	}
+
+	result, err := e.handler.ApproveUpgradePlan(ctx.Request().Context(), namespace, req)
+	if err != nil {
 		e.l.Errorf("ApproveUpgradePlan failed: %w", err)
 		return err
 	}
-	return nil
+	if pointer.Get(result.Status) == api.Queued {
+		return ctx.JSON(http.StatusAccepted, result)
+	}
+	return ctx.JSON(http.StatusOK, result)
+}
+
+// CancelUpgradePlanApproval cancels the upgrade of operators queued until the next maintenance window.
+func (e *EverestServer) CancelUpgradePlanApproval(ctx echo.Context, namespace string) error {
+	if err := e.handler.CancelUpgradePlanApproval(ctx.Request().Context(), namespace); err != nil {
+		e.l.Errorf("CancelUpgradePlanApproval failed: %w", err)
+		return err
+	}
+	return ctx.NoContent(http.StatusNoContent)
 }
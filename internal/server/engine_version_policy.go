@@ -0,0 +1,50 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/percona/everest/api"
+)
+
+// GetEngineVersionPolicy returns the engine version policy of the given namespace.
+func (e *EverestServer) GetEngineVersionPolicy(c echo.Context, namespace string) error {
+	result, err := e.handler.GetEngineVersionPolicy(c.Request().Context(), namespace)
+	if err != nil {
+		e.l.Errorf("GetEngineVersionPolicy failed: %v", err)
+		return err
+	}
+	return c.JSON(http.StatusOK, result)
+}
+
+// UpdateEngineVersionPolicy replaces the engine version policy of the given namespace.
+func (e *EverestServer) UpdateEngineVersionPolicy(c echo.Context, namespace string) error {
+	req := &api.EngineVersionPolicy{}
+	if err := e.getBodyFromContext(c, req); err != nil {
+		return errors.Join(errFailedToReadRequestBody, err)
+	}
+
+	result, err := e.handler.UpdateEngineVersionPolicy(c.Request().Context(), namespace, req)
+	if err != nil {
+		e.l.Errorf("UpdateEngineVersionPolicy failed: %v", err)
+		return err
+	}
+	return c.JSON(http.StatusOK, result)
+}
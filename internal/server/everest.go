@@ -53,7 +53,9 @@ import (
 	"github.com/percona/everest/pkg/common"
 	"github.com/percona/everest/pkg/kubernetes"
 	"github.com/percona/everest/pkg/oidc"
+	"github.com/percona/everest/pkg/rbac"
 	"github.com/percona/everest/pkg/session"
+	versionservice "github.com/percona/everest/pkg/version_service"
 	"github.com/percona/everest/public"
 )
 
@@ -66,30 +68,25 @@ type EverestServer struct {
 	sessionMgr    *session.Manager
 	attemptsStore *RateLimiterMemoryStore
 	handler       handlers.Handler
-	oidcProvider  *oidc.ProviderConfig
+	oidcProviders *oidc.Providers
+	oidcSettings  oidcSettingsCache
 }
 
-func getOIDCProviderConfig(ctx context.Context, kubeClient kubernetes.KubernetesConnector) (*oidc.ProviderConfig, error) {
+func getOIDCProviders(ctx context.Context, kubeClient kubernetes.KubernetesConnector, l *zap.SugaredLogger) (*oidc.Providers, error) {
 	settings, err := kubeClient.GetEverestSettings(ctx)
 	if client.IgnoreNotFound(err) != nil {
 		return nil, errors.Join(err, errors.New("failed to get Everest settings"))
 	}
 
-	if settings.OIDCConfigRaw == "" {
-		return nil, nil //nolint:nilnil
-	}
-
-	oidcConfig, err := settings.OIDCConfig()
+	oidcConfigs, err := settings.OIDCProviders()
 	if err != nil {
 		return nil, errors.Join(err, errors.New("cannot parse OIDC raw config"))
 	}
-
-	oidcProvider, err := oidc.NewProviderConfig(ctx, oidcConfig.IssuerURL)
-	if err != nil {
-		return nil, errors.Join(err, errors.New("failed to create OIDC provider config"))
+	if len(oidcConfigs) == 0 {
+		return nil, nil //nolint:nilnil
 	}
 
-	return &oidcProvider, nil
+	return oidc.NewProviders(ctx, l, oidcConfigs), nil
 }
 
 // NewEverestServer creates and configures everest API.
@@ -116,12 +113,19 @@ func NewEverestServer(ctx context.Context, c *config.EverestConfig, l *zap.Sugar
 	sessMgr, err := session.New(
 		ctx, l,
 		session.WithAccountManager(sessionManagerClient),
+		session.WithLockoutPolicy(accounts.LockoutPolicy{
+			Threshold: c.LoginLockoutThreshold,
+			Window:    c.LoginLockoutWindow,
+			Duration:  c.LoginLockoutDuration,
+		}),
+		session.WithSessionExpiry(c.SessionTokenExpiry, c.SessionRefreshWindow),
+		session.WithKeyStore(kubeConnector),
 	)
 	if err != nil {
 		return nil, errors.Join(err, errors.New("failed to create session manager"))
 	}
 
-	oidcProvider, err := getOIDCProviderConfig(ctx, kubeConnector)
+	oidcProviders, err := getOIDCProviders(ctx, kubeConnector, l)
 	if err != nil {
 		return nil, errors.Join(err, errors.New("failed to get OIDC provider config"))
 	}
@@ -133,11 +137,25 @@ func NewEverestServer(ctx context.Context, c *config.EverestConfig, l *zap.Sugar
 		kubeConnector: kubeConnector,
 		sessionMgr:    sessMgr,
 		attemptsStore: store,
-		oidcProvider:  oidcProvider,
+		oidcProviders: oidcProviders,
 	}
 	e.echo.HTTPErrorHandler = e.errorHandlerChain()
 
-	if err := e.setupHandlers(ctx, l, kubeConnector, c.VersionServiceURL); err != nil {
+	if oidcProviders != nil {
+		if err := e.oidcSettings.watchOIDCSettings(ctx, kubeConnector, l); err != nil {
+			return nil, err
+		}
+	}
+
+	vsConfig, err := versionservice.NewConfig(c.VersionServiceURL, c.VersionServiceBundle, c.VersionServicePublicKeyPath)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("failed to get version service config"))
+	}
+	if vsConfig.Bundle != "" {
+		l.Infof("Using offline version service metadata bundle %s", vsConfig.Bundle)
+	}
+
+	if err := e.setupHandlers(ctx, l, kubeConnector, vsConfig); err != nil {
 		return nil, err
 	}
 
@@ -171,6 +189,9 @@ func (e *EverestServer) initHTTPServer(ctx context.Context) error {
 		)
 	}, e.securityHeaders())
 
+	// Serve the public keys for verifying the tokens issued by Everest.
+	e.echo.GET(jwksPath, e.getJWKS)
+
 	// Serve static files.
 	fsys, err := fs.Sub(public.Static, "dist")
 	if err != nil {
@@ -212,7 +233,7 @@ func (e *EverestServer) initHTTPServer(ctx context.Context) error {
 	}))
 
 	// Setup and use JWT middleware.
-	jwtMW, err := e.jwtMiddleWare(ctx)
+	jwtMW, err := e.jwtMiddleWare()
 	if err != nil {
 		return err
 	}
@@ -234,9 +255,9 @@ func (e *EverestServer) setupHandlers(
 	ctx context.Context,
 	log *zap.SugaredLogger,
 	kubeConnector kubernetes.KubernetesConnector,
-	vsURL string,
+	vsConfig versionservice.Config,
 ) error {
-	k8sH := k8shandler.New(log, kubeConnector, vsURL)
+	k8sH := k8shandler.New(log, kubeConnector, vsConfig, e.sessionMgr.Sessions())
 	valH := valhandler.New(log, kubeConnector)
 	rbacH, err := rbachandler.New(ctx, log, kubeConnector)
 	if err != nil {
@@ -264,16 +285,7 @@ func newHandlerChain(hs ...handlers.Handler) handlers.Handler { //nolint:ireturn
 	return hs[0]
 }
 
-func (e *EverestServer) newJWTKeyFunc(ctx context.Context) (jwt.Keyfunc, error) {
-	var oidcKeyFn jwt.Keyfunc
-	if e.oidcProvider != nil {
-		fn, err := e.oidcProvider.NewKeyFunc(ctx)
-		if err != nil {
-			return nil, errors.Join(err, errors.New("failed to get OIDC key function"))
-		}
-		oidcKeyFn = fn
-	}
-
+func (e *EverestServer) newJWTKeyFunc() jwt.Keyfunc {
 	return func(token *jwt.Token) (interface{}, error) {
 		claims, ok := token.Claims.(jwt.MapClaims)
 		if !ok {
@@ -287,21 +299,14 @@ func (e *EverestServer) newJWTKeyFunc(ctx context.Context) (jwt.Keyfunc, error)
 		if issuer == session.SessionManagerClaimsIssuer {
 			return e.sessionMgr.KeyFunc()(token)
 		}
-		// XXX: currently we use OIDC only, but once we have multiple protocols supported,
-		// we should have a way to select which KeyFunc to use.
-		if oidcKeyFn != nil {
-			return oidcKeyFn(token)
+		if provider := e.oidcProviders.ForIssuer(issuer); provider != nil {
+			return provider.KeyFunc()(token)
 		}
 		return nil, errors.New("no key found for token")
-	}, nil
-}
-
-func (e *EverestServer) jwtMiddleWare(ctx context.Context) (echo.MiddlewareFunc, error) {
-	keyFunc, err := e.newJWTKeyFunc(ctx)
-	if err != nil {
-		return nil, err
 	}
+}
 
+func (e *EverestServer) jwtMiddleWare() (echo.MiddlewareFunc, error) {
 	skipper, err := newSkipperFunc()
 	if err != nil {
 		return nil, err
@@ -311,19 +316,43 @@ func (e *EverestServer) jwtMiddleWare(ctx context.Context) (echo.MiddlewareFunc,
 	return echojwt.WithConfig(echojwt.Config{
 		Skipper:     skipper,
 		TokenLookup: tokenLookup,
-		KeyFunc:     keyFunc,
+		KeyFunc:     e.newJWTKeyFunc(),
 		ContextKey:  common.UserCtxKey,
 		SuccessHandler: func(c echo.Context) {
 			// The user key exists only in the echo.Context object.
 			// We will copy it to the context.Context as well.
 			ctx := c.Request().Context()
 			newCtx := context.WithValue(ctx, common.UserCtxKey, c.Get(common.UserCtxKey))
+			newCtx = e.withOIDCClaimMapping(newCtx, c.Get(common.UserCtxKey))
 			newReq := c.Request().WithContext(newCtx)
 			c.SetRequest(newReq)
 		},
 	}), nil
 }
 
+// withOIDCClaimMapping returns a copy of ctx that carries the claim mapping of the OIDC provider
+// that issued the token. The mapping is taken from the cached Everest settings, so that its changes apply
+// without a restart, or from the configuration the provider was created with if the settings are not cached.
+func (e *EverestServer) withOIDCClaimMapping(ctx context.Context, value any) context.Context {
+	token, ok := value.(*jwt.Token)
+	if !ok {
+		return ctx
+	}
+	issuer, err := token.Claims.GetIssuer()
+	if err != nil || issuer == session.SessionManagerClaimsIssuer {
+		return ctx
+	}
+	provider := e.oidcProviders.ForIssuer(issuer)
+	if provider == nil {
+		return ctx
+	}
+	oidcConfig, ok := e.oidcSettings.get(provider.Config.Name)
+	if !ok {
+		oidcConfig = provider.Config
+	}
+	return rbac.ContextWithClaimMapping(ctx, rbac.NewClaimMapping(oidcConfig))
+}
+
 func newSkipperFunc() (echomiddleware.Skipper, error) {
 	swagger, err := api.GetSwagger()
 	if err != nil {
@@ -450,13 +479,27 @@ func everestErrorHandler(next echo.HTTPErrorHandler) echo.HTTPErrorHandler {
 			err = &echo.HTTPError{
 				Code: http.StatusConflict,
 			}
+		case errors.Is(err, rbac.ErrPolicyVersionNotFound),
+			errors.Is(err, accounts.ErrAccountNotFound),
+			errors.Is(err, accounts.ErrSessionNotFound):
+			err = &echo.HTTPError{
+				Code:    http.StatusNotFound,
+				Message: err.Error(),
+			}
 		case errors.Is(err, rbachandler.ErrInsufficientPermissions):
 			err = &echo.HTTPError{
 				Code:    http.StatusForbidden,
 				Message: rbachandler.ErrInsufficientPermissions.Error(),
 			}
+		case errors.Is(err, accounts.ErrUserAlreadyExists):
+			err = &echo.HTTPError{
+				Code:    http.StatusConflict,
+				Message: err.Error(),
+			}
 		case errors.Is(err, valhandler.ErrInvalidRequest),
-			errors.Is(err, errFailedToReadRequestBody):
+			errors.Is(err, errFailedToReadRequestBody),
+			errors.Is(err, accounts.ErrPasswordPolicy),
+			errors.Is(err, accounts.ErrPasswordReused):
 			err = &echo.HTTPError{
 				Code:    http.StatusBadRequest,
 				Message: err.Error(),
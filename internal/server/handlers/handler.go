@@ -10,6 +10,7 @@ import (
 
 	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
 	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/telemetry"
 )
 
 // Handler provides an abstraction for the core business logic of the Everest API.
@@ -23,6 +24,8 @@ type Handler interface {
 	SetNext(h Handler)
 
 	NamespacesHandler
+	MaintenanceWindowHandler
+	EngineVersionPolicyHandler
 	DatabaseClusterHandler
 	DatabaseClusterBackupHandler
 	DatabaseClusterRestoreHandler
@@ -32,11 +35,23 @@ type Handler interface {
 	PodSchedulingPolicyHandler
 	DataImporterHandler
 	DataImportJobHandler
+	AccountsHandler
 
 	GetKubernetesClusterResources(ctx context.Context) (*api.KubernetesClusterResources, error)
 	GetKubernetesClusterInfo(ctx context.Context) (*api.KubernetesClusterInfo, error)
 	GetUserPermissions(ctx context.Context) (*api.UserPermissions, error)
+	GetUserPermissionMatrix(ctx context.Context) (*api.UserPermissionMatrix, error)
+	ExplainPermission(ctx context.Context, req *api.PermissionExplanationRequest) (*api.PermissionExplanation, error)
 	GetSettings(ctx context.Context) (*api.Settings, error)
+	GetOIDCClaimMapping(ctx context.Context) (*api.OIDCClaimMapping, error)
+	UpdateOIDCClaimMapping(ctx context.Context, req *api.OIDCClaimMapping) (*api.OIDCClaimMapping, error)
+	ListTemporaryGrants(ctx context.Context) (*api.TemporaryGrants, error)
+	CreateTemporaryGrant(ctx context.Context, req *api.TemporaryGrantRequest) (*api.TemporaryGrant, error)
+	ListRBACPolicyVersions(ctx context.Context) (*api.RBACPolicyVersionList, error)
+	GetRBACPolicyVersion(ctx context.Context, version int) (*api.RBACPolicyVersion, error)
+	DiffRBACPolicyVersions(ctx context.Context, from, to int) (*api.RBACPolicyDiff, error)
+	RollbackRBACPolicy(ctx context.Context, version int) (*api.RBACPolicyVersion, error)
+	GetTelemetry(ctx context.Context) (*telemetry.Telemetry, error)
 }
 
 // DatabaseClusterHandler provides methods for handling operations on database clusters.
@@ -52,11 +67,38 @@ type DatabaseClusterHandler interface {
 	CreateDatabaseClusterSecret(ctx context.Context, namespace, dbName string, secret *corev1.Secret) (*corev1.Secret, error)
 }
 
+// AccountsHandler provides methods for handling operations on the built-in user accounts.
+type AccountsHandler interface {
+	ListAccounts(ctx context.Context) (*api.AccountList, error)
+	CreateAccount(ctx context.Context, req *api.AccountCreateRequest) (*api.Account, error)
+	GetAccount(ctx context.Context, username string) (*api.Account, error)
+	UpdateAccount(ctx context.Context, username string, req *api.AccountUpdateRequest) (*api.Account, error)
+	DeleteAccount(ctx context.Context, username string) error
+	SetAccountPassword(ctx context.Context, username string, req *api.AccountPasswordRequest) error
+	UnlockAccount(ctx context.Context, username string) error
+	ResetAccountMFA(ctx context.Context, username string) error
+	ListUserSessions(ctx context.Context, username string) (*api.UserSessionList, error)
+	DeleteUserSessions(ctx context.Context, username string) error
+	DeleteUserSession(ctx context.Context, username, sessionID string) error
+}
+
 // NamespacesHandler provides methods for handling operations on namespaces.
 type NamespacesHandler interface {
 	ListNamespaces(ctx context.Context) ([]string, error)
 }
 
+// MaintenanceWindowHandler provides methods for handling operations on the maintenance windows of namespaces.
+type MaintenanceWindowHandler interface {
+	GetMaintenanceWindows(ctx context.Context, namespace string) (*api.MaintenanceWindows, error)
+	UpdateMaintenanceWindows(ctx context.Context, namespace string, req *api.MaintenanceWindowsSpec) (*api.MaintenanceWindows, error)
+}
+
+// EngineVersionPolicyHandler provides methods for handling operations on the engine version policies of namespaces.
+type EngineVersionPolicyHandler interface {
+	GetEngineVersionPolicy(ctx context.Context, namespace string) (*api.EngineVersionPolicy, error)
+	UpdateEngineVersionPolicy(ctx context.Context, namespace string, req *api.EngineVersionPolicy) (*api.EngineVersionPolicy, error)
+}
+
 // DatabaseClusterBackupHandler provides methods for handling operations on database cluster backups.
 type DatabaseClusterBackupHandler interface {
 	GetDatabaseClusterBackup(ctx context.Context, namespace, name string) (*everestv1alpha1.DatabaseClusterBackup, error)
@@ -80,7 +122,8 @@ type DatabaseEngineHandler interface {
 	ListDatabaseEngines(ctx context.Context, namespace string) (*everestv1alpha1.DatabaseEngineList, error)
 	GetDatabaseEngine(ctx context.Context, namespace, name string) (*everestv1alpha1.DatabaseEngine, error)
 	GetUpgradePlan(ctx context.Context, namespace string) (*api.UpgradePlan, error)
-	ApproveUpgradePlan(ctx context.Context, namespace string) error
+	ApproveUpgradePlan(ctx context.Context, namespace string, req *api.UpgradePlanApproval) (*api.UpgradePlanApprovalResult, error)
+	CancelUpgradePlanApproval(ctx context.Context, namespace string) error
 }
 
 // BackupStorageHandler provides methods for handling operations on backup storages.
@@ -113,9 +156,17 @@ type PodSchedulingPolicyHandler interface {
 // DataImporterHandler provides methods for handling operations on data importers.
 type DataImporterHandler interface {
 	ListDataImporters(ctx context.Context, supportedEngines ...string) (*everestv1alpha1.DataImporterList, error)
+	CreateDataImporter(ctx context.Context, req *everestv1alpha1.DataImporter) (*everestv1alpha1.DataImporter, error)
+	UpdateDataImporter(ctx context.Context, req *everestv1alpha1.DataImporter) (*everestv1alpha1.DataImporter, error)
+	DeleteDataImporter(ctx context.Context, name string) error
 }
 
 // DataImportJobHandler provides methods for handling operations on data import jobs.
 type DataImportJobHandler interface {
 	ListDataImportJobs(ctx context.Context, namespace, dbName string) (*everestv1alpha1.DataImportJobList, error)
+	CreateDataImportJob(ctx context.Context, req *everestv1alpha1.DataImportJob) (*everestv1alpha1.DataImportJob, error)
+	GetDataImportJob(ctx context.Context, namespace, name string) (*everestv1alpha1.DataImportJob, error)
+	CancelDataImportJob(ctx context.Context, namespace, name string) error
+	DeleteDataImportJob(ctx context.Context, namespace, name string) error
+	GetDataImportJobProgress(ctx context.Context, namespace, name string, params *api.GetDataImportJobProgressParams) (*api.DataImportJobProgress, error)
 }
@@ -0,0 +1,130 @@
+package k8s
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/AlekSi/pointer"
+
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/accounts"
+)
+
+func (h *k8sHandler) ListAccounts(ctx context.Context) (*api.AccountList, error) {
+	list, err := h.kubeConnector.Accounts().List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := &api.AccountList{Items: make([]api.Account, 0, len(list))}
+	for username, account := range list {
+		result.Items = append(result.Items, accountToAPI(username, account))
+	}
+	sort.Slice(result.Items, func(i, j int) bool {
+		return result.Items[i].Username < result.Items[j].Username
+	})
+	return result, nil
+}
+
+func (h *k8sHandler) CreateAccount(ctx context.Context, req *api.AccountCreateRequest) (*api.Account, error) {
+	if err := h.kubeConnector.Accounts().Create(ctx, req.Username, req.Password); err != nil {
+		return nil, err
+	}
+	return h.GetAccount(ctx, req.Username)
+}
+
+func (h *k8sHandler) GetAccount(ctx context.Context, username string) (*api.Account, error) {
+	account, err := h.kubeConnector.Accounts().Get(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+	return pointer.To(accountToAPI(username, account)), nil
+}
+
+func (h *k8sHandler) UpdateAccount(ctx context.Context, username string, req *api.AccountUpdateRequest) (*api.Account, error) {
+	if req.Enabled != nil {
+		if err := h.kubeConnector.Accounts().SetEnabled(ctx, username, *req.Enabled); err != nil {
+			return nil, err
+		}
+	}
+	if req.Capabilities != nil {
+		capabilities := make([]accounts.AccountCapability, 0, len(*req.Capabilities))
+		for _, c := range *req.Capabilities {
+			capabilities = append(capabilities, accounts.AccountCapability(c))
+		}
+		if err := h.kubeConnector.Accounts().SetCapabilities(ctx, username, capabilities); err != nil {
+			return nil, err
+		}
+	}
+	return h.GetAccount(ctx, username)
+}
+
+func (h *k8sHandler) DeleteAccount(ctx context.Context, username string) error {
+	return h.kubeConnector.Accounts().Delete(ctx, username)
+}
+
+func (h *k8sHandler) SetAccountPassword(ctx context.Context, username string, req *api.AccountPasswordRequest) error {
+	return h.kubeConnector.Accounts().SetPassword(ctx, username, req.Password, true)
+}
+
+func (h *k8sHandler) UnlockAccount(ctx context.Context, username string) error {
+	return h.kubeConnector.Accounts().Unlock(ctx, username)
+}
+
+func (h *k8sHandler) ResetAccountMFA(ctx context.Context, username string) error {
+	return h.kubeConnector.Accounts().ResetMFA(ctx, username)
+}
+
+func (h *k8sHandler) ListUserSessions(ctx context.Context, username string) (*api.UserSessionList, error) {
+	sessions, err := h.sessions.List(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+	result := &api.UserSessionList{Items: make([]api.UserSession, 0, len(sessions))}
+	for _, s := range sessions {
+		item := api.UserSession{
+			Id:        s.ID,
+			LoginAt:   s.LoginAt,
+			IssuedAt:  s.IssuedAt,
+			ExpiresAt: s.ExpiresAt,
+		}
+		if s.IP != "" {
+			item.Ip = pointer.To(s.IP)
+		}
+		if s.UserAgent != "" {
+			item.UserAgent = pointer.To(s.UserAgent)
+		}
+		result.Items = append(result.Items, item)
+	}
+	return result, nil
+}
+
+func (h *k8sHandler) DeleteUserSessions(ctx context.Context, username string) error {
+	revoked, err := h.sessions.RevokeAll(ctx, username)
+	if err != nil {
+		return err
+	}
+	h.log.Infof("revoked %d sessions of user '%s'", revoked, username)
+	return nil
+}
+
+func (h *k8sHandler) DeleteUserSession(ctx context.Context, username, sessionID string) error {
+	return h.sessions.Revoke(ctx, username, sessionID)
+}
+
+func accountToAPI(username string, account *accounts.Account) api.Account {
+	result := api.Account{
+		Username:     username,
+		Enabled:      account.Enabled,
+		Capabilities: make([]api.AccountCapabilities, 0, len(account.Capabilities)),
+		Locked:       account.IsLocked(time.Now()),
+		MfaEnabled:   account.MFAEnabled(),
+	}
+	for _, c := range account.Capabilities {
+		result.Capabilities = append(result.Capabilities, api.AccountCapabilities(c))
+	}
+	if t, err := time.Parse(time.RFC3339, account.PasswordMtime); err == nil {
+		result.PasswordUpdatedAt = &t
+	}
+	return result
+}
@@ -1,11 +1,44 @@
 package k8s
 
 import (
+	"bufio"
 	"context"
+	"encoding/json"
+	"slices"
+	"strings"
+
+	"github.com/AlekSi/pointer"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/common"
+)
+
+const (
+	// dataImportProgressLogPrefix marks the log lines in which an importer reports its progress.
+	// The prefix is followed by a JSON object, see dataImportProgressReport.
+	dataImportProgressLogPrefix = "EVEREST_IMPORT_PROGRESS "
+	// defaultDataImportTailLines is the number of log lines returned when not specified in the request.
+	defaultDataImportTailLines = 100
+	// dataImportProgressScanLines is the minimum number of log lines scanned for a progress report.
+	dataImportProgressScanLines = 1000
+	// dataImportMaxLogLineSize is the maximum size of a single log line that can be read.
+	dataImportMaxLogLineSize = 1024 * 1024
 )
 
+// dataImportProgressReport is the progress reported by an importer in its logs.
+type dataImportProgressReport struct {
+	BytesProcessed  *int64 `json:"bytesProcessed,omitempty"`
+	BytesTotal      *int64 `json:"bytesTotal,omitempty"`
+	TablesProcessed *int64 `json:"tablesProcessed,omitempty"`
+	TablesTotal     *int64 `json:"tablesTotal,omitempty"`
+}
+
 // ListDataImportJobs returns a list of DataImportJobs for the specified database clusters.
 func (h *k8sHandler) ListDataImportJobs(ctx context.Context, namespace, dbName string) (*everestv1alpha1.DataImportJobList, error) {
 	result, err := h.kubeConnector.ListDataImportJobs(ctx, namespace, dbName)
@@ -14,3 +47,202 @@ func (h *k8sHandler) ListDataImportJobs(ctx context.Context, namespace, dbName s
 	}
 	return result, nil
 }
+
+// CreateDataImportJob creates a new DataImportJob.
+func (h *k8sHandler) CreateDataImportJob(ctx context.Context, req *everestv1alpha1.DataImportJob) (*everestv1alpha1.DataImportJob, error) {
+	// The label is required for the job to be listed together with the other jobs of the target cluster.
+	labels := req.GetLabels()
+	if labels == nil {
+		labels = make(map[string]string)
+	}
+	labels[common.DatabaseClusterNameLabel] = req.Spec.TargetClusterName
+	req.SetLabels(labels)
+	return h.kubeConnector.CreateDataImportJob(ctx, req)
+}
+
+// GetDataImportJob returns the specified DataImportJob.
+func (h *k8sHandler) GetDataImportJob(ctx context.Context, namespace, name string) (*everestv1alpha1.DataImportJob, error) {
+	return h.kubeConnector.GetDataImportJob(ctx, types.NamespacedName{Namespace: namespace, Name: name})
+}
+
+// CancelDataImportJob stops the specified DataImportJob.
+// The underlying Kubernetes job and its pods are owned by the DataImportJob,
+// so they are removed together with it in the foreground.
+func (h *k8sHandler) CancelDataImportJob(ctx context.Context, namespace, name string) error {
+	delObj := &everestv1alpha1.DataImportJob{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      name,
+		},
+	}
+	return h.kubeConnector.DeleteDataImportJob(ctx, delObj, ctrlclient.PropagationPolicy(metav1.DeletePropagationForeground))
+}
+
+// DeleteDataImportJob deletes the specified DataImportJob.
+func (h *k8sHandler) DeleteDataImportJob(ctx context.Context, namespace, name string) error {
+	delObj := &everestv1alpha1.DataImportJob{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      name,
+		},
+	}
+	return h.kubeConnector.DeleteDataImportJob(ctx, delObj)
+}
+
+// GetDataImportJobProgress returns the progress and the most recent logs of the specified DataImportJob.
+func (h *k8sHandler) GetDataImportJobProgress(
+	ctx context.Context,
+	namespace, name string,
+	params *api.GetDataImportJobProgressParams,
+) (*api.DataImportJobProgress, error) {
+	job, err := h.kubeConnector.GetDataImportJob(ctx, types.NamespacedName{Namespace: namespace, Name: name})
+	if err != nil {
+		return nil, err
+	}
+
+	phase := job.Status.State
+	if phase == "" {
+		phase = everestv1alpha1.DataImportJobStatePending
+	}
+	result := &api.DataImportJobProgress{
+		Phase:   pointer.ToString(string(phase)),
+		Message: pointer.ToString(job.Status.Message),
+	}
+	if t := job.Status.StartedAt; !t.IsZero() {
+		result.StartedAt = pointer.ToTime(t.Time)
+	}
+	if t := job.Status.CompletedAt; !t.IsZero() {
+		result.CompletedAt = pointer.ToTime(t.Time)
+	}
+	if phase == everestv1alpha1.DataImportJobStateSucceeded {
+		result.PercentComplete = pointer.ToFloat32(100) //nolint:mnd
+	}
+
+	pod, err := h.getDataImportJobPod(ctx, job)
+	if err != nil {
+		return nil, err
+	}
+	if pod == nil {
+		return result, nil
+	}
+	result.PodName = pointer.ToString(pod.GetName())
+	if pod.Status.Phase == corev1.PodPending {
+		// The containers have not started yet, so there are no logs.
+		return result, nil
+	}
+
+	tailLines := defaultDataImportTailLines
+	if params != nil && params.TailLines != nil {
+		tailLines = *params.TailLines
+	}
+	logs, report, err := h.readDataImportJobLogs(ctx, pod, tailLines)
+	if err != nil {
+		// The progress is still useful without the logs, e.g. when the pod has just been removed.
+		h.log.Warnf("failed to read logs of pod %s/%s: %v", pod.GetNamespace(), pod.GetName(), err)
+		return result, nil
+	}
+	result.Logs = &logs
+	applyDataImportProgressReport(result, report)
+	return result, nil
+}
+
+// getDataImportJobPod returns the most recent pod of the Kubernetes job running the import.
+// Returns nil if there is no such pod.
+func (h *k8sHandler) getDataImportJobPod(ctx context.Context, job *everestv1alpha1.DataImportJob) (*corev1.Pod, error) {
+	if job.Status.JobName == "" {
+		return nil, nil //nolint:nilnil
+	}
+	pods, err := h.kubeConnector.ListPods(ctx,
+		ctrlclient.InNamespace(job.GetNamespace()),
+		ctrlclient.MatchingLabels{batchv1.JobNameLabel: job.Status.JobName},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if len(pods.Items) == 0 {
+		return nil, nil //nolint:nilnil
+	}
+	// A failed pod is replaced by a new one, only the latest attempt is relevant.
+	pod := slices.MaxFunc(pods.Items, func(a, b corev1.Pod) int {
+		return a.GetCreationTimestamp().Compare(b.GetCreationTimestamp().Time)
+	})
+	return &pod, nil
+}
+
+// readDataImportJobLogs returns the last tailLines lines of the pod logs together with
+// the most recent progress report found in them.
+func (h *k8sHandler) readDataImportJobLogs(ctx context.Context, pod *corev1.Pod, tailLines int) ([]string, *dataImportProgressReport, error) {
+	opts := &corev1.PodLogOptions{
+		TailLines: pointer.ToInt64(int64(max(tailLines, dataImportProgressScanLines))),
+	}
+	if len(pod.Spec.Containers) > 0 {
+		opts.Container = pod.Spec.Containers[0].Name
+	}
+	stream, err := h.kubeConnector.GetPodLogs(ctx, pod.GetNamespace(), pod.GetName(), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer stream.Close() //nolint:errcheck
+
+	var (
+		lines  []string
+		report *dataImportProgressReport
+	)
+	scanner := bufio.NewScanner(stream)
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), dataImportMaxLogLineSize)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if r := parseDataImportProgressReport(line); r != nil {
+			report = r
+		}
+		lines = append(lines, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, nil, err
+	}
+	if len(lines) > tailLines {
+		lines = lines[len(lines)-tailLines:]
+	}
+	return lines, report, nil
+}
+
+// parseDataImportProgressReport parses a progress report from a log line.
+// Returns nil if the line does not contain a valid report.
+func parseDataImportProgressReport(line string) *dataImportProgressReport {
+	_, data, found := strings.Cut(line, dataImportProgressLogPrefix)
+	if !found {
+		return nil
+	}
+	report := &dataImportProgressReport{}
+	if err := json.Unmarshal([]byte(data), report); err != nil {
+		return nil
+	}
+	return report
+}
+
+// applyDataImportProgressReport copies the reported progress into the result
+// and estimates the completion percentage, preferring bytes over tables.
+func applyDataImportProgressReport(result *api.DataImportJobProgress, report *dataImportProgressReport) {
+	if report == nil {
+		return
+	}
+	result.BytesProcessed = report.BytesProcessed
+	result.BytesTotal = report.BytesTotal
+	result.TablesProcessed = report.TablesProcessed
+	result.TablesTotal = report.TablesTotal
+	if result.PercentComplete != nil {
+		return
+	}
+
+	percent := func(processed, total *int64) *float32 {
+		if processed == nil || total == nil || *total <= 0 {
+			return nil
+		}
+		return pointer.ToFloat32(min(float32(*processed)/float32(*total)*100, 100)) //nolint:mnd
+	}
+	if p := percent(report.BytesProcessed, report.BytesTotal); p != nil {
+		result.PercentComplete = p
+		return
+	}
+	result.PercentComplete = percent(report.TablesProcessed, report.TablesTotal)
+}
@@ -18,17 +18,23 @@ package k8s
 import (
 	"context"
 	"testing"
+	"time"
 
+	"github.com/AlekSi/pointer"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/percona/everest/api"
 	"github.com/percona/everest/pkg/common"
 	"github.com/percona/everest/pkg/kubernetes"
+	versionservice "github.com/percona/everest/pkg/version_service"
 )
 
 func TestListDataImportJobs(t *testing.T) {
@@ -124,7 +130,7 @@ func TestListDataImportJobs(t *testing.T) {
 
 			// Create k8s handler with mock client
 			k := kubernetes.NewEmpty(zap.NewNop().Sugar()).WithKubernetesClient(mockClient)
-			k8sH := New(zap.NewNop().Sugar(), k, "")
+			k8sH := New(zap.NewNop().Sugar(), k, versionservice.Config{}, nil)
 
 			// Call the function under test
 			jobList, err := k8sH.ListDataImportJobs(context.Background(), testNamespace, tc.dbName)
@@ -136,3 +142,198 @@ func TestListDataImportJobs(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateDataImportJob(t *testing.T) {
+	t.Parallel()
+
+	const (
+		testNamespace = "test-namespace"
+		testDBName    = "test-db"
+	)
+
+	mockClient := fakeclient.NewClientBuilder().
+		WithScheme(kubernetes.CreateScheme()).
+		Build()
+	k := kubernetes.NewEmpty(zap.NewNop().Sugar()).WithKubernetesClient(mockClient)
+	k8sH := New(zap.NewNop().Sugar(), k, versionservice.Config{}, nil)
+
+	_, err := k8sH.CreateDataImportJob(context.Background(), &everestv1alpha1.DataImportJob{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "job-1",
+			Namespace: testNamespace,
+		},
+		Spec: everestv1alpha1.DataImportJobSpec{
+			TargetClusterName:     testDBName,
+			DataImportJobTemplate: &everestv1alpha1.DataImportJobTemplate{},
+		},
+	})
+	require.NoError(t, err)
+
+	// The created job must be listed together with the other jobs of the target cluster.
+	jobList, err := k8sH.ListDataImportJobs(context.Background(), testNamespace, testDBName)
+	require.NoError(t, err)
+	require.Len(t, jobList.Items, 1)
+	assert.Equal(t, "job-1", jobList.Items[0].GetName())
+}
+
+func TestParseDataImportProgressReport(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name string
+		line string
+		want *dataImportProgressReport
+	}{
+		{
+			name: "regular log line",
+			line: "2025-01-01T00:00:00Z importing table users",
+		},
+		{
+			name: "invalid JSON",
+			line: `EVEREST_IMPORT_PROGRESS {"bytesProcessed": `,
+		},
+		{
+			name: "full report",
+			line: `EVEREST_IMPORT_PROGRESS {"bytesProcessed": 10, "bytesTotal": 40, "tablesProcessed": 1, "tablesTotal": 4}`,
+			want: &dataImportProgressReport{
+				BytesProcessed:  pointer.ToInt64(10),
+				BytesTotal:      pointer.ToInt64(40),
+				TablesProcessed: pointer.ToInt64(1),
+				TablesTotal:     pointer.ToInt64(4),
+			},
+		},
+		{
+			name: "partial report with timestamp prefix",
+			line: `2025-01-01T00:00:00Z EVEREST_IMPORT_PROGRESS {"tablesProcessed": 2}`,
+			want: &dataImportProgressReport{
+				TablesProcessed: pointer.ToInt64(2),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.want, parseDataImportProgressReport(tc.line))
+		})
+	}
+}
+
+func TestApplyDataImportProgressReport(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		report      *dataImportProgressReport
+		succeeded   bool
+		wantPercent *float32
+	}{
+		{
+			name: "no report",
+		},
+		{
+			name: "bytes are preferred over tables",
+			report: &dataImportProgressReport{
+				BytesProcessed:  pointer.ToInt64(10),
+				BytesTotal:      pointer.ToInt64(40),
+				TablesProcessed: pointer.ToInt64(3),
+				TablesTotal:     pointer.ToInt64(4),
+			},
+			wantPercent: pointer.ToFloat32(25),
+		},
+		{
+			name: "tables only",
+			report: &dataImportProgressReport{
+				TablesProcessed: pointer.ToInt64(3),
+				TablesTotal:     pointer.ToInt64(4),
+			},
+			wantPercent: pointer.ToFloat32(75),
+		},
+		{
+			name: "unknown total",
+			report: &dataImportProgressReport{
+				BytesProcessed: pointer.ToInt64(10),
+			},
+		},
+		{
+			name: "finished job is complete",
+			report: &dataImportProgressReport{
+				BytesProcessed: pointer.ToInt64(10),
+				BytesTotal:     pointer.ToInt64(40),
+			},
+			succeeded:   true,
+			wantPercent: pointer.ToFloat32(100),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			result := &api.DataImportJobProgress{}
+			if tc.succeeded {
+				result.PercentComplete = pointer.ToFloat32(100)
+			}
+			applyDataImportProgressReport(result, tc.report)
+			assert.Equal(t, tc.wantPercent, result.PercentComplete)
+		})
+	}
+}
+
+func TestGetDataImportJobProgress(t *testing.T) {
+	t.Parallel()
+
+	const testNamespace = "test-namespace"
+
+	startedAt := metav1.NewTime(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	job := &everestv1alpha1.DataImportJob{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "job-1",
+			Namespace: testNamespace,
+		},
+		Spec: everestv1alpha1.DataImportJobSpec{
+			TargetClusterName:     "test-db",
+			DataImportJobTemplate: &everestv1alpha1.DataImportJobTemplate{},
+		},
+		Status: everestv1alpha1.DataImportJobStatus{
+			State:     everestv1alpha1.DataImportJobStateRunning,
+			StartedAt: &startedAt,
+			JobName:   "job-1-import",
+		},
+	}
+	newPod := func(name string, created time.Time, phase corev1.PodPhase) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         testNamespace,
+				CreationTimestamp: metav1.NewTime(created),
+				Labels: map[string]string{
+					batchv1.JobNameLabel: "job-1-import",
+				},
+			},
+			Status: corev1.PodStatus{
+				Phase: phase,
+			},
+		}
+	}
+
+	mockClient := fakeclient.NewClientBuilder().
+		WithScheme(kubernetes.CreateScheme()).
+		WithObjects(
+			job,
+			newPod("job-1-import-old", startedAt.Time, corev1.PodFailed),
+			newPod("job-1-import-new", startedAt.Add(time.Minute), corev1.PodPending),
+		).
+		Build()
+	k := kubernetes.NewEmpty(zap.NewNop().Sugar()).WithKubernetesClient(mockClient)
+	k8sH := New(zap.NewNop().Sugar(), k, versionservice.Config{}, nil)
+
+	progress, err := k8sH.GetDataImportJobProgress(context.Background(), testNamespace, "job-1", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "Running", pointer.GetString(progress.Phase))
+	assert.True(t, startedAt.Time.Equal(pointer.GetTime(progress.StartedAt)))
+	assert.Nil(t, progress.CompletedAt)
+	assert.Equal(t, "job-1-import-new", pointer.GetString(progress.PodName))
+	assert.Nil(t, progress.Logs)
+	assert.Nil(t, progress.PercentComplete)
+}
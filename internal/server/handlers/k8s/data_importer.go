@@ -4,6 +4,7 @@ import (
 	"context"
 	"slices"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
@@ -34,3 +35,23 @@ func (h *k8sHandler) ListDataImporters(ctx context.Context, supportedEngines ...
 	})
 	return result, nil
 }
+
+// CreateDataImporter creates a new DataImporter.
+func (h *k8sHandler) CreateDataImporter(ctx context.Context, req *everestv1alpha1.DataImporter) (*everestv1alpha1.DataImporter, error) {
+	return h.kubeConnector.CreateDataImporter(ctx, req)
+}
+
+// UpdateDataImporter updates an existing DataImporter.
+func (h *k8sHandler) UpdateDataImporter(ctx context.Context, req *everestv1alpha1.DataImporter) (*everestv1alpha1.DataImporter, error) {
+	return h.kubeConnector.UpdateDataImporter(ctx, req)
+}
+
+// DeleteDataImporter deletes the specified DataImporter.
+func (h *k8sHandler) DeleteDataImporter(ctx context.Context, name string) error {
+	delObj := &everestv1alpha1.DataImporter{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+	}
+	return h.kubeConnector.DeleteDataImporter(ctx, delObj)
+}
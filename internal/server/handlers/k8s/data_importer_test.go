@@ -29,6 +29,7 @@ import (
 
 	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
 	"github.com/percona/everest/pkg/kubernetes"
+	versionservice "github.com/percona/everest/pkg/version_service"
 )
 
 func TestListDataImporters(t *testing.T) {
@@ -178,7 +179,7 @@ func TestListDataImporters(t *testing.T) {
 
 			// Create k8s handler with mock client
 			k := kubernetes.NewEmpty(zap.NewNop().Sugar()).WithKubernetesClient(mockClient)
-			k8sH := New(zap.NewNop().Sugar(), k, "")
+			k8sH := New(zap.NewNop().Sugar(), k, versionservice.Config{}, nil)
 
 			// Call the function under test
 			importerList, err := k8sH.ListDataImporters(context.Background(), tc.supportedEngines...)
@@ -16,6 +16,7 @@ import (
 	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
 	"github.com/percona/everest/pkg/common"
 	"github.com/percona/everest/pkg/kubernetes"
+	versionservice "github.com/percona/everest/pkg/version_service"
 )
 
 func TestLatestRestorableDate(t *testing.T) {
@@ -481,7 +482,7 @@ func TestCreateDatabaseClusterSecret(t *testing.T) {
 
 			// Create k8s handler with mock client
 			k := kubernetes.NewEmpty(zap.NewNop().Sugar()).WithKubernetesClient(mockClient)
-			k8sH := New(zap.NewNop().Sugar(), k, "")
+			k8sH := New(zap.NewNop().Sugar(), k, versionservice.Config{}, nil)
 
 			// Call the function under test
 			createdSecret, err := k8sH.CreateDatabaseClusterSecret(
@@ -7,6 +7,7 @@ import (
 	"slices"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/AlekSi/pointer"
 	"github.com/cenkalti/backoff"
@@ -16,12 +17,15 @@ import (
 
 	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
 	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/maintenance"
+	"github.com/percona/everest/pkg/rbac"
 	versionservice "github.com/percona/everest/pkg/version_service"
 )
 
 var (
 	errDBEngineUpgradeUnavailable   = errors.New("provided target version is not available for upgrade")
 	errDBEngineInvalidTargetVersion = errors.New("invalid target version provided for upgrade")
+	errNoUpgradesAvailable          = errors.New("there are no operator upgrades available")
 )
 
 func (h *k8sHandler) ListDatabaseEngines(ctx context.Context, namespace string) (*everestv1alpha1.DatabaseEngineList, error) {
@@ -44,6 +48,7 @@ func (h *k8sHandler) GetUpgradePlan(ctx context.Context, namespace string) (*api
 	// No upgrades available, so we will check if our clusters are ready for current version.
 	if len(pointer.Get(result.Upgrades)) == 0 {
 		result.PendingActions = pointer.To([]api.UpgradeTask{})
+		result.Impact = pointer.To([]api.UpgradeImpact{})
 		engines, err := h.kubeConnector.ListDatabaseEngines(ctx, ctrlclient.InNamespace(namespace))
 		if err != nil {
 			return nil, err
@@ -65,7 +70,95 @@ func (h *k8sHandler) GetUpgradePlan(ctx context.Context, namespace string) (*api
 	return result, nil
 }
 
-func (h *k8sHandler) ApproveUpgradePlan(ctx context.Context, namespace string) error {
+// ApproveUpgradePlan starts the operator upgrade if a maintenance window is open, otherwise queues it.
+// Approvals that must not be queued are rejected by the validation handler outside of the maintenance windows.
+func (h *k8sHandler) ApproveUpgradePlan(
+	ctx context.Context,
+	namespace string,
+	_ *api.UpgradePlanApproval,
+) (*api.UpgradePlanApprovalResult, error) {
+	config, err := h.kubeConnector.GetMaintenanceConfig(ctx, namespace)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("failed to get maintenance windows"))
+	}
+	now := time.Now().UTC()
+	open, err := config.IsOpen(now)
+	if err != nil {
+		return nil, err
+	}
+	if !open {
+		return h.queueUpgradePlanApproval(ctx, namespace, config, now)
+	}
+
+	if err := h.approveUpgradePlan(ctx, namespace); err != nil {
+		return nil, err
+	}
+	if config.QueuedUpgrade != nil {
+		config.QueuedUpgrade = nil
+		if err := h.kubeConnector.UpdateMaintenanceConfig(ctx, namespace, config); err != nil {
+			return nil, errors.Join(err, errors.New("failed to remove queued upgrade"))
+		}
+	}
+	return &api.UpgradePlanApprovalResult{Status: pointer.To(api.Started)}, nil
+}
+
+// CancelUpgradePlanApproval removes the upgrade that is queued until the next maintenance window.
+func (h *k8sHandler) CancelUpgradePlanApproval(ctx context.Context, namespace string) error {
+	config, err := h.kubeConnector.GetMaintenanceConfig(ctx, namespace)
+	if err != nil {
+		return errors.Join(err, errors.New("failed to get maintenance windows"))
+	}
+	if config.QueuedUpgrade == nil {
+		return nil
+	}
+	config.QueuedUpgrade = nil
+	return h.kubeConnector.UpdateMaintenanceConfig(ctx, namespace, config)
+}
+
+func (h *k8sHandler) queueUpgradePlanApproval(
+	ctx context.Context,
+	namespace string,
+	config *maintenance.Config,
+	now time.Time,
+) (*api.UpgradePlanApprovalResult, error) {
+	up, err := h.getUpgradePlan(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+	if len(pointer.Get(up.Upgrades)) == 0 {
+		return nil, errNoUpgradesAvailable
+	}
+	user, err := rbac.GetUser(ctx)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("failed to get user"))
+	}
+	start, _, err := config.Next(now)
+	if err != nil {
+		return nil, err
+	}
+
+	// The approved versions are recorded, so that the upgrade is not started if the plan changes until the window.
+	targets := make(map[string]string, len(pointer.Get(up.Upgrades)))
+	for _, upgrade := range pointer.Get(up.Upgrades) {
+		targets[pointer.Get(upgrade.Name)] = pointer.Get(upgrade.TargetVersion)
+	}
+	config.QueuedUpgrade = &maintenance.QueuedUpgrade{
+		RequestedBy:       user.Subject,
+		RequestedByGroups: user.Groups,
+		RequestedAt:       now.UTC(),
+		TargetVersions:    targets,
+	}
+	if err := h.kubeConnector.UpdateMaintenanceConfig(ctx, namespace, config); err != nil {
+		return nil, errors.Join(err, errors.New("failed to queue upgrade"))
+	}
+	result := &api.UpgradePlanApprovalResult{Status: pointer.To(api.Queued)}
+	if !start.IsZero() {
+		result.ScheduledAt = pointer.To(start)
+	}
+	return result, nil
+}
+
+func (h *k8sHandler) approveUpgradePlan(ctx context.Context, namespace string) error {
 	up, err := h.getUpgradePlan(ctx, namespace)
 	if err != nil {
 		return err
@@ -124,6 +217,7 @@ func (h *k8sHandler) getUpgradePlan(
 	result := &api.UpgradePlan{
 		Upgrades:       pointer.To([]api.Upgrade{}),
 		PendingActions: pointer.To([]api.UpgradeTask{}),
+		Impact:         pointer.To([]api.UpgradeImpact{}),
 	}
 
 	for _, engine := range engines.Items {
@@ -143,6 +237,7 @@ func (h *k8sHandler) getUpgradePlan(
 			return nil, err
 		}
 		*result.PendingActions = append(*result.PendingActions, pf.databases...)
+		*result.Impact = append(*result.Impact, pf.impact...)
 	}
 	return result, nil
 }
@@ -150,6 +245,7 @@ func (h *k8sHandler) getUpgradePlan(
 type operatorUpgradePreflight struct {
 	currentVersion string
 	databases      []api.UpgradeTask
+	impact         []api.UpgradeImpact
 }
 
 type upgradePreflightCheckArgs struct {
@@ -178,10 +274,14 @@ func (h *k8sHandler) getOperatorUpgradePreflight(
 		return nil, err
 	}
 
+	vs, err := versionservice.NewFromConfig(h.versionServiceConfig, h.kubeConnector)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("failed to create version service client"))
+	}
 	args := upgradePreflightCheckArgs{
 		targetVersion:  targetVersion,
 		engine:         engine,
-		versionService: versionservice.New(h.versionServiceURL),
+		versionService: vs,
 	}
 	result, err := getUpgradePreflightChecksResult(ctx, databases.Items, args)
 	if err != nil {
@@ -200,14 +300,30 @@ func getUpgradePreflightChecksResult(
 		return nil, errDBEngineUpgradeUnavailable
 	}
 
+	// The supported engine versions are the same for every DB, so we fetch them only once.
+	var supportedVersions []string
+	if len(dbs) > 0 {
+		var err error
+		if supportedVersions, err = getSupportedEngineVersions(ctx, args); err != nil {
+			return nil, errors.Join(err, errors.New("failed to validate database engine version for operator upgrade"))
+		}
+	}
+
 	// Perform checks for each given DB.
 	dbResults := make([]api.UpgradeTask, 0, len(dbs))
+	dbImpact := make([]api.UpgradeImpact, 0, len(dbs))
 	for _, db := range dbs {
-		result, err := getUpgradePreflightCheckResultForDatabase(ctx, db, args)
+		result, err := getUpgradePreflightCheckResultForDatabase(db, supportedVersions)
 		if err != nil {
 			return nil, err
 		}
 		dbResults = append(dbResults, result)
+
+		impact, err := getUpgradeImpactForDatabase(db, args.targetVersion, supportedVersions)
+		if err != nil {
+			return nil, err
+		}
+		dbImpact = append(dbImpact, impact)
 	}
 
 	// Sort by name.
@@ -217,9 +333,16 @@ func getUpgradePreflightChecksResult(
 			pointer.Get(dbResults[j].Name),
 		) < 0
 	})
+	sort.Slice(dbImpact, func(i, j int) bool {
+		return strings.Compare(
+			pointer.Get(dbImpact[i].Name),
+			pointer.Get(dbImpact[j].Name),
+		) < 0
+	})
 
 	return &operatorUpgradePreflight{
 		databases:      dbResults,
+		impact:         dbImpact,
 		currentVersion: args.engine.Status.OperatorVersion,
 	}, nil
 }
@@ -240,12 +363,11 @@ func validateOperatorUpgradeVersion(currentVersion, targetVersion string) error
 }
 
 func getUpgradePreflightCheckResultForDatabase(
-	ctx context.Context,
 	database everestv1alpha1.DatabaseCluster,
-	args upgradePreflightCheckArgs,
+	supportedVersions []string,
 ) (api.UpgradeTask, error) {
 	// Check that the database engine is at the desired version.
-	if valid, minReqVer, err := preflightCheckDBEngineVersion(ctx, database, args); err != nil {
+	if valid, minReqVer, err := preflightCheckDBEngineVersion(database, supportedVersions); err != nil {
 		return api.UpgradeTask{},
 			errors.Join(err, errors.New("failed to validate database engine version for operator upgrade"))
 	} else if !valid {
@@ -283,24 +405,30 @@ func getUpgradePreflightCheckResultForDatabase(
 	}, nil
 }
 
-// preflightCheckDBEngineVersion checks that the current database engine version is
-// greater than or equal to the minimum supported version for the target operator version.
-func preflightCheckDBEngineVersion(
-	ctx context.Context,
-	database everestv1alpha1.DatabaseCluster,
-	args upgradePreflightCheckArgs,
-) (bool, string, error) {
+// getSupportedEngineVersions returns the engine versions supported by the target operator version.
+func getSupportedEngineVersions(ctx context.Context, args upgradePreflightCheckArgs) ([]string, error) {
 	engineType := args.engine.Spec.Type
 	operator, found := versionservice.EngineTypeToOperatorName[engineType]
 	if !found {
-		return false, "", fmt.Errorf("unsupported engine type %s", engineType)
+		return nil, fmt.Errorf("unsupported engine type %s", engineType)
 	}
 
 	allSupportedVersions, err := args.versionService.GetSupportedEngineVersions(ctx, operator, args.targetVersion)
 	if err != nil {
-		return false, "", errors.Join(err, errors.New("failed to get supported engine versions"))
+		return nil, errors.Join(err, errors.New("failed to get supported engine versions"))
 	}
+	if len(allSupportedVersions) == 0 {
+		return nil, fmt.Errorf("no minimum supported versions found for %s", operator)
+	}
+	return allSupportedVersions, nil
+}
 
+// preflightCheckDBEngineVersion checks that the current database engine version is
+// greater than or equal to the minimum supported version for the target operator version.
+func preflightCheckDBEngineVersion(
+	database everestv1alpha1.DatabaseCluster,
+	allSupportedVersions []string,
+) (bool, string, error) {
 	currentVersion, err := goversion.NewVersion(database.Spec.Engine.Version)
 	if err != nil {
 		return false, "", err
@@ -322,12 +450,97 @@ func preflightCheckDBEngineVersion(
 	}
 
 	if minSupportedMajVersion == nil {
-		return false, "", fmt.Errorf("no minimum supported versions found for %s", operator)
+		return false, "", fmt.Errorf("no minimum supported versions found for %s", database.Spec.Engine.Type)
 	}
 
 	return currentVersion.GreaterThanOrEqual(minSupportedMajVersion), minSupportedMajVersion.Original(), nil
 }
 
+// getUpgradeImpactForDatabase returns a report of how the operator upgrade affects the given database.
+func getUpgradeImpactForDatabase(
+	database everestv1alpha1.DatabaseCluster,
+	targetVersion string,
+	supportedVersions []string,
+) (api.UpgradeImpact, error) {
+	valid, minReqVer, err := preflightCheckDBEngineVersion(database, supportedVersions)
+	if err != nil {
+		return api.UpgradeImpact{}, err
+	}
+	supported, err := isEngineVersionSupported(database.Spec.Engine.Version, supportedVersions)
+	if err != nil {
+		return api.UpgradeImpact{}, err
+	}
+
+	reasons := []api.UpgradeImpactRestartReasons{}
+	// Once the operator is upgraded, the database needs to be restarted to use the new CRVersion,
+	// unless it already uses it.
+	if currentCRVersion(database) != targetVersion {
+		reasons = append(reasons, api.CrVersionUpdate)
+	}
+	if !valid {
+		reasons = append(reasons, api.EngineUpgrade)
+	}
+	restartPods := 0
+	if len(reasons) > 0 {
+		restartPods = estimateRestartPods(database)
+	}
+
+	return api.UpgradeImpact{
+		Name:                   pointer.To(database.GetName()),
+		EngineType:             pointer.To(string(database.Spec.Engine.Type)),
+		EngineVersion:          pointer.To(database.Spec.Engine.Version),
+		OperatorTargetVersion:  pointer.To(targetVersion),
+		Ready:                  pointer.To(database.Status.Status == everestv1alpha1.AppStateReady),
+		Status:                 pointer.To(string(database.Status.Status)),
+		EngineVersionSupported: pointer.To(supported),
+		RequiresEngineUpgrade:  pointer.To(!valid),
+		MinimumEngineVersion:   pointer.To(minReqVer),
+		RequiresRestart:        pointer.To(len(reasons) > 0),
+		RestartReasons:         &reasons,
+		EstimatedRestartPods:   pointer.To(restartPods),
+	}, nil
+}
+
+// currentCRVersion returns the version of the CR the database uses, or an empty string if it is unknown.
+func currentCRVersion(database everestv1alpha1.DatabaseCluster) string {
+	if crVersion := pointer.Get(database.Spec.Engine.CRVersion); crVersion != "" {
+		return crVersion
+	}
+	return database.Status.CRVersion
+}
+
+// isEngineVersionSupported checks if the given engine version is in the list of supported versions.
+func isEngineVersionSupported(version string, supportedVersions []string) (bool, error) {
+	current, err := goversion.NewVersion(version)
+	if err != nil {
+		return false, err
+	}
+	for _, v := range supportedVersions {
+		supported, err := goversion.NewVersion(v)
+		if err != nil {
+			return false, err
+		}
+		if current.Equal(supported) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// estimateRestartPods returns the number of pods that are restarted during a rolling restart of the database.
+func estimateRestartPods(database everestv1alpha1.DatabaseCluster) int {
+	pods := int(database.Spec.Engine.Replicas)
+	sharding := database.Spec.Sharding
+	if sharding != nil && sharding.Enabled {
+		pods = pods*int(sharding.Shards) + int(sharding.ConfigServer.Replicas)
+	}
+	// Non-sharded PSMDB clusters have no proxy.
+	if database.Spec.Engine.Type == everestv1alpha1.DatabaseEnginePSMDB && (sharding == nil || !sharding.Enabled) {
+		return pods
+	}
+	return pods + int(pointer.Get(database.Spec.Proxy.Replicas))
+}
+
 func (h *k8sHandler) getDBPostUpgradeTasks(
 	ctx context.Context,
 	engine *everestv1alpha1.DatabaseEngine,
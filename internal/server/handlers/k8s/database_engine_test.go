@@ -109,6 +109,10 @@ func TestGetUpgradePreflightChecks(t *testing.T) {
 		assert.Equal(t, "test-db", pointer.Get(dbResult.Name))
 		assert.Equal(t, api.UpgradeEngine, pointer.Get(dbResult.PendingTask))
 		assert.Equal(t, "Upgrade DB version to 0.5.0 or higher", pointer.Get(dbResult.Message))
+		require.Len(t, result.impact, 1)
+		assert.Equal(t, "test-db", pointer.Get(result.impact[0].Name))
+		assert.True(t, pointer.Get(result.impact[0].RequiresEngineUpgrade))
+		assert.False(t, pointer.Get(result.impact[0].EngineVersionSupported))
 	})
 
 	t.Run("pending minor version upgrade", func(t *testing.T) {
@@ -379,3 +383,167 @@ func TestGetUpgradePreflightChecks(t *testing.T) {
 		assert.Equal(t, api.Ready, pointer.Get(dbResult.PendingTask))
 	})
 }
+
+func TestGetUpgradeImpactForDatabase(t *testing.T) {
+	t.Parallel()
+
+	supportedVersions := []string{"8.0.36-28", "8.0.39-30", "8.4.2-2"}
+	newDB := func(version string, status everestv1alpha1.AppState) everestv1alpha1.DatabaseCluster {
+		return everestv1alpha1.DatabaseCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-db",
+				Namespace: "test-namespace",
+			},
+			Spec: everestv1alpha1.DatabaseClusterSpec{
+				Engine: everestv1alpha1.Engine{
+					Type:     everestv1alpha1.DatabaseEnginePXC,
+					Version:  version,
+					Replicas: 3,
+				},
+				Proxy: everestv1alpha1.Proxy{
+					Replicas: pointer.ToInt32(2),
+				},
+			},
+			Status: everestv1alpha1.DatabaseClusterStatus{
+				Status: status,
+			},
+		}
+	}
+
+	testCases := []struct {
+		name string
+		db   everestv1alpha1.DatabaseCluster
+		want api.UpgradeImpact
+	}{
+		{
+			name: "supported version",
+			db:   newDB("8.0.39-30", everestv1alpha1.AppStateReady),
+			want: api.UpgradeImpact{
+				Ready:                  pointer.ToBool(true),
+				Status:                 pointer.ToString(string(everestv1alpha1.AppStateReady)),
+				EngineVersion:          pointer.ToString("8.0.39-30"),
+				EngineVersionSupported: pointer.ToBool(true),
+				RequiresEngineUpgrade:  pointer.ToBool(false),
+				MinimumEngineVersion:   pointer.ToString("8.0.36-28"),
+				RestartReasons:         &[]api.UpgradeImpactRestartReasons{api.CrVersionUpdate},
+			},
+		},
+		{
+			name: "unsupported version above the minimum",
+			db:   newDB("8.0.37-29", everestv1alpha1.AppStateReady),
+			want: api.UpgradeImpact{
+				Ready:                  pointer.ToBool(true),
+				Status:                 pointer.ToString(string(everestv1alpha1.AppStateReady)),
+				EngineVersion:          pointer.ToString("8.0.37-29"),
+				EngineVersionSupported: pointer.ToBool(false),
+				RequiresEngineUpgrade:  pointer.ToBool(false),
+				MinimumEngineVersion:   pointer.ToString("8.0.36-28"),
+				RestartReasons:         &[]api.UpgradeImpactRestartReasons{api.CrVersionUpdate},
+			},
+		},
+		{
+			name: "engine upgrade required on a cluster that is not ready",
+			db:   newDB("8.0.35-27", everestv1alpha1.AppStateInit),
+			want: api.UpgradeImpact{
+				Ready:                  pointer.ToBool(false),
+				Status:                 pointer.ToString(string(everestv1alpha1.AppStateInit)),
+				EngineVersion:          pointer.ToString("8.0.35-27"),
+				EngineVersionSupported: pointer.ToBool(false),
+				RequiresEngineUpgrade:  pointer.ToBool(true),
+				MinimumEngineVersion:   pointer.ToString("8.0.36-28"),
+				RestartReasons:         &[]api.UpgradeImpactRestartReasons{api.CrVersionUpdate, api.EngineUpgrade},
+			},
+		},
+		{
+			name: "already at the target CR version",
+			db: func() everestv1alpha1.DatabaseCluster {
+				db := newDB("8.0.39-30", everestv1alpha1.AppStateReady)
+				db.Status.CRVersion = "1.16.0"
+				return db
+			}(),
+			want: api.UpgradeImpact{
+				Ready:                  pointer.ToBool(true),
+				Status:                 pointer.ToString(string(everestv1alpha1.AppStateReady)),
+				EngineVersion:          pointer.ToString("8.0.39-30"),
+				EngineVersionSupported: pointer.ToBool(true),
+				RequiresEngineUpgrade:  pointer.ToBool(false),
+				MinimumEngineVersion:   pointer.ToString("8.0.36-28"),
+				RequiresRestart:        pointer.ToBool(false),
+				RestartReasons:         &[]api.UpgradeImpactRestartReasons{},
+				EstimatedRestartPods:   pointer.ToInt(0),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			tc.want.Name = pointer.ToString("test-db")
+			tc.want.EngineType = pointer.ToString(string(everestv1alpha1.DatabaseEnginePXC))
+			tc.want.OperatorTargetVersion = pointer.ToString("1.16.0")
+			if tc.want.RequiresRestart == nil {
+				tc.want.RequiresRestart = pointer.ToBool(true)
+				tc.want.EstimatedRestartPods = pointer.ToInt(5)
+			}
+
+			impact, err := getUpgradeImpactForDatabase(tc.db, "1.16.0", supportedVersions)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, impact)
+		})
+	}
+}
+
+func TestEstimateRestartPods(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name string
+		spec everestv1alpha1.DatabaseClusterSpec
+		want int
+	}{
+		{
+			name: "pxc with proxies",
+			spec: everestv1alpha1.DatabaseClusterSpec{
+				Engine: everestv1alpha1.Engine{Type: everestv1alpha1.DatabaseEnginePXC, Replicas: 3},
+				Proxy:  everestv1alpha1.Proxy{Replicas: pointer.ToInt32(3)},
+			},
+			want: 6,
+		},
+		{
+			name: "postgresql without proxy replicas",
+			spec: everestv1alpha1.DatabaseClusterSpec{
+				Engine: everestv1alpha1.Engine{Type: everestv1alpha1.DatabaseEnginePostgresql, Replicas: 1},
+			},
+			want: 1,
+		},
+		{
+			name: "psmdb replica set",
+			spec: everestv1alpha1.DatabaseClusterSpec{
+				Engine: everestv1alpha1.Engine{Type: everestv1alpha1.DatabaseEnginePSMDB, Replicas: 3},
+				Proxy:  everestv1alpha1.Proxy{Replicas: pointer.ToInt32(3)},
+			},
+			want: 3,
+		},
+		{
+			name: "sharded psmdb",
+			spec: everestv1alpha1.DatabaseClusterSpec{
+				Engine: everestv1alpha1.Engine{Type: everestv1alpha1.DatabaseEnginePSMDB, Replicas: 3},
+				Proxy:  everestv1alpha1.Proxy{Replicas: pointer.ToInt32(2)},
+				Sharding: &everestv1alpha1.Sharding{
+					Enabled:      true,
+					Shards:       2,
+					ConfigServer: everestv1alpha1.ConfigServer{Replicas: 3},
+				},
+			},
+			want: 11,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.want, estimateRestartPods(everestv1alpha1.DatabaseCluster{Spec: tc.spec}))
+		})
+	}
+}
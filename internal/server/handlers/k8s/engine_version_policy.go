@@ -0,0 +1,70 @@
+package k8s
+
+import (
+	"context"
+
+	"github.com/AlekSi/pointer"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/versionpolicy"
+)
+
+func (h *k8sHandler) GetEngineVersionPolicy(ctx context.Context, namespace string) (*api.EngineVersionPolicy, error) {
+	policy, err := h.kubeConnector.GetEngineVersionPolicy(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+	return engineVersionPolicyToAPI(policy), nil
+}
+
+func (h *k8sHandler) UpdateEngineVersionPolicy(
+	ctx context.Context,
+	namespace string,
+	req *api.EngineVersionPolicy,
+) (*api.EngineVersionPolicy, error) {
+	policy := engineVersionPolicyFromAPI(req)
+	if err := h.kubeConnector.UpdateEngineVersionPolicy(ctx, namespace, policy); err != nil {
+		return nil, err
+	}
+	return engineVersionPolicyToAPI(policy), nil
+}
+
+// engineVersionPolicyFromAPI converts the API representation of an engine version policy to the internal one.
+func engineVersionPolicyFromAPI(in *api.EngineVersionPolicy) *versionpolicy.Policy {
+	patterns := func(list *[]api.EngineVersionPattern) []versionpolicy.Pattern {
+		result := make([]versionpolicy.Pattern, 0, len(pointer.Get(list)))
+		for _, p := range pointer.Get(list) {
+			result = append(result, versionpolicy.Pattern{
+				EngineType: everestv1alpha1.EngineType(pointer.Get(p.EngineType)),
+				Version:    p.Version,
+			})
+		}
+		return result
+	}
+	return &versionpolicy.Policy{
+		RecommendedOnly: pointer.Get(in.RecommendedOnly),
+		Allowed:         patterns(in.Allowed),
+		Denied:          patterns(in.Denied),
+	}
+}
+
+// engineVersionPolicyToAPI converts an engine version policy to its API representation.
+func engineVersionPolicyToAPI(in *versionpolicy.Policy) *api.EngineVersionPolicy {
+	patterns := func(list []versionpolicy.Pattern) *[]api.EngineVersionPattern {
+		result := make([]api.EngineVersionPattern, 0, len(list))
+		for _, p := range list {
+			pattern := api.EngineVersionPattern{Version: p.Version}
+			if p.EngineType != "" {
+				pattern.EngineType = pointer.To(string(p.EngineType))
+			}
+			result = append(result, pattern)
+		}
+		return &result
+	}
+	return &api.EngineVersionPolicy{
+		RecommendedOnly: pointer.To(in.RecommendedOnly),
+		Allowed:         patterns(in.Allowed),
+		Denied:          patterns(in.Denied),
+	}
+}
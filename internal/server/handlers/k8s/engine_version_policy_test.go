@@ -0,0 +1,50 @@
+package k8s
+
+import (
+	"context"
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/kubernetes"
+	versionservice "github.com/percona/everest/pkg/version_service"
+)
+
+func TestEngineVersionPolicy(t *testing.T) {
+	t.Parallel()
+
+	const testNamespace = "test-ns"
+
+	mockClient := fakeclient.NewClientBuilder().
+		WithScheme(kubernetes.CreateScheme()).
+		Build()
+	k := kubernetes.NewEmpty(zap.NewNop().Sugar()).WithKubernetesClient(mockClient)
+	k8sH := New(zap.NewNop().Sugar(), k, versionservice.Config{}, nil)
+	ctx := context.Background()
+
+	// No policy configured.
+	policy, err := k8sH.GetEngineVersionPolicy(ctx, testNamespace)
+	require.NoError(t, err)
+	assert.False(t, pointer.Get(policy.RecommendedOnly))
+	assert.Empty(t, pointer.Get(policy.Allowed))
+	assert.Empty(t, pointer.Get(policy.Denied))
+
+	// Configure a policy.
+	req := &api.EngineVersionPolicy{
+		RecommendedOnly: pointer.ToBool(true),
+		Allowed:         &[]api.EngineVersionPattern{{Version: "8.0.*"}},
+		Denied:          &[]api.EngineVersionPattern{{EngineType: pointer.ToString("pxc"), Version: "8.0.35*"}},
+	}
+	policy, err = k8sH.UpdateEngineVersionPolicy(ctx, testNamespace, req)
+	require.NoError(t, err)
+	assert.Equal(t, req, policy)
+
+	policy, err = k8sH.GetEngineVersionPolicy(ctx, testNamespace)
+	require.NoError(t, err)
+	assert.Equal(t, req, policy)
+}
@@ -5,24 +5,34 @@ import (
 
 	"github.com/percona/everest/internal/server/handlers"
 	"github.com/percona/everest/pkg/kubernetes"
+	"github.com/percona/everest/pkg/session"
+	versionservice "github.com/percona/everest/pkg/version_service"
 )
 
 // k8sHandler is usually the last handler in the chain, so it does not have a next handler.
 type k8sHandler struct {
-	kubeConnector     kubernetes.KubernetesConnector
-	log               *zap.SugaredLogger
-	versionServiceURL string
+	kubeConnector        kubernetes.KubernetesConnector
+	log                  *zap.SugaredLogger
+	versionServiceConfig versionservice.Config
+	// sessions is the registry of the login sessions of the built-in accounts shared with the session manager.
+	sessions *session.SessionRegistry
 }
 
 // New returns a new RBAC handler.
 //
 //nolint:ireturn
-func New(log *zap.SugaredLogger, kubeConnector kubernetes.KubernetesConnector, vsURL string) handlers.Handler {
+func New(
+	log *zap.SugaredLogger,
+	kubeConnector kubernetes.KubernetesConnector,
+	vsConfig versionservice.Config,
+	sessions *session.SessionRegistry,
+) handlers.Handler {
 	l := log.With("handler", "k8s")
 	return &k8sHandler{
-		kubeConnector:     kubeConnector,
-		log:               l,
-		versionServiceURL: vsURL,
+		kubeConnector:        kubeConnector,
+		log:                  l,
+		versionServiceConfig: vsConfig,
+		sessions:             sessions,
 	}
 }
 
@@ -83,6 +83,29 @@ func (h *k8sHandler) GetUserPermissions(ctx context.Context) (*api.UserPermissio
 	}, nil
 }
 
+func (h *k8sHandler) GetUserPermissionMatrix(ctx context.Context) (*api.UserPermissionMatrix, error) {
+	perms, err := h.GetUserPermissions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &api.UserPermissionMatrix{
+		Enabled:   perms.Enabled,
+		Resources: []api.ResourcePermissions{},
+	}, nil
+}
+
+func (h *k8sHandler) ExplainPermission(ctx context.Context, _ *api.PermissionExplanationRequest) (*api.PermissionExplanation, error) {
+	perms, err := h.GetUserPermissions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &api.PermissionExplanation{
+		Enabled:  perms.Enabled,
+		Policies: [][]string{},
+		Roles:    [][]string{},
+	}, nil
+}
+
 func (h *k8sHandler) GetSettings(ctx context.Context) (*api.Settings, error) {
 	settings, err := h.kubeConnector.GetEverestSettings(ctx)
 	if err != nil && !k8serrors.IsNotFound(err) {
@@ -92,13 +115,43 @@ func (h *k8sHandler) GetSettings(ctx context.Context) (*api.Settings, error) {
 	if err != nil {
 		return nil, errors.Join(err, errors.New("cannot parse OIDC raw config"))
 	}
-	return &api.Settings{
-		OidcConfig: api.OIDCConfig{
-			ClientId:  config.ClientID,
-			IssuerURL: config.IssuerURL,
-			Scopes:    config.Scopes,
-		},
-	}, nil
+	providers, err := settings.OIDCProviders()
+	if err != nil {
+		return nil, errors.Join(err, errors.New("cannot parse OIDC raw config"))
+	}
+	oidcProviders := make([]api.OIDCConfig, 0, len(providers))
+	for _, provider := range providers {
+		oidcProviders = append(oidcProviders, oidcConfigToAPI(provider))
+	}
+	result := &api.Settings{
+		OidcConfig:    oidcConfigToAPI(config),
+		OidcProviders: &oidcProviders,
+	}
+	// The first provider is the one to use by the clients that support a single provider only.
+	if len(providers) > 0 {
+		result.OidcConfig = oidcProviders[0]
+	}
+	return result, nil
+}
+
+func oidcConfigToAPI(config common.OIDCConfig) api.OIDCConfig {
+	claims := claimMappingToAPI(rbac.NewClaimMapping(config))
+	result := api.OIDCConfig{
+		ClientId:       config.ClientID,
+		IssuerURL:      config.IssuerURL,
+		Scopes:         config.Scopes,
+		UsernameClaim:  claims.UsernameClaim,
+		UsernamePrefix: claims.UsernamePrefix,
+		GroupsClaims:   claims.GroupsClaims,
+		GroupsPrefix:   claims.GroupsPrefix,
+	}
+	if config.Name != "" {
+		result.Name = pointer.ToString(config.Name)
+	}
+	if len(config.Audiences) > 0 {
+		result.Audiences = pointer.To(config.Audiences)
+	}
+	return result
 }
 
 func storageClasses(storagesList *storagev1.StorageClassList) []string {
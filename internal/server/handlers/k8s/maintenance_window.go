@@ -0,0 +1,82 @@
+package k8s
+
+import (
+	"context"
+	"time"
+
+	"github.com/AlekSi/pointer"
+
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/maintenance"
+)
+
+func (h *k8sHandler) GetMaintenanceWindows(ctx context.Context, namespace string) (*api.MaintenanceWindows, error) {
+	config, err := h.kubeConnector.GetMaintenanceConfig(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+	return maintenanceWindowsToAPI(config, time.Now().UTC())
+}
+
+func (h *k8sHandler) UpdateMaintenanceWindows(
+	ctx context.Context,
+	namespace string,
+	req *api.MaintenanceWindowsSpec,
+) (*api.MaintenanceWindows, error) {
+	config, err := h.kubeConnector.GetMaintenanceConfig(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+	config.Windows = maintenanceWindowsFromAPI(req.Windows)
+	if err := h.kubeConnector.UpdateMaintenanceConfig(ctx, namespace, config); err != nil {
+		return nil, err
+	}
+	return maintenanceWindowsToAPI(config, time.Now().UTC())
+}
+
+// maintenanceWindowsFromAPI converts the API representation of maintenance windows to the internal one.
+func maintenanceWindowsFromAPI(windows []api.MaintenanceWindow) []maintenance.Window {
+	result := make([]maintenance.Window, 0, len(windows))
+	for _, w := range windows {
+		result = append(result, maintenance.Window{
+			Name:     w.Name,
+			Schedule: w.Schedule,
+			Duration: w.Duration,
+		})
+	}
+	return result
+}
+
+func maintenanceWindowsToAPI(config *maintenance.Config, now time.Time) (*api.MaintenanceWindows, error) {
+	open, err := config.IsOpen(now)
+	if err != nil {
+		return nil, err
+	}
+	start, end, err := config.Next(now)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &api.MaintenanceWindows{
+		Windows: make([]api.MaintenanceWindow, 0, len(config.Windows)),
+		Open:    open,
+	}
+	for _, w := range config.Windows {
+		result.Windows = append(result.Windows, api.MaintenanceWindow{
+			Name:     w.Name,
+			Schedule: w.Schedule,
+			Duration: w.Duration,
+		})
+	}
+	if !start.IsZero() {
+		result.NextWindowStart = pointer.To(start)
+		result.NextWindowEnd = pointer.To(end)
+	}
+	if q := config.QueuedUpgrade; q != nil {
+		result.QueuedUpgrade = &api.QueuedUpgrade{
+			RequestedBy: pointer.To(q.RequestedBy),
+			RequestedAt: pointer.To(q.RequestedAt),
+		}
+	}
+	return result, nil
+}
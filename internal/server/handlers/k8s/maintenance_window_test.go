@@ -0,0 +1,144 @@
+package k8s
+
+import (
+	"context"
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/kubernetes"
+	"github.com/percona/everest/pkg/maintenance"
+	versionservice "github.com/percona/everest/pkg/version_service"
+)
+
+func TestMaintenanceWindows(t *testing.T) {
+	t.Parallel()
+
+	const testNamespace = "test-ns"
+
+	mockClient := fakeclient.NewClientBuilder().
+		WithScheme(kubernetes.CreateScheme()).
+		Build()
+	k := kubernetes.NewEmpty(zap.NewNop().Sugar()).WithKubernetesClient(mockClient)
+	k8sH := New(zap.NewNop().Sugar(), k, versionservice.Config{}, nil)
+	ctx := context.Background()
+
+	// No maintenance windows configured.
+	windows, err := k8sH.GetMaintenanceWindows(ctx, testNamespace)
+	require.NoError(t, err)
+	assert.True(t, windows.Open)
+	assert.Empty(t, windows.Windows)
+	assert.Nil(t, windows.NextWindowStart)
+
+	// Configure a window.
+	req := &api.MaintenanceWindowsSpec{
+		Windows: []api.MaintenanceWindow{
+			{Name: "leap-day", Schedule: "0 0 29 2 *", Duration: "1m"},
+		},
+	}
+	windows, err = k8sH.UpdateMaintenanceWindows(ctx, testNamespace, req)
+	require.NoError(t, err)
+	assert.Equal(t, req.Windows, windows.Windows)
+	require.NotNil(t, windows.NextWindowStart)
+	assert.Equal(t, 29, windows.NextWindowStart.Day())
+
+	windows, err = k8sH.GetMaintenanceWindows(ctx, testNamespace)
+	require.NoError(t, err)
+	assert.Equal(t, req.Windows, windows.Windows)
+}
+
+func TestQueueUpgradePlanApproval(t *testing.T) {
+	t.Parallel()
+
+	const testNamespace = "test-ns"
+
+	windows := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      maintenance.ConfigMapName,
+			Namespace: testNamespace,
+		},
+		Data: map[string]string{
+			"windows": "- name: leap-day\n  schedule: 0 0 29 2 *\n  duration: 1m\n",
+		},
+	}
+	engine := &everestv1alpha1.DatabaseEngine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      common.MySQLOperatorName,
+			Namespace: testNamespace,
+		},
+		Spec: everestv1alpha1.DatabaseEngineSpec{
+			Type: everestv1alpha1.DatabaseEnginePXC,
+		},
+		Status: everestv1alpha1.DatabaseEngineStatus{
+			OperatorVersion: "1.15.0",
+			PendingOperatorUpgrades: []everestv1alpha1.OperatorUpgrade{
+				{TargetVersion: "1.16.0"},
+			},
+		},
+	}
+	ctx := context.WithValue(context.Background(), common.UserCtxKey, &jwt.Token{
+		Claims: jwt.MapClaims{"sub": "bob", "iss": "test"},
+	})
+
+	newHandler := func(objs ...ctrlclient.Object) (*k8sHandler, kubernetes.KubernetesConnector) {
+		mockClient := fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.CreateScheme()).
+			WithObjects(objs...).
+			Build()
+		k := kubernetes.NewEmpty(zap.NewNop().Sugar()).WithKubernetesClient(mockClient)
+		return New(zap.NewNop().Sugar(), k, versionservice.Config{}, nil).(*k8sHandler), k
+	}
+
+	t.Run("queue and cancel", func(t *testing.T) {
+		t.Parallel()
+
+		h, k := newHandler(windows.DeepCopy(), engine.DeepCopy())
+		result, err := h.ApproveUpgradePlan(ctx, testNamespace, &api.UpgradePlanApproval{
+			Schedule: pointer.To(api.UpgradePlanApprovalScheduleMaintenanceWindow),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, api.Queued, pointer.Get(result.Status))
+		require.NotNil(t, result.ScheduledAt)
+		assert.Equal(t, 29, result.ScheduledAt.Day())
+
+		config, err := k.GetMaintenanceConfig(ctx, testNamespace)
+		require.NoError(t, err)
+		require.NotNil(t, config.QueuedUpgrade)
+		assert.Equal(t, "bob", config.QueuedUpgrade.RequestedBy)
+		assert.Equal(t, map[string]string{common.MySQLOperatorName: "1.16.0"}, config.QueuedUpgrade.TargetVersions)
+
+		mw, err := h.GetMaintenanceWindows(ctx, testNamespace)
+		require.NoError(t, err)
+		require.NotNil(t, mw.QueuedUpgrade)
+		assert.Equal(t, "bob", pointer.Get(mw.QueuedUpgrade.RequestedBy))
+
+		require.NoError(t, h.CancelUpgradePlanApproval(ctx, testNamespace))
+		config, err = k.GetMaintenanceConfig(ctx, testNamespace)
+		require.NoError(t, err)
+		assert.Nil(t, config.QueuedUpgrade)
+		assert.Len(t, config.Windows, 1)
+	})
+
+	t.Run("nothing to upgrade", func(t *testing.T) {
+		t.Parallel()
+
+		upToDate := engine.DeepCopy()
+		upToDate.Status.PendingOperatorUpgrades = nil
+		h, _ := newHandler(windows.DeepCopy(), upToDate)
+		_, err := h.ApproveUpgradePlan(ctx, testNamespace, &api.UpgradePlanApproval{
+			Schedule: pointer.To(api.UpgradePlanApprovalScheduleMaintenanceWindow),
+		})
+		assert.ErrorIs(t, err, errNoUpgradesAvailable)
+	})
+}
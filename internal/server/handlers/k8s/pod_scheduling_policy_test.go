@@ -32,6 +32,7 @@ import (
 	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
 	"github.com/percona/everest/api"
 	"github.com/percona/everest/pkg/kubernetes"
+	versionservice "github.com/percona/everest/pkg/version_service"
 )
 
 func getDefaultPXCPolicy() *everestv1alpha1.PodSchedulingPolicy {
@@ -637,7 +638,7 @@ func TestValidate_ListPodSchedulingPolicy(t *testing.T) {
 				Build()
 
 			k := kubernetes.NewEmpty(zap.NewNop().Sugar()).WithKubernetesClient(mockClient)
-			k8sH := New(zap.NewNop().Sugar(), k, "")
+			k8sH := New(zap.NewNop().Sugar(), k, versionservice.Config{}, nil)
 
 			pspList, err := k8sH.ListPodSchedulingPolicies(context.Background(), tc.listParams)
 			require.NoError(t, err)
@@ -0,0 +1,73 @@
+package k8s
+
+import (
+	"context"
+	"errors"
+
+	"github.com/AlekSi/pointer"
+
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/rbac"
+)
+
+func (h *k8sHandler) ListRBACPolicyVersions(ctx context.Context) (*api.RBACPolicyVersionList, error) {
+	history, err := rbac.GetPolicyHistory(ctx, h.kubeConnector)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("failed to get RBAC policy history"))
+	}
+	result := &api.RBACPolicyVersionList{Items: make([]api.RBACPolicyVersion, 0, len(history.Versions))}
+	for _, v := range history.Versions {
+		item := policyVersionToAPI(v)
+		// The content of the policy is only returned for a single version.
+		item.Policy = nil
+		result.Items = append(result.Items, *item)
+	}
+	return result, nil
+}
+
+func (h *k8sHandler) GetRBACPolicyVersion(ctx context.Context, version int) (*api.RBACPolicyVersion, error) {
+	history, err := rbac.GetPolicyHistory(ctx, h.kubeConnector)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("failed to get RBAC policy history"))
+	}
+	v, err := history.Get(version)
+	if err != nil {
+		return nil, err
+	}
+	return policyVersionToAPI(v), nil
+}
+
+func (h *k8sHandler) DiffRBACPolicyVersions(ctx context.Context, from, to int) (*api.RBACPolicyDiff, error) {
+	history, err := rbac.GetPolicyHistory(ctx, h.kubeConnector)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("failed to get RBAC policy history"))
+	}
+	lines, err := history.Diff(from, to)
+	if err != nil {
+		return nil, err
+	}
+	return &api.RBACPolicyDiff{From: from, To: to, Lines: lines}, nil
+}
+
+func (h *k8sHandler) RollbackRBACPolicy(ctx context.Context, version int) (*api.RBACPolicyVersion, error) {
+	user, err := rbac.GetUser(ctx)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("failed to get user"))
+	}
+	v, err := rbac.RollbackPolicy(ctx, h.kubeConnector, version, user.Subject)
+	if err != nil {
+		return nil, err
+	}
+	return policyVersionToAPI(v), nil
+}
+
+func policyVersionToAPI(v rbac.PolicyVersion) *api.RBACPolicyVersion {
+	return &api.RBACPolicyVersion{
+		Version:   v.Version,
+		Author:    v.Author,
+		Timestamp: v.Timestamp,
+		Enabled:   v.Enabled,
+		Valid:     v.Valid,
+		Policy:    pointer.To(v.Policy),
+	}
+}
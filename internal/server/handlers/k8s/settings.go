@@ -0,0 +1,57 @@
+package k8s
+
+import (
+	"context"
+	"errors"
+
+	"github.com/AlekSi/pointer"
+
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/rbac"
+)
+
+func (h *k8sHandler) GetOIDCClaimMapping(ctx context.Context) (*api.OIDCClaimMapping, error) {
+	settings, err := h.kubeConnector.GetEverestSettings(ctx)
+	if err != nil {
+		return nil, err
+	}
+	config, err := settings.OIDCConfig()
+	if err != nil {
+		return nil, errors.Join(err, errors.New("cannot parse OIDC raw config"))
+	}
+	return claimMappingToAPI(rbac.NewClaimMapping(config)), nil
+}
+
+func (h *k8sHandler) UpdateOIDCClaimMapping(ctx context.Context, req *api.OIDCClaimMapping) (*api.OIDCClaimMapping, error) {
+	settings, err := h.kubeConnector.GetEverestSettings(ctx)
+	if err != nil {
+		return nil, err
+	}
+	config, err := settings.OIDCConfig()
+	if err != nil {
+		return nil, errors.Join(err, errors.New("cannot parse OIDC raw config"))
+	}
+	config.UsernameClaim = pointer.Get(req.UsernameClaim)
+	config.UsernamePrefix = pointer.Get(req.UsernamePrefix)
+	config.GroupsClaims = pointer.Get(req.GroupsClaims)
+	config.GroupsPrefix = pointer.Get(req.GroupsPrefix)
+
+	raw, err := config.Raw()
+	if err != nil {
+		return nil, err
+	}
+	settings.OIDCConfigRaw = raw
+	if err := h.kubeConnector.UpdateEverestSettings(ctx, settings); err != nil {
+		return nil, err
+	}
+	return claimMappingToAPI(rbac.NewClaimMapping(config)), nil
+}
+
+func claimMappingToAPI(m rbac.ClaimMapping) *api.OIDCClaimMapping {
+	return &api.OIDCClaimMapping{
+		UsernameClaim:  pointer.To(m.UsernameClaim),
+		UsernamePrefix: pointer.To(m.UsernamePrefix),
+		GroupsClaims:   pointer.To(m.GroupsClaims),
+		GroupsPrefix:   pointer.To(m.GroupsPrefix),
+	}
+}
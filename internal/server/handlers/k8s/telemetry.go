@@ -0,0 +1,12 @@
+package k8s
+
+import (
+	"context"
+
+	"github.com/percona/everest/pkg/telemetry"
+	"github.com/percona/everest/pkg/version"
+)
+
+func (h *k8sHandler) GetTelemetry(ctx context.Context) (*telemetry.Telemetry, error) {
+	return telemetry.Collect(ctx, h.kubeConnector, version.Version)
+}
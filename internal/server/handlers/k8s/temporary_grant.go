@@ -0,0 +1,72 @@
+package k8s
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/AlekSi/pointer"
+
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/rbac"
+)
+
+func (h *k8sHandler) ListTemporaryGrants(ctx context.Context) (*api.TemporaryGrants, error) {
+	grants, err := rbac.GetGrants(ctx, h.kubeConnector)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("failed to get temporary grants"))
+	}
+	result := &api.TemporaryGrants{
+		Grants:  make([]api.TemporaryGrant, 0, len(grants.Grants)),
+		History: make([]api.TemporaryGrantEvent, 0, len(grants.History)),
+	}
+	for _, grant := range grants.Active(time.Now()) {
+		result.Grants = append(result.Grants, *temporaryGrantToAPI(grant))
+	}
+	for _, e := range grants.History {
+		event := api.TemporaryGrantEvent{
+			Time:   e.Time,
+			Action: api.TemporaryGrantEventAction(e.Action),
+			Grant:  *temporaryGrantToAPI(e.Grant),
+		}
+		if e.Actor != "" {
+			event.Actor = pointer.To(e.Actor)
+		}
+		result.History = append(result.History, event)
+	}
+	return result, nil
+}
+
+func (h *k8sHandler) CreateTemporaryGrant(ctx context.Context, req *api.TemporaryGrantRequest) (*api.TemporaryGrant, error) {
+	user, err := rbac.GetUser(ctx)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("failed to get user"))
+	}
+	now := time.Now().UTC()
+	grant := rbac.TemporaryGrant{
+		Subject:   req.Subject,
+		Role:      req.Role,
+		ExpiresAt: req.ExpiresAt.UTC(),
+		GrantedBy: user.Subject,
+		GrantedAt: now,
+		Reason:    pointer.Get(req.Reason),
+	}
+	if err := rbac.AddGrant(ctx, h.kubeConnector, grant, now); err != nil {
+		return nil, errors.Join(err, errors.New("failed to create temporary grant"))
+	}
+	return temporaryGrantToAPI(grant), nil
+}
+
+func temporaryGrantToAPI(g rbac.TemporaryGrant) *api.TemporaryGrant {
+	result := &api.TemporaryGrant{
+		Subject:   g.Subject,
+		Role:      g.Role,
+		ExpiresAt: g.ExpiresAt,
+		GrantedBy: g.GrantedBy,
+		GrantedAt: g.GrantedAt,
+	}
+	if g.Reason != "" {
+		result.Reason = pointer.To(g.Reason)
+	}
+	return result
+}
@@ -10,6 +10,7 @@ import (
 
 	v1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
 	api "github.com/percona/everest/api"
+	telemetry "github.com/percona/everest/pkg/telemetry"
 )
 
 // MockHandler is an autogenerated mock type for the Handler type
@@ -17,14 +18,62 @@ type MockHandler struct {
 	mock.Mock
 }
 
-// ApproveUpgradePlan provides a mock function with given fields: ctx, namespace
-func (_m *MockHandler) ApproveUpgradePlan(ctx context.Context, namespace string) error {
-	ret := _m.Called(ctx, namespace)
+// ApproveUpgradePlan provides a mock function with given fields: ctx, namespace, req
+func (_m *MockHandler) ApproveUpgradePlan(ctx context.Context, namespace string, req *api.UpgradePlanApproval) (*api.UpgradePlanApprovalResult, error) {
+	ret := _m.Called(ctx, namespace, req)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ApproveUpgradePlan")
 	}
 
+	var r0 *api.UpgradePlanApprovalResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *api.UpgradePlanApproval) (*api.UpgradePlanApprovalResult, error)); ok {
+		return rf(ctx, namespace, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *api.UpgradePlanApproval) *api.UpgradePlanApprovalResult); ok {
+		r0 = rf(ctx, namespace, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.UpgradePlanApprovalResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *api.UpgradePlanApproval) error); ok {
+		r1 = rf(ctx, namespace, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CancelDataImportJob provides a mock function with given fields: ctx, namespace, name
+func (_m *MockHandler) CancelDataImportJob(ctx context.Context, namespace string, name string) error {
+	ret := _m.Called(ctx, namespace, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelDataImportJob")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, namespace, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CancelUpgradePlanApproval provides a mock function with given fields: ctx, namespace
+func (_m *MockHandler) CancelUpgradePlanApproval(ctx context.Context, namespace string) error {
+	ret := _m.Called(ctx, namespace)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelUpgradePlanApproval")
+	}
+
 	var r0 error
 	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
 		r0 = rf(ctx, namespace)
@@ -35,6 +84,36 @@ func (_m *MockHandler) ApproveUpgradePlan(ctx context.Context, namespace string)
 	return r0
 }
 
+// CreateAccount provides a mock function with given fields: ctx, req
+func (_m *MockHandler) CreateAccount(ctx context.Context, req *api.AccountCreateRequest) (*api.Account, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateAccount")
+	}
+
+	var r0 *api.Account
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *api.AccountCreateRequest) (*api.Account, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *api.AccountCreateRequest) *api.Account); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.Account)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *api.AccountCreateRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateBackupStorage provides a mock function with given fields: ctx, namespace, req
 func (_m *MockHandler) CreateBackupStorage(ctx context.Context, namespace string, req *api.CreateBackupStorageParams) (*v1alpha1.BackupStorage, error) {
 	ret := _m.Called(ctx, namespace, req)
@@ -65,6 +144,66 @@ func (_m *MockHandler) CreateBackupStorage(ctx context.Context, namespace string
 	return r0, r1
 }
 
+// CreateDataImportJob provides a mock function with given fields: ctx, req
+func (_m *MockHandler) CreateDataImportJob(ctx context.Context, req *v1alpha1.DataImportJob) (*v1alpha1.DataImportJob, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateDataImportJob")
+	}
+
+	var r0 *v1alpha1.DataImportJob
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1alpha1.DataImportJob) (*v1alpha1.DataImportJob, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1alpha1.DataImportJob) *v1alpha1.DataImportJob); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.DataImportJob)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1alpha1.DataImportJob) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateDataImporter provides a mock function with given fields: ctx, req
+func (_m *MockHandler) CreateDataImporter(ctx context.Context, req *v1alpha1.DataImporter) (*v1alpha1.DataImporter, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateDataImporter")
+	}
+
+	var r0 *v1alpha1.DataImporter
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1alpha1.DataImporter) (*v1alpha1.DataImporter, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1alpha1.DataImporter) *v1alpha1.DataImporter); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.DataImporter)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1alpha1.DataImporter) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateDatabaseCluster provides a mock function with given fields: ctx, req
 func (_m *MockHandler) CreateDatabaseCluster(ctx context.Context, req *v1alpha1.DatabaseCluster) (*v1alpha1.DatabaseCluster, error) {
 	ret := _m.Called(ctx, req)
@@ -245,6 +384,54 @@ func (_m *MockHandler) CreatePodSchedulingPolicy(ctx context.Context, psp *v1alp
 	return r0, r1
 }
 
+// CreateTemporaryGrant provides a mock function with given fields: ctx, req
+func (_m *MockHandler) CreateTemporaryGrant(ctx context.Context, req *api.TemporaryGrantRequest) (*api.TemporaryGrant, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateTemporaryGrant")
+	}
+
+	var r0 *api.TemporaryGrant
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *api.TemporaryGrantRequest) (*api.TemporaryGrant, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *api.TemporaryGrantRequest) *api.TemporaryGrant); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.TemporaryGrant)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *api.TemporaryGrantRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteAccount provides a mock function with given fields: ctx, username
+func (_m *MockHandler) DeleteAccount(ctx context.Context, username string) error {
+	ret := _m.Called(ctx, username)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAccount")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, username)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteBackupStorage provides a mock function with given fields: ctx, namespace, name
 func (_m *MockHandler) DeleteBackupStorage(ctx context.Context, namespace string, name string) error {
 	ret := _m.Called(ctx, namespace, name)
@@ -263,6 +450,42 @@ func (_m *MockHandler) DeleteBackupStorage(ctx context.Context, namespace string
 	return r0
 }
 
+// DeleteDataImportJob provides a mock function with given fields: ctx, namespace, name
+func (_m *MockHandler) DeleteDataImportJob(ctx context.Context, namespace string, name string) error {
+	ret := _m.Called(ctx, namespace, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteDataImportJob")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, namespace, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteDataImporter provides a mock function with given fields: ctx, name
+func (_m *MockHandler) DeleteDataImporter(ctx context.Context, name string) error {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteDataImporter")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteDatabaseCluster provides a mock function with given fields: ctx, namespace, name, delReq
 func (_m *MockHandler) DeleteDatabaseCluster(ctx context.Context, namespace string, name string, delReq *api.DeleteDatabaseClusterParams) error {
 	ret := _m.Called(ctx, namespace, name, delReq)
@@ -353,6 +576,132 @@ func (_m *MockHandler) DeletePodSchedulingPolicy(ctx context.Context, name strin
 	return r0
 }
 
+// DeleteUserSession provides a mock function with given fields: ctx, username, sessionID
+func (_m *MockHandler) DeleteUserSession(ctx context.Context, username string, sessionID string) error {
+	ret := _m.Called(ctx, username, sessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteUserSession")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, username, sessionID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteUserSessions provides a mock function with given fields: ctx, username
+func (_m *MockHandler) DeleteUserSessions(ctx context.Context, username string) error {
+	ret := _m.Called(ctx, username)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteUserSessions")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, username)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DiffRBACPolicyVersions provides a mock function with given fields: ctx, from, to
+func (_m *MockHandler) DiffRBACPolicyVersions(ctx context.Context, from int, to int) (*api.RBACPolicyDiff, error) {
+	ret := _m.Called(ctx, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DiffRBACPolicyVersions")
+	}
+
+	var r0 *api.RBACPolicyDiff
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) (*api.RBACPolicyDiff, error)); ok {
+		return rf(ctx, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) *api.RBACPolicyDiff); ok {
+		r0 = rf(ctx, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.RBACPolicyDiff)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int) error); ok {
+		r1 = rf(ctx, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExplainPermission provides a mock function with given fields: ctx, req
+func (_m *MockHandler) ExplainPermission(ctx context.Context, req *api.PermissionExplanationRequest) (*api.PermissionExplanation, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExplainPermission")
+	}
+
+	var r0 *api.PermissionExplanation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *api.PermissionExplanationRequest) (*api.PermissionExplanation, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *api.PermissionExplanationRequest) *api.PermissionExplanation); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.PermissionExplanation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *api.PermissionExplanationRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAccount provides a mock function with given fields: ctx, username
+func (_m *MockHandler) GetAccount(ctx context.Context, username string) (*api.Account, error) {
+	ret := _m.Called(ctx, username)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAccount")
+	}
+
+	var r0 *api.Account
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*api.Account, error)); ok {
+		return rf(ctx, username)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *api.Account); ok {
+		r0 = rf(ctx, username)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.Account)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, username)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetBackupStorage provides a mock function with given fields: ctx, namespace, name
 func (_m *MockHandler) GetBackupStorage(ctx context.Context, namespace string, name string) (*v1alpha1.BackupStorage, error) {
 	ret := _m.Called(ctx, namespace, name)
@@ -383,6 +732,66 @@ func (_m *MockHandler) GetBackupStorage(ctx context.Context, namespace string, n
 	return r0, r1
 }
 
+// GetDataImportJob provides a mock function with given fields: ctx, namespace, name
+func (_m *MockHandler) GetDataImportJob(ctx context.Context, namespace string, name string) (*v1alpha1.DataImportJob, error) {
+	ret := _m.Called(ctx, namespace, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDataImportJob")
+	}
+
+	var r0 *v1alpha1.DataImportJob
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*v1alpha1.DataImportJob, error)); ok {
+		return rf(ctx, namespace, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *v1alpha1.DataImportJob); ok {
+		r0 = rf(ctx, namespace, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.DataImportJob)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, namespace, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDataImportJobProgress provides a mock function with given fields: ctx, namespace, name, params
+func (_m *MockHandler) GetDataImportJobProgress(ctx context.Context, namespace string, name string, params *api.GetDataImportJobProgressParams) (*api.DataImportJobProgress, error) {
+	ret := _m.Called(ctx, namespace, name, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDataImportJobProgress")
+	}
+
+	var r0 *api.DataImportJobProgress
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *api.GetDataImportJobProgressParams) (*api.DataImportJobProgress, error)); ok {
+		return rf(ctx, namespace, name, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *api.GetDataImportJobProgressParams) *api.DataImportJobProgress); ok {
+		r0 = rf(ctx, namespace, name, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.DataImportJobProgress)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, *api.GetDataImportJobProgressParams) error); ok {
+		r1 = rf(ctx, namespace, name, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetDatabaseCluster provides a mock function with given fields: ctx, namespace, name
 func (_m *MockHandler) GetDatabaseCluster(ctx context.Context, namespace string, name string) (*v1alpha1.DatabaseCluster, error) {
 	ret := _m.Called(ctx, namespace, name)
@@ -593,6 +1002,36 @@ func (_m *MockHandler) GetDatabaseEngine(ctx context.Context, namespace string,
 	return r0, r1
 }
 
+// GetEngineVersionPolicy provides a mock function with given fields: ctx, namespace
+func (_m *MockHandler) GetEngineVersionPolicy(ctx context.Context, namespace string) (*api.EngineVersionPolicy, error) {
+	ret := _m.Called(ctx, namespace)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEngineVersionPolicy")
+	}
+
+	var r0 *api.EngineVersionPolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*api.EngineVersionPolicy, error)); ok {
+		return rf(ctx, namespace)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *api.EngineVersionPolicy); ok {
+		r0 = rf(ctx, namespace)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.EngineVersionPolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, namespace)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetKubernetesClusterInfo provides a mock function with given fields: ctx
 func (_m *MockHandler) GetKubernetesClusterInfo(ctx context.Context) (*api.KubernetesClusterInfo, error) {
 	ret := _m.Called(ctx)
@@ -653,6 +1092,36 @@ func (_m *MockHandler) GetKubernetesClusterResources(ctx context.Context) (*api.
 	return r0, r1
 }
 
+// GetMaintenanceWindows provides a mock function with given fields: ctx, namespace
+func (_m *MockHandler) GetMaintenanceWindows(ctx context.Context, namespace string) (*api.MaintenanceWindows, error) {
+	ret := _m.Called(ctx, namespace)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMaintenanceWindows")
+	}
+
+	var r0 *api.MaintenanceWindows
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*api.MaintenanceWindows, error)); ok {
+		return rf(ctx, namespace)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *api.MaintenanceWindows); ok {
+		r0 = rf(ctx, namespace)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.MaintenanceWindows)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, namespace)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetMonitoringInstance provides a mock function with given fields: ctx, namespace, name
 func (_m *MockHandler) GetMonitoringInstance(ctx context.Context, namespace string, name string) (*v1alpha1.MonitoringConfig, error) {
 	ret := _m.Called(ctx, namespace, name)
@@ -683,6 +1152,36 @@ func (_m *MockHandler) GetMonitoringInstance(ctx context.Context, namespace stri
 	return r0, r1
 }
 
+// GetOIDCClaimMapping provides a mock function with given fields: ctx
+func (_m *MockHandler) GetOIDCClaimMapping(ctx context.Context) (*api.OIDCClaimMapping, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOIDCClaimMapping")
+	}
+
+	var r0 *api.OIDCClaimMapping
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*api.OIDCClaimMapping, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *api.OIDCClaimMapping); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.OIDCClaimMapping)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetPodSchedulingPolicy provides a mock function with given fields: ctx, name
 func (_m *MockHandler) GetPodSchedulingPolicy(ctx context.Context, name string) (*v1alpha1.PodSchedulingPolicy, error) {
 	ret := _m.Called(ctx, name)
@@ -713,6 +1212,36 @@ func (_m *MockHandler) GetPodSchedulingPolicy(ctx context.Context, name string)
 	return r0, r1
 }
 
+// GetRBACPolicyVersion provides a mock function with given fields: ctx, version
+func (_m *MockHandler) GetRBACPolicyVersion(ctx context.Context, version int) (*api.RBACPolicyVersion, error) {
+	ret := _m.Called(ctx, version)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRBACPolicyVersion")
+	}
+
+	var r0 *api.RBACPolicyVersion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*api.RBACPolicyVersion, error)); ok {
+		return rf(ctx, version)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *api.RBACPolicyVersion); ok {
+		r0 = rf(ctx, version)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.RBACPolicyVersion)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, version)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetSettings provides a mock function with given fields: ctx
 func (_m *MockHandler) GetSettings(ctx context.Context) (*api.Settings, error) {
 	ret := _m.Called(ctx)
@@ -743,6 +1272,36 @@ func (_m *MockHandler) GetSettings(ctx context.Context) (*api.Settings, error) {
 	return r0, r1
 }
 
+// GetTelemetry provides a mock function with given fields: ctx
+func (_m *MockHandler) GetTelemetry(ctx context.Context) (*telemetry.Telemetry, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTelemetry")
+	}
+
+	var r0 *telemetry.Telemetry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*telemetry.Telemetry, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *telemetry.Telemetry); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*telemetry.Telemetry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetUpgradePlan provides a mock function with given fields: ctx, namespace
 func (_m *MockHandler) GetUpgradePlan(ctx context.Context, namespace string) (*api.UpgradePlan, error) {
 	ret := _m.Called(ctx, namespace)
@@ -760,12 +1319,42 @@ func (_m *MockHandler) GetUpgradePlan(ctx context.Context, namespace string) (*a
 		r0 = rf(ctx, namespace)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*api.UpgradePlan)
+			r0 = ret.Get(0).(*api.UpgradePlan)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, namespace)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetUserPermissionMatrix provides a mock function with given fields: ctx
+func (_m *MockHandler) GetUserPermissionMatrix(ctx context.Context) (*api.UserPermissionMatrix, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserPermissionMatrix")
+	}
+
+	var r0 *api.UserPermissionMatrix
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*api.UserPermissionMatrix, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *api.UserPermissionMatrix); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.UserPermissionMatrix)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, namespace)
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -803,6 +1392,36 @@ func (_m *MockHandler) GetUserPermissions(ctx context.Context) (*api.UserPermiss
 	return r0, r1
 }
 
+// ListAccounts provides a mock function with given fields: ctx
+func (_m *MockHandler) ListAccounts(ctx context.Context) (*api.AccountList, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAccounts")
+	}
+
+	var r0 *api.AccountList
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*api.AccountList, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *api.AccountList); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.AccountList)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListBackupStorages provides a mock function with given fields: ctx, namespace
 func (_m *MockHandler) ListBackupStorages(ctx context.Context, namespace string) (*v1alpha1.BackupStorageList, error) {
 	ret := _m.Called(ctx, namespace)
@@ -1110,11 +1729,215 @@ func (_m *MockHandler) ListPodSchedulingPolicies(ctx context.Context, params *ap
 	return r0, r1
 }
 
+// ListRBACPolicyVersions provides a mock function with given fields: ctx
+func (_m *MockHandler) ListRBACPolicyVersions(ctx context.Context) (*api.RBACPolicyVersionList, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRBACPolicyVersions")
+	}
+
+	var r0 *api.RBACPolicyVersionList
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*api.RBACPolicyVersionList, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *api.RBACPolicyVersionList); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.RBACPolicyVersionList)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListTemporaryGrants provides a mock function with given fields: ctx
+func (_m *MockHandler) ListTemporaryGrants(ctx context.Context) (*api.TemporaryGrants, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTemporaryGrants")
+	}
+
+	var r0 *api.TemporaryGrants
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*api.TemporaryGrants, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *api.TemporaryGrants); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.TemporaryGrants)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListUserSessions provides a mock function with given fields: ctx, username
+func (_m *MockHandler) ListUserSessions(ctx context.Context, username string) (*api.UserSessionList, error) {
+	ret := _m.Called(ctx, username)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListUserSessions")
+	}
+
+	var r0 *api.UserSessionList
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*api.UserSessionList, error)); ok {
+		return rf(ctx, username)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *api.UserSessionList); ok {
+		r0 = rf(ctx, username)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.UserSessionList)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, username)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResetAccountMFA provides a mock function with given fields: ctx, username
+func (_m *MockHandler) ResetAccountMFA(ctx context.Context, username string) error {
+	ret := _m.Called(ctx, username)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResetAccountMFA")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, username)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RollbackRBACPolicy provides a mock function with given fields: ctx, version
+func (_m *MockHandler) RollbackRBACPolicy(ctx context.Context, version int) (*api.RBACPolicyVersion, error) {
+	ret := _m.Called(ctx, version)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RollbackRBACPolicy")
+	}
+
+	var r0 *api.RBACPolicyVersion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*api.RBACPolicyVersion, error)); ok {
+		return rf(ctx, version)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *api.RBACPolicyVersion); ok {
+		r0 = rf(ctx, version)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.RBACPolicyVersion)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, version)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetAccountPassword provides a mock function with given fields: ctx, username, req
+func (_m *MockHandler) SetAccountPassword(ctx context.Context, username string, req *api.AccountPasswordRequest) error {
+	ret := _m.Called(ctx, username, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetAccountPassword")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *api.AccountPasswordRequest) error); ok {
+		r0 = rf(ctx, username, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SetNext provides a mock function with given fields: h
 func (_m *MockHandler) SetNext(h Handler) {
 	_m.Called(h)
 }
 
+// UnlockAccount provides a mock function with given fields: ctx, username
+func (_m *MockHandler) UnlockAccount(ctx context.Context, username string) error {
+	ret := _m.Called(ctx, username)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnlockAccount")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, username)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateAccount provides a mock function with given fields: ctx, username, req
+func (_m *MockHandler) UpdateAccount(ctx context.Context, username string, req *api.AccountUpdateRequest) (*api.Account, error) {
+	ret := _m.Called(ctx, username, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateAccount")
+	}
+
+	var r0 *api.Account
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *api.AccountUpdateRequest) (*api.Account, error)); ok {
+		return rf(ctx, username, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *api.AccountUpdateRequest) *api.Account); ok {
+		r0 = rf(ctx, username, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.Account)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *api.AccountUpdateRequest) error); ok {
+		r1 = rf(ctx, username, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateBackupStorage provides a mock function with given fields: ctx, name, namespace, req
 func (_m *MockHandler) UpdateBackupStorage(ctx context.Context, name string, namespace string, req *api.UpdateBackupStorageParams) (*v1alpha1.BackupStorage, error) {
 	ret := _m.Called(ctx, name, namespace, req)
@@ -1145,6 +1968,36 @@ func (_m *MockHandler) UpdateBackupStorage(ctx context.Context, name string, nam
 	return r0, r1
 }
 
+// UpdateDataImporter provides a mock function with given fields: ctx, req
+func (_m *MockHandler) UpdateDataImporter(ctx context.Context, req *v1alpha1.DataImporter) (*v1alpha1.DataImporter, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateDataImporter")
+	}
+
+	var r0 *v1alpha1.DataImporter
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1alpha1.DataImporter) (*v1alpha1.DataImporter, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1alpha1.DataImporter) *v1alpha1.DataImporter); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.DataImporter)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1alpha1.DataImporter) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateDatabaseCluster provides a mock function with given fields: ctx, req
 func (_m *MockHandler) UpdateDatabaseCluster(ctx context.Context, req *v1alpha1.DatabaseCluster) (*v1alpha1.DatabaseCluster, error) {
 	ret := _m.Called(ctx, req)
@@ -1235,6 +2088,66 @@ func (_m *MockHandler) UpdateDatabaseEngine(ctx context.Context, req *v1alpha1.D
 	return r0, r1
 }
 
+// UpdateEngineVersionPolicy provides a mock function with given fields: ctx, namespace, req
+func (_m *MockHandler) UpdateEngineVersionPolicy(ctx context.Context, namespace string, req *api.EngineVersionPolicy) (*api.EngineVersionPolicy, error) {
+	ret := _m.Called(ctx, namespace, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateEngineVersionPolicy")
+	}
+
+	var r0 *api.EngineVersionPolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *api.EngineVersionPolicy) (*api.EngineVersionPolicy, error)); ok {
+		return rf(ctx, namespace, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *api.EngineVersionPolicy) *api.EngineVersionPolicy); ok {
+		r0 = rf(ctx, namespace, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.EngineVersionPolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *api.EngineVersionPolicy) error); ok {
+		r1 = rf(ctx, namespace, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateMaintenanceWindows provides a mock function with given fields: ctx, namespace, req
+func (_m *MockHandler) UpdateMaintenanceWindows(ctx context.Context, namespace string, req *api.MaintenanceWindowsSpec) (*api.MaintenanceWindows, error) {
+	ret := _m.Called(ctx, namespace, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateMaintenanceWindows")
+	}
+
+	var r0 *api.MaintenanceWindows
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *api.MaintenanceWindowsSpec) (*api.MaintenanceWindows, error)); ok {
+		return rf(ctx, namespace, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *api.MaintenanceWindowsSpec) *api.MaintenanceWindows); ok {
+		r0 = rf(ctx, namespace, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.MaintenanceWindows)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *api.MaintenanceWindowsSpec) error); ok {
+		r1 = rf(ctx, namespace, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateMonitoringInstance provides a mock function with given fields: ctx, namespace, name, req
 func (_m *MockHandler) UpdateMonitoringInstance(ctx context.Context, namespace string, name string, req *api.MonitoringInstanceUpdateParams) (*v1alpha1.MonitoringConfig, error) {
 	ret := _m.Called(ctx, namespace, name, req)
@@ -1265,6 +2178,36 @@ func (_m *MockHandler) UpdateMonitoringInstance(ctx context.Context, namespace s
 	return r0, r1
 }
 
+// UpdateOIDCClaimMapping provides a mock function with given fields: ctx, req
+func (_m *MockHandler) UpdateOIDCClaimMapping(ctx context.Context, req *api.OIDCClaimMapping) (*api.OIDCClaimMapping, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateOIDCClaimMapping")
+	}
+
+	var r0 *api.OIDCClaimMapping
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *api.OIDCClaimMapping) (*api.OIDCClaimMapping, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *api.OIDCClaimMapping) *api.OIDCClaimMapping); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.OIDCClaimMapping)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *api.OIDCClaimMapping) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdatePodSchedulingPolicy provides a mock function with given fields: ctx, psp
 func (_m *MockHandler) UpdatePodSchedulingPolicy(ctx context.Context, psp *v1alpha1.PodSchedulingPolicy) (*v1alpha1.PodSchedulingPolicy, error) {
 	ret := _m.Called(ctx, psp)
@@ -0,0 +1,131 @@
+package rbac
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/rbac"
+)
+
+func (h *rbacHandler) ListAccounts(ctx context.Context) (*api.AccountList, error) {
+	list, err := h.next.ListAccounts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// filter out the accounts that the user does not have access to.
+	list.Items = slices.DeleteFunc(list.Items, func(a api.Account) bool {
+		return h.enforce(ctx, rbac.ResourceAccounts, rbac.ActionRead, rbac.ObjectName(a.Username)) != nil
+	})
+	return list, nil
+}
+
+func (h *rbacHandler) CreateAccount(ctx context.Context, req *api.AccountCreateRequest) (*api.Account, error) {
+	if err := h.enforce(ctx, rbac.ResourceAccounts, rbac.ActionCreate, rbac.ObjectName(req.Username)); err != nil {
+		return nil, err
+	}
+	return h.next.CreateAccount(ctx, req)
+}
+
+func (h *rbacHandler) GetAccount(ctx context.Context, username string) (*api.Account, error) {
+	if err := h.enforce(ctx, rbac.ResourceAccounts, rbac.ActionRead, rbac.ObjectName(username)); err != nil {
+		return nil, err
+	}
+	return h.next.GetAccount(ctx, username)
+}
+
+func (h *rbacHandler) UpdateAccount(ctx context.Context, username string, req *api.AccountUpdateRequest) (*api.Account, error) {
+	if err := h.enforceAccountChange(ctx, rbac.ActionUpdate, username); err != nil {
+		return nil, err
+	}
+	return h.next.UpdateAccount(ctx, username, req)
+}
+
+func (h *rbacHandler) DeleteAccount(ctx context.Context, username string) error {
+	if err := h.enforceAccountChange(ctx, rbac.ActionDelete, username); err != nil {
+		return err
+	}
+	return h.next.DeleteAccount(ctx, username)
+}
+
+func (h *rbacHandler) SetAccountPassword(ctx context.Context, username string, req *api.AccountPasswordRequest) error {
+	if err := h.enforceAccountChange(ctx, rbac.ActionUpdate, username); err != nil {
+		return err
+	}
+	return h.next.SetAccountPassword(ctx, username, req)
+}
+
+func (h *rbacHandler) UnlockAccount(ctx context.Context, username string) error {
+	if err := h.enforceAccountChange(ctx, rbac.ActionUpdate, username); err != nil {
+		return err
+	}
+	return h.next.UnlockAccount(ctx, username)
+}
+
+func (h *rbacHandler) ResetAccountMFA(ctx context.Context, username string) error {
+	if err := h.enforceAccountChange(ctx, rbac.ActionUpdate, username); err != nil {
+		return err
+	}
+	return h.next.ResetAccountMFA(ctx, username)
+}
+
+func (h *rbacHandler) ListUserSessions(ctx context.Context, username string) (*api.UserSessionList, error) {
+	if err := h.enforce(ctx, rbac.ResourceAccounts, rbac.ActionRead, rbac.ObjectName(username)); err != nil {
+		return nil, err
+	}
+	return h.next.ListUserSessions(ctx, username)
+}
+
+func (h *rbacHandler) DeleteUserSessions(ctx context.Context, username string) error {
+	if err := h.enforceAccountChange(ctx, rbac.ActionUpdate, username); err != nil {
+		return err
+	}
+	return h.next.DeleteUserSessions(ctx, username)
+}
+
+func (h *rbacHandler) DeleteUserSession(ctx context.Context, username, sessionID string) error {
+	if err := h.enforceAccountChange(ctx, rbac.ActionUpdate, username); err != nil {
+		return err
+	}
+	return h.next.DeleteUserSession(ctx, username, sessionID)
+}
+
+// enforceAccountChange ensures that the user may perform the action on the account.
+// Besides the permission for the action, changing an account that has the admin role
+// or a role that the user does not have requires the user to be an admin,
+// so that the user cannot take over the permissions of the account, e.g. by resetting its password.
+func (h *rbacHandler) enforceAccountChange(ctx context.Context, action, username string) error {
+	if err := h.enforce(ctx, rbac.ResourceAccounts, action, rbac.ObjectName(username)); err != nil {
+		return err
+	}
+	user, err := h.userGetter(ctx)
+	if err != nil {
+		return err
+	}
+	isAdmin, err := h.isAdmin(user)
+	if err != nil {
+		return err
+	}
+	if isAdmin {
+		return nil
+	}
+	roles, err := h.enforcer.GetImplicitRolesForUser(username)
+	if err != nil {
+		return fmt.Errorf("failed to GetImplicitRolesForUser: %w", err)
+	}
+	for _, role := range roles {
+		held := false
+		if role != common.EverestAdminRole {
+			if held, err = h.hasRole(user, role); err != nil {
+				return err
+			}
+		}
+		if !held {
+			h.log.Warnf("Permission denied: [%s] cannot change the account [%s] that has the role [%s]", user.Subject, username, role)
+			return ErrInsufficientPermissions
+		}
+	}
+	return nil
+}
@@ -0,0 +1,244 @@
+package rbac
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/internal/server/handlers"
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/rbac"
+)
+
+func TestRBAC_Accounts(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.WithValue(context.Background(), common.UserCtxKey, rbac.User{Subject: "bob"})
+
+	t.Run("ListAccounts", func(t *testing.T) {
+		t.Parallel()
+
+		testCases := []struct {
+			desc   string
+			policy string
+			want   []string
+		}{
+			{
+				desc: "admin",
+				policy: newPolicy(
+					"g, bob, role:admin",
+				),
+				want: []string{"admin", "alice", "bob"},
+			},
+			{
+				desc: "read all accounts",
+				policy: newPolicy(
+					"p, role:test, accounts, read, *",
+					"g, bob, role:test",
+				),
+				want: []string{"admin", "alice", "bob"},
+			},
+			{
+				desc: "read own account",
+				policy: newPolicy(
+					"p, role:test, accounts, read, bob",
+					"g, bob, role:test",
+				),
+				want: []string{"bob"},
+			},
+			{
+				desc: "no accounts permissions",
+				policy: newPolicy(
+					"p, role:test, settings, read, *",
+					"g, bob, role:test",
+				),
+				want: []string{},
+			},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.desc, func(t *testing.T) {
+				t.Parallel()
+
+				k8sMock := newConfigMapMock(tc.policy)
+				enf, err := rbac.NewEnforcer(ctx, k8sMock, zap.NewNop().Sugar())
+				require.NoError(t, err)
+				next := &handlers.MockHandler{}
+				next.On("ListAccounts", mock.Anything).Return(&api.AccountList{
+					Items: []api.Account{
+						{Username: "admin"},
+						{Username: "alice"},
+						{Username: "bob"},
+					},
+				}, nil)
+
+				h := &rbacHandler{
+					next:       next,
+					log:        zap.NewNop().Sugar(),
+					enforcer:   enf,
+					userGetter: testUserGetter,
+				}
+
+				list, err := h.ListAccounts(ctx)
+				require.NoError(t, err)
+				got := make([]string, 0, len(list.Items))
+				for _, a := range list.Items {
+					got = append(got, a.Username)
+				}
+				assert.Equal(t, tc.want, got)
+			})
+		}
+	})
+
+	t.Run("UpdateAccount", func(t *testing.T) {
+		t.Parallel()
+
+		testCases := []struct {
+			desc    string
+			policy  string
+			wantErr error
+		}{
+			{
+				desc: "admin",
+				policy: newPolicy(
+					"g, bob, role:admin",
+				),
+			},
+			{
+				desc: "update alice",
+				policy: newPolicy(
+					"p, role:test, accounts, update, alice",
+					"g, bob, role:test",
+				),
+			},
+			{
+				desc: "read alice",
+				policy: newPolicy(
+					"p, role:test, accounts, read, alice",
+					"g, bob, role:test",
+				),
+				wantErr: ErrInsufficientPermissions,
+			},
+			{
+				desc: "update another account",
+				policy: newPolicy(
+					"p, role:test, accounts, update, carol",
+					"g, bob, role:test",
+				),
+				wantErr: ErrInsufficientPermissions,
+			},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.desc, func(t *testing.T) {
+				t.Parallel()
+
+				k8sMock := newConfigMapMock(tc.policy)
+				enf, err := rbac.NewEnforcer(ctx, k8sMock, zap.NewNop().Sugar())
+				require.NoError(t, err)
+				next := &handlers.MockHandler{}
+				next.On("UpdateAccount", mock.Anything, "alice", mock.Anything).Return(&api.Account{Username: "alice"}, nil)
+
+				h := &rbacHandler{
+					next:       next,
+					log:        zap.NewNop().Sugar(),
+					enforcer:   enf,
+					userGetter: testUserGetter,
+				}
+
+				_, err = h.UpdateAccount(ctx, "alice", &api.AccountUpdateRequest{})
+				assert.ErrorIs(t, err, tc.wantErr)
+			})
+		}
+	})
+
+	t.Run("ChangeAccountWithRoles", func(t *testing.T) {
+		t.Parallel()
+
+		policy := newPolicy(
+			"p, role:ops, accounts, *, *",
+			"p, role:dev, database-clusters, *, dev/*",
+			"p, role:dba, database-clusters, *, */*",
+			"g, bob, role:ops",
+			"g, bob, role:dev",
+			"g, alice, role:dev",
+			"g, carol, role:dba",
+			"g, root, role:admin",
+			"g, admin, role:admin",
+		)
+
+		testCases := []struct {
+			desc     string
+			user     string
+			username string
+			wantErr  error
+		}{
+			{
+				desc:     "account with the roles of the user",
+				user:     "bob",
+				username: "alice",
+			},
+			{
+				desc:     "account with a role the user does not have",
+				user:     "bob",
+				username: "carol",
+				wantErr:  ErrInsufficientPermissions,
+			},
+			{
+				desc:     "admin account",
+				user:     "bob",
+				username: "admin",
+				wantErr:  ErrInsufficientPermissions,
+			},
+			{
+				desc:     "admin changes an admin account",
+				user:     "root",
+				username: "admin",
+			},
+			{
+				desc:     "admin changes an account with any role",
+				user:     "root",
+				username: "carol",
+			},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.desc, func(t *testing.T) {
+				t.Parallel()
+
+				ctx := context.WithValue(context.Background(), common.UserCtxKey, rbac.User{Subject: tc.user})
+				enf, err := rbac.NewEnforcer(ctx, newConfigMapMock(policy), zap.NewNop().Sugar())
+				require.NoError(t, err)
+				next := &handlers.MockHandler{}
+				next.On("UpdateAccount", mock.Anything, tc.username, mock.Anything).Return(&api.Account{Username: tc.username}, nil)
+				next.On("DeleteAccount", mock.Anything, tc.username).Return(nil)
+				next.On("SetAccountPassword", mock.Anything, tc.username, mock.Anything).Return(nil)
+				next.On("UnlockAccount", mock.Anything, tc.username).Return(nil)
+				next.On("ResetAccountMFA", mock.Anything, tc.username).Return(nil)
+				next.On("DeleteUserSessions", mock.Anything, tc.username).Return(nil)
+				next.On("DeleteUserSession", mock.Anything, tc.username, mock.Anything).Return(nil)
+
+				h := &rbacHandler{
+					next:       next,
+					log:        zap.NewNop().Sugar(),
+					enforcer:   enf,
+					userGetter: testUserGetter,
+				}
+
+				_, err = h.UpdateAccount(ctx, tc.username, &api.AccountUpdateRequest{})
+				require.ErrorIs(t, err, tc.wantErr)
+				require.ErrorIs(t, h.DeleteAccount(ctx, tc.username), tc.wantErr)
+				require.ErrorIs(t, h.SetAccountPassword(ctx, tc.username, &api.AccountPasswordRequest{}), tc.wantErr)
+				require.ErrorIs(t, h.UnlockAccount(ctx, tc.username), tc.wantErr)
+				require.ErrorIs(t, h.ResetAccountMFA(ctx, tc.username), tc.wantErr)
+				require.ErrorIs(t, h.DeleteUserSessions(ctx, tc.username), tc.wantErr)
+				require.ErrorIs(t, h.DeleteUserSession(ctx, tc.username, "session-1"), tc.wantErr)
+			})
+		}
+	})
+}
@@ -17,8 +17,8 @@ func (h *rbacHandler) ListBackupStorages(ctx context.Context, namespace string)
 	}
 	filtered := []everestv1alpha1.BackupStorage{}
 	for _, bs := range list.Items {
-		if err := h.enforce(ctx, rbac.ResourceBackupStorages, rbac.ActionRead,
-			rbac.ObjectName(namespace, bs.GetName()),
+		if err := h.enforceWithAttributes(ctx, rbac.ResourceBackupStorages, rbac.ActionRead,
+			rbac.ObjectName(namespace, bs.GetName()), backupStorageAttributes(&bs),
 		); errors.Is(err, ErrInsufficientPermissions) {
 			continue
 		} else if err != nil {
@@ -31,29 +31,56 @@ func (h *rbacHandler) ListBackupStorages(ctx context.Context, namespace string)
 }
 
 func (h *rbacHandler) GetBackupStorage(ctx context.Context, namespace, name string) (*everestv1alpha1.BackupStorage, error) {
-	if err := h.enforce(ctx, rbac.ResourceBackupStorages, rbac.ActionRead, rbac.ObjectName(namespace, name)); err != nil {
+	if err := h.enforceWithAttributes(ctx, rbac.ResourceBackupStorages, rbac.ActionRead, rbac.ObjectName(namespace, name),
+		h.backupStorageAttributesByName(ctx, namespace, name),
+	); err != nil {
 		return nil, err
 	}
 	return h.next.GetBackupStorage(ctx, namespace, name)
 }
 
 func (h *rbacHandler) CreateBackupStorage(ctx context.Context, namespace string, req *api.CreateBackupStorageParams) (*everestv1alpha1.BackupStorage, error) {
-	if err := h.enforce(ctx, rbac.ResourceBackupStorages, rbac.ActionCreate, rbac.ObjectName(namespace, req.Name)); err != nil {
+	if err := h.enforceWithAttributes(ctx, rbac.ResourceBackupStorages, rbac.ActionCreate, rbac.ObjectName(namespace, req.Name),
+		func() (rbac.Attributes, error) { return rbac.BackupStorageAttributes(string(req.Type)), nil },
+	); err != nil {
 		return nil, err
 	}
 	return h.next.CreateBackupStorage(ctx, namespace, req)
 }
 
 func (h *rbacHandler) UpdateBackupStorage(ctx context.Context, namespace, name string, req *api.UpdateBackupStorageParams) (*everestv1alpha1.BackupStorage, error) {
-	if err := h.enforce(ctx, rbac.ResourceBackupStorages, rbac.ActionUpdate, rbac.ObjectName(namespace, name)); err != nil {
+	if err := h.enforceWithAttributes(ctx, rbac.ResourceBackupStorages, rbac.ActionUpdate, rbac.ObjectName(namespace, name),
+		h.backupStorageAttributesByName(ctx, namespace, name),
+	); err != nil {
 		return nil, err
 	}
 	return h.next.UpdateBackupStorage(ctx, namespace, name, req)
 }
 
 func (h *rbacHandler) DeleteBackupStorage(ctx context.Context, namespace, name string) error {
-	if err := h.enforce(ctx, rbac.ResourceBackupStorages, rbac.ActionDelete, rbac.ObjectName(namespace, name)); err != nil {
+	if err := h.enforceWithAttributes(ctx, rbac.ResourceBackupStorages, rbac.ActionDelete, rbac.ObjectName(namespace, name),
+		h.backupStorageAttributesByName(ctx, namespace, name),
+	); err != nil {
 		return err
 	}
 	return h.next.DeleteBackupStorage(ctx, namespace, name)
 }
+
+// backupStorageAttributes returns the attributes of the backup storage matched against the conditions of the policy.
+func backupStorageAttributes(bs *everestv1alpha1.BackupStorage) func() (rbac.Attributes, error) {
+	return func() (rbac.Attributes, error) {
+		return rbac.BackupStorageAttributes(string(bs.Spec.Type)), nil
+	}
+}
+
+// backupStorageAttributesByName returns the attributes of the backup storage with the given name.
+// The backup storage is only fetched if the attributes are requested.
+func (h *rbacHandler) backupStorageAttributesByName(ctx context.Context, namespace, name string) func() (rbac.Attributes, error) {
+	return func() (rbac.Attributes, error) {
+		bs, err := h.next.GetBackupStorage(ctx, namespace, name)
+		if err != nil {
+			return nil, fmt.Errorf("GetBackupStorage failed: %w", err)
+		}
+		return backupStorageAttributes(bs)()
+	}
+}
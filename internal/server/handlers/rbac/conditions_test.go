@@ -0,0 +1,129 @@
+package rbac
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/percona/everest/internal/server/handlers"
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/rbac"
+)
+
+func TestRBAC_Conditions(t *testing.T) {
+	t.Parallel()
+
+	policy := newPolicy(
+		"p, role:dev, database-engines, read, ns-dev/*",
+		"p, role:dev, database-clusters, *, ns-dev/*;engineType=postgresql",
+		"p, role:dev, database-clusters, read, ns-dev/*;labels.team=dev",
+		"p, role:dev, backup-storages, read, ns-dev/*;storageType=s3",
+		"g, bob, role:dev",
+	)
+	db := func(name string, engineType everestv1alpha1.EngineType, labels map[string]string) *everestv1alpha1.DatabaseCluster {
+		return &everestv1alpha1.DatabaseCluster{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns-dev", Name: name, Labels: labels},
+			Spec:       everestv1alpha1.DatabaseClusterSpec{Engine: everestv1alpha1.Engine{Type: engineType}},
+		}
+	}
+	bs := func(name string, storageType everestv1alpha1.BackupStorageType) *everestv1alpha1.BackupStorage {
+		return &everestv1alpha1.BackupStorage{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns-dev", Name: name},
+			Spec:       everestv1alpha1.BackupStorageSpec{Type: storageType},
+		}
+	}
+
+	ctx := context.WithValue(context.Background(), common.UserCtxKey, rbac.User{Subject: "bob"})
+	newHandler := func(t *testing.T, next *handlers.MockHandler) *rbacHandler {
+		t.Helper()
+		enf, err := rbac.NewEnforcer(ctx, newConfigMapMock(policy), zap.NewNop().Sugar())
+		require.NoError(t, err)
+		return &rbacHandler{
+			next:       next,
+			log:        zap.NewNop().Sugar(),
+			enforcer:   enf,
+			userGetter: testUserGetter,
+		}
+	}
+
+	t.Run("CreateDatabaseCluster", func(t *testing.T) {
+		t.Parallel()
+		next := &handlers.MockHandler{}
+		next.On("CreateDatabaseCluster", mock.Anything, mock.Anything).Return(&everestv1alpha1.DatabaseCluster{}, nil)
+		h := newHandler(t, next)
+
+		_, err := h.CreateDatabaseCluster(ctx, db("pg", everestv1alpha1.DatabaseEnginePostgresql, nil))
+		require.NoError(t, err)
+		_, err = h.CreateDatabaseCluster(ctx, db("mongo", everestv1alpha1.DatabaseEnginePSMDB, nil))
+		require.ErrorIs(t, err, ErrInsufficientPermissions)
+	})
+
+	t.Run("UpdateDatabaseCluster", func(t *testing.T) {
+		t.Parallel()
+		next := &handlers.MockHandler{}
+		next.On("GetDatabaseCluster", mock.Anything, "ns-dev", "pg").Return(db("pg", everestv1alpha1.DatabaseEnginePostgresql, nil), nil)
+		next.On("GetDatabaseCluster", mock.Anything, "ns-dev", "mongo").Return(db("mongo", everestv1alpha1.DatabaseEnginePSMDB, nil), nil)
+		next.On("UpdateDatabaseCluster", mock.Anything, mock.Anything).Return(&everestv1alpha1.DatabaseCluster{}, nil)
+		h := newHandler(t, next)
+
+		_, err := h.UpdateDatabaseCluster(ctx, db("pg", everestv1alpha1.DatabaseEnginePostgresql, nil))
+		require.NoError(t, err)
+		// The existing cluster must satisfy the conditions as well.
+		_, err = h.UpdateDatabaseCluster(ctx, db("mongo", everestv1alpha1.DatabaseEnginePostgresql, nil))
+		require.ErrorIs(t, err, ErrInsufficientPermissions)
+	})
+
+	t.Run("ListDatabaseClusters", func(t *testing.T) {
+		t.Parallel()
+		next := &handlers.MockHandler{}
+		next.On("ListDatabaseClusters", mock.Anything, "ns-dev").Return(&everestv1alpha1.DatabaseClusterList{
+			Items: []everestv1alpha1.DatabaseCluster{
+				*db("pg", everestv1alpha1.DatabaseEnginePostgresql, nil),
+				*db("mongo", everestv1alpha1.DatabaseEnginePSMDB, nil),
+				*db("mongo-dev", everestv1alpha1.DatabaseEnginePSMDB, map[string]string{"team": "dev"}),
+			},
+		}, nil)
+		h := newHandler(t, next)
+
+		list, err := h.ListDatabaseClusters(ctx, "ns-dev")
+		require.NoError(t, err)
+		names := make([]string, 0, len(list.Items))
+		for _, item := range list.Items {
+			names = append(names, item.GetName())
+		}
+		assert.Equal(t, []string{"pg", "mongo-dev"}, names)
+	})
+
+	t.Run("GetDatabaseClusterComponents", func(t *testing.T) {
+		t.Parallel()
+		next := &handlers.MockHandler{}
+		next.On("GetDatabaseCluster", mock.Anything, "ns-dev", "pg").Return(db("pg", everestv1alpha1.DatabaseEnginePostgresql, nil), nil)
+		next.On("GetDatabaseCluster", mock.Anything, "ns-dev", "mongo").Return(db("mongo", everestv1alpha1.DatabaseEnginePSMDB, nil), nil)
+		next.On("GetDatabaseClusterComponents", mock.Anything, mock.Anything, mock.Anything).Return(nil, nil)
+		h := newHandler(t, next)
+
+		_, err := h.GetDatabaseClusterComponents(ctx, "ns-dev", "pg")
+		require.NoError(t, err)
+		_, err = h.GetDatabaseClusterComponents(ctx, "ns-dev", "mongo")
+		require.ErrorIs(t, err, ErrInsufficientPermissions)
+	})
+
+	t.Run("GetBackupStorage", func(t *testing.T) {
+		t.Parallel()
+		next := &handlers.MockHandler{}
+		next.On("GetBackupStorage", mock.Anything, "ns-dev", "s3").Return(bs("s3", everestv1alpha1.BackupStorageTypeS3), nil)
+		next.On("GetBackupStorage", mock.Anything, "ns-dev", "azure").Return(bs("azure", everestv1alpha1.BackupStorageTypeAzure), nil)
+		h := newHandler(t, next)
+
+		_, err := h.GetBackupStorage(ctx, "ns-dev", "s3")
+		require.NoError(t, err)
+		_, err = h.GetBackupStorage(ctx, "ns-dev", "azure")
+		require.ErrorIs(t, err, ErrInsufficientPermissions)
+	})
+}
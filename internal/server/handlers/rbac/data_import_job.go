@@ -3,8 +3,10 @@ package rbac
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/percona/everest/api"
 	"github.com/percona/everest/pkg/rbac"
 )
 
@@ -23,3 +25,69 @@ func (h *rbacHandler) ListDataImportJobs(ctx context.Context, namespace, dbName
 	}
 	return list, nil
 }
+
+// CreateDataImportJob creates a new DataImportJob.
+func (h *rbacHandler) CreateDataImportJob(ctx context.Context, req *everestv1alpha1.DataImportJob) (*everestv1alpha1.DataImportJob, error) {
+	if req.Spec.DataImportJobTemplate != nil {
+		if err := h.enforce(ctx, rbac.ResourceDataImporters, rbac.ActionRead, rbac.ObjectName(req.Spec.DataImporterName)); err != nil {
+			return nil, err
+		}
+	}
+	if err := h.enforce(ctx, rbac.ResourceDataImportJobs, rbac.ActionCreate, rbac.ObjectName(req.GetNamespace(), req.Spec.TargetClusterName)); err != nil {
+		return nil, err
+	}
+	return h.next.CreateDataImportJob(ctx, req)
+}
+
+// GetDataImportJob returns the specified DataImportJob.
+func (h *rbacHandler) GetDataImportJob(ctx context.Context, namespace, name string) (*everestv1alpha1.DataImportJob, error) {
+	job, err := h.next.GetDataImportJob(ctx, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.enforce(ctx, rbac.ResourceDataImportJobs, rbac.ActionRead, rbac.ObjectName(namespace, job.Spec.TargetClusterName)); err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+// GetDataImportJobProgress returns the progress and the most recent logs of the specified DataImportJob.
+func (h *rbacHandler) GetDataImportJobProgress(
+	ctx context.Context,
+	namespace, name string,
+	params *api.GetDataImportJobProgressParams,
+) (*api.DataImportJobProgress, error) {
+	job, err := h.next.GetDataImportJob(ctx, namespace, name)
+	if err != nil {
+		return nil, fmt.Errorf("GetDataImportJob failed: %w", err)
+	}
+	if err := h.enforce(ctx, rbac.ResourceDataImportJobs, rbac.ActionRead, rbac.ObjectName(namespace, job.Spec.TargetClusterName)); err != nil {
+		return nil, err
+	}
+	return h.next.GetDataImportJobProgress(ctx, namespace, name, params)
+}
+
+// CancelDataImportJob stops the specified DataImportJob.
+func (h *rbacHandler) CancelDataImportJob(ctx context.Context, namespace, name string) error {
+	if err := h.enforceDataImportJobDelete(ctx, namespace, name); err != nil {
+		return err
+	}
+	return h.next.CancelDataImportJob(ctx, namespace, name)
+}
+
+// DeleteDataImportJob deletes the specified DataImportJob.
+func (h *rbacHandler) DeleteDataImportJob(ctx context.Context, namespace, name string) error {
+	if err := h.enforceDataImportJobDelete(ctx, namespace, name); err != nil {
+		return err
+	}
+	return h.next.DeleteDataImportJob(ctx, namespace, name)
+}
+
+// Cancelling a DataImportJob removes it, so it requires the same permissions as deleting it.
+func (h *rbacHandler) enforceDataImportJobDelete(ctx context.Context, namespace, name string) error {
+	job, err := h.next.GetDataImportJob(ctx, namespace, name)
+	if err != nil {
+		return fmt.Errorf("GetDataImportJob failed: %w", err)
+	}
+	return h.enforce(ctx, rbac.ResourceDataImportJobs, rbac.ActionDelete, rbac.ObjectName(namespace, job.Spec.TargetClusterName))
+}
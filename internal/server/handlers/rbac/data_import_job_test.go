@@ -27,6 +27,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/percona/everest/api"
 	"github.com/percona/everest/internal/server/handlers"
 	"github.com/percona/everest/pkg/common"
 	"github.com/percona/everest/pkg/rbac"
@@ -177,4 +178,177 @@ func TestRBAC_DataImportJob(t *testing.T) {
 			})
 		}
 	})
+	t.Run("CreateDataImportJob", func(t *testing.T) {
+		t.Parallel()
+
+		newJob := func() *everestv1alpha1.DataImportJob {
+			return &everestv1alpha1.DataImportJob{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "job1",
+					Namespace: "default",
+				},
+				Spec: everestv1alpha1.DataImportJobSpec{
+					TargetClusterName: "test-cluster",
+					DataImportJobTemplate: &everestv1alpha1.DataImportJobTemplate{
+						DataImporterName: "test-importer",
+					},
+				},
+			}
+		}
+
+		testCases := []struct {
+			desc    string
+			policy  string
+			wantErr error
+		}{
+			{
+				desc: "admin",
+				policy: newPolicy(
+					"g, bob, role:admin",
+				),
+			},
+			{
+				desc: "create job and read importer",
+				policy: newPolicy(
+					"p, role:test, data-import-jobs, create, default/test-cluster",
+					"p, role:test, data-importers, read, test-importer",
+					"g, bob, role:test",
+				),
+			},
+			{
+				desc: "missing read importer",
+				policy: newPolicy(
+					"p, role:test, data-import-jobs, create, default/test-cluster",
+					"g, bob, role:test",
+				),
+				wantErr: ErrInsufficientPermissions,
+			},
+			{
+				desc: "missing create job",
+				policy: newPolicy(
+					"p, role:test, data-importers, read, test-importer",
+					"g, bob, role:test",
+				),
+				wantErr: ErrInsufficientPermissions,
+			},
+			{
+				desc: "wrong cluster",
+				policy: newPolicy(
+					"p, role:test, data-import-jobs, create, default/other-cluster",
+					"p, role:test, data-importers, read, test-importer",
+					"g, bob, role:test",
+				),
+				wantErr: ErrInsufficientPermissions,
+			},
+		}
+
+		ctx := context.WithValue(context.Background(), common.UserCtxKey, rbac.User{Subject: "bob"})
+		for _, tc := range testCases {
+			t.Run(tc.desc, func(t *testing.T) {
+				t.Parallel()
+
+				k8sMock := newConfigMapMock(tc.policy)
+				enf, err := rbac.NewEnforcer(ctx, k8sMock, zap.NewNop().Sugar())
+				require.NoError(t, err)
+				next := &handlers.MockHandler{}
+				next.On("CreateDataImportJob", mock.Anything, mock.Anything).Return(newJob(), nil)
+
+				h := &rbacHandler{
+					next:       next,
+					log:        zap.NewNop().Sugar(),
+					enforcer:   enf,
+					userGetter: testUserGetter,
+				}
+
+				_, err = h.CreateDataImportJob(ctx, newJob())
+				assert.ErrorIs(t, err, tc.wantErr)
+			})
+		}
+	})
+
+	t.Run("Get, GetProgress, Cancel and Delete DataImportJob", func(t *testing.T) {
+		t.Parallel()
+
+		job := &everestv1alpha1.DataImportJob{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "job1",
+				Namespace: "default",
+			},
+			Spec: everestv1alpha1.DataImportJobSpec{
+				TargetClusterName: "test-cluster",
+			},
+		}
+
+		testCases := []struct {
+			desc          string
+			policy        string
+			wantGetErr    error
+			wantDeleteErr error
+		}{
+			{
+				desc: "admin",
+				policy: newPolicy(
+					"g, bob, role:admin",
+				),
+			},
+			{
+				desc: "read and delete",
+				policy: newPolicy(
+					"p, role:test, data-import-jobs, read, default/test-cluster",
+					"p, role:test, data-import-jobs, delete, default/test-cluster",
+					"g, bob, role:test",
+				),
+			},
+			{
+				desc: "read only",
+				policy: newPolicy(
+					"p, role:test, data-import-jobs, read, default/*",
+					"g, bob, role:test",
+				),
+				wantDeleteErr: ErrInsufficientPermissions,
+			},
+			{
+				desc: "wrong cluster",
+				policy: newPolicy(
+					"p, role:test, data-import-jobs, *, default/other-cluster",
+					"g, bob, role:test",
+				),
+				wantGetErr:    ErrInsufficientPermissions,
+				wantDeleteErr: ErrInsufficientPermissions,
+			},
+		}
+
+		ctx := context.WithValue(context.Background(), common.UserCtxKey, rbac.User{Subject: "bob"})
+		for _, tc := range testCases {
+			t.Run(tc.desc, func(t *testing.T) {
+				t.Parallel()
+
+				k8sMock := newConfigMapMock(tc.policy)
+				enf, err := rbac.NewEnforcer(ctx, k8sMock, zap.NewNop().Sugar())
+				require.NoError(t, err)
+				next := &handlers.MockHandler{}
+				next.On("GetDataImportJob", mock.Anything, "default", "job1").Return(job, nil)
+				next.On("GetDataImportJobProgress", mock.Anything, "default", "job1", mock.Anything).
+					Return(&api.DataImportJobProgress{}, nil)
+				next.On("CancelDataImportJob", mock.Anything, "default", "job1").Return(nil)
+				next.On("DeleteDataImportJob", mock.Anything, "default", "job1").Return(nil)
+
+				h := &rbacHandler{
+					next:       next,
+					log:        zap.NewNop().Sugar(),
+					enforcer:   enf,
+					userGetter: testUserGetter,
+				}
+
+				_, err = h.GetDataImportJob(ctx, "default", "job1")
+				assert.ErrorIs(t, err, tc.wantGetErr)
+				_, err = h.GetDataImportJobProgress(ctx, "default", "job1", &api.GetDataImportJobProgressParams{})
+				assert.ErrorIs(t, err, tc.wantGetErr)
+				err = h.CancelDataImportJob(ctx, "default", "job1")
+				assert.ErrorIs(t, err, tc.wantDeleteErr)
+				err = h.DeleteDataImportJob(ctx, "default", "job1")
+				assert.ErrorIs(t, err, tc.wantDeleteErr)
+			})
+		}
+	})
 }
@@ -26,3 +26,27 @@ func (h *rbacHandler) ListDataImporters(ctx context.Context, supportedEngines ..
 	result.Items = filtered
 	return result, nil
 }
+
+// CreateDataImporter creates a new DataImporter.
+func (h *rbacHandler) CreateDataImporter(ctx context.Context, req *everestv1alpha1.DataImporter) (*everestv1alpha1.DataImporter, error) {
+	if err := h.enforce(ctx, rbac.ResourceDataImporters, rbac.ActionCreate, rbac.ObjectName(req.GetName())); err != nil {
+		return nil, err
+	}
+	return h.next.CreateDataImporter(ctx, req)
+}
+
+// UpdateDataImporter updates an existing DataImporter.
+func (h *rbacHandler) UpdateDataImporter(ctx context.Context, req *everestv1alpha1.DataImporter) (*everestv1alpha1.DataImporter, error) {
+	if err := h.enforce(ctx, rbac.ResourceDataImporters, rbac.ActionUpdate, rbac.ObjectName(req.GetName())); err != nil {
+		return nil, err
+	}
+	return h.next.UpdateDataImporter(ctx, req)
+}
+
+// DeleteDataImporter deletes the specified DataImporter.
+func (h *rbacHandler) DeleteDataImporter(ctx context.Context, name string) error {
+	if err := h.enforce(ctx, rbac.ResourceDataImporters, rbac.ActionDelete, rbac.ObjectName(name)); err != nil {
+		return err
+	}
+	return h.next.DeleteDataImporter(ctx, name)
+}
@@ -164,4 +164,85 @@ func TestRBAC_DataImporter(t *testing.T) {
 			})
 		}
 	})
+	t.Run("Create, Update and Delete DataImporter", func(t *testing.T) {
+		t.Parallel()
+
+		importer := &everestv1alpha1.DataImporter{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "importer1",
+			},
+		}
+
+		testCases := []struct {
+			desc    string
+			policy  string
+			wantErr error
+		}{
+			{
+				desc: "admin",
+				policy: newPolicy(
+					"g, bob, role:admin",
+				),
+			},
+			{
+				desc: "all actions on importer1",
+				policy: newPolicy(
+					"p, role:test, data-importers, *, importer1",
+					"g, bob, role:test",
+				),
+			},
+			{
+				desc: "all actions with wildcard",
+				policy: newPolicy(
+					"p, role:test, data-importers, *, *",
+					"g, bob, role:test",
+				),
+			},
+			{
+				desc: "read only",
+				policy: newPolicy(
+					"p, role:test, data-importers, read, *",
+					"g, bob, role:test",
+				),
+				wantErr: ErrInsufficientPermissions,
+			},
+			{
+				desc: "other importer",
+				policy: newPolicy(
+					"p, role:test, data-importers, *, importer2",
+					"g, bob, role:test",
+				),
+				wantErr: ErrInsufficientPermissions,
+			},
+		}
+
+		ctx := context.WithValue(context.Background(), common.UserCtxKey, rbac.User{Subject: "bob"})
+		for _, tc := range testCases {
+			t.Run(tc.desc, func(t *testing.T) {
+				t.Parallel()
+
+				k8sMock := newConfigMapMock(tc.policy)
+				enf, err := rbac.NewEnforcer(ctx, k8sMock, zap.NewNop().Sugar())
+				require.NoError(t, err)
+				next := &handlers.MockHandler{}
+				next.On("CreateDataImporter", mock.Anything, mock.Anything).Return(importer, nil)
+				next.On("UpdateDataImporter", mock.Anything, mock.Anything).Return(importer, nil)
+				next.On("DeleteDataImporter", mock.Anything, "importer1").Return(nil)
+
+				h := &rbacHandler{
+					next:       next,
+					log:        zap.NewNop().Sugar(),
+					enforcer:   enf,
+					userGetter: testUserGetter,
+				}
+
+				_, err = h.CreateDataImporter(ctx, importer)
+				assert.ErrorIs(t, err, tc.wantErr)
+				_, err = h.UpdateDataImporter(ctx, importer)
+				assert.ErrorIs(t, err, tc.wantErr)
+				err = h.DeleteDataImporter(ctx, "importer1")
+				assert.ErrorIs(t, err, tc.wantErr)
+			})
+		}
+	})
 }
@@ -9,6 +9,7 @@ import (
 
 	"github.com/AlekSi/pointer"
 	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 
 	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
 	"github.com/percona/everest/api"
@@ -20,7 +21,7 @@ func (h *rbacHandler) CreateDatabaseCluster(ctx context.Context, db *everestv1al
 	name := db.GetName()
 	namespace := db.GetNamespace()
 	object := rbac.ObjectName(namespace, name)
-	if err := h.enforce(ctx, rbac.ResourceDatabaseClusters, rbac.ActionCreate, object); err != nil {
+	if err := h.enforceWithAttributes(ctx, rbac.ResourceDatabaseClusters, rbac.ActionCreate, object, dbClusterAttributes(db)); err != nil {
 		return nil, err
 	}
 
@@ -93,7 +94,9 @@ func (h *rbacHandler) DeleteDatabaseCluster(ctx context.Context, namespace, name
 	if err != nil {
 		return fmt.Errorf("GetDatabaseCluster failed: %w", err)
 	}
-	if err := h.enforce(ctx, rbac.ResourceDatabaseClusters, rbac.ActionDelete, rbac.ObjectName(namespace, name)); err != nil {
+	if err := h.enforceWithAttributes(ctx, rbac.ResourceDatabaseClusters, rbac.ActionDelete, rbac.ObjectName(namespace, name),
+		dbClusterAttributes(db),
+	); err != nil {
 		return err
 	}
 	engineName := common.OperatorTypeToName[db.Spec.Engine.Type]
@@ -106,7 +109,9 @@ func (h *rbacHandler) DeleteDatabaseCluster(ctx context.Context, namespace, name
 func (h *rbacHandler) UpdateDatabaseCluster(ctx context.Context, db *everestv1alpha1.DatabaseCluster) (*everestv1alpha1.DatabaseCluster, error) {
 	name := db.GetName()
 	namespace := db.GetNamespace()
-	if err := h.enforce(ctx, rbac.ResourceDatabaseClusters, rbac.ActionUpdate, rbac.ObjectName(namespace, name)); err != nil {
+	if err := h.enforceWithAttributes(ctx, rbac.ResourceDatabaseClusters, rbac.ActionUpdate, rbac.ObjectName(namespace, name),
+		dbClusterAttributes(db),
+	); err != nil {
 		return nil, err
 	}
 	engineName := common.OperatorTypeToName[db.Spec.Engine.Type]
@@ -118,6 +123,13 @@ func (h *rbacHandler) UpdateDatabaseCluster(ctx context.Context, db *everestv1al
 	if err != nil {
 		return nil, err
 	}
+	// The conditions must also be satisfied by the cluster before the update,
+	// e.g. changing the labels must not give access to a cluster the user cannot update.
+	if err := h.enforceWithAttributes(ctx, rbac.ResourceDatabaseClusters, rbac.ActionUpdate, rbac.ObjectName(namespace, name),
+		dbClusterAttributes(oldDB),
+	); err != nil {
+		return nil, err
+	}
 	oldSched := oldDB.Spec.Backup.Schedules
 	updatedSched := db.Spec.Backup.Schedules
 
@@ -172,24 +184,29 @@ func (h *rbacHandler) GetDatabaseCluster(ctx context.Context, namespace, name st
 }
 
 func (h *rbacHandler) GetDatabaseClusterCredentials(ctx context.Context, namespace, name string) (*api.DatabaseClusterCredential, error) {
-	if err := h.enforce(ctx, rbac.ResourceDatabaseClusters, rbac.ActionRead, rbac.ObjectName(namespace, name)); err != nil {
+	attrs := h.dbClusterAttributesByName(ctx, namespace, name)
+	if err := h.enforceWithAttributes(ctx, rbac.ResourceDatabaseClusters, rbac.ActionRead, rbac.ObjectName(namespace, name), attrs); err != nil {
 		return nil, err
 	}
-	if err := h.enforce(ctx, rbac.ResourceDatabaseClusterCredentials, rbac.ActionRead, rbac.ObjectName(namespace, name)); err != nil {
+	if err := h.enforceWithAttributes(ctx, rbac.ResourceDatabaseClusterCredentials, rbac.ActionRead, rbac.ObjectName(namespace, name), attrs); err != nil {
 		return nil, err
 	}
 	return h.next.GetDatabaseClusterCredentials(ctx, namespace, name)
 }
 
 func (h *rbacHandler) GetDatabaseClusterComponents(ctx context.Context, namespace, name string) ([]api.DatabaseClusterComponent, error) {
-	if err := h.enforce(ctx, rbac.ResourceDatabaseClusters, rbac.ActionRead, rbac.ObjectName(namespace, name)); err != nil {
+	if err := h.enforceWithAttributes(ctx, rbac.ResourceDatabaseClusters, rbac.ActionRead, rbac.ObjectName(namespace, name),
+		h.dbClusterAttributesByName(ctx, namespace, name),
+	); err != nil {
 		return nil, err
 	}
 	return h.next.GetDatabaseClusterComponents(ctx, namespace, name)
 }
 
 func (h *rbacHandler) GetDatabaseClusterPitr(ctx context.Context, namespace, name string) (*api.DatabaseClusterPitr, error) {
-	if err := h.enforce(ctx, rbac.ResourceDatabaseClusters, rbac.ActionRead, rbac.ObjectName(namespace, name)); err != nil {
+	if err := h.enforceWithAttributes(ctx, rbac.ResourceDatabaseClusters, rbac.ActionRead, rbac.ObjectName(namespace, name),
+		h.dbClusterAttributesByName(ctx, namespace, name),
+	); err != nil {
 		return nil, err
 	}
 	return h.next.GetDatabaseClusterPitr(ctx, namespace, name)
@@ -198,7 +215,9 @@ func (h *rbacHandler) GetDatabaseClusterPitr(ctx context.Context, namespace, nam
 func (h *rbacHandler) enforceDBClusterRead(ctx context.Context, db *everestv1alpha1.DatabaseCluster) error {
 	name := db.GetName()
 	namespace := db.GetNamespace()
-	if err := h.enforce(ctx, rbac.ResourceDatabaseClusters, rbac.ActionRead, rbac.ObjectName(namespace, name)); err != nil {
+	if err := h.enforceWithAttributes(ctx, rbac.ResourceDatabaseClusters, rbac.ActionRead, rbac.ObjectName(namespace, name),
+		dbClusterAttributes(db),
+	); err != nil {
 		return err
 	}
 
@@ -231,8 +250,33 @@ func (h *rbacHandler) enforceDBClusterRead(ctx context.Context, db *everestv1alp
 
 func (h *rbacHandler) CreateDatabaseClusterSecret(ctx context.Context, namespace, dbName string, secret *corev1.Secret,
 ) (*corev1.Secret, error) {
-	if err := h.enforce(ctx, rbac.ResourceDatabaseClusters, rbac.ActionCreate, rbac.ObjectName(namespace, dbName)); err != nil {
+	if err := h.enforceWithAttributes(ctx, rbac.ResourceDatabaseClusters, rbac.ActionCreate, rbac.ObjectName(namespace, dbName),
+		h.dbClusterAttributesByName(ctx, namespace, dbName),
+	); err != nil {
 		return nil, err
 	}
 	return h.next.CreateDatabaseClusterSecret(ctx, namespace, dbName, secret)
 }
+
+// dbClusterAttributes returns the attributes of the database cluster matched against the conditions of the policy.
+func dbClusterAttributes(db *everestv1alpha1.DatabaseCluster) func() (rbac.Attributes, error) {
+	return func() (rbac.Attributes, error) {
+		return rbac.DatabaseClusterAttributes(string(db.Spec.Engine.Type), db.GetLabels()), nil
+	}
+}
+
+// dbClusterAttributesByName returns the attributes of the database cluster with the given name.
+// The cluster is only fetched if the attributes are requested.
+// A cluster that does not exist (yet) has no attributes.
+func (h *rbacHandler) dbClusterAttributesByName(ctx context.Context, namespace, name string) func() (rbac.Attributes, error) {
+	return func() (rbac.Attributes, error) {
+		db, err := h.next.GetDatabaseCluster(ctx, namespace, name)
+		if k8serrors.IsNotFound(err) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("GetDatabaseCluster failed: %w", err)
+		}
+		return dbClusterAttributes(db)()
+	}
+}
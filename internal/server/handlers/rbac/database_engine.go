@@ -65,7 +65,26 @@ func (h *rbacHandler) GetUpgradePlan(ctx context.Context, namespace string) (*ap
 	return result, nil
 }
 
-func (h *rbacHandler) ApproveUpgradePlan(ctx context.Context, namespace string) error {
+func (h *rbacHandler) ApproveUpgradePlan(
+	ctx context.Context,
+	namespace string,
+	req *api.UpgradePlanApproval,
+) (*api.UpgradePlanApprovalResult, error) {
+	if err := h.enforceUpgradePlan(ctx, namespace); err != nil {
+		return nil, err
+	}
+	return h.next.ApproveUpgradePlan(ctx, namespace, req)
+}
+
+func (h *rbacHandler) CancelUpgradePlanApproval(ctx context.Context, namespace string) error {
+	if err := h.enforceUpgradePlan(ctx, namespace); err != nil {
+		return err
+	}
+	return h.next.CancelUpgradePlanApproval(ctx, namespace)
+}
+
+// enforceUpgradePlan ensures that the user can update all the engines in the upgrade plan.
+func (h *rbacHandler) enforceUpgradePlan(ctx context.Context, namespace string) error {
 	plan, err := h.GetUpgradePlan(ctx, namespace)
 	if err != nil {
 		return err
@@ -76,5 +95,5 @@ func (h *rbacHandler) ApproveUpgradePlan(ctx context.Context, namespace string)
 			return err
 		}
 	}
-	return h.next.ApproveUpgradePlan(ctx, namespace)
+	return nil
 }
@@ -477,7 +477,7 @@ func TestRBAC_DatabaseEngines(t *testing.T) {
 		}
 	})
 
-	t.Run("ApproveUpgradePlan", func(t *testing.T) {
+	t.Run("ApproveUpgradePlan and CancelUpgradePlanApproval", func(t *testing.T) {
 		next := func() *handlers.MockHandler {
 			h := handlers.MockHandler{}
 			h.On("GetUpgradePlan", mock.Anything, "default").Return(&api.UpgradePlan{
@@ -488,7 +488,9 @@ func TestRBAC_DatabaseEngines(t *testing.T) {
 				},
 			}, nil,
 			)
-			h.On("ApproveUpgradePlan", mock.Anything, "default").Return(nil)
+			h.On("ApproveUpgradePlan", mock.Anything, "default", mock.Anything).
+				Return(&api.UpgradePlanApprovalResult{Status: pointer.To(api.Started)}, nil)
+			h.On("CancelUpgradePlanApproval", mock.Anything, "default").Return(nil)
 			return &h
 		}
 
@@ -586,7 +588,9 @@ func TestRBAC_DatabaseEngines(t *testing.T) {
 					userGetter: testUserGetter,
 				}
 
-				err = h.ApproveUpgradePlan(ctx, "default")
+				_, err = h.ApproveUpgradePlan(ctx, "default", &api.UpgradePlanApproval{})
+				assert.ErrorIs(t, tc.wantErr, err)
+				err = h.CancelUpgradePlanApproval(ctx, "default")
 				assert.ErrorIs(t, tc.wantErr, err)
 			})
 		}
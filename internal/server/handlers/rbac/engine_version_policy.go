@@ -0,0 +1,26 @@
+package rbac
+
+import (
+	"context"
+
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/rbac"
+)
+
+func (h *rbacHandler) GetEngineVersionPolicy(ctx context.Context, namespace string) (*api.EngineVersionPolicy, error) {
+	if err := h.enforce(ctx, rbac.ResourceNamespaces, rbac.ActionRead, rbac.ObjectName(namespace)); err != nil {
+		return nil, err
+	}
+	return h.next.GetEngineVersionPolicy(ctx, namespace)
+}
+
+func (h *rbacHandler) UpdateEngineVersionPolicy(
+	ctx context.Context,
+	namespace string,
+	req *api.EngineVersionPolicy,
+) (*api.EngineVersionPolicy, error) {
+	if err := h.enforce(ctx, rbac.ResourceNamespaces, rbac.ActionUpdate, rbac.ObjectName(namespace)); err != nil {
+		return nil, err
+	}
+	return h.next.UpdateEngineVersionPolicy(ctx, namespace, req)
+}
@@ -0,0 +1,85 @@
+package rbac
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/internal/server/handlers"
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/rbac"
+)
+
+func TestRBAC_EngineVersionPolicy(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		desc          string
+		policy        string
+		wantGetErr    error
+		wantUpdateErr error
+	}{
+		{
+			desc: "admin",
+			policy: newPolicy(
+				"g, bob, role:admin",
+			),
+		},
+		{
+			desc: "read and update",
+			policy: newPolicy(
+				"p, role:test, namespaces, read, default",
+				"p, role:test, namespaces, update, default",
+				"g, bob, role:test",
+			),
+		},
+		{
+			desc: "read only",
+			policy: newPolicy(
+				"p, role:test, namespaces, read, *",
+				"g, bob, role:test",
+			),
+			wantUpdateErr: ErrInsufficientPermissions,
+		},
+		{
+			desc: "other namespace",
+			policy: newPolicy(
+				"p, role:test, namespaces, *, other",
+				"g, bob, role:test",
+			),
+			wantGetErr:    ErrInsufficientPermissions,
+			wantUpdateErr: ErrInsufficientPermissions,
+		},
+	}
+
+	ctx := context.WithValue(context.Background(), common.UserCtxKey, rbac.User{Subject: "bob"})
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			k8sMock := newConfigMapMock(tc.policy)
+			enf, err := rbac.NewEnforcer(ctx, k8sMock, zap.NewNop().Sugar())
+			require.NoError(t, err)
+			next := &handlers.MockHandler{}
+			next.On("GetEngineVersionPolicy", mock.Anything, "default").Return(&api.EngineVersionPolicy{}, nil)
+			next.On("UpdateEngineVersionPolicy", mock.Anything, "default", mock.Anything).Return(&api.EngineVersionPolicy{}, nil)
+
+			h := &rbacHandler{
+				next:       next,
+				log:        zap.NewNop().Sugar(),
+				enforcer:   enf,
+				userGetter: testUserGetter,
+			}
+
+			_, err = h.GetEngineVersionPolicy(ctx, "default")
+			assert.ErrorIs(t, err, tc.wantGetErr)
+			_, err = h.UpdateEngineVersionPolicy(ctx, "default", &api.EngineVersionPolicy{})
+			assert.ErrorIs(t, err, tc.wantUpdateErr)
+		})
+	}
+}
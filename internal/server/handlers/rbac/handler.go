@@ -54,24 +54,57 @@ func (h *rbacHandler) enforce(
 	resource,
 	action,
 	object string,
+) error {
+	return h.enforceWithAttributes(ctx, resource, action, object, nil)
+}
+
+// enforceWithAttributes is like enforce, but the conditions of the policy rules
+// are also matched against the attributes of the object.
+// The attributes are only requested if the policy has conditional rules
+// and the operation is not allowed without them, so that they may be fetched lazily.
+func (h *rbacHandler) enforceWithAttributes(
+	ctx context.Context,
+	resource,
+	action,
+	object string,
+	attributes func() (rbac.Attributes, error),
 ) error {
 	user, err := h.userGetter(ctx)
 	if err != nil {
 		return err
 	}
 
-	// User is allowed to perform the operation if the user's subject or any
-	// of its groups have the required permission.
-	for _, sub := range append([]string{user.Subject}, user.Groups...) {
-		ok, err := h.enforcer.Enforce(sub, resource, action, object)
+	ok, err := h.allowed(user, resource, action, object, nil)
+	if err != nil {
+		return err
+	}
+	if !ok && attributes != nil && rbac.HasConditions(h.enforcer) {
+		attrs, err := attributes()
 		if err != nil {
-			return fmt.Errorf("enforce error: %w", err)
+			return err
 		}
-		if ok {
-			return nil
+		if ok, err = h.allowed(user, resource, action, object, attrs); err != nil {
+			return err
 		}
 	}
+	if ok {
+		return nil
+	}
 
 	h.log.Warnf("Permission denied: [%s %s %s %s]", user.Subject, resource, action, object)
 	return ErrInsufficientPermissions
 }
+
+// allowed returns true if the user's subject or any of its groups have the required permission.
+func (h *rbacHandler) allowed(user rbac.User, resource, action, object string, attrs rbac.Attributes) (bool, error) {
+	for _, sub := range append([]string{user.Subject}, user.Groups...) {
+		ok, err := h.enforcer.Enforce(sub, resource, action, object, attrs)
+		if err != nil {
+			return false, fmt.Errorf("enforce error: %w", err)
+		}
+		if ok {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -4,10 +4,14 @@ package rbac
 import (
 	"context"
 	"fmt"
+	"maps"
+	"slices"
 
 	"github.com/AlekSi/pointer"
 
 	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/rbac"
 )
 
 func (h *rbacHandler) GetKubernetesClusterResources(ctx context.Context) (*api.KubernetesClusterResources, error) {
@@ -77,3 +81,110 @@ func (h *rbacHandler) GetUserPermissions(ctx context.Context) (*api.UserPermissi
 	}
 	return res, nil
 }
+
+func (h *rbacHandler) GetUserPermissionMatrix(ctx context.Context) (*api.UserPermissionMatrix, error) {
+	user, err := h.userGetter(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	nextRes, err := h.next.GetUserPermissionMatrix(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to GetUserPermissionMatrix: %w", err)
+	}
+	if !nextRes.Enabled {
+		// All actions are allowed if RBAC is disabled.
+		return nextRes, nil
+	}
+
+	matrix, err := rbac.NewPermissionMatrix(h.enforcer, append([]string{user.Subject}, user.Groups...)...)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]api.ResourcePermissions, 0, len(matrix))
+	for _, resource := range slices.Sorted(maps.Keys(matrix)) {
+		objects := make([]api.ObjectPermissions, 0, len(matrix[resource]))
+		for _, object := range slices.Sorted(maps.Keys(matrix[resource])) {
+			if len(matrix[resource][object]) == 0 {
+				continue
+			}
+			objects = append(objects, api.ObjectPermissions{
+				Object:  object,
+				Actions: matrix[resource][object],
+			})
+		}
+		if len(objects) == 0 {
+			continue
+		}
+		resources = append(resources, api.ResourcePermissions{
+			Resource: resource,
+			Objects:  objects,
+		})
+	}
+	nextRes.Resources = resources
+	return nextRes, nil
+}
+
+func (h *rbacHandler) ExplainPermission(ctx context.Context, req *api.PermissionExplanationRequest) (*api.PermissionExplanation, error) {
+	user, err := h.userGetter(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	nextRes, err := h.next.ExplainPermission(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to ExplainPermission: %w", err)
+	}
+
+	subject := pointer.Get(req.Subject)
+	// The permissions of the user come from both its subject and groups, see allowed.
+	explained := user
+	if subject != "" && subject != user.Subject {
+		explained = rbac.User{Subject: subject}
+	}
+	// Users may explain their own permissions, only admins may explain the permissions of others.
+	if nextRes.Enabled && explained.Subject != user.Subject && !slices.Contains(user.Groups, subject) {
+		isAdmin, err := h.isAdmin(user)
+		if err != nil {
+			return nil, err
+		}
+		if !isAdmin {
+			h.log.Warnf("Permission denied: [%s] cannot explain the permissions of [%s]", user.Subject, subject)
+			return nil, ErrInsufficientPermissions
+		}
+	}
+
+	explanation, err := rbac.Explain(h.enforcer, explained, req.Resource, req.Action, req.Object, pointer.Get(req.Attributes))
+	if err != nil {
+		return nil, fmt.Errorf("failed to explain permission: %w", err)
+	}
+	nextRes.Allowed = explanation.Allowed
+	nextRes.DenyOverride = explanation.DenyOverride
+	if explanation.Policies != nil {
+		nextRes.Policies = explanation.Policies
+	}
+	if explanation.Roles != nil {
+		nextRes.Roles = explanation.Roles
+	}
+	return nextRes, nil
+}
+
+// isAdmin returns true if the user or any of its groups has the admin role.
+func (h *rbacHandler) isAdmin(user rbac.User) (bool, error) {
+	return h.hasRole(user, common.EverestAdminRole)
+}
+
+// hasRole returns true if the user or any of its groups has the role, directly or through other roles.
+func (h *rbacHandler) hasRole(user rbac.User, role string) (bool, error) {
+	for _, sub := range append([]string{user.Subject}, user.Groups...) {
+		roles, err := h.enforcer.GetImplicitRolesForUser(sub)
+		if err != nil {
+			return false, fmt.Errorf("failed to GetImplicitRolesForUser: %w", err)
+		}
+		if slices.Contains(roles, role) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/AlekSi/pointer"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -60,6 +61,9 @@ func TestRBAC_Kubernetes(t *testing.T) {
 					{"bob", "pod-scheduling-policies", "*", "*"},
 					{"bob", "data-importers", "*", "*"},
 					{"bob", "data-import-jobs", "*", "*/*"},
+					{"bob", "telemetry", "*", "*"},
+					{"bob", "settings", "*", "*"},
+					{"bob", "accounts", "*", "*"},
 				},
 			},
 			{
@@ -150,4 +154,205 @@ func TestRBAC_Kubernetes(t *testing.T) {
 			})
 		}
 	})
+
+	t.Run("ExplainPermission", func(t *testing.T) {
+		t.Parallel()
+
+		policy := newPolicy(
+			"p, role:dev, database-clusters, read, dev/*",
+			"p, role:ops, database-clusters, delete, dev/*",
+			"g, alice, role:dev",
+			"g, ops, role:ops",
+			"g, admin, role:admin",
+		)
+		req := &api.PermissionExplanationRequest{
+			Subject:  pointer.To("alice"),
+			Resource: "database-clusters",
+			Action:   "read",
+			Object:   "dev/cluster-1",
+		}
+
+		testCases := []struct {
+			desc    string
+			user    rbac.User
+			req     *api.PermissionExplanationRequest
+			want    *api.PermissionExplanation
+			wantErr error
+		}{
+			{
+				desc: "own permissions",
+				user: rbac.User{Subject: "alice"},
+				req: &api.PermissionExplanationRequest{
+					Resource: "database-clusters",
+					Action:   "read",
+					Object:   "dev/cluster-1",
+				},
+				want: &api.PermissionExplanation{
+					Enabled:  true,
+					Allowed:  true,
+					Policies: [][]string{{"role:dev", "database-clusters", "read", "dev/*"}},
+					Roles:    [][]string{{"alice", "role:dev"}},
+				},
+			},
+			{
+				desc: "permission of a group",
+				user: rbac.User{Subject: "carol", Groups: []string{"ops"}},
+				req: &api.PermissionExplanationRequest{
+					Resource: "database-clusters",
+					Action:   "delete",
+					Object:   "dev/cluster-1",
+				},
+				want: &api.PermissionExplanation{
+					Enabled:  true,
+					Allowed:  true,
+					Policies: [][]string{{"role:ops", "database-clusters", "delete", "dev/*"}},
+					Roles:    [][]string{{"ops", "role:ops"}},
+				},
+			},
+			{
+				desc: "admin explains another subject",
+				user: rbac.User{Subject: "admin"},
+				req:  req,
+				want: &api.PermissionExplanation{
+					Enabled:  true,
+					Allowed:  true,
+					Policies: [][]string{{"role:dev", "database-clusters", "read", "dev/*"}},
+					Roles:    [][]string{{"alice", "role:dev"}},
+				},
+			},
+			{
+				desc: "denied",
+				user: rbac.User{Subject: "alice"},
+				req: &api.PermissionExplanationRequest{
+					Resource: "database-clusters",
+					Action:   "delete",
+					Object:   "dev/cluster-1",
+				},
+				want: &api.PermissionExplanation{
+					Enabled:  true,
+					Policies: [][]string{},
+					Roles:    [][]string{},
+				},
+			},
+			{
+				desc:    "non-admin explains another subject",
+				user:    rbac.User{Subject: "bob"},
+				req:     req,
+				wantErr: ErrInsufficientPermissions,
+			},
+		}
+
+		for _, tc := range testCases {
+			ctx := context.WithValue(context.Background(), common.UserCtxKey, tc.user)
+			t.Run(tc.desc, func(t *testing.T) {
+				t.Parallel()
+				k8sMock := newConfigMapMock(policy)
+				enf, err := rbac.NewEnforcer(ctx, k8sMock, zap.NewNop().Sugar())
+				require.NoError(t, err)
+				next := &handlers.MockHandler{}
+				next.On("ExplainPermission", mock.Anything, mock.Anything).Return(
+					&api.PermissionExplanation{
+						Enabled:  true,
+						Policies: [][]string{},
+						Roles:    [][]string{},
+					},
+					nil,
+				)
+
+				h := &rbacHandler{
+					next:       next,
+					log:        zap.NewNop().Sugar(),
+					enforcer:   enf,
+					userGetter: testUserGetter,
+				}
+
+				got, err := h.ExplainPermission(ctx, tc.req)
+				if tc.wantErr != nil {
+					assert.ErrorIs(t, err, tc.wantErr)
+					return
+				}
+				require.NoError(t, err)
+				assert.Equal(t, tc.want, got)
+			})
+		}
+	})
+
+	t.Run("GetUserPermissionMatrix", func(t *testing.T) {
+		t.Parallel()
+
+		policy := newPolicy(
+			"p, role:dev, namespaces, read, *",
+			"p, role:dev, database-clusters, *, dev/*",
+			"p, devs, database-clusters, read, prod/*",
+			"g, alice, role:dev",
+		)
+
+		testCases := []struct {
+			desc    string
+			user    rbac.User
+			enabled bool
+			want    []api.ResourcePermissions
+		}{
+			{
+				desc:    "user and groups",
+				user:    rbac.User{Subject: "alice", Groups: []string{"devs"}},
+				enabled: true,
+				want: []api.ResourcePermissions{
+					{
+						Resource: "database-clusters",
+						Objects: []api.ObjectPermissions{
+							{Object: "dev/*", Actions: []string{"create", "read", "update", "delete"}},
+							{Object: "prod/*", Actions: []string{"read"}},
+						},
+					},
+					{
+						Resource: "namespaces",
+						Objects:  []api.ObjectPermissions{{Object: "*", Actions: []string{"read"}}},
+					},
+				},
+			},
+			{
+				desc:    "no permissions",
+				user:    rbac.User{Subject: "bob"},
+				enabled: true,
+				want:    []api.ResourcePermissions{},
+			},
+			{
+				desc:    "rbac disabled",
+				user:    rbac.User{Subject: "bob"},
+				enabled: false,
+				want:    []api.ResourcePermissions{},
+			},
+		}
+
+		for _, tc := range testCases {
+			ctx := context.WithValue(context.Background(), common.UserCtxKey, tc.user)
+			t.Run(tc.desc, func(t *testing.T) {
+				t.Parallel()
+				k8sMock := newConfigMapMock(policy)
+				enf, err := rbac.NewEnforcer(ctx, k8sMock, zap.NewNop().Sugar())
+				require.NoError(t, err)
+				next := &handlers.MockHandler{}
+				next.On("GetUserPermissionMatrix", mock.Anything).Return(
+					&api.UserPermissionMatrix{
+						Enabled:   tc.enabled,
+						Resources: []api.ResourcePermissions{},
+					},
+					nil,
+				)
+
+				h := &rbacHandler{
+					next:       next,
+					log:        zap.NewNop().Sugar(),
+					enforcer:   enf,
+					userGetter: testUserGetter,
+				}
+
+				got, err := h.GetUserPermissionMatrix(ctx)
+				require.NoError(t, err)
+				assert.Equal(t, tc.enabled, got.Enabled)
+				assert.Equal(t, tc.want, got.Resources)
+			})
+		}
+	})
 }
@@ -0,0 +1,26 @@
+package rbac
+
+import (
+	"context"
+
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/rbac"
+)
+
+func (h *rbacHandler) GetMaintenanceWindows(ctx context.Context, namespace string) (*api.MaintenanceWindows, error) {
+	if err := h.enforce(ctx, rbac.ResourceNamespaces, rbac.ActionRead, rbac.ObjectName(namespace)); err != nil {
+		return nil, err
+	}
+	return h.next.GetMaintenanceWindows(ctx, namespace)
+}
+
+func (h *rbacHandler) UpdateMaintenanceWindows(
+	ctx context.Context,
+	namespace string,
+	req *api.MaintenanceWindowsSpec,
+) (*api.MaintenanceWindows, error) {
+	if err := h.enforce(ctx, rbac.ResourceNamespaces, rbac.ActionUpdate, rbac.ObjectName(namespace)); err != nil {
+		return nil, err
+	}
+	return h.next.UpdateMaintenanceWindows(ctx, namespace, req)
+}
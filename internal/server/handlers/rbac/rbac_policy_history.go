@@ -0,0 +1,46 @@
+package rbac
+
+import (
+	"context"
+
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/rbac"
+)
+
+func (h *rbacHandler) ListRBACPolicyVersions(ctx context.Context) (*api.RBACPolicyVersionList, error) {
+	if err := h.enforce(ctx, rbac.ResourceSettings, rbac.ActionRead, rbac.ObjectName()); err != nil {
+		return nil, err
+	}
+	return h.next.ListRBACPolicyVersions(ctx)
+}
+
+func (h *rbacHandler) GetRBACPolicyVersion(ctx context.Context, version int) (*api.RBACPolicyVersion, error) {
+	if err := h.enforce(ctx, rbac.ResourceSettings, rbac.ActionRead, rbac.ObjectName()); err != nil {
+		return nil, err
+	}
+	return h.next.GetRBACPolicyVersion(ctx, version)
+}
+
+func (h *rbacHandler) DiffRBACPolicyVersions(ctx context.Context, from, to int) (*api.RBACPolicyDiff, error) {
+	if err := h.enforce(ctx, rbac.ResourceSettings, rbac.ActionRead, rbac.ObjectName()); err != nil {
+		return nil, err
+	}
+	return h.next.DiffRBACPolicyVersions(ctx, from, to)
+}
+
+func (h *rbacHandler) RollbackRBACPolicy(ctx context.Context, version int) (*api.RBACPolicyVersion, error) {
+	user, err := h.userGetter(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// The rolled back policy may grant any permissions, so only admins may roll it back.
+	isAdmin, err := h.isAdmin(user)
+	if err != nil {
+		return nil, err
+	}
+	if !isAdmin {
+		h.log.Warnf("Permission denied: [%s] cannot roll back the RBAC policy", user.Subject)
+		return nil, ErrInsufficientPermissions
+	}
+	return h.next.RollbackRBACPolicy(ctx, version)
+}
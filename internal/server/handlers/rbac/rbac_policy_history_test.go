@@ -0,0 +1,67 @@
+package rbac
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/internal/server/handlers"
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/rbac"
+)
+
+func TestRBAC_RollbackRBACPolicy(t *testing.T) {
+	t.Parallel()
+
+	policy := newPolicy(
+		"p, role:ops, settings, *, *",
+		"g, alice, role:ops",
+		"g, admins, role:admin",
+	)
+
+	testCases := []struct {
+		desc    string
+		user    rbac.User
+		wantErr error
+	}{
+		{
+			desc: "admin",
+			user: rbac.User{Subject: "bob", Groups: []string{"admins"}},
+		},
+		{
+			desc:    "settings update permission",
+			user:    rbac.User{Subject: "alice"},
+			wantErr: ErrInsufficientPermissions,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.WithValue(context.Background(), common.UserCtxKey, tc.user)
+			enf, err := rbac.NewEnforcer(ctx, newConfigMapMock(policy), zap.NewNop().Sugar())
+			require.NoError(t, err)
+			next := &handlers.MockHandler{}
+			next.On("RollbackRBACPolicy", mock.Anything, mock.Anything).Return(&api.RBACPolicyVersion{}, nil)
+
+			h := &rbacHandler{
+				next:       next,
+				log:        zap.NewNop().Sugar(),
+				enforcer:   enf,
+				userGetter: testUserGetter,
+			}
+
+			_, err = h.RollbackRBACPolicy(ctx, 1)
+			if tc.wantErr != nil {
+				require.ErrorIs(t, err, tc.wantErr)
+				next.AssertNotCalled(t, "RollbackRBACPolicy", mock.Anything, mock.Anything)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
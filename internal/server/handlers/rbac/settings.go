@@ -0,0 +1,22 @@
+package rbac
+
+import (
+	"context"
+
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/rbac"
+)
+
+func (h *rbacHandler) GetOIDCClaimMapping(ctx context.Context) (*api.OIDCClaimMapping, error) {
+	if err := h.enforce(ctx, rbac.ResourceSettings, rbac.ActionRead, rbac.ObjectName()); err != nil {
+		return nil, err
+	}
+	return h.next.GetOIDCClaimMapping(ctx)
+}
+
+func (h *rbacHandler) UpdateOIDCClaimMapping(ctx context.Context, req *api.OIDCClaimMapping) (*api.OIDCClaimMapping, error) {
+	if err := h.enforce(ctx, rbac.ResourceSettings, rbac.ActionUpdate, rbac.ObjectName()); err != nil {
+		return nil, err
+	}
+	return h.next.UpdateOIDCClaimMapping(ctx, req)
+}
@@ -0,0 +1,15 @@
+package rbac
+
+import (
+	"context"
+
+	"github.com/percona/everest/pkg/rbac"
+	"github.com/percona/everest/pkg/telemetry"
+)
+
+func (h *rbacHandler) GetTelemetry(ctx context.Context) (*telemetry.Telemetry, error) {
+	if err := h.enforce(ctx, rbac.ResourceTelemetry, rbac.ActionRead, rbac.ObjectName()); err != nil {
+		return nil, err
+	}
+	return h.next.GetTelemetry(ctx)
+}
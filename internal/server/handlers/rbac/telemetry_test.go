@@ -0,0 +1,72 @@
+package rbac
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/percona/everest/internal/server/handlers"
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/rbac"
+	"github.com/percona/everest/pkg/telemetry"
+)
+
+func TestRBAC_Telemetry(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		desc    string
+		policy  string
+		wantErr error
+	}{
+		{
+			desc: "admin",
+			policy: newPolicy(
+				"g, bob, role:admin",
+			),
+		},
+		{
+			desc: "read telemetry",
+			policy: newPolicy(
+				"p, role:test, telemetry, read, *",
+				"g, bob, role:test",
+			),
+		},
+		{
+			desc: "read everything in namespaces",
+			policy: newPolicy(
+				"p, role:test, namespaces, read, *",
+				"p, role:test, database-clusters, read, */*",
+				"g, bob, role:test",
+			),
+			wantErr: ErrInsufficientPermissions,
+		},
+	}
+
+	ctx := context.WithValue(context.Background(), common.UserCtxKey, rbac.User{Subject: "bob"})
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			k8sMock := newConfigMapMock(tc.policy)
+			enf, err := rbac.NewEnforcer(ctx, k8sMock, zap.NewNop().Sugar())
+			require.NoError(t, err)
+			next := &handlers.MockHandler{}
+			next.On("GetTelemetry", mock.Anything).Return(&telemetry.Telemetry{}, nil)
+
+			h := &rbacHandler{
+				next:       next,
+				log:        zap.NewNop().Sugar(),
+				enforcer:   enf,
+				userGetter: testUserGetter,
+			}
+
+			_, err = h.GetTelemetry(ctx)
+			assert.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package rbac
+
+import (
+	"context"
+
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/rbac"
+)
+
+func (h *rbacHandler) ListTemporaryGrants(ctx context.Context) (*api.TemporaryGrants, error) {
+	if err := h.enforce(ctx, rbac.ResourceSettings, rbac.ActionRead, rbac.ObjectName()); err != nil {
+		return nil, err
+	}
+	return h.next.ListTemporaryGrants(ctx)
+}
+
+func (h *rbacHandler) CreateTemporaryGrant(ctx context.Context, req *api.TemporaryGrantRequest) (*api.TemporaryGrant, error) {
+	if err := h.enforce(ctx, rbac.ResourceSettings, rbac.ActionCreate, rbac.ObjectName()); err != nil {
+		return nil, err
+	}
+	user, err := h.userGetter(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Users may only grant the roles they hold, so that they cannot escalate their own permissions.
+	// Only admins may grant the admin role.
+	isAdmin, err := h.isAdmin(user)
+	if err != nil {
+		return nil, err
+	}
+	if !isAdmin {
+		hasRole, err := h.hasRole(user, req.Role)
+		if err != nil {
+			return nil, err
+		}
+		if !hasRole {
+			h.log.Warnf("Permission denied: [%s] does not hold the role [%s] and cannot grant it", user.Subject, req.Role)
+			return nil, ErrInsufficientPermissions
+		}
+	}
+	return h.next.CreateTemporaryGrant(ctx, req)
+}
@@ -0,0 +1,107 @@
+package rbac
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/internal/server/handlers"
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/rbac"
+)
+
+func TestRBAC_TemporaryGrant(t *testing.T) {
+	t.Parallel()
+
+	policy := newPolicy(
+		"p, role:ops, settings, *, *",
+		"p, role:dev, database-clusters, *, dev/*",
+		"p, role:lead, database-clusters, read, *",
+		"g, role:lead, role:dev",
+		"g, alice, role:ops",
+		"g, alice, role:lead",
+		"g, bob, role:dev",
+		"g, admin, role:admin",
+	)
+
+	testCases := []struct {
+		desc    string
+		user    rbac.User
+		role    string
+		wantErr error
+	}{
+		{
+			desc: "admin grants the admin role",
+			user: rbac.User{Subject: "admin"},
+			role: common.EverestAdminRole,
+		},
+		{
+			desc: "admin grants a role it does not hold",
+			user: rbac.User{Subject: "admin"},
+			role: "role:dev",
+		},
+		{
+			desc: "role held directly",
+			user: rbac.User{Subject: "alice"},
+			role: "role:lead",
+		},
+		{
+			desc: "role held through another role",
+			user: rbac.User{Subject: "alice"},
+			role: "role:dev",
+		},
+		{
+			desc:    "admin role",
+			user:    rbac.User{Subject: "alice"},
+			role:    common.EverestAdminRole,
+			wantErr: ErrInsufficientPermissions,
+		},
+		{
+			desc:    "role not held",
+			user:    rbac.User{Subject: "alice"},
+			role:    "role:other",
+			wantErr: ErrInsufficientPermissions,
+		},
+		{
+			desc:    "no access to settings",
+			user:    rbac.User{Subject: "bob"},
+			role:    "role:dev",
+			wantErr: ErrInsufficientPermissions,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.WithValue(context.Background(), common.UserCtxKey, tc.user)
+			enf, err := rbac.NewEnforcer(ctx, newConfigMapMock(policy), zap.NewNop().Sugar())
+			require.NoError(t, err)
+			next := &handlers.MockHandler{}
+			next.On("CreateTemporaryGrant", mock.Anything, mock.Anything).Return(&api.TemporaryGrant{}, nil)
+
+			h := &rbacHandler{
+				next:       next,
+				log:        zap.NewNop().Sugar(),
+				enforcer:   enf,
+				userGetter: testUserGetter,
+			}
+
+			_, err = h.CreateTemporaryGrant(ctx, &api.TemporaryGrantRequest{
+				Subject:   "carol",
+				Role:      tc.role,
+				ExpiresAt: time.Now().Add(time.Hour),
+			})
+			if tc.wantErr != nil {
+				require.ErrorIs(t, err, tc.wantErr)
+				next.AssertNotCalled(t, "CreateTemporaryGrant", mock.Anything, mock.Anything)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package validation
+
+import (
+	"context"
+	"errors"
+
+	"github.com/percona/everest/api"
+	accountscli "github.com/percona/everest/pkg/accounts/cli"
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/rbac"
+	"github.com/percona/everest/pkg/session"
+)
+
+var (
+	errDeleteOwnAccount  = errors.New("cannot delete your own account")
+	errDisableOwnAccount = errors.New("cannot disable your own account")
+)
+
+func (h *validateHandler) ListAccounts(ctx context.Context) (*api.AccountList, error) {
+	return h.next.ListAccounts(ctx)
+}
+
+func (h *validateHandler) CreateAccount(ctx context.Context, req *api.AccountCreateRequest) (*api.Account, error) {
+	if err := accountscli.ValidateUsername(req.Username); err != nil {
+		return nil, errors.Join(ErrInvalidRequest, err)
+	}
+	if err := accountscli.ValidatePassword(req.Password); err != nil {
+		return nil, errors.Join(ErrInvalidRequest, err)
+	}
+	return h.next.CreateAccount(ctx, req)
+}
+
+func (h *validateHandler) GetAccount(ctx context.Context, username string) (*api.Account, error) {
+	return h.next.GetAccount(ctx, username)
+}
+
+func (h *validateHandler) UpdateAccount(ctx context.Context, username string, req *api.AccountUpdateRequest) (*api.Account, error) {
+	if req.Enabled != nil && !*req.Enabled {
+		// An administrator disabling their own account would lock themselves out.
+		if isOwnAccount(ctx, username) {
+			return nil, errors.Join(ErrInvalidRequest, errDisableOwnAccount)
+		}
+	}
+	if req.Capabilities != nil {
+		capabilities := make([]string, 0, len(*req.Capabilities))
+		for _, c := range *req.Capabilities {
+			capabilities = append(capabilities, string(c))
+		}
+		if err := accountscli.ValidateCapabilities(capabilities); err != nil {
+			return nil, errors.Join(ErrInvalidRequest, err)
+		}
+	}
+	return h.next.UpdateAccount(ctx, username, req)
+}
+
+func (h *validateHandler) DeleteAccount(ctx context.Context, username string) error {
+	if isOwnAccount(ctx, username) {
+		return errors.Join(ErrInvalidRequest, errDeleteOwnAccount)
+	}
+	return h.next.DeleteAccount(ctx, username)
+}
+
+func (h *validateHandler) SetAccountPassword(ctx context.Context, username string, req *api.AccountPasswordRequest) error {
+	if err := accountscli.ValidatePassword(req.Password); err != nil {
+		return errors.Join(ErrInvalidRequest, err)
+	}
+	return h.next.SetAccountPassword(ctx, username, req)
+}
+
+func (h *validateHandler) UnlockAccount(ctx context.Context, username string) error {
+	return h.next.UnlockAccount(ctx, username)
+}
+
+func (h *validateHandler) ResetAccountMFA(ctx context.Context, username string) error {
+	return h.next.ResetAccountMFA(ctx, username)
+}
+
+func (h *validateHandler) ListUserSessions(ctx context.Context, username string) (*api.UserSessionList, error) {
+	return h.next.ListUserSessions(ctx, username)
+}
+
+func (h *validateHandler) DeleteUserSessions(ctx context.Context, username string) error {
+	return h.next.DeleteUserSessions(ctx, username)
+}
+
+func (h *validateHandler) DeleteUserSession(ctx context.Context, username, sessionID string) error {
+	return h.next.DeleteUserSession(ctx, username, sessionID)
+}
+
+// isOwnAccount returns true if the request is made by the built-in user that owns the account.
+func isOwnAccount(ctx context.Context, username string) bool {
+	token, err := common.ExtractToken(ctx)
+	if err != nil {
+		return false
+	}
+	if iss, err := token.Claims.GetIssuer(); err != nil || iss != session.SessionManagerClaimsIssuer {
+		return false
+	}
+	user, err := rbac.GetUser(ctx)
+	return err == nil && user.Subject == username
+}
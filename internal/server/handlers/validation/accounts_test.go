@@ -0,0 +1,124 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/internal/server/handlers"
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/session"
+)
+
+func TestValidate_Accounts(t *testing.T) {
+	t.Parallel()
+
+	sessionCtx := func(sub, iss string) context.Context {
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{"sub": sub, "iss": iss})
+		return context.WithValue(context.Background(), common.UserCtxKey, token)
+	}
+	adminCtx := sessionCtx("admin:login", session.SessionManagerClaimsIssuer)
+	// An OIDC user with the same name does not own the built-in account.
+	oidcCtx := sessionCtx("admin", "https://idp.example.com")
+
+	newHandler := func() *validateHandler {
+		next := &handlers.MockHandler{}
+		next.On("UpdateAccount", mock.Anything, mock.Anything, mock.Anything).Return(&api.Account{}, nil)
+		next.On("DeleteAccount", mock.Anything, mock.Anything).Return(nil)
+		h := &validateHandler{log: zap.NewNop().Sugar()}
+		h.SetNext(next)
+		return h
+	}
+
+	t.Run("UpdateAccount", func(t *testing.T) {
+		t.Parallel()
+
+		testCases := []struct {
+			name     string
+			ctx      context.Context
+			username string
+			req      *api.AccountUpdateRequest
+			wantErr  error
+		}{
+			{
+				name:     "disable another account",
+				ctx:      adminCtx,
+				username: "alice",
+				req:      &api.AccountUpdateRequest{Enabled: pointer.ToBool(false)},
+			},
+			{
+				name:     "disable own account",
+				ctx:      adminCtx,
+				username: "admin",
+				req:      &api.AccountUpdateRequest{Enabled: pointer.ToBool(false)},
+				wantErr:  errDisableOwnAccount,
+			},
+			{
+				name:     "disable built-in account by OIDC user",
+				ctx:      oidcCtx,
+				username: "admin",
+				req:      &api.AccountUpdateRequest{Enabled: pointer.ToBool(false)},
+			},
+			{
+				name:     "enable own account",
+				ctx:      adminCtx,
+				username: "admin",
+				req:      &api.AccountUpdateRequest{Enabled: pointer.ToBool(true)},
+			},
+			{
+				name:     "valid capabilities",
+				ctx:      adminCtx,
+				username: "alice",
+				req: &api.AccountUpdateRequest{Capabilities: &[]api.AccountUpdateRequestCapabilities{
+					api.AccountUpdateRequestCapabilitiesLogin,
+					api.AccountUpdateRequestCapabilitiesApiKey,
+				}},
+			},
+			{
+				name:     "unknown capability",
+				ctx:      adminCtx,
+				username: "alice",
+				req:      &api.AccountUpdateRequest{Capabilities: &[]api.AccountUpdateRequestCapabilities{"admin"}},
+				wantErr:  ErrInvalidRequest,
+			},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				t.Parallel()
+				_, err := newHandler().UpdateAccount(tc.ctx, tc.username, tc.req)
+				assert.ErrorIs(t, err, tc.wantErr)
+			})
+		}
+	})
+
+	t.Run("DeleteAccount", func(t *testing.T) {
+		t.Parallel()
+
+		h := newHandler()
+		assert.NoError(t, h.DeleteAccount(adminCtx, "alice"))
+		err := h.DeleteAccount(adminCtx, "admin")
+		assert.ErrorIs(t, err, errDeleteOwnAccount)
+		assert.ErrorIs(t, err, ErrInvalidRequest)
+	})
+}
@@ -2,11 +2,241 @@ package validation
 
 import (
 	"context"
+	"errors"
+	"fmt"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 
 	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/utils"
+)
+
+const (
+	maxDataImportTailLines = 5000
+
+	s3AccessKeyIDSecretKey     = "AWS_ACCESS_KEY_ID"
+	s3SecretAccessKeySecretKey = "AWS_SECRET_ACCESS_KEY"
+)
+
+var (
+	errDataImportJobNoTargetCluster = errors.New(".spec.targetClusterName cannot be empty")
+	errDataImportJobNoImporter      = errors.New(".spec.dataImporterName cannot be empty")
+	errDataImportJobNoSource        = errors.New(".spec.source cannot be empty")
+	errDataImportJobNoPath          = errors.New(".spec.source.path cannot be empty")
+	errDataImportJobNoS3            = errors.New(".spec.source.s3 cannot be empty")
+	errDataImportJobNoBucket        = errors.New(".spec.source.s3.bucket cannot be empty")
+	errDataImportJobNoRegion        = errors.New(".spec.source.s3.region cannot be empty")
+	errDataImportJobNoCredentials   = errors.New(".spec.source.s3.credentialsSecretName cannot be empty")
+	errDataImportJobInvalidEndpoint = ErrInvalidURL(".spec.source.s3.endpointURL")
+	errDataImportInvalidTailLines   = fmt.Errorf("tailLines must be between 1 and %d", maxDataImportTailLines)
+
+	errDataImporterNotFound = func(name string) error {
+		return fmt.Errorf("data importer '%s' does not exist", name)
+	}
+	errDataImporterUnsupportedEngine = func(name string, engine everestv1alpha1.EngineType) error {
+		return fmt.Errorf("data importer '%s' does not support engine type '%s'", name, engine)
+	}
+	errDataImportTargetNotFound = func(name string) error {
+		return fmt.Errorf("database cluster '%s' does not exist", name)
+	}
+	errDataImportTargetNotReady = func(name string, state everestv1alpha1.AppState) error {
+		return fmt.Errorf("database cluster '%s' is not ready (current state: '%s')", name, state)
+	}
+	errDataImportCredentialsNotFound = func(name string) error {
+		return fmt.Errorf("credentials secret '%s' does not exist", name)
+	}
+	errDataImportCredentialsMissingKey = func(name, key string) error {
+		return fmt.Errorf("credentials secret '%s' does not contain the key '%s'", name, key)
+	}
+	errDataImportAlreadyRunning = func(dbName string) error {
+		return fmt.Errorf("a data import job is already in progress for database cluster '%s'", dbName)
+	}
+	errDataImportJobInProgress = func(name string) error {
+		return fmt.Errorf("data import job '%s' is still in progress, cancel it instead", name)
+	}
+	errDataImportJobFinished = func(name string, state everestv1alpha1.DataImportJobState) error {
+		return fmt.Errorf("data import job '%s' has already finished with state '%s'", name, state)
+	}
 )
 
 // ListDataImportJobs returns a list of DataImportJobs for the specified database clusters.
 func (h *validateHandler) ListDataImportJobs(ctx context.Context, namespace, dbName string) (*everestv1alpha1.DataImportJobList, error) {
 	return h.next.ListDataImportJobs(ctx, namespace, dbName)
 }
+
+// CreateDataImportJob creates a new DataImportJob.
+func (h *validateHandler) CreateDataImportJob(ctx context.Context, req *everestv1alpha1.DataImportJob) (*everestv1alpha1.DataImportJob, error) {
+	if err := h.validateDataImportJob(ctx, req); err != nil {
+		return nil, errors.Join(ErrInvalidRequest, err)
+	}
+	return h.next.CreateDataImportJob(ctx, req)
+}
+
+// GetDataImportJob returns the specified DataImportJob.
+func (h *validateHandler) GetDataImportJob(ctx context.Context, namespace, name string) (*everestv1alpha1.DataImportJob, error) {
+	return h.next.GetDataImportJob(ctx, namespace, name)
+}
+
+// GetDataImportJobProgress returns the progress and the most recent logs of the specified DataImportJob.
+func (h *validateHandler) GetDataImportJobProgress(
+	ctx context.Context,
+	namespace, name string,
+	params *api.GetDataImportJobProgressParams,
+) (*api.DataImportJobProgress, error) {
+	if params != nil && params.TailLines != nil {
+		if tail := *params.TailLines; tail < 1 || tail > maxDataImportTailLines {
+			return nil, errors.Join(ErrInvalidRequest, errDataImportInvalidTailLines)
+		}
+	}
+	return h.next.GetDataImportJobProgress(ctx, namespace, name, params)
+}
+
+// CancelDataImportJob stops the specified DataImportJob.
+func (h *validateHandler) CancelDataImportJob(ctx context.Context, namespace, name string) error {
+	job, err := h.kubeConnector.GetDataImportJob(ctx, types.NamespacedName{Namespace: namespace, Name: name})
+	if err != nil {
+		return err
+	}
+	if isDataImportJobFinished(job) {
+		return errors.Join(ErrInvalidRequest, errDataImportJobFinished(name, job.Status.State))
+	}
+	return h.next.CancelDataImportJob(ctx, namespace, name)
+}
+
+// DeleteDataImportJob deletes the specified DataImportJob.
+func (h *validateHandler) DeleteDataImportJob(ctx context.Context, namespace, name string) error {
+	job, err := h.kubeConnector.GetDataImportJob(ctx, types.NamespacedName{Namespace: namespace, Name: name})
+	if err != nil {
+		return err
+	}
+	if !isDataImportJobFinished(job) {
+		return errors.Join(ErrInvalidRequest, errDataImportJobInProgress(name))
+	}
+	return h.next.DeleteDataImportJob(ctx, namespace, name)
+}
+
+//nolint:cyclop
+func (h *validateHandler) validateDataImportJob(ctx context.Context, job *everestv1alpha1.DataImportJob) error {
+	if err := validateMetadata(job); err != nil {
+		return err
+	}
+	if err := utils.ValidateRFC1035(job.GetName(), "metadata.name"); err != nil {
+		return err
+	}
+	if err := validateDataImportJobSpec(&job.Spec); err != nil {
+		return err
+	}
+
+	namespace := job.GetNamespace()
+	importerName := job.Spec.DataImporterName
+	importer, err := h.kubeConnector.GetDataImporter(ctx, types.NamespacedName{Name: importerName})
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return errDataImporterNotFound(importerName)
+		}
+		return err
+	}
+
+	dbName := job.Spec.TargetClusterName
+	db, err := h.kubeConnector.GetDatabaseCluster(ctx, types.NamespacedName{Namespace: namespace, Name: dbName})
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return errDataImportTargetNotFound(dbName)
+		}
+		return err
+	}
+	if db.Status.Status != everestv1alpha1.AppStateReady {
+		return errDataImportTargetNotReady(dbName, db.Status.Status)
+	}
+	if !importer.Spec.SupportedEngines.Has(db.Spec.Engine.Type) {
+		return errDataImporterUnsupportedEngine(importerName, db.Spec.Engine.Type)
+	}
+
+	config := job.Spec.Config
+	if config == nil && importer.Spec.Config.OpenAPIV3Schema != nil {
+		// Validate against an empty object so that the required fields of the schema are checked.
+		config = &runtime.RawExtension{Raw: []byte("{}")}
+	}
+	if err := importer.Spec.Config.Validate(config); err != nil {
+		return err
+	}
+
+	if err := h.validateDataImportCredentials(ctx, namespace, job.Spec.Source.S3); err != nil {
+		return err
+	}
+
+	jobs, err := h.kubeConnector.ListDataImportJobs(ctx, namespace, dbName)
+	if err != nil {
+		return err
+	}
+	for _, j := range jobs.Items {
+		if !isDataImportJobFinished(&j) && j.GetDeletionTimestamp().IsZero() {
+			return errDataImportAlreadyRunning(dbName)
+		}
+	}
+	return nil
+}
+
+func validateDataImportJobSpec(spec *everestv1alpha1.DataImportJobSpec) error {
+	if spec.TargetClusterName == "" {
+		return errDataImportJobNoTargetCluster
+	}
+	if spec.DataImportJobTemplate == nil || spec.DataImporterName == "" {
+		return errDataImportJobNoImporter
+	}
+	source := spec.Source
+	if source == nil {
+		return errDataImportJobNoSource
+	}
+	if source.Path == "" {
+		return errDataImportJobNoPath
+	}
+	s3 := source.S3
+	if s3 == nil {
+		return errDataImportJobNoS3
+	}
+	if s3.Bucket == "" {
+		return errDataImportJobNoBucket
+	}
+	if s3.Region == "" {
+		return errDataImportJobNoRegion
+	}
+	if !utils.ValidateURL(s3.EndpointURL) {
+		return errDataImportJobInvalidEndpoint
+	}
+	if s3.CredentialsSecretName == "" {
+		return errDataImportJobNoCredentials
+	}
+	return nil
+}
+
+// validateDataImportCredentials ensures that the S3 credentials are available to the data importer.
+// When the credentials are provided inline, they are written into the Secret by the operator webhook,
+// so the Secret does not need to exist yet.
+func (h *validateHandler) validateDataImportCredentials(ctx context.Context, namespace string, s3 *everestv1alpha1.DataImportJobS3Source) error {
+	if s3.AccessKeyID != "" && s3.SecretAccessKey != "" {
+		return nil
+	}
+	secret, err := h.kubeConnector.GetSecret(ctx, types.NamespacedName{Namespace: namespace, Name: s3.CredentialsSecretName})
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return errDataImportCredentialsNotFound(s3.CredentialsSecretName)
+		}
+		return err
+	}
+	for _, key := range []string{s3AccessKeyIDSecretKey, s3SecretAccessKeySecretKey} {
+		if len(secret.Data[key]) == 0 && secret.StringData[key] == "" {
+			return errDataImportCredentialsMissingKey(s3.CredentialsSecretName, key)
+		}
+	}
+	return nil
+}
+
+// isDataImportJobFinished returns true if the DataImportJob has reached a terminal state.
+func isDataImportJobFinished(job *everestv1alpha1.DataImportJob) bool {
+	return job.Status.State == everestv1alpha1.DataImportJobStateSucceeded ||
+		job.Status.State == everestv1alpha1.DataImportJobStateFailed
+}
@@ -0,0 +1,418 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/internal/server/handlers/k8s"
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/kubernetes"
+	"github.com/percona/everest/pkg/utils"
+	versionservice "github.com/percona/everest/pkg/version_service"
+)
+
+const (
+	testImportNamespace = "default"
+	testImportDBName    = "test-db"
+	testImporterName    = "test-importer"
+	testImportSecret    = "test-creds"
+)
+
+func newTestDataImportJob(mutate func(job *everestv1alpha1.DataImportJob)) *everestv1alpha1.DataImportJob {
+	job := &everestv1alpha1.DataImportJob{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "import-1",
+			Namespace: testImportNamespace,
+		},
+		Spec: everestv1alpha1.DataImportJobSpec{
+			TargetClusterName: testImportDBName,
+			DataImportJobTemplate: &everestv1alpha1.DataImportJobTemplate{
+				DataImporterName: testImporterName,
+				Source: &everestv1alpha1.DataImportJobSource{
+					Path: "/backups/dump",
+					S3: &everestv1alpha1.DataImportJobS3Source{
+						Bucket:                "bucket",
+						Region:                "us-east-1",
+						EndpointURL:           "https://s3.amazonaws.com",
+						CredentialsSecretName: testImportSecret,
+					},
+				},
+			},
+		},
+	}
+	if mutate != nil {
+		mutate(job)
+	}
+	return job
+}
+
+func newTestDataImporter() *everestv1alpha1.DataImporter {
+	return &everestv1alpha1.DataImporter{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: testImporterName,
+		},
+		Spec: everestv1alpha1.DataImporterSpec{
+			SupportedEngines: everestv1alpha1.EngineList{everestv1alpha1.DatabaseEnginePXC},
+		},
+	}
+}
+
+func newTestImportTargetDB(engine everestv1alpha1.EngineType, state everestv1alpha1.AppState) *everestv1alpha1.DatabaseCluster {
+	return &everestv1alpha1.DatabaseCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      testImportDBName,
+			Namespace: testImportNamespace,
+		},
+		Spec: everestv1alpha1.DatabaseClusterSpec{
+			Engine: everestv1alpha1.Engine{Type: engine},
+		},
+		Status: everestv1alpha1.DatabaseClusterStatus{
+			Status: state,
+		},
+	}
+}
+
+func newTestImportSecret(data map[string][]byte) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      testImportSecret,
+			Namespace: testImportNamespace,
+		},
+		Data: data,
+	}
+}
+
+func newTestImportJobWithState(name string, state everestv1alpha1.DataImportJobState) *everestv1alpha1.DataImportJob {
+	return &everestv1alpha1.DataImportJob{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: testImportNamespace,
+			Labels: map[string]string{
+				common.DatabaseClusterNameLabel: testImportDBName,
+			},
+		},
+		Spec: everestv1alpha1.DataImportJobSpec{
+			TargetClusterName: testImportDBName,
+		},
+		Status: everestv1alpha1.DataImportJobStatus{
+			State: state,
+		},
+	}
+}
+
+func newTestDataImportValidator(objs ...ctrlclient.Object) *validateHandler {
+	mockClient := fakeclient.NewClientBuilder().
+		WithScheme(kubernetes.CreateScheme()).
+		WithObjects(objs...).
+		Build()
+	k := kubernetes.NewEmpty(zap.NewNop().Sugar()).WithKubernetesClient(mockClient)
+	k8sHandler := k8s.New(zap.NewNop().Sugar(), k, versionservice.Config{}, nil)
+
+	valHandler := New(zap.NewNop().Sugar(), k)
+	valHandler.SetNext(k8sHandler)
+	return valHandler.(*validateHandler)
+}
+
+func TestValidate_CreateDataImportJob(t *testing.T) {
+	t.Parallel()
+
+	validCreds := map[string][]byte{
+		s3AccessKeyIDSecretKey:     []byte("access"),
+		s3SecretAccessKeySecretKey: []byte("secret"),
+	}
+	importerWithSchema := newTestDataImporter()
+	importerWithSchema.Spec.Config.OpenAPIV3Schema = &apiextensionsv1.JSONSchemaProps{
+		Type:     "object",
+		Required: []string{"mode"},
+		Properties: map[string]apiextensionsv1.JSONSchemaProps{
+			"mode": {Type: "string"},
+		},
+	}
+
+	testCases := []struct {
+		name    string
+		objs    []ctrlclient.Object
+		job     *everestv1alpha1.DataImportJob
+		wantErr error
+	}{
+		{
+			name: "invalid name",
+			job: newTestDataImportJob(func(job *everestv1alpha1.DataImportJob) {
+				job.SetName("Import_1")
+			}),
+			wantErr: utils.ErrNameNotRFC1035Compatible("metadata.name"),
+		},
+		{
+			name: "empty target cluster",
+			job: newTestDataImportJob(func(job *everestv1alpha1.DataImportJob) {
+				job.Spec.TargetClusterName = ""
+			}),
+			wantErr: errDataImportJobNoTargetCluster,
+		},
+		{
+			name: "empty data importer",
+			job: newTestDataImportJob(func(job *everestv1alpha1.DataImportJob) {
+				job.Spec.DataImportJobTemplate = nil
+			}),
+			wantErr: errDataImportJobNoImporter,
+		},
+		{
+			name: "empty s3 source",
+			job: newTestDataImportJob(func(job *everestv1alpha1.DataImportJob) {
+				job.Spec.Source.S3 = nil
+			}),
+			wantErr: errDataImportJobNoS3,
+		},
+		{
+			name: "invalid endpoint URL",
+			job: newTestDataImportJob(func(job *everestv1alpha1.DataImportJob) {
+				job.Spec.Source.S3.EndpointURL = "not a url"
+			}),
+			wantErr: errDataImportJobInvalidEndpoint,
+		},
+		{
+			name:    "data importer does not exist",
+			job:     newTestDataImportJob(nil),
+			wantErr: errDataImporterNotFound(testImporterName),
+		},
+		{
+			name: "target cluster does not exist",
+			objs: []ctrlclient.Object{
+				newTestDataImporter(),
+			},
+			job:     newTestDataImportJob(nil),
+			wantErr: errDataImportTargetNotFound(testImportDBName),
+		},
+		{
+			name: "target cluster not ready",
+			objs: []ctrlclient.Object{
+				newTestDataImporter(),
+				newTestImportTargetDB(everestv1alpha1.DatabaseEnginePXC, everestv1alpha1.AppStateInit),
+			},
+			job:     newTestDataImportJob(nil),
+			wantErr: errDataImportTargetNotReady(testImportDBName, everestv1alpha1.AppStateInit),
+		},
+		{
+			name: "engine not supported by data importer",
+			objs: []ctrlclient.Object{
+				newTestDataImporter(),
+				newTestImportTargetDB(everestv1alpha1.DatabaseEnginePSMDB, everestv1alpha1.AppStateReady),
+			},
+			job:     newTestDataImportJob(nil),
+			wantErr: errDataImporterUnsupportedEngine(testImporterName, everestv1alpha1.DatabaseEnginePSMDB),
+		},
+		{
+			name: "config does not match schema",
+			objs: []ctrlclient.Object{
+				importerWithSchema,
+				newTestImportTargetDB(everestv1alpha1.DatabaseEnginePXC, everestv1alpha1.AppStateReady),
+				newTestImportSecret(validCreds),
+			},
+			job:     newTestDataImportJob(nil),
+			wantErr: everestv1alpha1.ErrSchemaValidationFailure,
+		},
+		{
+			name: "credentials secret does not exist",
+			objs: []ctrlclient.Object{
+				newTestDataImporter(),
+				newTestImportTargetDB(everestv1alpha1.DatabaseEnginePXC, everestv1alpha1.AppStateReady),
+			},
+			job:     newTestDataImportJob(nil),
+			wantErr: errDataImportCredentialsNotFound(testImportSecret),
+		},
+		{
+			name: "credentials secret is missing a key",
+			objs: []ctrlclient.Object{
+				newTestDataImporter(),
+				newTestImportTargetDB(everestv1alpha1.DatabaseEnginePXC, everestv1alpha1.AppStateReady),
+				newTestImportSecret(map[string][]byte{s3AccessKeyIDSecretKey: []byte("access")}),
+			},
+			job:     newTestDataImportJob(nil),
+			wantErr: errDataImportCredentialsMissingKey(testImportSecret, s3SecretAccessKeySecretKey),
+		},
+		{
+			name: "another import in progress",
+			objs: []ctrlclient.Object{
+				newTestDataImporter(),
+				newTestImportTargetDB(everestv1alpha1.DatabaseEnginePXC, everestv1alpha1.AppStateReady),
+				newTestImportSecret(validCreds),
+				newTestImportJobWithState("import-0", everestv1alpha1.DataImportJobStateRunning),
+			},
+			job:     newTestDataImportJob(nil),
+			wantErr: errDataImportAlreadyRunning(testImportDBName),
+		},
+		{
+			name: "valid with existing secret",
+			objs: []ctrlclient.Object{
+				newTestDataImporter(),
+				newTestImportTargetDB(everestv1alpha1.DatabaseEnginePXC, everestv1alpha1.AppStateReady),
+				newTestImportSecret(validCreds),
+				newTestImportJobWithState("import-0", everestv1alpha1.DataImportJobStateSucceeded),
+			},
+			job: newTestDataImportJob(nil),
+		},
+		{
+			name: "valid with inline credentials",
+			objs: []ctrlclient.Object{
+				newTestDataImporter(),
+				newTestImportTargetDB(everestv1alpha1.DatabaseEnginePXC, everestv1alpha1.AppStateReady),
+			},
+			job: newTestDataImportJob(func(job *everestv1alpha1.DataImportJob) {
+				job.Spec.Source.S3.AccessKeyID = "access"
+				job.Spec.Source.S3.SecretAccessKey = "secret"
+			}),
+		},
+		{
+			name: "valid config",
+			objs: []ctrlclient.Object{
+				importerWithSchema,
+				newTestImportTargetDB(everestv1alpha1.DatabaseEnginePXC, everestv1alpha1.AppStateReady),
+				newTestImportSecret(validCreds),
+			},
+			job: newTestDataImportJob(func(job *everestv1alpha1.DataImportJob) {
+				job.Spec.Config = &runtime.RawExtension{Raw: []byte(`{"mode":"full"}`)}
+			}),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			h := newTestDataImportValidator(tc.objs...)
+			err := h.validateDataImportJob(context.Background(), tc.job)
+			if tc.wantErr == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.ErrorContains(t, err, tc.wantErr.Error())
+		})
+	}
+}
+
+func TestValidate_CancelAndDeleteDataImportJob(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		state         everestv1alpha1.DataImportJobState
+		wantCancelErr error
+		wantDeleteErr error
+	}{
+		{
+			name:          "pending",
+			state:         everestv1alpha1.DataImportJobStatePending,
+			wantDeleteErr: errDataImportJobInProgress("import-0"),
+		},
+		{
+			name:          "running",
+			state:         everestv1alpha1.DataImportJobStateRunning,
+			wantDeleteErr: errDataImportJobInProgress("import-0"),
+		},
+		{
+			name:          "succeeded",
+			state:         everestv1alpha1.DataImportJobStateSucceeded,
+			wantCancelErr: errDataImportJobFinished("import-0", everestv1alpha1.DataImportJobStateSucceeded),
+		},
+		{
+			name:          "failed",
+			state:         everestv1alpha1.DataImportJobStateFailed,
+			wantCancelErr: errDataImportJobFinished("import-0", everestv1alpha1.DataImportJobStateFailed),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			check := func(err, wantErr error) {
+				if wantErr == nil {
+					require.NoError(t, err)
+					return
+				}
+				require.ErrorIs(t, err, ErrInvalidRequest)
+				assert.Equal(t, errors.Join(ErrInvalidRequest, wantErr).Error(), err.Error())
+			}
+
+			h := newTestDataImportValidator(newTestImportJobWithState("import-0", tc.state))
+			check(h.CancelDataImportJob(context.Background(), testImportNamespace, "import-0"), tc.wantCancelErr)
+
+			h = newTestDataImportValidator(newTestImportJobWithState("import-0", tc.state))
+			check(h.DeleteDataImportJob(context.Background(), testImportNamespace, "import-0"), tc.wantDeleteErr)
+		})
+	}
+}
+
+func TestValidate_GetDataImportJobProgress(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name      string
+		tailLines *int
+		wantErr   bool
+	}{
+		{
+			name: "default tail lines",
+		},
+		{
+			name:      "valid tail lines",
+			tailLines: pointer.ToInt(500),
+		},
+		{
+			name:      "zero tail lines",
+			tailLines: pointer.ToInt(0),
+			wantErr:   true,
+		},
+		{
+			name:      "too many tail lines",
+			tailLines: pointer.ToInt(maxDataImportTailLines + 1),
+			wantErr:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			h := newTestDataImportValidator(newTestImportJobWithState("import-0", everestv1alpha1.DataImportJobStateRunning))
+			_, err := h.GetDataImportJobProgress(context.Background(), testImportNamespace, "import-0",
+				&api.GetDataImportJobProgressParams{TailLines: tc.tailLines})
+			if tc.wantErr {
+				require.ErrorIs(t, err, ErrInvalidRequest)
+				assert.ErrorIs(t, err, errDataImportInvalidTailLines)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
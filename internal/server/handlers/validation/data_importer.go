@@ -2,11 +2,157 @@ package validation
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/distribution/reference"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
 
 	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/utils"
+)
+
+const dataImporterSchemaTypeObject = "object"
+
+var (
+	errDataImporterNoImage            = errors.New(".spec.jobSpec.image cannot be empty")
+	errDataImporterNoSupportedEngines = errors.New(".spec.supportedEngines cannot be empty")
+	errDataImporterSchemaNotObject    = fmt.Errorf(".spec.config.openAPIV3Schema.type must be '%s'", dataImporterSchemaTypeObject)
+
+	errDataImporterInvalidImage = func(image string, err error) error {
+		return fmt.Errorf("invalid image reference '%s' in .spec.jobSpec.image: %w", image, err)
+	}
+	errDataImporterInvalidEngine = func(engine everestv1alpha1.EngineType) error {
+		return fmt.Errorf("unsupported engine type '%s' in .spec.supportedEngines", engine)
+	}
+	errDataImporterDuplicateEngine = func(engine everestv1alpha1.EngineType) error {
+		return fmt.Errorf("duplicate engine type '%s' in .spec.supportedEngines", engine)
+	}
+	errDataImporterUnknownRequiredField = func(field string) error {
+		return fmt.Errorf("required field '%s' is not defined in .spec.config.openAPIV3Schema.properties", field)
+	}
+	errUpdateDefaultDataImporter = func(name string) error {
+		return fmt.Errorf("data importer with name='%s' is default and cannot be updated", name)
+	}
+	errDeleteDefaultDataImporter = func(name string) error {
+		return fmt.Errorf("data importer with name='%s' is default and cannot be deleted", name)
+	}
+	errDeleteInUseDataImporter = func(name string) error {
+		return fmt.Errorf("data importer with name='%s' is used by an in-progress data import job and cannot be deleted", name)
+	}
 )
 
 // ListDataImporters returns a list of DataImporters that support the specified engines.
 func (h *validateHandler) ListDataImporters(ctx context.Context, supportedEngines ...string) (*everestv1alpha1.DataImporterList, error) {
 	return h.next.ListDataImporters(ctx, supportedEngines...)
 }
+
+// CreateDataImporter creates a new DataImporter.
+func (h *validateHandler) CreateDataImporter(ctx context.Context, req *everestv1alpha1.DataImporter) (*everestv1alpha1.DataImporter, error) {
+	if err := validateDataImporter(req); err != nil {
+		return nil, errors.Join(ErrInvalidRequest, err)
+	}
+	return h.next.CreateDataImporter(ctx, req)
+}
+
+// UpdateDataImporter updates an existing DataImporter.
+func (h *validateHandler) UpdateDataImporter(ctx context.Context, req *everestv1alpha1.DataImporter) (*everestv1alpha1.DataImporter, error) {
+	if err := validateDataImporter(req); err != nil {
+		return nil, errors.Join(ErrInvalidRequest, err)
+	}
+
+	existing, err := h.kubeConnector.GetDataImporter(ctx, types.NamespacedName{Name: req.GetName()})
+	if err != nil {
+		return nil, err
+	}
+	if h.isEverestReadOnlyObject(existing) {
+		// default data importers are managed by Everest
+		return nil, errors.Join(ErrInvalidRequest, errUpdateDefaultDataImporter(req.GetName()))
+	}
+	return h.next.UpdateDataImporter(ctx, req)
+}
+
+// DeleteDataImporter deletes the specified DataImporter.
+func (h *validateHandler) DeleteDataImporter(ctx context.Context, name string) error {
+	if err := h.validateDataImporterOnDelete(ctx, name); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return err
+		}
+		return errors.Join(ErrInvalidRequest, err)
+	}
+	return h.next.DeleteDataImporter(ctx, name)
+}
+
+func validateDataImporter(di *everestv1alpha1.DataImporter) error {
+	if err := utils.ValidateRFC1035(di.GetName(), "metadata.name"); err != nil {
+		return err
+	}
+
+	image := di.Spec.JobSpec.Image
+	if image == "" {
+		return errDataImporterNoImage
+	}
+	if _, err := reference.ParseNormalizedNamed(image); err != nil {
+		return errDataImporterInvalidImage(image, err)
+	}
+
+	if len(di.Spec.SupportedEngines) == 0 {
+		return errDataImporterNoSupportedEngines
+	}
+	for i, engine := range di.Spec.SupportedEngines {
+		if _, ok := common.OperatorTypeToName[engine]; !ok {
+			return errDataImporterInvalidEngine(engine)
+		}
+		if slices.Contains(di.Spec.SupportedEngines[:i], engine) {
+			return errDataImporterDuplicateEngine(engine)
+		}
+	}
+
+	return validateDataImporterConfigSchema(&di.Spec.Config)
+}
+
+// validateDataImporterConfigSchema ensures that the config schema describes an object
+// and that every required field is defined in it.
+func validateDataImporterConfigSchema(cfg *everestv1alpha1.DataImporterConfig) error {
+	schema := cfg.OpenAPIV3Schema
+	if schema == nil {
+		return nil
+	}
+	if schema.Type != dataImporterSchemaTypeObject {
+		return errDataImporterSchemaNotObject
+	}
+	for _, field := range schema.Required {
+		if _, ok := schema.Properties[field]; !ok {
+			return errDataImporterUnknownRequiredField(field)
+		}
+	}
+	return nil
+}
+
+func (h *validateHandler) validateDataImporterOnDelete(ctx context.Context, name string) error {
+	di, err := h.kubeConnector.GetDataImporter(ctx, types.NamespacedName{Name: name})
+	if err != nil {
+		return err
+	}
+	if h.isEverestReadOnlyObject(di) {
+		// default data importers are managed by Everest
+		return errDeleteDefaultDataImporter(name)
+	}
+
+	jobs, err := h.kubeConnector.ListAllDataImportJobs(ctx)
+	if err != nil {
+		return err
+	}
+	for _, job := range jobs.Items {
+		if job.Spec.DataImportJobTemplate == nil || job.Spec.DataImporterName != name {
+			continue
+		}
+		if !isDataImportJobFinished(&job) {
+			return errDeleteInUseDataImporter(name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,223 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/percona/everest/pkg/utils"
+)
+
+func newTestImporterSpec(mutate func(di *everestv1alpha1.DataImporter)) *everestv1alpha1.DataImporter {
+	di := &everestv1alpha1.DataImporter{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: testImporterName,
+		},
+		Spec: everestv1alpha1.DataImporterSpec{
+			SupportedEngines: everestv1alpha1.EngineList{everestv1alpha1.DatabaseEnginePXC},
+			JobSpec: everestv1alpha1.DataImporterJobSpec{
+				Image:   "docker.io/example/mydumper-importer:1.0.0",
+				Command: []string{"/import.sh"},
+			},
+		},
+	}
+	if mutate != nil {
+		mutate(di)
+	}
+	return di
+}
+
+func TestValidate_DataImporter(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name    string
+		di      *everestv1alpha1.DataImporter
+		wantErr error
+	}{
+		{
+			name: "invalid name",
+			di: newTestImporterSpec(func(di *everestv1alpha1.DataImporter) {
+				di.SetName("My_Importer")
+			}),
+			wantErr: utils.ErrNameNotRFC1035Compatible("metadata.name"),
+		},
+		{
+			name: "empty image",
+			di: newTestImporterSpec(func(di *everestv1alpha1.DataImporter) {
+				di.Spec.JobSpec.Image = ""
+			}),
+			wantErr: errDataImporterNoImage,
+		},
+		{
+			name: "invalid image",
+			di: newTestImporterSpec(func(di *everestv1alpha1.DataImporter) {
+				di.Spec.JobSpec.Image = "Example/Importer:latest"
+			}),
+			wantErr: errors.New("invalid image reference 'Example/Importer:latest'"),
+		},
+		{
+			name: "no supported engines",
+			di: newTestImporterSpec(func(di *everestv1alpha1.DataImporter) {
+				di.Spec.SupportedEngines = nil
+			}),
+			wantErr: errDataImporterNoSupportedEngines,
+		},
+		{
+			name: "unknown engine",
+			di: newTestImporterSpec(func(di *everestv1alpha1.DataImporter) {
+				di.Spec.SupportedEngines = everestv1alpha1.EngineList{"mysql"}
+			}),
+			wantErr: errDataImporterInvalidEngine("mysql"),
+		},
+		{
+			name: "duplicate engine",
+			di: newTestImporterSpec(func(di *everestv1alpha1.DataImporter) {
+				di.Spec.SupportedEngines = everestv1alpha1.EngineList{everestv1alpha1.DatabaseEnginePXC, everestv1alpha1.DatabaseEnginePXC}
+			}),
+			wantErr: errDataImporterDuplicateEngine(everestv1alpha1.DatabaseEnginePXC),
+		},
+		{
+			name: "schema is not an object",
+			di: newTestImporterSpec(func(di *everestv1alpha1.DataImporter) {
+				di.Spec.Config.OpenAPIV3Schema = &apiextensionsv1.JSONSchemaProps{Type: "string"}
+			}),
+			wantErr: errDataImporterSchemaNotObject,
+		},
+		{
+			name: "required field not defined",
+			di: newTestImporterSpec(func(di *everestv1alpha1.DataImporter) {
+				di.Spec.Config.OpenAPIV3Schema = &apiextensionsv1.JSONSchemaProps{
+					Type:     "object",
+					Required: []string{"mode"},
+				}
+			}),
+			wantErr: errDataImporterUnknownRequiredField("mode"),
+		},
+		{
+			name: "valid with schema",
+			di: newTestImporterSpec(func(di *everestv1alpha1.DataImporter) {
+				di.Spec.SupportedEngines = everestv1alpha1.EngineList{everestv1alpha1.DatabaseEnginePXC, everestv1alpha1.DatabaseEnginePostgresql}
+				di.Spec.Config.OpenAPIV3Schema = &apiextensionsv1.JSONSchemaProps{
+					Type:     "object",
+					Required: []string{"mode"},
+					Properties: map[string]apiextensionsv1.JSONSchemaProps{
+						"mode": {Type: "string"},
+					},
+				}
+			}),
+		},
+		{
+			name: "valid image with digest",
+			di: newTestImporterSpec(func(di *everestv1alpha1.DataImporter) {
+				di.Spec.JobSpec.Image = "percona/importer@sha256:" +
+					"0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+			}),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateDataImporter(tc.di)
+			if tc.wantErr == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.ErrorContains(t, err, tc.wantErr.Error())
+		})
+	}
+}
+
+func TestValidate_UpdateAndDeleteDataImporter(t *testing.T) {
+	t.Parallel()
+
+	readOnly := newTestImporterSpec(func(di *everestv1alpha1.DataImporter) {
+		di.SetFinalizers([]string{everestv1alpha1.ReadOnlyFinalizer})
+	})
+	runningJob := newTestImportJobWithState("import-0", everestv1alpha1.DataImportJobStateRunning)
+	runningJob.Spec.DataImportJobTemplate = &everestv1alpha1.DataImportJobTemplate{DataImporterName: testImporterName}
+	finishedJob := newTestImportJobWithState("import-1", everestv1alpha1.DataImportJobStateSucceeded)
+	finishedJob.Spec.DataImportJobTemplate = &everestv1alpha1.DataImportJobTemplate{DataImporterName: testImporterName}
+
+	testCases := []struct {
+		name          string
+		objs          []ctrlclient.Object
+		wantUpdateErr error
+		wantDeleteErr error
+	}{
+		{
+			name: "default data importer",
+			objs: []ctrlclient.Object{
+				readOnly,
+			},
+			wantUpdateErr: errUpdateDefaultDataImporter(testImporterName),
+			wantDeleteErr: errDeleteDefaultDataImporter(testImporterName),
+		},
+		{
+			name: "used by an in-progress job",
+			objs: []ctrlclient.Object{
+				newTestImporterSpec(nil),
+				runningJob,
+			},
+			wantDeleteErr: errDeleteInUseDataImporter(testImporterName),
+		},
+		{
+			name: "used by a finished job",
+			objs: []ctrlclient.Object{
+				newTestImporterSpec(nil),
+				finishedJob,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			check := func(err, wantErr error) {
+				if wantErr == nil {
+					require.NoError(t, err)
+					return
+				}
+				assert.Equal(t, errors.Join(ErrInvalidRequest, wantErr).Error(), err.Error())
+			}
+
+			h := newTestDataImportValidator(tc.objs...)
+			updated := newTestImporterSpec(func(di *everestv1alpha1.DataImporter) {
+				// the fake client assigns this version to the initial objects
+				di.SetResourceVersion("999")
+				di.Spec.JobSpec.Image = "docker.io/example/mydumper-importer:1.1.0"
+			})
+			_, err := h.UpdateDataImporter(context.Background(), updated)
+			check(err, tc.wantUpdateErr)
+
+			h = newTestDataImportValidator(tc.objs...)
+			check(h.DeleteDataImporter(context.Background(), testImporterName), tc.wantDeleteErr)
+		})
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/percona/everest/api"
 	"github.com/percona/everest/pkg/common"
 	"github.com/percona/everest/pkg/utils"
+	"github.com/percona/everest/pkg/versionpolicy"
 )
 
 const (
@@ -32,7 +33,11 @@ const (
 )
 
 func (h *validateHandler) CreateDatabaseCluster(ctx context.Context, db *everestv1alpha1.DatabaseCluster) (*everestv1alpha1.DatabaseCluster, error) {
-	if err := h.validateDatabaseClusterCR(ctx, db.GetNamespace(), db); err != nil {
+	policy, err := h.getEngineVersionPolicy(ctx, db.GetNamespace())
+	if err != nil {
+		return nil, err
+	}
+	if err := h.validateDatabaseClusterCR(ctx, db.GetNamespace(), db, policy); err != nil {
 		return nil, errors.Join(ErrInvalidRequest, err)
 	}
 
@@ -56,7 +61,8 @@ func (h *validateHandler) DeleteDatabaseCluster(ctx context.Context, namespace,
 }
 
 func (h *validateHandler) UpdateDatabaseCluster(ctx context.Context, db *everestv1alpha1.DatabaseCluster) (*everestv1alpha1.DatabaseCluster, error) {
-	if err := h.validateDatabaseClusterCR(ctx, db.GetNamespace(), db); err != nil {
+	// The engine version policy is checked only if the version changes, see validateDatabaseClusterOnUpdate.
+	if err := h.validateDatabaseClusterCR(ctx, db.GetNamespace(), db, nil); err != nil {
 		return nil, errors.Join(ErrInvalidRequest, err)
 	}
 
@@ -64,9 +70,14 @@ func (h *validateHandler) UpdateDatabaseCluster(ctx context.Context, db *everest
 	if err != nil {
 		return nil, fmt.Errorf("failed to GetDatabaseCluster: %w", err)
 	}
-	if err := h.validateDatabaseClusterOnUpdate(db, current); err != nil {
+	if err := h.validateDatabaseClusterOnUpdate(ctx, db, current); err != nil {
 		return nil, errors.Join(ErrInvalidRequest, err)
 	}
+	if requiresRestart(db, current) {
+		if err := h.validateMaintenanceWindow(ctx, db.GetNamespace(), "a database cluster change that requires a restart"); err != nil {
+			return nil, err
+		}
+	}
 	return h.next.UpdateDatabaseCluster(ctx, db)
 }
 
@@ -91,6 +102,7 @@ func (h *validateHandler) validateDatabaseClusterCR(
 	ctx context.Context,
 	namespace string,
 	databaseCluster *everestv1alpha1.DatabaseCluster,
+	policy *versionpolicy.Policy,
 ) error {
 	if err := validateMetadata(databaseCluster); err != nil {
 		return err
@@ -107,7 +119,7 @@ func (h *validateHandler) validateDatabaseClusterCR(
 	if err != nil {
 		return err
 	}
-	if err := validateEngine(databaseCluster, engine); err != nil {
+	if err := validateEngine(databaseCluster, engine, policy); err != nil {
 		return err
 	}
 	if databaseCluster.Spec.Proxy.Type != "" {
@@ -188,8 +200,12 @@ func validateCreateDatabaseClusterRequest(dbc *everestv1alpha1.DatabaseCluster)
 	return utils.ValidateEverestResourceName(dbc.GetName(), "metadata.name")
 }
 
-func validateEngine(databaseCluster *everestv1alpha1.DatabaseCluster, engine *everestv1alpha1.DatabaseEngine) error {
-	if err := validateVersion(databaseCluster.Spec.Engine.Version, engine); err != nil {
+func validateEngine(
+	databaseCluster *everestv1alpha1.DatabaseCluster,
+	engine *everestv1alpha1.DatabaseEngine,
+	policy *versionpolicy.Policy,
+) error {
+	if err := validateVersion(databaseCluster.Spec.Engine.Version, engine, policy); err != nil {
 		return err
 	}
 
@@ -211,17 +227,18 @@ func validateEngine(databaseCluster *everestv1alpha1.DatabaseCluster, engine *ev
 	return nil
 }
 
-func validateVersion(version string, engine *everestv1alpha1.DatabaseEngine) error {
+// validateVersion checks that the version is available for the engine and allowed by the engine version policy
+// of the namespace. A nil policy allows all the available versions.
+func validateVersion(version string, engine *everestv1alpha1.DatabaseEngine, policy *versionpolicy.Policy) error {
 	if version != "" {
 		if len(engine.Spec.AllowedVersions) > 0 {
 			if !containsVersion(version, engine.Spec.AllowedVersions) {
 				return fmt.Errorf("using %s version for %s is not allowed", version, engine.Spec.Type)
 			}
-			return nil
-		}
-		if _, ok := engine.Status.AvailableVersions.Engine[version]; !ok {
+		} else if _, ok := engine.Status.AvailableVersions.Engine[version]; !ok {
 			return fmt.Errorf("%s is not in available versions list", version)
 		}
+		return policy.Check(engine.Spec.Type, version, engine.Status.AvailableVersions.Engine)
 	}
 	return nil
 }
@@ -564,6 +581,7 @@ func validateMetadata(obj metav1.Object) error {
 }
 
 func (h *validateHandler) validateDatabaseClusterOnUpdate(
+	ctx context.Context,
 	dbc, oldDB *everestv1alpha1.DatabaseCluster,
 ) error {
 	if !isDatabaseClusterUpdateAllowed(oldDB) {
@@ -573,7 +591,18 @@ func (h *validateHandler) validateDatabaseClusterOnUpdate(
 	newVersion := dbc.Spec.Engine.Version
 	oldVersion := oldDB.Spec.Engine.Version
 	if newVersion != "" && newVersion != oldVersion {
-		if err := validateDBEngineVersionUpgrade(oldDB.Spec.Engine.Type, newVersion, oldVersion); err != nil {
+		engine, err := h.kubeConnector.GetDatabaseEngine(ctx, types.NamespacedName{
+			Namespace: oldDB.GetNamespace(),
+			Name:      common.OperatorTypeToName[oldDB.Spec.Engine.Type],
+		})
+		if err != nil {
+			return err
+		}
+		policy, err := h.getEngineVersionPolicy(ctx, oldDB.GetNamespace())
+		if err != nil {
+			return err
+		}
+		if err := validateDBEngineVersionUpgrade(engine, policy, newVersion, oldVersion); err != nil {
 			return err
 		}
 	}
@@ -598,8 +627,18 @@ func (h *validateHandler) validateDatabaseClusterOnUpdate(
 	return nil
 }
 
-// validateDBEngineVersionUpgrade validates if upgrade of DBEngine from `oldVersion` to `newVersion` is allowed.
-func validateDBEngineVersionUpgrade(engineType everestv1alpha1.EngineType, newVersion, oldVersion string) error {
+// validateDBEngineVersionUpgrade validates if upgrade of DBEngine from `oldVersion` to `newVersion` is allowed
+// and if `newVersion` is allowed by the engine version policy of the namespace.
+func validateDBEngineVersionUpgrade(
+	engine *everestv1alpha1.DatabaseEngine,
+	policy *versionpolicy.Policy,
+	newVersion, oldVersion string,
+) error {
+	if err := policy.Check(engine.Spec.Type, newVersion, engine.Status.AvailableVersions.Engine); err != nil {
+		return err
+	}
+
+	engineType := engine.Spec.Type
 	// Ensure a "v" prefix so that it is a valid semver.
 	if !strings.HasPrefix(newVersion, "v") {
 		newVersion = "v" + newVersion
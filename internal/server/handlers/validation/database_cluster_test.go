@@ -21,6 +21,7 @@ import (
 	"github.com/percona/everest/pkg/common"
 	"github.com/percona/everest/pkg/kubernetes"
 	"github.com/percona/everest/pkg/utils"
+	versionservice "github.com/percona/everest/pkg/version_service"
 )
 
 func TestValidateCreateDatabaseClusterRequest(t *testing.T) {
@@ -2306,7 +2307,7 @@ func TestValidatePodSchedulingPolicy(t *testing.T) {
 				WithObjects(tc.objs...).
 				Build()
 			k := kubernetes.NewEmpty(zap.NewNop().Sugar()).WithKubernetesClient(mockClient)
-			k8sHandler := k8s.New(zap.NewNop().Sugar(), k, "")
+			k8sHandler := k8s.New(zap.NewNop().Sugar(), k, versionservice.Config{}, nil)
 
 			valHandler := &validateHandler{
 				log:           zap.NewNop().Sugar(),
@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 
+	"github.com/AlekSi/pointer"
+
 	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
 	"github.com/percona/everest/api"
 )
@@ -27,6 +29,20 @@ func (h *validateHandler) GetUpgradePlan(ctx context.Context, namespace string)
 	return h.next.GetUpgradePlan(ctx, namespace)
 }
 
-func (h *validateHandler) ApproveUpgradePlan(ctx context.Context, namespace string) error {
-	return h.next.ApproveUpgradePlan(ctx, namespace)
+func (h *validateHandler) ApproveUpgradePlan(
+	ctx context.Context,
+	namespace string,
+	req *api.UpgradePlanApproval,
+) (*api.UpgradePlanApprovalResult, error) {
+	// Upgrades that may be queued are handled by the next handler.
+	if req == nil || pointer.Get(req.Schedule) != api.UpgradePlanApprovalScheduleMaintenanceWindow {
+		if err := h.validateMaintenanceWindow(ctx, namespace, "operator upgrade"); err != nil {
+			return nil, err
+		}
+	}
+	return h.next.ApproveUpgradePlan(ctx, namespace, req)
+}
+
+func (h *validateHandler) CancelUpgradePlanApproval(ctx context.Context, namespace string) error {
+	return h.next.CancelUpgradePlanApproval(ctx, namespace)
 }
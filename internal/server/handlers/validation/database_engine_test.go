@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/percona/everest/pkg/versionpolicy"
 )
 
 func TestValidateVersion(t *testing.T) {
@@ -16,6 +17,7 @@ func TestValidateVersion(t *testing.T) {
 		name    string
 		version string
 		engine  *everestv1alpha1.DatabaseEngine
+		policy  *versionpolicy.Policy
 		err     error
 	}{
 		{
@@ -74,11 +76,52 @@ func TestValidateVersion(t *testing.T) {
 			},
 			err: errors.New("using 8.0.32 version for pxc is not allowed"),
 		},
+		{
+			name:    "shall be allowed by the policy",
+			version: "8.0.32",
+			engine: &everestv1alpha1.DatabaseEngine{
+				Spec: everestv1alpha1.DatabaseEngineSpec{
+					Type: "pxc",
+				},
+				Status: everestv1alpha1.DatabaseEngineStatus{
+					AvailableVersions: everestv1alpha1.Versions{
+						Engine: everestv1alpha1.ComponentsMap{
+							"8.0.32": &everestv1alpha1.Component{Status: everestv1alpha1.DBEngineComponentRecommended},
+						},
+					},
+				},
+			},
+			policy: &versionpolicy.Policy{
+				RecommendedOnly: true,
+				Allowed:         []versionpolicy.Pattern{{Version: "8.0.*"}},
+			},
+			err: nil,
+		},
+		{
+			name:    "shall not be denied by the policy",
+			version: "8.0.32",
+			engine: &everestv1alpha1.DatabaseEngine{
+				Spec: everestv1alpha1.DatabaseEngineSpec{
+					Type: "pxc",
+				},
+				Status: everestv1alpha1.DatabaseEngineStatus{
+					AvailableVersions: everestv1alpha1.Versions{
+						Engine: everestv1alpha1.ComponentsMap{
+							"8.0.32": &everestv1alpha1.Component{},
+						},
+					},
+				},
+			},
+			policy: &versionpolicy.Policy{
+				Denied: []versionpolicy.Pattern{{EngineType: "pxc", Version: "8.0.32"}},
+			},
+			err: errors.New("pxc version 8.0.32 is not allowed by the namespace policy: it is denied by 'pxc:8.0.32'"),
+		},
 	}
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
-			err := validateVersion(tc.version, tc.engine)
+			err := validateVersion(tc.version, tc.engine, tc.policy)
 			if tc.err == nil {
 				require.NoError(t, err)
 				return
@@ -93,6 +136,7 @@ func TestValidateDBEngineUpgrade(t *testing.T) {
 	testCases := []struct {
 		name       string
 		engineType everestv1alpha1.EngineType
+		policy     *versionpolicy.Policy
 		oldVersion string
 		newVersion string
 		err        error
@@ -153,12 +197,77 @@ func TestValidateDBEngineUpgrade(t *testing.T) {
 			newVersion: "15.5",
 			err:        errDBEngineDowngrade,
 		},
+		{
+			name:       "upgrade allowed by the policy",
+			engineType: everestv1alpha1.DatabaseEnginePXC,
+			policy:     &versionpolicy.Policy{Denied: []versionpolicy.Pattern{{Version: "8.0.22"}}},
+			oldVersion: "8.0.21",
+			newVersion: "8.0.23",
+			err:        nil,
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
-			err := validateDBEngineVersionUpgrade(tc.engineType, tc.newVersion, tc.oldVersion)
+			engine := &everestv1alpha1.DatabaseEngine{Spec: everestv1alpha1.DatabaseEngineSpec{Type: tc.engineType}}
+			err := validateDBEngineVersionUpgrade(engine, tc.policy, tc.newVersion, tc.oldVersion)
 			assert.ErrorIs(t, err, tc.err)
 		})
 	}
 }
+
+func TestValidateDBEngineUpgradePolicy(t *testing.T) {
+	t.Parallel()
+	engine := &everestv1alpha1.DatabaseEngine{
+		Spec: everestv1alpha1.DatabaseEngineSpec{Type: everestv1alpha1.DatabaseEnginePXC},
+		Status: everestv1alpha1.DatabaseEngineStatus{
+			AvailableVersions: everestv1alpha1.Versions{
+				Engine: everestv1alpha1.ComponentsMap{
+					"8.0.23": &everestv1alpha1.Component{Status: everestv1alpha1.DBEngineComponentAvailable},
+					"8.0.24": &everestv1alpha1.Component{Status: everestv1alpha1.DBEngineComponentRecommended},
+				},
+			},
+		},
+	}
+	testCases := []struct {
+		name       string
+		policy     *versionpolicy.Policy
+		newVersion string
+		wantErr    string
+	}{
+		{
+			name:       "recommended only",
+			policy:     &versionpolicy.Policy{RecommendedOnly: true},
+			newVersion: "8.0.23",
+			wantErr:    "only recommended versions are allowed",
+		},
+		{
+			name:       "recommended version",
+			policy:     &versionpolicy.Policy{RecommendedOnly: true},
+			newVersion: "8.0.24",
+		},
+		{
+			name:       "denied version",
+			policy:     &versionpolicy.Policy{Denied: []versionpolicy.Pattern{{Version: "8.0.2[34]"}}},
+			newVersion: "8.0.24",
+			wantErr:    "it is denied by '8.0.2[34]'",
+		},
+		{
+			name:       "not in the allowed versions",
+			policy:     &versionpolicy.Policy{Allowed: []versionpolicy.Pattern{{EngineType: everestv1alpha1.DatabaseEnginePXC, Version: "8.4.*"}}},
+			newVersion: "8.0.24",
+			wantErr:    "it does not match any allowed version",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := validateDBEngineVersionUpgrade(engine, tc.policy, tc.newVersion, "8.0.22")
+			if tc.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tc.wantErr)
+		})
+	}
+}
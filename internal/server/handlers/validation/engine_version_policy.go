@@ -0,0 +1,54 @@
+package validation
+
+import (
+	"context"
+	"errors"
+
+	"github.com/AlekSi/pointer"
+	"k8s.io/apimachinery/pkg/types"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/versionpolicy"
+)
+
+func (h *validateHandler) GetEngineVersionPolicy(ctx context.Context, namespace string) (*api.EngineVersionPolicy, error) {
+	return h.next.GetEngineVersionPolicy(ctx, namespace)
+}
+
+func (h *validateHandler) UpdateEngineVersionPolicy(
+	ctx context.Context,
+	namespace string,
+	req *api.EngineVersionPolicy,
+) (*api.EngineVersionPolicy, error) {
+	ns, err := h.kubeConnector.GetNamespace(ctx, types.NamespacedName{Name: namespace})
+	if err != nil {
+		return nil, err
+	}
+	if ns.GetLabels()[common.KubernetesManagedByLabel] != common.Everest {
+		return nil, errors.Join(ErrInvalidRequest, errNamespaceNotManaged(namespace))
+	}
+
+	for _, list := range []*[]api.EngineVersionPattern{req.Allowed, req.Denied} {
+		for _, p := range pointer.Get(list) {
+			pattern := versionpolicy.Pattern{
+				EngineType: everestv1alpha1.EngineType(pointer.Get(p.EngineType)),
+				Version:    p.Version,
+			}
+			if err := pattern.Validate(); err != nil {
+				return nil, errors.Join(ErrInvalidRequest, err)
+			}
+		}
+	}
+	return h.next.UpdateEngineVersionPolicy(ctx, namespace, req)
+}
+
+// getEngineVersionPolicy returns the engine version policy of the namespace.
+func (h *validateHandler) getEngineVersionPolicy(ctx context.Context, namespace string) (*versionpolicy.Policy, error) {
+	policy, err := h.kubeConnector.GetEngineVersionPolicy(ctx, namespace)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("failed to get engine version policy"))
+	}
+	return policy, nil
+}
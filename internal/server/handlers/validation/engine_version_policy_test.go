@@ -0,0 +1,96 @@
+package validation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/internal/server/handlers"
+	"github.com/percona/everest/pkg/common"
+)
+
+func TestValidate_UpdateEngineVersionPolicy(t *testing.T) {
+	t.Parallel()
+
+	managedNs := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   testMaintenanceNamespace,
+			Labels: map[string]string{common.KubernetesManagedByLabel: common.Everest},
+		},
+	}
+	unmanagedNs := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: testMaintenanceNamespace,
+		},
+	}
+
+	testCases := []struct {
+		name    string
+		ns      *corev1.Namespace
+		req     *api.EngineVersionPolicy
+		wantErr string
+	}{
+		{
+			name: "valid policy",
+			ns:   managedNs,
+			req: &api.EngineVersionPolicy{
+				RecommendedOnly: pointer.ToBool(true),
+				Allowed:         &[]api.EngineVersionPattern{{Version: "8.0.*"}},
+				Denied:          &[]api.EngineVersionPattern{{EngineType: pointer.ToString("pxc"), Version: "8.0.35*"}},
+			},
+		},
+		{
+			name: "empty policy",
+			ns:   managedNs,
+			req:  &api.EngineVersionPolicy{},
+		},
+		{
+			name:    "namespace not managed by Everest",
+			ns:      unmanagedNs,
+			req:     &api.EngineVersionPolicy{},
+			wantErr: errNamespaceNotManaged(testMaintenanceNamespace).Error(),
+		},
+		{
+			name: "unknown engine type",
+			ns:   managedNs,
+			req: &api.EngineVersionPolicy{
+				Denied: &[]api.EngineVersionPattern{{EngineType: pointer.ToString("mysql"), Version: "8.0.35"}},
+			},
+			wantErr: "unknown engine type 'mysql'",
+		},
+		{
+			name: "invalid pattern",
+			ns:   managedNs,
+			req: &api.EngineVersionPolicy{
+				Allowed: &[]api.EngineVersionPattern{{Version: "8.0.["}},
+			},
+			wantErr: "invalid version pattern '8.0.['",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			next := &handlers.MockHandler{}
+			next.On("UpdateEngineVersionPolicy", mock.Anything, testMaintenanceNamespace, tc.req).
+				Return(&api.EngineVersionPolicy{}, nil)
+			h := newTestMaintenanceValidator(next, tc.ns)
+
+			_, err := h.UpdateEngineVersionPolicy(context.Background(), testMaintenanceNamespace, tc.req)
+			if tc.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, ErrInvalidRequest)
+			assert.ErrorContains(t, err, tc.wantErr)
+		})
+	}
+}
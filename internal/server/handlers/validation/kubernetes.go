@@ -3,8 +3,12 @@ package validation
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strings"
 
 	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/rbac"
 )
 
 func (h *validateHandler) GetKubernetesClusterResources(ctx context.Context) (*api.KubernetesClusterResources, error) {
@@ -19,6 +23,25 @@ func (h *validateHandler) GetUserPermissions(ctx context.Context) (*api.UserPerm
 	return h.next.GetUserPermissions(ctx)
 }
 
+func (h *validateHandler) GetUserPermissionMatrix(ctx context.Context) (*api.UserPermissionMatrix, error) {
+	return h.next.GetUserPermissionMatrix(ctx)
+}
+
+func (h *validateHandler) ExplainPermission(ctx context.Context, req *api.PermissionExplanationRequest) (*api.PermissionExplanation, error) {
+	if req.Resource == "" {
+		return nil, errors.Join(ErrInvalidRequest, errors.New("resource cannot be empty"))
+	}
+	if req.Object == "" {
+		return nil, errors.Join(ErrInvalidRequest, errors.New("object cannot be empty"))
+	}
+	if !rbac.ValidateAction(req.Action) {
+		return nil, errors.Join(ErrInvalidRequest, fmt.Errorf("invalid action '%s'. Supported actions: %s",
+			req.Action, strings.Join(rbac.SupportedActions, ","),
+		))
+	}
+	return h.next.ExplainPermission(ctx, req)
+}
+
 func (h *validateHandler) GetSettings(ctx context.Context) (*api.Settings, error) {
 	return h.next.GetSettings(ctx)
 }
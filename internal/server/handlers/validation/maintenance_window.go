@@ -0,0 +1,100 @@
+package validation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/maintenance"
+)
+
+var (
+	errNamespaceNotManaged = func(namespace string) error {
+		return fmt.Errorf("namespace '%s' is not managed by Everest", namespace)
+	}
+	errOutsideMaintenanceWindow = func(operation string, next time.Time) error {
+		if next.IsZero() {
+			return fmt.Errorf("%s is only allowed inside a maintenance window", operation)
+		}
+		return fmt.Errorf("%s is only allowed inside a maintenance window, the next one starts at %s",
+			operation, next.UTC().Format(time.RFC3339))
+	}
+)
+
+func (h *validateHandler) GetMaintenanceWindows(ctx context.Context, namespace string) (*api.MaintenanceWindows, error) {
+	return h.next.GetMaintenanceWindows(ctx, namespace)
+}
+
+func (h *validateHandler) UpdateMaintenanceWindows(
+	ctx context.Context,
+	namespace string,
+	req *api.MaintenanceWindowsSpec,
+) (*api.MaintenanceWindows, error) {
+	ns, err := h.kubeConnector.GetNamespace(ctx, types.NamespacedName{Name: namespace})
+	if err != nil {
+		return nil, err
+	}
+	if ns.GetLabels()[common.KubernetesManagedByLabel] != common.Everest {
+		return nil, errors.Join(ErrInvalidRequest, errNamespaceNotManaged(namespace))
+	}
+
+	config := &maintenance.Config{Windows: make([]maintenance.Window, 0, len(req.Windows))}
+	for _, w := range req.Windows {
+		config.Windows = append(config.Windows, maintenance.Window{
+			Name:     w.Name,
+			Schedule: w.Schedule,
+			Duration: w.Duration,
+		})
+	}
+	if err := config.Validate(); err != nil {
+		return nil, errors.Join(ErrInvalidRequest, err)
+	}
+	return h.next.UpdateMaintenanceWindows(ctx, namespace, req)
+}
+
+// validateMaintenanceWindow ensures that a disruptive operation happens inside a maintenance window of the namespace.
+func (h *validateHandler) validateMaintenanceWindow(ctx context.Context, namespace, operation string) error {
+	config, err := h.kubeConnector.GetMaintenanceConfig(ctx, namespace)
+	if err != nil {
+		return errors.Join(err, errors.New("failed to get maintenance windows"))
+	}
+	now := time.Now().UTC()
+	open, err := config.IsOpen(now)
+	if err != nil {
+		return err
+	}
+	if open {
+		return nil
+	}
+	next, _, err := config.Next(now)
+	if err != nil {
+		return err
+	}
+	return errors.Join(ErrInvalidRequest, errOutsideMaintenanceWindow(operation, next))
+}
+
+// requiresRestart returns true if updating the database cluster from oldDB to dbc restarts its pods.
+func requiresRestart(dbc, oldDB *everestv1alpha1.DatabaseCluster) bool {
+	newEngine, oldEngine := dbc.Spec.Engine, oldDB.Spec.Engine
+	if newEngine.Version != "" && newEngine.Version != oldEngine.Version {
+		return true
+	}
+	if newEngine.CRVersion != nil && (oldEngine.CRVersion == nil || *newEngine.CRVersion != *oldEngine.CRVersion) {
+		return true
+	}
+	if newEngine.Config != oldEngine.Config || resourcesChanged(newEngine.Resources, oldEngine.Resources) {
+		return true
+	}
+	newProxy, oldProxy := dbc.Spec.Proxy, oldDB.Spec.Proxy
+	return newProxy.Config != oldProxy.Config || resourcesChanged(newProxy.Resources, oldProxy.Resources)
+}
+
+func resourcesChanged(newRes, oldRes everestv1alpha1.Resources) bool {
+	return newRes.CPU.Cmp(oldRes.CPU) != 0 || newRes.Memory.Cmp(oldRes.Memory) != 0
+}
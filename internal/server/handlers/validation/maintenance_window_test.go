@@ -0,0 +1,263 @@
+package validation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/internal/server/handlers"
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/kubernetes"
+	"github.com/percona/everest/pkg/maintenance"
+)
+
+const testMaintenanceNamespace = "test-ns"
+
+// closedMaintenanceWindows returns a ConfigMap with a maintenance window that is open only on February 29th.
+func closedMaintenanceWindows() *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      maintenance.ConfigMapName,
+			Namespace: testMaintenanceNamespace,
+		},
+		Data: map[string]string{
+			"windows": "- name: leap-day\n  schedule: 0 0 29 2 *\n  duration: 1m\n",
+		},
+	}
+}
+
+func newTestMaintenanceValidator(next handlers.Handler, objs ...ctrlclient.Object) handlers.Handler {
+	mockClient := fakeclient.NewClientBuilder().
+		WithScheme(kubernetes.CreateScheme()).
+		WithObjects(objs...).
+		Build()
+	k := kubernetes.NewEmpty(zap.NewNop().Sugar()).WithKubernetesClient(mockClient)
+	valHandler := New(zap.NewNop().Sugar(), k)
+	valHandler.SetNext(next)
+	return valHandler
+}
+
+func TestValidate_UpdateMaintenanceWindows(t *testing.T) {
+	t.Parallel()
+
+	managedNs := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   testMaintenanceNamespace,
+			Labels: map[string]string{common.KubernetesManagedByLabel: common.Everest},
+		},
+	}
+	unmanagedNs := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: testMaintenanceNamespace,
+		},
+	}
+
+	testCases := []struct {
+		name    string
+		ns      *corev1.Namespace
+		windows []api.MaintenanceWindow
+		wantErr error
+	}{
+		{
+			name: "valid windows",
+			ns:   managedNs,
+			windows: []api.MaintenanceWindow{
+				{Name: "sunday", Schedule: "0 2 * * 0", Duration: "4h"},
+			},
+		},
+		{
+			name: "remove all windows",
+			ns:   managedNs,
+		},
+		{
+			name: "namespace not managed by Everest",
+			ns:   unmanagedNs,
+			windows: []api.MaintenanceWindow{
+				{Name: "sunday", Schedule: "0 2 * * 0", Duration: "4h"},
+			},
+			wantErr: errNamespaceNotManaged(testMaintenanceNamespace),
+		},
+		{
+			name: "invalid window",
+			ns:   managedNs,
+			windows: []api.MaintenanceWindow{
+				{Name: "sunday", Schedule: "0 2 * * 0", Duration: "0s"},
+			},
+			wantErr: ErrInvalidRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := &api.MaintenanceWindowsSpec{Windows: tc.windows}
+			next := &handlers.MockHandler{}
+			next.On("UpdateMaintenanceWindows", mock.Anything, testMaintenanceNamespace, req).
+				Return(&api.MaintenanceWindows{}, nil)
+			h := newTestMaintenanceValidator(next, tc.ns)
+
+			_, err := h.UpdateMaintenanceWindows(context.Background(), testMaintenanceNamespace, req)
+			if tc.wantErr == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, ErrInvalidRequest)
+			assert.ErrorContains(t, err, tc.wantErr.Error())
+		})
+	}
+}
+
+func TestValidate_ApproveUpgradePlanMaintenanceWindow(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		objs     []ctrlclient.Object
+		schedule *api.UpgradePlanApprovalSchedule
+		wantErr  bool
+	}{
+		{
+			name: "no maintenance windows",
+		},
+		{
+			name:    "immediate upgrade outside of the maintenance window",
+			objs:    []ctrlclient.Object{closedMaintenanceWindows()},
+			wantErr: true,
+		},
+		{
+			name:     "explicit immediate upgrade outside of the maintenance window",
+			objs:     []ctrlclient.Object{closedMaintenanceWindows()},
+			schedule: pointer.To(api.UpgradePlanApprovalScheduleImmediate),
+			wantErr:  true,
+		},
+		{
+			name:     "queued upgrade outside of the maintenance window",
+			objs:     []ctrlclient.Object{closedMaintenanceWindows()},
+			schedule: pointer.To(api.UpgradePlanApprovalScheduleMaintenanceWindow),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			next := &handlers.MockHandler{}
+			next.On("ApproveUpgradePlan", mock.Anything, testMaintenanceNamespace, mock.Anything).
+				Return(&api.UpgradePlanApprovalResult{}, nil)
+			h := newTestMaintenanceValidator(next, tc.objs...)
+
+			_, err := h.ApproveUpgradePlan(context.Background(), testMaintenanceNamespace,
+				&api.UpgradePlanApproval{Schedule: tc.schedule})
+			if tc.wantErr {
+				require.ErrorIs(t, err, ErrInvalidRequest)
+				assert.ErrorContains(t, err, "operator upgrade is only allowed inside a maintenance window")
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestRequiresRestart(t *testing.T) {
+	t.Parallel()
+
+	oldDB := &everestv1alpha1.DatabaseCluster{
+		Spec: everestv1alpha1.DatabaseClusterSpec{
+			Engine: everestv1alpha1.Engine{
+				Type:     everestv1alpha1.DatabaseEnginePXC,
+				Version:  "8.0.36-28",
+				Replicas: 3,
+				Resources: everestv1alpha1.Resources{
+					CPU:    resource.MustParse("1"),
+					Memory: resource.MustParse("2G"),
+				},
+				CRVersion: pointer.ToString("1.15.0"),
+			},
+			Proxy: everestv1alpha1.Proxy{
+				Replicas: pointer.ToInt32(3),
+			},
+		},
+	}
+
+	testCases := []struct {
+		name   string
+		update func(db *everestv1alpha1.DatabaseCluster)
+		want   bool
+	}{
+		{
+			name:   "no changes",
+			update: func(_ *everestv1alpha1.DatabaseCluster) {},
+		},
+		{
+			name: "same resources in a different notation",
+			update: func(db *everestv1alpha1.DatabaseCluster) {
+				db.Spec.Engine.Resources.CPU = resource.MustParse("1000m")
+			},
+		},
+		{
+			name: "scaling",
+			update: func(db *everestv1alpha1.DatabaseCluster) {
+				db.Spec.Engine.Replicas = 5
+				db.Spec.Proxy.Replicas = pointer.ToInt32(5)
+			},
+		},
+		{
+			name: "engine version",
+			update: func(db *everestv1alpha1.DatabaseCluster) {
+				db.Spec.Engine.Version = "8.0.39-30"
+			},
+			want: true,
+		},
+		{
+			name: "CR version",
+			update: func(db *everestv1alpha1.DatabaseCluster) {
+				db.Spec.Engine.CRVersion = pointer.ToString("1.16.0")
+			},
+			want: true,
+		},
+		{
+			name: "engine config",
+			update: func(db *everestv1alpha1.DatabaseCluster) {
+				db.Spec.Engine.Config = "[mysqld]\nmax_connections=1000"
+			},
+			want: true,
+		},
+		{
+			name: "engine resources",
+			update: func(db *everestv1alpha1.DatabaseCluster) {
+				db.Spec.Engine.Resources.Memory = resource.MustParse("4G")
+			},
+			want: true,
+		},
+		{
+			name: "proxy resources",
+			update: func(db *everestv1alpha1.DatabaseCluster) {
+				db.Spec.Proxy.Resources.CPU = resource.MustParse("500m")
+			},
+			want: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			db := oldDB.DeepCopy()
+			tc.update(db)
+			assert.Equal(t, tc.want, requiresRestart(db, oldDB))
+		})
+	}
+}
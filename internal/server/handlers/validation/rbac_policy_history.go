@@ -0,0 +1,36 @@
+package validation
+
+import (
+	"context"
+	"errors"
+
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/rbac"
+)
+
+func (h *validateHandler) ListRBACPolicyVersions(ctx context.Context) (*api.RBACPolicyVersionList, error) {
+	return h.next.ListRBACPolicyVersions(ctx)
+}
+
+func (h *validateHandler) GetRBACPolicyVersion(ctx context.Context, version int) (*api.RBACPolicyVersion, error) {
+	return h.next.GetRBACPolicyVersion(ctx, version)
+}
+
+func (h *validateHandler) DiffRBACPolicyVersions(ctx context.Context, from, to int) (*api.RBACPolicyDiff, error) {
+	return h.next.DiffRBACPolicyVersions(ctx, from, to)
+}
+
+func (h *validateHandler) RollbackRBACPolicy(ctx context.Context, version int) (*api.RBACPolicyVersion, error) {
+	history, err := rbac.GetPolicyHistory(ctx, h.kubeConnector)
+	if err != nil {
+		return nil, err
+	}
+	v, err := history.Get(version)
+	if err != nil {
+		return nil, err
+	}
+	if err := rbac.ParsePolicy(v.Policy).Validate(); err != nil {
+		return nil, errors.Join(ErrInvalidRequest, err)
+	}
+	return h.next.RollbackRBACPolicy(ctx, version)
+}
@@ -0,0 +1,50 @@
+package validation
+
+import (
+	"context"
+	"errors"
+
+	"github.com/AlekSi/pointer"
+
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/rbac"
+)
+
+var errOIDCNotConfigured = errors.New("OIDC is not configured")
+
+func (h *validateHandler) GetOIDCClaimMapping(ctx context.Context) (*api.OIDCClaimMapping, error) {
+	return h.next.GetOIDCClaimMapping(ctx)
+}
+
+func (h *validateHandler) UpdateOIDCClaimMapping(ctx context.Context, req *api.OIDCClaimMapping) (*api.OIDCClaimMapping, error) {
+	if err := rbac.ValidateClaimMapping(common.OIDCConfig{
+		UsernameClaim:  pointer.Get(req.UsernameClaim),
+		UsernamePrefix: pointer.Get(req.UsernamePrefix),
+		GroupsClaims:   pointer.Get(req.GroupsClaims),
+		GroupsPrefix:   pointer.Get(req.GroupsPrefix),
+	}); err != nil {
+		return nil, errors.Join(ErrInvalidRequest, err)
+	}
+
+	settings, err := h.kubeConnector.GetEverestSettings(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if settings.OIDCConfigRaw == "" {
+		return nil, errors.Join(ErrInvalidRequest, errOIDCNotConfigured)
+	}
+	// The users of the provider must still be told apart from the users of the other providers.
+	config, err := settings.OIDCConfig()
+	if err != nil {
+		return nil, errors.Join(err, errors.New("cannot parse OIDC raw config"))
+	}
+	config.UsernamePrefix = pointer.Get(req.UsernamePrefix)
+	if err := settings.SetOIDCProvider(config); err != nil {
+		return nil, err
+	}
+	if _, err := settings.OIDCProviders(); err != nil {
+		return nil, errors.Join(ErrInvalidRequest, err)
+	}
+	return h.next.UpdateOIDCClaimMapping(ctx, req)
+}
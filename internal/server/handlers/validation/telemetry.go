@@ -0,0 +1,11 @@
+package validation
+
+import (
+	"context"
+
+	"github.com/percona/everest/pkg/telemetry"
+)
+
+func (h *validateHandler) GetTelemetry(ctx context.Context) (*telemetry.Telemetry, error) {
+	return h.next.GetTelemetry(ctx)
+}
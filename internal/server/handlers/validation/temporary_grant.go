@@ -0,0 +1,25 @@
+package validation
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/pkg/rbac"
+)
+
+func (h *validateHandler) ListTemporaryGrants(ctx context.Context) (*api.TemporaryGrants, error) {
+	return h.next.ListTemporaryGrants(ctx)
+}
+
+func (h *validateHandler) CreateTemporaryGrant(ctx context.Context, req *api.TemporaryGrantRequest) (*api.TemporaryGrant, error) {
+	if err := rbac.ValidateGrant(ctx, h.kubeConnector, rbac.TemporaryGrant{
+		Subject:   req.Subject,
+		Role:      req.Role,
+		ExpiresAt: req.ExpiresAt,
+	}, time.Now()); err != nil {
+		return nil, errors.Join(ErrInvalidRequest, err)
+	}
+	return h.next.CreateTemporaryGrant(ctx, req)
+}
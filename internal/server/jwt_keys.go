@@ -0,0 +1,74 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	// jwksPath is the path of the JSON Web Key Set of the keys for verifying the tokens issued by Everest.
+	jwksPath = "/.well-known/jwks.json"
+	// jwtKeysJobInterval is how often the keys are reloaded and checked for the scheduled rotation.
+	// The keys rotated by everestctl or by another Everest instance are picked up within this period.
+	jwtKeysJobInterval = time.Minute
+)
+
+// RunJWTKeysJob periodically reloads the keys for signing and verifying the JWT tokens
+// and rotates them according to the configured interval.
+func (e *EverestServer) RunJWTKeysJob(ctx context.Context) {
+	if e.config.JWTKeyRotationInterval > 0 && e.config.JWTKeyRotationGracePeriod < e.config.SessionTokenExpiry {
+		e.l.Warnf("JWT key rotation grace period %s is shorter than the session token expiry %s, "+
+			"the sessions may end on rotation", e.config.JWTKeyRotationGracePeriod, e.config.SessionTokenExpiry)
+	}
+
+	ticker := time.NewTicker(jwtKeysJobInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			e.refreshJWTKeys(ctx)
+		}
+	}
+}
+
+func (e *EverestServer) refreshJWTKeys(ctx context.Context) {
+	rotated, err := e.sessionMgr.RotateKeysIfDue(ctx, e.config.JWTKeyRotationInterval, e.config.JWTKeyRotationGracePeriod)
+	if err != nil {
+		e.l.Error(errors.Join(err, errors.New("failed to rotate JWT keys")))
+	}
+	if rotated || err != nil {
+		return
+	}
+	if err := e.sessionMgr.RefreshKeys(ctx); err != nil {
+		e.l.Error(errors.Join(err, errors.New("failed to reload JWT keys")))
+	}
+}
+
+// getJWKS returns the public keys for verifying the tokens issued by Everest.
+func (e *EverestServer) getJWKS(c echo.Context) error {
+	// The keys are rotated with a grace period, so a short caching does not break the verification.
+	c.Response().Header().Set(echo.HeaderCacheControl, "public, max-age=300")
+	return c.JSON(http.StatusOK, e.sessionMgr.JWKS())
+}
@@ -0,0 +1,161 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"net/http"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/labstack/echo/v4"
+
+	"github.com/percona/everest/api"
+	rbachandler "github.com/percona/everest/internal/server/handlers/rbac"
+	"github.com/percona/everest/pkg/maintenance"
+	"github.com/percona/everest/pkg/rbac"
+)
+
+// maintenanceWindowsJobInterval is how often the queued operations are checked against the maintenance windows.
+const maintenanceWindowsJobInterval = time.Minute
+
+// errQueuedUpgradeOutdated is returned when the upgrade plan changed since the queued upgrade was approved.
+var errQueuedUpgradeOutdated = errors.New("the upgrade plan changed since the upgrade was approved")
+
+// GetMaintenanceWindows returns the maintenance windows of the given namespace.
+func (e *EverestServer) GetMaintenanceWindows(c echo.Context, namespace string) error {
+	result, err := e.handler.GetMaintenanceWindows(c.Request().Context(), namespace)
+	if err != nil {
+		e.l.Errorf("GetMaintenanceWindows failed: %v", err)
+		return err
+	}
+	return c.JSON(http.StatusOK, result)
+}
+
+// UpdateMaintenanceWindows replaces the maintenance windows of the given namespace.
+func (e *EverestServer) UpdateMaintenanceWindows(c echo.Context, namespace string) error {
+	req := &api.MaintenanceWindowsSpec{}
+	if err := e.getBodyFromContext(c, req); err != nil {
+		return errors.Join(errFailedToReadRequestBody, err)
+	}
+
+	result, err := e.handler.UpdateMaintenanceWindows(c.Request().Context(), namespace, req)
+	if err != nil {
+		e.l.Errorf("UpdateMaintenanceWindows failed: %v", err)
+		return err
+	}
+	return c.JSON(http.StatusOK, result)
+}
+
+// RunMaintenanceWindowsJob runs background job for starting the operator upgrades
+// that are queued until the maintenance windows of the DB namespaces.
+func (e *EverestServer) RunMaintenanceWindowsJob(ctx context.Context) {
+	ticker := time.NewTicker(maintenanceWindowsJobInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			e.runQueuedUpgrades(ctx)
+		}
+	}
+}
+
+func (e *EverestServer) runQueuedUpgrades(ctx context.Context) {
+	namespaces, err := e.kubeConnector.GetDBNamespaces(ctx)
+	if err != nil {
+		e.l.Error(errors.Join(err, errors.New("failed to get watched namespaces")))
+		return
+	}
+
+	now := time.Now().UTC()
+	for _, ns := range namespaces.Items {
+		namespace := ns.GetName()
+		config, err := e.kubeConnector.GetMaintenanceConfig(ctx, namespace)
+		if err != nil {
+			e.l.Errorf("failed to get maintenance windows of namespace %s: %v", namespace, err)
+			continue
+		}
+		if config.QueuedUpgrade == nil {
+			continue
+		}
+		if open, err := config.IsOpen(now); err != nil || !open {
+			continue
+		}
+
+		// Every replica runs this job, so the upgrade is claimed before starting it.
+		queued, err := e.kubeConnector.ClaimQueuedUpgrade(ctx, namespace)
+		if err != nil {
+			e.l.Errorf("failed to claim the queued operator upgrade in namespace %s: %v", namespace, err)
+			continue
+		}
+		if queued == nil {
+			continue
+		}
+
+		e.l.Infof("Starting the operator upgrade in namespace %s approved by %s", namespace, queued.RequestedBy)
+		err = e.startQueuedUpgrade(ctx, namespace, queued)
+		switch {
+		case err == nil:
+		case errors.Is(err, errQueuedUpgradeOutdated), errors.Is(err, rbachandler.ErrInsufficientPermissions):
+			// The approval no longer holds, so the upgrade is dropped until it is approved again.
+			e.l.Warnf("dropping the queued operator upgrade in namespace %s approved by %s: %v", namespace, queued.RequestedBy, err)
+		default:
+			e.l.Errorf("failed to start the queued operator upgrade in namespace %s: %v", namespace, err)
+			e.requeueUpgrade(ctx, namespace, queued)
+		}
+	}
+}
+
+// startQueuedUpgrade starts the queued upgrade on behalf of the user who approved it,
+// so that the upgrade is authorized and validated against the current state of the namespace.
+// The upgrade is started only if the upgrade plan still has the approved target versions.
+func (e *EverestServer) startQueuedUpgrade(ctx context.Context, namespace string, queued *maintenance.QueuedUpgrade) error {
+	ctx = rbac.ContextWithUser(ctx, rbac.User{Subject: queued.RequestedBy, Groups: queued.RequestedByGroups})
+	plan, err := e.handler.GetUpgradePlan(ctx, namespace)
+	if err != nil {
+		return err
+	}
+	targets := make(map[string]string, len(pointer.Get(plan.Upgrades)))
+	for _, upgrade := range pointer.Get(plan.Upgrades) {
+		targets[pointer.Get(upgrade.Name)] = pointer.Get(upgrade.TargetVersion)
+	}
+	if len(targets) == 0 || !maps.Equal(targets, queued.TargetVersions) {
+		return errQueuedUpgradeOutdated
+	}
+	_, err = e.handler.ApproveUpgradePlan(ctx, namespace, nil)
+	return err
+}
+
+// requeueUpgrade puts back the claimed upgrade that failed to start, so that it is retried.
+func (e *EverestServer) requeueUpgrade(ctx context.Context, namespace string, queued *maintenance.QueuedUpgrade) {
+	config, err := e.kubeConnector.GetMaintenanceConfig(ctx, namespace)
+	if err != nil {
+		e.l.Errorf("failed to requeue the operator upgrade in namespace %s: %v", namespace, err)
+		return
+	}
+	if config.QueuedUpgrade != nil {
+		return
+	}
+	config.QueuedUpgrade = queued
+	if err := e.kubeConnector.UpdateMaintenanceConfig(ctx, namespace, config); err != nil {
+		e.l.Errorf("failed to requeue the operator upgrade in namespace %s: %v", namespace, err)
+	}
+}
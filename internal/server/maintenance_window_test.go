@@ -0,0 +1,113 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/percona/everest/api"
+	"github.com/percona/everest/internal/server/handlers"
+	rbachandler "github.com/percona/everest/internal/server/handlers/rbac"
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/maintenance"
+	"github.com/percona/everest/pkg/rbac"
+)
+
+func TestStartQueuedUpgrade(t *testing.T) {
+	t.Parallel()
+
+	const testNamespace = "test-ns"
+
+	queued := &maintenance.QueuedUpgrade{
+		RequestedBy:       "bob",
+		RequestedByGroups: []string{"dba"},
+		TargetVersions:    map[string]string{common.MySQLOperatorName: "1.16.0"},
+	}
+	plan := func(targetVersion string) *api.UpgradePlan {
+		return &api.UpgradePlan{Upgrades: &[]api.Upgrade{{
+			Name:           pointer.To(common.MySQLOperatorName),
+			CurrentVersion: pointer.To("1.15.0"),
+			TargetVersion:  pointer.To(targetVersion),
+		}}}
+	}
+	// The upgrade is started on behalf of the user who approved it.
+	asRequester := mock.MatchedBy(func(ctx context.Context) bool {
+		user, err := rbac.GetUser(ctx)
+		return err == nil && user.Subject == "bob" && assert.ObjectsAreEqual([]string{"dba"}, user.Groups)
+	})
+
+	testCases := []struct {
+		desc       string
+		plan       *api.UpgradePlan
+		approveErr error
+		wantErr    error
+		approved   bool
+	}{
+		{
+			desc:     "plan unchanged",
+			plan:     plan("1.16.0"),
+			approved: true,
+		},
+		{
+			desc:    "plan changed",
+			plan:    plan("1.17.0"),
+			wantErr: errQueuedUpgradeOutdated,
+		},
+		{
+			desc:    "nothing to upgrade",
+			plan:    &api.UpgradePlan{},
+			wantErr: errQueuedUpgradeOutdated,
+		},
+		{
+			desc:       "permission revoked",
+			plan:       plan("1.16.0"),
+			approveErr: rbachandler.ErrInsufficientPermissions,
+			wantErr:    rbachandler.ErrInsufficientPermissions,
+			approved:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			h := &handlers.MockHandler{}
+			h.On("GetUpgradePlan", asRequester, testNamespace).Return(tc.plan, nil)
+			h.On("ApproveUpgradePlan", asRequester, testNamespace, mock.Anything).
+				Return(&api.UpgradePlanApprovalResult{Status: pointer.To(api.Started)}, tc.approveErr)
+			e := &EverestServer{l: zap.NewNop().Sugar(), handler: h}
+
+			err := e.startQueuedUpgrade(context.Background(), testNamespace, queued)
+			if tc.wantErr != nil {
+				require.ErrorIs(t, err, tc.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+			if tc.approved {
+				h.AssertCalled(t, "ApproveUpgradePlan", asRequester, testNamespace, mock.Anything)
+			} else {
+				h.AssertNotCalled(t, "ApproveUpgradePlan", mock.Anything, mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
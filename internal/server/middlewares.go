@@ -97,10 +97,10 @@ func (e *EverestServer) checkOperatorUpgradeState(next echo.HandlerFunc) echo.Ha
 }
 
 func (e *EverestServer) securityHeaders() echo.MiddlewareFunc {
-	oidcProvider := e.oidcProvider
 	useTLS := e.config.TLSCertsPath != ""
 	connectSrc := []string{CSPSelf}
-	if oidcProvider != nil {
+	for _, provider := range e.oidcProviders.List() {
+		oidcProvider := provider.ProviderConfig
 		issuer, _ := url.JoinPath(oidcProvider.Issuer, oidc.WellKnownPath)
 		connectSrc = append(connectSrc, issuer)
 		connectSrc = append(connectSrc, oidcProvider.TokenURL)
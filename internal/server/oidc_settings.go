@@ -0,0 +1,96 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/kubernetes"
+	"github.com/percona/everest/pkg/kubernetes/informer"
+)
+
+// oidcSettingsCache holds the OIDC provider configurations of the Everest settings,
+// so that their changes apply without reading the settings on every request.
+type oidcSettingsCache struct {
+	mu      sync.RWMutex
+	configs map[string]common.OIDCConfig
+}
+
+// get returns the configuration of the provider with the name, if it is known.
+func (c *oidcSettingsCache) get(name string) (common.OIDCConfig, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	config, ok := c.configs[name]
+	return config, ok
+}
+
+// update replaces the cached configurations with the ones in the settings ConfigMap.
+// The cached configurations are kept if the settings cannot be parsed.
+func (c *oidcSettingsCache) update(cm *corev1.ConfigMap) error {
+	settings := common.EverestSettings{}
+	if err := settings.FromMap(cm.Data); err != nil {
+		return err
+	}
+	oidcConfigs, err := settings.OIDCProviders()
+	if err != nil {
+		return err
+	}
+	configs := make(map[string]common.OIDCConfig, len(oidcConfigs))
+	for _, config := range oidcConfigs {
+		configs[config.Name] = config
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.configs = configs
+	return nil
+}
+
+// watchOIDCSettings sets up an informer that updates the cache whenever the Everest settings ConfigMap changes.
+func (c *oidcSettingsCache) watchOIDCSettings(
+	ctx context.Context,
+	kubeConnector kubernetes.KubernetesConnector,
+	l *zap.SugaredLogger,
+) error {
+	inf, err := informer.New(
+		informer.WithConfig(kubeConnector.Config()),
+		informer.WithLogger(l),
+		informer.Watches(&corev1.ConfigMap{}, common.SystemNamespace),
+	)
+	if err != nil {
+		return errors.Join(err, errors.New("failed to create Everest settings informer"))
+	}
+	update := func(obj interface{}) {
+		cm, ok := obj.(*corev1.ConfigMap)
+		if !ok || cm.GetName() != common.EverestSettingsConfigMapName {
+			return
+		}
+		if err := c.update(cm); err != nil {
+			l.Errorf("cannot parse OIDC raw config: %v", err)
+		}
+	}
+	inf.OnAdd(update)
+	inf.OnUpdate(func(_, newObj interface{}) { update(newObj) })
+	if err := inf.Start(ctx, &corev1.ConfigMap{}); err != nil {
+		return errors.Join(err, errors.New("failed to watch Everest settings ConfigMap"))
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/percona/everest/pkg/common"
+)
+
+func TestOIDCSettingsCache(t *testing.T) {
+	t.Parallel()
+
+	cache := &oidcSettingsCache{}
+	_, ok := cache.get(common.DefaultOIDCProviderName)
+	assert.False(t, ok)
+
+	require.NoError(t, cache.update(&corev1.ConfigMap{Data: map[string]string{
+		"oidc.config":    "issuerUrl: https://okta.example.com\nusernameClaim: email\nusernamePrefix: 'okta:'\n",
+		"oidc.providers": "- name: contractors\n  issuerUrl: https://entra.example.com\n  usernamePrefix: 'entra:'\n",
+	}}))
+	config, ok := cache.get(common.DefaultOIDCProviderName)
+	require.True(t, ok)
+	assert.Equal(t, "email", config.UsernameClaim)
+	_, ok = cache.get("contractors")
+	assert.True(t, ok)
+
+	// The cached configurations are kept if the settings are invalid.
+	require.Error(t, cache.update(&corev1.ConfigMap{Data: map[string]string{
+		"oidc.config": "usernameClaim: [",
+	}}))
+	config, ok = cache.get(common.DefaultOIDCProviderName)
+	require.True(t, ok)
+	assert.Equal(t, "email", config.UsernameClaim)
+}
@@ -1,9 +1,12 @@
 package server
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/labstack/echo/v4"
+
+	"github.com/percona/everest/api"
 )
 
 // GetUserPermissions returns the permissions for the currently logged in user.
@@ -15,3 +18,28 @@ func (e *EverestServer) GetUserPermissions(c echo.Context) error {
 	}
 	return c.JSON(http.StatusOK, permissions)
 }
+
+// GetUserPermissionMatrix returns the actions the currently logged in user is allowed to perform per resource and object.
+func (e *EverestServer) GetUserPermissionMatrix(c echo.Context) error {
+	result, err := e.handler.GetUserPermissionMatrix(c.Request().Context())
+	if err != nil {
+		e.l.Errorf("GetUserPermissionMatrix failed: %v", err)
+		return err
+	}
+	return c.JSON(http.StatusOK, result)
+}
+
+// ExplainPermission explains whether a subject is allowed to perform an action on an object.
+func (e *EverestServer) ExplainPermission(c echo.Context) error {
+	req := &api.PermissionExplanationRequest{}
+	if err := e.getBodyFromContext(c, req); err != nil {
+		return errors.Join(errFailedToReadRequestBody, err)
+	}
+
+	result, err := e.handler.ExplainPermission(c.Request().Context(), req)
+	if err != nil {
+		e.l.Errorf("ExplainPermission failed: %v", err)
+		return err
+	}
+	return c.JSON(http.StatusOK, result)
+}
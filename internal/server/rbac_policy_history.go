@@ -0,0 +1,64 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/percona/everest/api"
+)
+
+// ListRBACPolicyVersions returns the most recent versions of the RBAC policy.
+func (e *EverestServer) ListRBACPolicyVersions(c echo.Context) error {
+	result, err := e.handler.ListRBACPolicyVersions(c.Request().Context())
+	if err != nil {
+		e.l.Errorf("ListRBACPolicyVersions failed: %v", err)
+		return err
+	}
+	return c.JSON(http.StatusOK, result)
+}
+
+// GetRBACPolicyVersion returns the specified version of the RBAC policy.
+func (e *EverestServer) GetRBACPolicyVersion(c echo.Context, version int) error {
+	result, err := e.handler.GetRBACPolicyVersion(c.Request().Context(), version)
+	if err != nil {
+		e.l.Errorf("GetRBACPolicyVersion failed: %v", err)
+		return err
+	}
+	return c.JSON(http.StatusOK, result)
+}
+
+// DiffRBACPolicyVersions returns the difference between two versions of the RBAC policy.
+func (e *EverestServer) DiffRBACPolicyVersions(c echo.Context, params api.DiffRBACPolicyVersionsParams) error {
+	result, err := e.handler.DiffRBACPolicyVersions(c.Request().Context(), params.From, params.To)
+	if err != nil {
+		e.l.Errorf("DiffRBACPolicyVersions failed: %v", err)
+		return err
+	}
+	return c.JSON(http.StatusOK, result)
+}
+
+// RollbackRBACPolicy replaces the RBAC policy with the specified version of the policy.
+func (e *EverestServer) RollbackRBACPolicy(c echo.Context, version int) error {
+	result, err := e.handler.RollbackRBACPolicy(c.Request().Context(), version)
+	if err != nil {
+		e.l.Errorf("RollbackRBACPolicy failed: %v", err)
+		return err
+	}
+	return c.JSON(http.StatusOK, result)
+}
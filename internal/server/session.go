@@ -18,22 +18,15 @@ package server
 
 import (
 	"errors"
-	"fmt"
 	"net/http"
-	"time"
 
 	"github.com/AlekSi/pointer"
-	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 
 	"github.com/percona/everest/api"
 	"github.com/percona/everest/pkg/accounts"
 	"github.com/percona/everest/pkg/common"
-)
-
-const (
-	jwtSubjectTml    = "%s:%s" // username:capability
-	jwtDefaultExpiry = time.Hour * 24
+	"github.com/percona/everest/pkg/session"
 )
 
 // CreateSession creates a new session.
@@ -44,26 +37,41 @@ func (e *EverestServer) CreateSession(ctx echo.Context) error {
 	}
 
 	c := ctx.Request().Context()
-	err := e.sessionMgr.Authenticate(c, *params.Username, *params.Password)
+	err := e.sessionMgr.Authenticate(c, *params.Username, *params.Password, pointer.Get(params.MfaCode))
+	if errors.Is(err, accounts.ErrPasswordExpired) && params.NewPassword != nil {
+		// The credentials are valid, so the expired password can be replaced as a part of the login.
+		err = e.sessionMgr.ChangeExpiredPassword(c, *params.Username, *params.NewPassword)
+	}
 	if err != nil {
 		e.attemptsStore.IncreaseTimeout(ctx.RealIP())
 		return sessionErrToHTTPRes(ctx, err)
 	}
 
-	uniqueID, err := uuid.NewRandom()
+	jwtToken, err := e.sessionMgr.StartSession(c, *params.Username, session.ClientInfo{
+		IP:        ctx.RealIP(),
+		UserAgent: ctx.Request().UserAgent(),
+	})
 	if err != nil {
 		return err
 	}
-	subject := fmt.Sprintf(jwtSubjectTml, *params.Username, accounts.AccountCapabilityLogin)
-	secondsBeforeExpiry := int64(jwtDefaultExpiry.Seconds())
 
-	jwtToken, err := e.sessionMgr.Create(subject, secondsBeforeExpiry, uniqueID.String())
+	e.attemptsStore.CleanupVisitor(ctx.RealIP())
+
+	return ctx.JSON(http.StatusOK, map[string]string{"token": jwtToken})
+}
+
+// RefreshSession replaces the session token of the current user with a new one.
+func (e *EverestServer) RefreshSession(ctx echo.Context) error {
+	c := ctx.Request().Context()
+	token, err := common.ExtractToken(c)
 	if err != nil {
 		return err
 	}
-
-	e.attemptsStore.CleanupVisitor(ctx.RealIP())
-
+	jwtToken, err := e.sessionMgr.RefreshSession(c, token)
+	if err != nil {
+		e.l.Errorf("RefreshSession failed: %v", err)
+		return sessionErrToHTTPRes(ctx, err)
+	}
 	return ctx.JSON(http.StatusOK, map[string]string{"token": jwtToken})
 }
 
@@ -86,6 +94,42 @@ func (e *EverestServer) DeleteSession(ctx echo.Context) error {
 	return ctx.NoContent(http.StatusNoContent)
 }
 
+// ChangePassword replaces the password of the current user.
+func (e *EverestServer) ChangePassword(ctx echo.Context) error {
+	var params api.PasswordChangeRequest
+	if err := ctx.Bind(&params); err != nil {
+		return err
+	}
+	if params.NewPassword == "" {
+		return ctx.JSON(http.StatusBadRequest, api.Error{
+			Message: pointer.To("New password cannot be blank"),
+		})
+	}
+
+	c := ctx.Request().Context()
+	token, err := common.ExtractToken(c)
+	if err != nil {
+		return err
+	}
+	username, ok := session.LoginUsername(token)
+	if !ok {
+		return ctx.JSON(http.StatusForbidden, api.Error{
+			Message: pointer.To("Password can be changed only by built-in users logged in with a session token"),
+		})
+	}
+	if err := e.sessionMgr.ChangePassword(c, username, params.CurrentPassword, params.NewPassword); err != nil {
+		e.l.Errorf("ChangePassword failed: %v", err)
+		if errors.Is(err, accounts.ErrIncorrectPassword) {
+			// Not 401, since the user is authenticated and only the current password is wrong.
+			return ctx.JSON(http.StatusBadRequest, api.Error{
+				Message: pointer.To("Incorrect current password provided"),
+			})
+		}
+		return sessionErrToHTTPRes(ctx, err)
+	}
+	return ctx.NoContent(http.StatusNoContent)
+}
+
 func sessionErrToHTTPRes(ctx echo.Context, err error) error {
 	if errors.Is(err, accounts.ErrAccountNotFound) ||
 		errors.Is(err, accounts.ErrIncorrectPassword) {
@@ -94,6 +138,50 @@ func sessionErrToHTTPRes(ctx echo.Context, err error) error {
 		})
 	}
 
+	if errors.Is(err, accounts.ErrSessionNotFound) ||
+		errors.Is(err, session.ErrRefreshWindowExpired) {
+		return ctx.JSON(http.StatusUnauthorized, api.Error{
+			Message: pointer.To(err.Error()),
+		})
+	}
+
+	if errors.Is(err, session.ErrRefreshNotAllowed) {
+		return ctx.JSON(http.StatusForbidden, api.Error{
+			Message: pointer.To(err.Error()),
+		})
+	}
+
+	if errors.Is(err, accounts.ErrMFARequired) {
+		return ctx.JSON(http.StatusUnauthorized, api.Error{
+			Message: pointer.To("MFA code required"),
+		})
+	}
+
+	if errors.Is(err, accounts.ErrInvalidMFACode) {
+		return ctx.JSON(http.StatusUnauthorized, api.Error{
+			Message: pointer.To("Invalid MFA code"),
+		})
+	}
+
+	if errors.Is(err, accounts.ErrPasswordExpired) {
+		return ctx.JSON(http.StatusForbidden, api.Error{
+			Message: pointer.To("Password has expired and must be changed"),
+		})
+	}
+
+	if errors.Is(err, accounts.ErrPasswordPolicy) ||
+		errors.Is(err, accounts.ErrPasswordReused) {
+		return ctx.JSON(http.StatusBadRequest, api.Error{
+			Message: pointer.To(err.Error()),
+		})
+	}
+
+	if errors.Is(err, accounts.ErrAccountLocked) {
+		return ctx.JSON(http.StatusForbidden, api.Error{
+			Message: pointer.To("User account is locked due to too many failed logins"),
+		})
+	}
+
 	if errors.Is(err, accounts.ErrAccountDisabled) {
 		return ctx.JSON(http.StatusForbidden, api.Error{
 			Message: pointer.To("User account is disabled"),
@@ -1,6 +1,7 @@
 package server
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/AlekSi/pointer"
@@ -19,3 +20,28 @@ func (e *EverestServer) GetSettings(ctx echo.Context) error {
 	}
 	return ctx.JSON(http.StatusOK, result)
 }
+
+// GetOIDCClaimMapping returns the claims of the OIDC tokens that describe the user.
+func (e *EverestServer) GetOIDCClaimMapping(c echo.Context) error {
+	result, err := e.handler.GetOIDCClaimMapping(c.Request().Context())
+	if err != nil {
+		e.l.Errorf("GetOIDCClaimMapping failed: %v", err)
+		return err
+	}
+	return c.JSON(http.StatusOK, result)
+}
+
+// UpdateOIDCClaimMapping updates the claims of the OIDC tokens that describe the user.
+func (e *EverestServer) UpdateOIDCClaimMapping(c echo.Context) error {
+	req := &api.OIDCClaimMapping{}
+	if err := e.getBodyFromContext(c, req); err != nil {
+		return errors.Join(errFailedToReadRequestBody, err)
+	}
+
+	result, err := e.handler.UpdateOIDCClaimMapping(c.Request().Context(), req)
+	if err != nil {
+		e.l.Errorf("UpdateOIDCClaimMapping failed: %v", err)
+		return err
+	}
+	return c.JSON(http.StatusOK, result)
+}
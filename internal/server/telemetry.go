@@ -1,77 +1,29 @@
 package server
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
 	"errors"
-	"fmt"
 	"net/http"
-	"strconv"
 	"time"
 
-	"github.com/google/uuid"
-	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"github.com/labstack/echo/v4"
 
 	"github.com/percona/everest/cmd/config"
+	"github.com/percona/everest/pkg/telemetry"
 	"github.com/percona/everest/pkg/version"
 )
 
-const (
-	telemetryProductFamily = "PRODUCT_FAMILY_EVEREST"
-	telemetryVersionKey    = "version"
+// delay the initial metrics to prevent flooding in case of many restarts.
+const initialMetricsDelay = 5 * time.Minute
 
-	// delay the initial metrics to prevent flooding in case of many restarts.
-	initialMetricsDelay = 5 * time.Minute
-
-	numEngineTypes = 3
-)
-
-// Telemetry is the struct for telemetry reports.
-type Telemetry struct {
-	Reports []Report `json:"reports"`
-}
-
-// Report is a struct for a single telemetry report.
-type Report struct {
-	ID            string    `json:"id"`
-	CreateTime    time.Time `json:"createTime"`
-	InstanceID    string    `json:"instanceId"`
-	ProductFamily string    `json:"productFamily"`
-	Metrics       []Metric  `json:"metrics"`
-}
-
-// Metric represents key-value metrics.
-type Metric struct {
-	Key   string `json:"key"`
-	Value string `json:"value"`
-}
-
-func (e *EverestServer) report(ctx context.Context, baseURL string, data Telemetry) error {
-	b, err := json.Marshal(data)
-	if err != nil {
-		e.l.Error(errors.Join(err, errors.New("failed to marshal the telemetry report")))
-		return err
-	}
-
-	url := fmt.Sprintf("%s/v1/telemetry/GenericReport", baseURL)
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(b))
-	if err != nil {
-		e.l.Error(errors.Join(err, errors.New("failed to create http request")))
-		return err
-	}
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Accept", "application/json")
-	resp, err := http.DefaultClient.Do(req)
+// GetTelemetry returns the telemetry report exactly as it would be sent to the telemetry service.
+func (e *EverestServer) GetTelemetry(c echo.Context) error {
+	result, err := e.handler.GetTelemetry(c.Request().Context())
 	if err != nil {
-		e.l.Error(errors.Join(err, errors.New("failed to send telemetry request")))
+		e.l.Errorf("GetTelemetry failed: %v", err)
 		return err
 	}
-	defer resp.Body.Close() //nolint:errcheck
-	if resp.StatusCode != http.StatusOK {
-		e.l.Info("Telemetry service responded with http status ", resp.StatusCode)
-	}
-	return nil
+	return c.JSON(http.StatusOK, result)
 }
 
 // RunTelemetryJob runs background job for collecting telemetry.
@@ -105,54 +57,28 @@ func (e *EverestServer) collectMetrics(ctx context.Context, config config.Everes
 	if config.DisableTelemetry {
 		return nil
 	}
-	everestID, err := e.kubeConnector.GetEverestID(ctx)
-	if err != nil {
-		e.l.Error(errors.Join(err, errors.New("failed to get Everest settings")))
-		return err
-	}
-
-	namespaces, err := e.kubeConnector.GetDBNamespaces(ctx)
+	report, err := telemetry.Collect(ctx, e.kubeConnector, version.Version)
 	if err != nil {
-		e.l.Error(errors.Join(err, errors.New("failed to get watched namespaces")))
+		e.l.Error(err)
 		return err
 	}
 
-	types := make(map[string]int, numEngineTypes)
-	metrics := make([]Metric, 0, numEngineTypes+1)
-	// Everest version.
-	metrics = append(metrics, Metric{
-		Key:   telemetryVersionKey,
-		Value: version.Version,
-	})
-
-	for _, ns := range namespaces.Items {
-		clusters, err := e.kubeConnector.ListDatabaseClusters(ctx, ctrlclient.InNamespace(ns.GetName()))
-		if err != nil {
-			e.l.Error(errors.Join(err, errors.New("failed to list database clusters")))
+	// The local file sink replaces the telemetry service, e.g. to review the reports before sending them.
+	if config.TelemetryFile != "" {
+		if err := telemetry.WriteFile(config.TelemetryFile, report); err != nil {
+			e.l.Error(err)
 			return err
 		}
-
-		for _, cl := range clusters.Items {
-			types[string(cl.Spec.Engine.Type)]++
-		}
+		return nil
 	}
 
-	for key, val := range types {
-		// Number of DBs per DB engine.
-		metrics = append(metrics, Metric{key, strconv.Itoa(val)})
+	status, err := telemetry.Send(ctx, config.TelemetryURL, report)
+	if err != nil {
+		e.l.Error(err)
+		return err
 	}
-
-	report := Telemetry{
-		[]Report{
-			{
-				ID:            uuid.NewString(),
-				CreateTime:    time.Now(),
-				InstanceID:    everestID,
-				ProductFamily: telemetryProductFamily,
-				Metrics:       metrics,
-			},
-		},
+	if status != http.StatusOK {
+		e.l.Info("Telemetry service responded with http status ", status)
 	}
-
-	return e.report(ctx, config.TelemetryURL, report)
+	return nil
 }
@@ -0,0 +1,50 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/percona/everest/api"
+)
+
+// ListTemporaryGrants returns the active temporary RBAC grants and the history of their changes.
+func (e *EverestServer) ListTemporaryGrants(c echo.Context) error {
+	result, err := e.handler.ListTemporaryGrants(c.Request().Context())
+	if err != nil {
+		e.l.Errorf("ListTemporaryGrants failed: %v", err)
+		return err
+	}
+	return c.JSON(http.StatusOK, result)
+}
+
+// CreateTemporaryGrant assigns an RBAC role to a user or a group until the expiry time.
+func (e *EverestServer) CreateTemporaryGrant(c echo.Context) error {
+	req := &api.TemporaryGrantRequest{}
+	if err := e.getBodyFromContext(c, req); err != nil {
+		return errors.Join(errFailedToReadRequestBody, err)
+	}
+
+	result, err := e.handler.CreateTemporaryGrant(c.Request().Context(), req)
+	if err != nil {
+		e.l.Errorf("CreateTemporaryGrant failed: %v", err)
+		return err
+	}
+	return c.JSON(http.StatusOK, result)
+}
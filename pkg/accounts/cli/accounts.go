@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/rodaine/table"
 	"go.uber.org/zap"
@@ -31,6 +32,7 @@ import (
 	"github.com/percona/everest/pkg/common"
 	"github.com/percona/everest/pkg/kubernetes"
 	"github.com/percona/everest/pkg/output"
+	"github.com/percona/everest/pkg/session"
 )
 
 type (
@@ -48,6 +50,8 @@ type (
 		l              *zap.SugaredLogger
 		config         Config
 		kubeClient     kubernetes.KubernetesConnector
+		apiKeys        *session.APIKeyIssuer
+		sessions       *session.SessionRegistry
 	}
 )
 
@@ -76,6 +80,16 @@ func (c *Accounts) WithAccountManager(m accounts.Interface) {
 	c.accountManager = m
 }
 
+// WithAPIKeyIssuer sets the issuer of the API keys for the Accounts.
+func (c *Accounts) WithAPIKeyIssuer(i *session.APIKeyIssuer) {
+	c.apiKeys = i
+}
+
+// WithSessionRegistry sets the registry of the login sessions for the Accounts.
+func (c *Accounts) WithSessionRegistry(r *session.SessionRegistry) {
+	c.sessions = r
+}
+
 // CreateOptions holds options for creating a new user accounts.
 type CreateOptions struct {
 	// Username is the username for the account.
@@ -170,12 +184,16 @@ const (
 	ColumnCapabilities = "capabilities"
 	// ColumnEnabled is the column name for the enabled status.
 	ColumnEnabled = "enabled"
+	// ColumnLocked is the column name for the locked status.
+	ColumnLocked = "locked"
+	// ColumnMFA is the column name for the MFA enrollment status.
+	ColumnMFA = "mfa"
 )
 
 // List all user accounts in the system.
 func (c *Accounts) List(ctx context.Context, opts ListOptions) error {
 	// Prepare table headings.
-	headings := []interface{}{ColumnUser, ColumnCapabilities, ColumnEnabled}
+	headings := []interface{}{ColumnUser, ColumnCapabilities, ColumnEnabled, ColumnLocked, ColumnMFA}
 	if len(opts.Columns) > 0 {
 		headings = []interface{}{}
 		for _, col := range opts.Columns {
@@ -196,6 +214,7 @@ func (c *Accounts) List(ctx context.Context, opts ListOptions) error {
 		return err
 	}
 
+	now := time.Now()
 	// Return a table row for the given account.
 	row := func(user string, account *accounts.Account) []any {
 		var row []any
@@ -207,6 +226,14 @@ func (c *Accounts) List(ctx context.Context, opts ListOptions) error {
 				row = append(row, account.Capabilities)
 			case ColumnEnabled:
 				row = append(row, account.Enabled)
+			case ColumnLocked:
+				locked := "false"
+				if account.IsLocked(now) {
+					locked = "until " + account.Lockout.LockedUntil.Format(time.RFC3339)
+				}
+				row = append(row, locked)
+			case ColumnMFA:
+				row = append(row, account.MFAEnabled())
 			}
 		}
 		return row
@@ -218,6 +245,68 @@ func (c *Accounts) List(ctx context.Context, opts ListOptions) error {
 	return nil
 }
 
+// Unlock unlocks an account that has been locked after too many failed logins.
+func (c *Accounts) Unlock(ctx context.Context, username string) error {
+	if err := ValidateUsername(username); err != nil {
+		return err
+	}
+
+	c.l.Infof("Unlocking user '%s'", username)
+	if err := c.accountManager.Unlock(ctx, username); err != nil {
+		return err
+	}
+
+	c.l.Infof("User '%s' has been unlocked successfully", username)
+	if c.config.Pretty {
+		_, _ = fmt.Fprintln(os.Stdout, output.Success("User '%s' has been unlocked successfully", username))
+	}
+	return nil
+}
+
+// EnrollMFA enrolls an account in TOTP multi-factor authentication
+// and prints the TOTP secret and the recovery codes.
+func (c *Accounts) EnrollMFA(ctx context.Context, username string) error {
+	if err := ValidateUsername(username); err != nil {
+		return err
+	}
+
+	c.l.Infof("Enrolling user '%s' in MFA", username)
+	enrollment, err := c.accountManager.EnrollMFA(ctx, username)
+	if err != nil {
+		return err
+	}
+
+	c.l.Infof("User '%s' has been enrolled in MFA successfully", username)
+	if c.config.Pretty {
+		_, _ = fmt.Fprintln(os.Stdout, output.Success("User '%s' has been enrolled in MFA successfully. "+
+			"Add the secret below to an authenticator app and store the recovery codes securely, "+
+			"they cannot be retrieved later.", username))
+	}
+	_, _ = fmt.Fprintf(os.Stdout, "Secret: %s\nURI: %s\nRecovery codes:\n", enrollment.Secret, enrollment.URI)
+	for _, code := range enrollment.RecoveryCodes {
+		_, _ = fmt.Fprintf(os.Stdout, "  %s\n", code)
+	}
+	return nil
+}
+
+// ResetMFA removes the multi-factor authentication of an account, e.g. when its owner lost the authenticator.
+func (c *Accounts) ResetMFA(ctx context.Context, username string) error {
+	if err := ValidateUsername(username); err != nil {
+		return err
+	}
+
+	c.l.Infof("Resetting MFA of user '%s'", username)
+	if err := c.accountManager.ResetMFA(ctx, username); err != nil {
+		return err
+	}
+
+	c.l.Infof("MFA of user '%s' has been reset successfully", username)
+	if c.config.Pretty {
+		_, _ = fmt.Fprintln(os.Stdout, output.Success("MFA of user '%s' has been reset successfully", username))
+	}
+	return nil
+}
+
 // GetInitAdminPassword returns the initial admin password.
 func (c *Accounts) GetInitAdminPassword(ctx context.Context) (string, error) {
 	secure, err := c.accountManager.IsSecure(ctx, common.EverestAdminUser)
@@ -249,3 +338,331 @@ func (c *Accounts) CreateRSAKeyPair(ctx context.Context) error {
 	}
 	return nil
 }
+
+// RotateJWTKeys adds a new key for signing the JWT tokens of Everest users.
+// The tokens signed with the previous keys remain valid for gracePeriod.
+func (c *Accounts) RotateJWTKeys(ctx context.Context, gracePeriod time.Duration) error {
+	if gracePeriod <= 0 {
+		return errors.New("grace period must be positive")
+	}
+	key, err := c.kubeClient.RotateJWTKeys(ctx, gracePeriod)
+	if err != nil {
+		return errors.Join(err, errors.New("failed to rotate JWT keys"))
+	}
+
+	c.l.Infof("JWT keys have been rotated, the new key '%s' is used for signing", key.ID)
+	if c.config.Pretty {
+		_, _ = fmt.Fprintln(os.Stdout, output.Success("JWT keys have been rotated. The previous keys are accepted until %s",
+			time.Now().Add(gracePeriod).Format(time.RFC3339)))
+		_, _ = fmt.Fprintln(os.Stdout, output.Warn("API keys signed with the previous keys must be issued again before then"))
+	}
+	return nil
+}
+
+// SetCapabilitiesOptions holds options for setting the capabilities of user accounts.
+type SetCapabilitiesOptions struct {
+	// Username is the username for the account.
+	Username string
+	// Capabilities is the new list of capabilities of the account.
+	Capabilities []string
+}
+
+// SetCapabilities replaces the capabilities of an existing account.
+func (c *Accounts) SetCapabilities(ctx context.Context, opts SetCapabilitiesOptions) error {
+	if err := ValidateUsername(opts.Username); err != nil {
+		return err
+	}
+	if err := ValidateCapabilities(opts.Capabilities); err != nil {
+		return err
+	}
+
+	capabilities := make([]accounts.AccountCapability, 0, len(opts.Capabilities))
+	for _, capability := range opts.Capabilities {
+		capabilities = append(capabilities, accounts.AccountCapability(capability))
+	}
+
+	c.l.Infof("Setting capabilities %v for user '%s'", opts.Capabilities, opts.Username)
+	if err := c.accountManager.SetCapabilities(ctx, opts.Username, capabilities); err != nil {
+		return err
+	}
+
+	c.l.Infof("Capabilities of user '%s' have been set successfully", opts.Username)
+	if c.config.Pretty {
+		_, _ = fmt.Fprintln(os.Stdout, output.Success("Capabilities of user '%s' have been set successfully", opts.Username))
+	}
+	return nil
+}
+
+// APIKeyOptions holds options for creating and revoking API keys.
+type APIKeyOptions struct {
+	// Username is the username of the account that owns the key.
+	Username string
+	// Name is the name of the key.
+	Name string
+	// ExpiresIn is the validity period of a new key. The key never expires if zero.
+	ExpiresIn time.Duration
+}
+
+// CreateAPIKey issues a new API key for an existing account and prints its token.
+func (c *Accounts) CreateAPIKey(ctx context.Context, opts APIKeyOptions) error {
+	if err := ValidateUsername(opts.Username); err != nil {
+		return err
+	}
+	if opts.ExpiresIn < 0 {
+		return errors.New("API key validity period cannot be negative")
+	}
+	issuer, err := c.apiKeyIssuer(ctx)
+	if err != nil {
+		return err
+	}
+
+	var expiresAt *time.Time
+	if opts.ExpiresIn > 0 {
+		t := time.Now().Add(opts.ExpiresIn)
+		expiresAt = &t
+	}
+
+	c.l.Infof("Creating API key '%s' for user '%s'", opts.Name, opts.Username)
+	token, _, err := issuer.Issue(ctx, opts.Username, opts.Name, expiresAt)
+	if err != nil {
+		return err
+	}
+
+	c.l.Infof("API key '%s' for user '%s' has been created successfully", opts.Name, opts.Username)
+	if c.config.Pretty {
+		_, _ = fmt.Fprintln(os.Stdout, output.Success("API key '%s' for user '%s' has been created successfully. "+
+			"Store the token below securely, it cannot be retrieved later.", opts.Name, opts.Username))
+	}
+	_, _ = fmt.Fprintln(os.Stdout, token)
+	return nil
+}
+
+// RevokeAPIKey revokes an API key of an existing account.
+func (c *Accounts) RevokeAPIKey(ctx context.Context, opts APIKeyOptions) error {
+	if err := ValidateUsername(opts.Username); err != nil {
+		return err
+	}
+	issuer, err := c.apiKeyIssuer(ctx)
+	if err != nil {
+		return err
+	}
+
+	c.l.Infof("Revoking API key '%s' of user '%s'", opts.Name, opts.Username)
+	if err := issuer.Revoke(ctx, opts.Username, opts.Name); err != nil {
+		return err
+	}
+
+	c.l.Infof("API key '%s' of user '%s' has been revoked successfully", opts.Name, opts.Username)
+	if c.config.Pretty {
+		_, _ = fmt.Fprintln(os.Stdout, output.Success("API key '%s' of user '%s' has been revoked successfully", opts.Name, opts.Username))
+	}
+	return nil
+}
+
+const (
+	// ColumnName is the column name for the API key name.
+	ColumnName = "name"
+	// ColumnCreated is the column name for the API key creation time.
+	ColumnCreated = "created"
+	// ColumnExpires is the column name for the API key expiry time.
+	ColumnExpires = "expires"
+	// ColumnLastUsed is the column name for the API key last used time.
+	ColumnLastUsed = "last used"
+)
+
+// ListAPIKeys lists the API keys of an existing account.
+func (c *Accounts) ListAPIKeys(ctx context.Context, username string) error {
+	if err := ValidateUsername(username); err != nil {
+		return err
+	}
+	account, err := c.accountManager.Get(ctx, username)
+	if err != nil {
+		return err
+	}
+
+	tbl := table.New(ColumnName, ColumnCreated, ColumnExpires, ColumnLastUsed)
+	tbl.WithHeaderFormatter(func(format string, vals ...interface{}) string {
+		// Print all in caps.
+		return strings.ToUpper(fmt.Sprintf(format, vals...))
+	})
+	formatTime := func(t *time.Time, unset string) string {
+		if t == nil {
+			return unset
+		}
+		return t.Format(time.RFC3339)
+	}
+	for _, key := range account.APIKeys {
+		tbl.AddRow(key.Name, key.CreatedAt.Format(time.RFC3339), formatTime(key.ExpiresAt, "never"), formatTime(key.LastUsedAt, "never"))
+	}
+	tbl.Print()
+	return nil
+}
+
+// apiKeyIssuer returns the issuer of the API keys, which signs the tokens with the key of the Everest server.
+func (c *Accounts) apiKeyIssuer(ctx context.Context) (*session.APIKeyIssuer, error) {
+	if c.apiKeys != nil {
+		return c.apiKeys, nil
+	}
+	keySet, err := c.kubeClient.GetJWTKeySet(ctx)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("failed to get JWT keys"))
+	}
+	keys, err := session.NewKeyRing(*keySet)
+	if err != nil {
+		return nil, err
+	}
+	blocklist, err := c.blocklist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	c.apiKeys = session.NewAPIKeyIssuer(c.accountManager, keys, blocklist)
+	return c.apiKeys, nil
+}
+
+// blocklist returns the blocklist of the tokens of the Everest server.
+func (c *Accounts) blocklist(ctx context.Context) (session.Blocklist, error) { //nolint:ireturn
+	blocklist, err := session.NewBlocklistWithClient(ctx, c.kubeClient, c.l)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("failed to configure tokens blocklist"))
+	}
+	return blocklist, nil
+}
+
+const (
+	// ColumnID is the column name for the session ID.
+	ColumnID = "id"
+	// ColumnIP is the column name for the IP address a session was started from.
+	ColumnIP = "ip"
+	// ColumnUserAgent is the column name for the user agent a session was started with.
+	ColumnUserAgent = "user agent"
+	// ColumnLogin is the column name for the login time of a session.
+	ColumnLogin = "login"
+)
+
+// ListSessions lists the active login sessions of an existing account.
+func (c *Accounts) ListSessions(ctx context.Context, username string) error {
+	if err := ValidateUsername(username); err != nil {
+		return err
+	}
+	registry, err := c.sessionRegistry(ctx)
+	if err != nil {
+		return err
+	}
+	sessions, err := registry.List(ctx, username)
+	if err != nil {
+		return err
+	}
+
+	tbl := table.New(ColumnID, ColumnIP, ColumnUserAgent, ColumnLogin, ColumnExpires)
+	tbl.WithHeaderFormatter(func(format string, vals ...interface{}) string {
+		// Print all in caps.
+		return strings.ToUpper(fmt.Sprintf(format, vals...))
+	})
+	for _, s := range sessions {
+		tbl.AddRow(s.ID, s.IP, s.UserAgent, s.LoginAt.Format(time.RFC3339), s.ExpiresAt.Format(time.RFC3339))
+	}
+	tbl.Print()
+	return nil
+}
+
+// RevokeSessionsOptions holds options for revoking login sessions.
+type RevokeSessionsOptions struct {
+	// Username is the username of the account that owns the sessions.
+	Username string
+	// ID is the ID of the session to revoke. All the sessions are revoked if empty.
+	ID string
+}
+
+// RevokeSessions revokes a login session, or all the login sessions, of an existing account.
+func (c *Accounts) RevokeSessions(ctx context.Context, opts RevokeSessionsOptions) error {
+	if err := ValidateUsername(opts.Username); err != nil {
+		return err
+	}
+	registry, err := c.sessionRegistry(ctx)
+	if err != nil {
+		return err
+	}
+
+	if opts.ID != "" {
+		c.l.Infof("Revoking session '%s' of user '%s'", opts.ID, opts.Username)
+		if err := registry.Revoke(ctx, opts.Username, opts.ID); err != nil {
+			return err
+		}
+		c.l.Infof("Session '%s' of user '%s' has been revoked successfully", opts.ID, opts.Username)
+		if c.config.Pretty {
+			_, _ = fmt.Fprintln(os.Stdout, output.Success("Session '%s' of user '%s' has been revoked successfully", opts.ID, opts.Username))
+		}
+		return nil
+	}
+
+	c.l.Infof("Revoking all sessions of user '%s'", opts.Username)
+	revoked, err := registry.RevokeAll(ctx, opts.Username)
+	if err != nil {
+		return err
+	}
+	c.l.Infof("%d sessions of user '%s' have been revoked successfully", revoked, opts.Username)
+	if c.config.Pretty {
+		_, _ = fmt.Fprintln(os.Stdout, output.Success("%d sessions of user '%s' have been revoked successfully", revoked, opts.Username))
+	}
+	return nil
+}
+
+// sessionRegistry returns the registry of the login sessions, which revokes them through the blocklist of the Everest server.
+func (c *Accounts) sessionRegistry(ctx context.Context) (*session.SessionRegistry, error) {
+	if c.sessions != nil {
+		return c.sessions, nil
+	}
+	blocklist, err := c.blocklist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	c.sessions = session.NewSessionRegistry(c.accountManager, blocklist)
+	return c.sessions, nil
+}
+
+// GetPasswordPolicy returns the password policy of the accounts.
+func (c *Accounts) GetPasswordPolicy(ctx context.Context) (*accounts.PasswordPolicy, error) {
+	return c.accountManager.GetPasswordPolicy(ctx)
+}
+
+// SetPasswordPolicy sets the password policy of the accounts.
+func (c *Accounts) SetPasswordPolicy(ctx context.Context, policy accounts.PasswordPolicy) error {
+	if err := policy.Validate(); err != nil {
+		return err
+	}
+
+	c.l.Info("Setting the password policy")
+	if err := c.accountManager.SetPasswordPolicy(ctx, policy); err != nil {
+		return err
+	}
+
+	c.l.Info("Password policy has been set successfully")
+	if c.config.Pretty {
+		_, _ = fmt.Fprintln(os.Stdout, output.Success("Password policy has been set successfully"))
+	}
+	return nil
+}
+
+// PrintPasswordPolicy prints the password policy of the accounts.
+func (c *Accounts) PrintPasswordPolicy(ctx context.Context) error {
+	policy, err := c.accountManager.GetPasswordPolicy(ctx)
+	if err != nil {
+		return err
+	}
+
+	tbl := table.New("setting", "value")
+	tbl.WithHeaderFormatter(func(format string, vals ...interface{}) string {
+		// Print all in caps.
+		return strings.ToUpper(fmt.Sprintf(format, vals...))
+	})
+	tbl.AddRow("min length", policy.MinLength)
+	tbl.AddRow("require uppercase", policy.RequireUppercase)
+	tbl.AddRow("require lowercase", policy.RequireLowercase)
+	tbl.AddRow("require digit", policy.RequireDigit)
+	tbl.AddRow("require special", policy.RequireSpecial)
+	tbl.AddRow("reject breached", policy.RejectBreached)
+	tbl.AddRow("history size", policy.HistorySize)
+	tbl.AddRow("max age days", policy.MaxAgeDays)
+	tbl.Print()
+	return nil
+}
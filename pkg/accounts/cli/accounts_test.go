@@ -64,3 +64,11 @@ func TestUsernamePasswordSanitation(t *testing.T) {
 		}
 	})
 }
+
+func TestValidateCapabilities(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, ValidateCapabilities([]string{"login", "apiKey"}))
+	require.NoError(t, ValidateCapabilities(nil))
+	require.Error(t, ValidateCapabilities([]string{"login", "admin"}))
+}
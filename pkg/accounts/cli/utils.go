@@ -19,9 +19,12 @@ package cli
 import (
 	"context"
 	"errors"
+	"fmt"
 	"regexp"
+	"slices"
 	"strings"
 
+	"github.com/percona/everest/pkg/accounts"
 	"github.com/percona/everest/pkg/cli/tui"
 )
 
@@ -64,6 +67,16 @@ func ValidatePassword(password string) error {
 	return nil
 }
 
+// ValidateCapabilities validates the provided account capabilities.
+func ValidateCapabilities(capabilities []string) error {
+	for _, c := range capabilities {
+		if !slices.Contains(accounts.Capabilities, accounts.AccountCapability(c)) {
+			return fmt.Errorf("unknown capability '%s', supported capabilities are %v", c, accounts.Capabilities)
+		}
+	}
+	return nil
+}
+
 // PopulateUsername function to fill the username.
 // This function shall be called only in cases when there is no other way to obtain username value.
 // User will be asked to provide the username in interactive mode.
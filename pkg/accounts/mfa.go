@@ -0,0 +1,204 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package accounts
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1" //nolint:gosec
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	// totpPeriod is the validity period of a TOTP code.
+	totpPeriod = 30 * time.Second
+	// totpDigits is the number of digits of a TOTP code.
+	totpDigits = 6
+	// totpModulo is 10^totpDigits.
+	totpModulo = 1000000
+	// totpSkew is the number of periods before and after the current one whose codes are accepted,
+	// to tolerate clock drift between the server and the authenticator.
+	totpSkew = 1
+	// totpSecretSize is the size (bytes) of a TOTP secret.
+	totpSecretSize = 20
+	// totpIssuer is the issuer shown in the authenticator apps.
+	totpIssuer = "Everest"
+
+	// recoveryCodesCount is the number of recovery codes generated on enrollment.
+	recoveryCodesCount = 10
+	// recoveryCodeSize is the size (bytes) of the random part of a recovery code.
+	recoveryCodeSize = 5
+)
+
+var (
+	// ErrMFARequired is returned when the account is enrolled in MFA and no code was provided.
+	ErrMFARequired = errors.New("MFA code required")
+	// ErrInvalidMFACode is returned when the provided MFA code is not valid.
+	ErrInvalidMFACode = errors.New("invalid MFA code")
+	// ErrMFAAlreadyEnrolled is returned when enrolling an account that is already enrolled in MFA.
+	ErrMFAAlreadyEnrolled = errors.New("account is already enrolled in MFA")
+
+	base32NoPadding = base32.StdEncoding.WithPadding(base32.NoPadding) //nolint:gochecknoglobals
+)
+
+// MFAEnrollment holds the data an account owner needs to set up TOTP multi-factor authentication.
+// It is returned only once, on enrollment.
+type MFAEnrollment struct {
+	// Secret is the base32 encoded TOTP secret.
+	Secret string
+	// URI is the otpauth URI of the secret, usually rendered as a QR code.
+	URI string
+	// RecoveryCodes are the single-use codes that can be used instead of a TOTP code.
+	RecoveryCodes []string
+}
+
+// MFAEnabled returns true if the account is enrolled in TOTP multi-factor authentication.
+func (a Account) MFAEnabled() bool {
+	return a.TOTPSecret != ""
+}
+
+// EnrollMFA generates a new TOTP secret and recovery codes for the account.
+func (a *Account) EnrollMFA(username string) (*MFAEnrollment, error) {
+	if a.MFAEnabled() {
+		return nil, ErrMFAAlreadyEnrolled
+	}
+	secret := make([]byte, totpSecretSize)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, err
+	}
+	enrollment := &MFAEnrollment{
+		Secret:        base32NoPadding.EncodeToString(secret),
+		RecoveryCodes: make([]string, 0, recoveryCodesCount),
+	}
+	enrollment.URI = totpURI(username, enrollment.Secret)
+
+	hashes := make([]string, 0, recoveryCodesCount)
+	for range recoveryCodesCount {
+		code, err := newRecoveryCode()
+		if err != nil {
+			return nil, err
+		}
+		enrollment.RecoveryCodes = append(enrollment.RecoveryCodes, code)
+		hashes = append(hashes, hashRecoveryCode(code))
+	}
+
+	a.TOTPSecret = enrollment.Secret
+	a.TOTPLastCounter = 0
+	a.RecoveryCodes = hashes
+	return enrollment, nil
+}
+
+// ResetMFA removes the TOTP secret and the recovery codes of the account.
+func (a *Account) ResetMFA() {
+	a.TOTPSecret = ""
+	a.TOTPLastCounter = 0
+	a.RecoveryCodes = nil
+}
+
+// VerifyMFACode verifies a TOTP code or a recovery code of the account at the given time.
+// A TOTP code is accepted only once, and a recovery code is removed once used.
+func (a *Account) VerifyMFACode(code string, now time.Time) error {
+	if !a.MFAEnabled() {
+		return nil
+	}
+	code = strings.TrimSpace(code)
+	if code == "" {
+		return ErrMFARequired
+	}
+
+	if len(code) == totpDigits {
+		secret, err := base32NoPadding.DecodeString(a.TOTPSecret)
+		if err != nil {
+			return errors.Join(err, errors.New("failed to decode TOTP secret"))
+		}
+		current := now.Unix() / int64(totpPeriod.Seconds())
+		for c := current - totpSkew; c <= current+totpSkew; c++ {
+			// Codes of the already used periods are rejected to prevent replays.
+			if c <= a.TOTPLastCounter {
+				continue
+			}
+			if subtle.ConstantTimeCompare([]byte(totpCode(secret, uint64(c))), []byte(code)) == 1 { //nolint:gosec
+				a.TOTPLastCounter = c
+				return nil
+			}
+		}
+		return ErrInvalidMFACode
+	}
+
+	hash := hashRecoveryCode(code)
+	idx := slices.IndexFunc(a.RecoveryCodes, func(h string) bool {
+		return subtle.ConstantTimeCompare([]byte(h), []byte(hash)) == 1
+	})
+	if idx < 0 {
+		return ErrInvalidMFACode
+	}
+	a.RecoveryCodes = slices.Delete(slices.Clone(a.RecoveryCodes), idx, idx+1)
+	return nil
+}
+
+// totpCode returns the TOTP code of the given counter as defined in RFC 6238.
+func totpCode(secret []byte, counter uint64) string {
+	buf := make([]byte, 8) //nolint:mnd
+	binary.BigEndian.PutUint64(buf, counter)
+	mac := hmac.New(sha1.New, secret)
+	mac.Write(buf)
+	sum := mac.Sum(nil)
+	// Dynamic truncation: the low 4 bits of the last byte select the offset of the 31-bit value.
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	return fmt.Sprintf("%0*d", totpDigits, value%totpModulo)
+}
+
+func totpURI(username, secret string) string {
+	params := url.Values{}
+	params.Set("secret", secret)
+	params.Set("issuer", totpIssuer)
+	params.Set("algorithm", "SHA1")
+	params.Set("digits", fmt.Sprint(totpDigits))
+	params.Set("period", fmt.Sprint(int(totpPeriod.Seconds())))
+	u := url.URL{
+		Scheme:   "otpauth",
+		Host:     "totp",
+		Path:     "/" + totpIssuer + ":" + username,
+		RawQuery: params.Encode(),
+	}
+	return u.String()
+}
+
+// newRecoveryCode returns a random recovery code in the form xxxx-xxxx.
+func newRecoveryCode() (string, error) {
+	b := make([]byte, recoveryCodeSize)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	code := strings.ToLower(base32NoPadding.EncodeToString(b))
+	return code[:len(code)/2] + "-" + code[len(code)/2:], nil
+}
+
+func hashRecoveryCode(code string) string {
+	sum := sha256.Sum256([]byte(strings.ToLower(strings.TrimSpace(code))))
+	return hex.EncodeToString(sum[:])
+}
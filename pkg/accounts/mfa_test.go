@@ -0,0 +1,81 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package accounts
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTOTPCode(t *testing.T) {
+	t.Parallel()
+
+	// Test vectors of RFC 6238 (SHA1), truncated to 6 digits.
+	secret := []byte("12345678901234567890")
+	testCases := []struct {
+		time int64
+		code string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+	}
+	for _, tc := range testCases {
+		assert.Equal(t, tc.code, totpCode(secret, uint64(tc.time/30))) //nolint:gosec
+	}
+}
+
+func TestVerifyMFACode(t *testing.T) {
+	t.Parallel()
+
+	account := &Account{}
+	require.NoError(t, account.VerifyMFACode("", time.Now()))
+
+	enrollment, err := account.EnrollMFA("user1")
+	require.NoError(t, err)
+	assert.True(t, account.MFAEnabled())
+	assert.True(t, strings.HasPrefix(enrollment.URI, "otpauth://totp/Everest:user1?"))
+	assert.Len(t, enrollment.RecoveryCodes, recoveryCodesCount)
+
+	secret, err := base32NoPadding.DecodeString(enrollment.Secret)
+	require.NoError(t, err)
+	now := time.Now()
+	counter := uint64(now.Unix() / 30) //nolint:gosec
+
+	require.ErrorIs(t, account.VerifyMFACode("", now), ErrMFARequired)
+	require.ErrorIs(t, account.VerifyMFACode("000000x", now), ErrInvalidMFACode)
+	// The code of the previous period is accepted to tolerate clock drift.
+	require.NoError(t, account.VerifyMFACode(totpCode(secret, counter-1), now))
+	require.NoError(t, account.VerifyMFACode(totpCode(secret, counter), now))
+	// Codes cannot be replayed.
+	require.ErrorIs(t, account.VerifyMFACode(totpCode(secret, counter), now), ErrInvalidMFACode)
+	require.ErrorIs(t, account.VerifyMFACode(totpCode(secret, counter-1), now), ErrInvalidMFACode)
+
+	// Recovery codes are single use and case insensitive.
+	require.NoError(t, account.VerifyMFACode(strings.ToUpper(enrollment.RecoveryCodes[1]), now))
+	require.ErrorIs(t, account.VerifyMFACode(enrollment.RecoveryCodes[1], now), ErrInvalidMFACode)
+	assert.Len(t, account.RecoveryCodes, recoveryCodesCount-1)
+
+	account.ResetMFA()
+	assert.False(t, account.MFAEnabled())
+	require.NoError(t, account.VerifyMFACode("", now))
+}
@@ -0,0 +1,129 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package accounts
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+)
+
+const (
+	// argon2idPrefix is the prefix of the password hashes computed with argon2id.
+	// The hashes use the PHC string format: $argon2id$v=19$m=<memory>,t=<time>,p=<threads>$<salt>$<key>.
+	// Hashes without a known prefix are legacy PBKDF2-SHA256 hashes salted with the UID of the system namespace.
+	argon2idPrefix = "$argon2id$"
+	// passwordSaltSize is the size (bytes) of the random per-user salt.
+	passwordSaltSize = 16
+)
+
+// argon2idParams are the cost parameters of argon2id.
+type argon2idParams struct {
+	time    uint32
+	memory  uint32 // KiB
+	threads uint8
+	keyLen  uint32
+}
+
+// defaultArgon2idParams follow the minimum configuration recommended by OWASP.
+// The memory cost is kept low, since a hash is computed on every login and password change,
+// so that concurrent logins do not exhaust the memory of the server.
+// The hashes computed with other parameters are replaced on the next successful login.
+var defaultArgon2idParams = argon2idParams{ //nolint:gochecknoglobals
+	time:    2,
+	memory:  19 * 1024,
+	threads: 1,
+	keyLen:  32,
+}
+
+var (
+	errMalformedPasswordHash = errors.New("malformed password hash")
+
+	base64NoPadding = base64.RawStdEncoding //nolint:gochecknoglobals
+)
+
+// HashPassword returns the argon2id hash of the password with a random salt.
+func HashPassword(password string) (string, error) {
+	salt := make([]byte, passwordSaltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return "", errors.Join(err, errors.New("failed to generate salt"))
+	}
+	return encodeArgon2id(defaultArgon2idParams, salt, password), nil
+}
+
+// IsLegacyPasswordHash returns true if the hash was not computed by HashPassword,
+// that is it is a PBKDF2-SHA256 hash with the shared salt.
+func IsLegacyPasswordHash(hash string) bool {
+	return !strings.HasPrefix(hash, argon2idPrefix)
+}
+
+// PasswordHashNeedsRehash returns true if the hash is a legacy one or was computed with outdated cost parameters.
+func PasswordHashNeedsRehash(hash string) bool {
+	if IsLegacyPasswordHash(hash) {
+		return true
+	}
+	params, _, _, err := decodeArgon2id(hash)
+	return err != nil || params != defaultArgon2idParams
+}
+
+// VerifyPasswordHash returns true if the password matches the hash computed by HashPassword.
+// Legacy hashes must be verified by the caller, since they depend on the shared salt.
+func VerifyPasswordHash(hash, password string) (bool, error) {
+	params, salt, key, err := decodeArgon2id(hash)
+	if err != nil {
+		return false, err
+	}
+	computed := argon2.IDKey([]byte(password), salt, params.time, params.memory, params.threads, params.keyLen)
+	return subtle.ConstantTimeCompare(key, computed) == 1, nil
+}
+
+func encodeArgon2id(p argon2idParams, salt []byte, password string) string {
+	key := argon2.IDKey([]byte(password), salt, p.time, p.memory, p.threads, p.keyLen)
+	return fmt.Sprintf("%sv=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2idPrefix, argon2.Version, p.memory, p.time, p.threads,
+		base64NoPadding.EncodeToString(salt), base64NoPadding.EncodeToString(key))
+}
+
+func decodeArgon2id(hash string) (argon2idParams, []byte, []byte, error) {
+	// The leading '$' yields an empty first part.
+	parts := strings.Split(hash, "$")
+	if len(parts) != 6 || parts[1] != strings.Trim(argon2idPrefix, "$") {
+		return argon2idParams{}, nil, nil, errMalformedPasswordHash
+	}
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return argon2idParams{}, nil, nil, errors.Join(errMalformedPasswordHash, fmt.Errorf("unsupported argon2 version '%s'", parts[2]))
+	}
+	var p argon2idParams
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &p.memory, &p.time, &p.threads); err != nil {
+		return argon2idParams{}, nil, nil, errors.Join(errMalformedPasswordHash, err)
+	}
+	salt, err := base64NoPadding.DecodeString(parts[4])
+	if err != nil {
+		return argon2idParams{}, nil, nil, errors.Join(errMalformedPasswordHash, err)
+	}
+	key, err := base64NoPadding.DecodeString(parts[5])
+	if err != nil {
+		return argon2idParams{}, nil, nil, errors.Join(errMalformedPasswordHash, err)
+	}
+	p.keyLen = uint32(len(key)) //nolint:gosec
+	return p, salt, key, nil
+}
@@ -0,0 +1,83 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package accounts
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHashPassword(t *testing.T) {
+	t.Parallel()
+
+	hash1, err := HashPassword("password")
+	require.NoError(t, err)
+	hash2, err := HashPassword("password")
+	require.NoError(t, err)
+	// Every hash has its own salt.
+	assert.NotEqual(t, hash1, hash2)
+	assert.False(t, IsLegacyPasswordHash(hash1))
+	assert.False(t, PasswordHashNeedsRehash(hash1))
+
+	ok, err := VerifyPasswordHash(hash1, "password")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	ok, err = VerifyPasswordHash(hash2, "password")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	ok, err = VerifyPasswordHash(hash1, "wrong")
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestPasswordHashNeedsRehash(t *testing.T) {
+	t.Parallel()
+
+	for _, params := range []argon2idParams{
+		{time: 1, memory: 1024, threads: 1, keyLen: 16},
+		// The previous default parameters.
+		{time: 3, memory: 64 * 1024, threads: 4, keyLen: 32},
+	} {
+		outdated := encodeArgon2id(params, []byte("0123456789abcdef"), "password")
+		assert.True(t, PasswordHashNeedsRehash(outdated))
+		// The hashes with outdated parameters are still verified with their own parameters.
+		ok, err := VerifyPasswordHash(outdated, "password")
+		require.NoError(t, err)
+		assert.True(t, ok)
+	}
+
+	legacy := string([]byte{0x8f, 0x01, 0x24, 0x7a})
+	assert.True(t, IsLegacyPasswordHash(legacy))
+	assert.True(t, PasswordHashNeedsRehash(legacy))
+}
+
+func TestVerifyPasswordHashMalformed(t *testing.T) {
+	t.Parallel()
+
+	for _, hash := range []string{
+		"",
+		"$argon2id$",
+		"$argon2id$v=16$m=65536,t=3,p=4$c2FsdA$a2V5",
+		"$argon2id$v=19$m=x,t=3,p=4$c2FsdA$a2V5",
+		"$argon2id$v=19$m=65536,t=3,p=4$!!!$a2V5",
+		"$argon2i$v=19$m=65536,t=3,p=4$c2FsdA$a2V5",
+	} {
+		_, err := VerifyPasswordHash(hash, "password")
+		require.ErrorIs(t, err, errMalformedPasswordHash, hash)
+	}
+}
@@ -0,0 +1,145 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package accounts
+
+import (
+	_ "embed"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+)
+
+var (
+	// ErrPasswordPolicy is returned when a password does not satisfy the password policy.
+	ErrPasswordPolicy = errors.New("password does not satisfy the password policy")
+	// ErrPasswordReused is returned when a password has been used recently by the account.
+	ErrPasswordReused = errors.New("password has been used recently")
+	// ErrPasswordExpired is returned when the password of the account is older than allowed by the password policy.
+	ErrPasswordExpired = errors.New("password expired")
+
+	//go:embed breached_passwords.txt
+	breachedPasswordsFile string
+	breachedPasswords     = sync.OnceValue(func() map[string]struct{} { //nolint:gochecknoglobals
+		result := make(map[string]struct{})
+		for _, line := range strings.Split(breachedPasswordsFile, "\n") {
+			line = strings.TrimSpace(line)
+			if line == "" || strings.HasPrefix(line, "#") {
+				continue
+			}
+			result[strings.ToLower(line)] = struct{}{}
+		}
+		return result
+	})
+)
+
+// PasswordPolicy defines the requirements for the passwords of the built-in accounts.
+// The zero value does not impose any requirements.
+type PasswordPolicy struct {
+	// MinLength is the minimum number of characters of a password.
+	MinLength int `yaml:"minLength,omitempty"`
+	// RequireUppercase requires at least one uppercase letter.
+	RequireUppercase bool `yaml:"requireUppercase,omitempty"`
+	// RequireLowercase requires at least one lowercase letter.
+	RequireLowercase bool `yaml:"requireLowercase,omitempty"`
+	// RequireDigit requires at least one digit.
+	RequireDigit bool `yaml:"requireDigit,omitempty"`
+	// RequireSpecial requires at least one character that is neither a letter nor a digit.
+	RequireSpecial bool `yaml:"requireSpecial,omitempty"`
+	// RejectBreached rejects the passwords that are known from public breaches.
+	RejectBreached bool `yaml:"rejectBreached,omitempty"`
+	// HistorySize is the number of previous passwords of an account that cannot be reused.
+	HistorySize int `yaml:"historySize,omitempty"`
+	// MaxAgeDays is the number of days after which a password must be changed at login.
+	// Passwords never expire if it is 0.
+	MaxAgeDays int `yaml:"maxAgeDays,omitempty"`
+}
+
+// Validate checks that the password policy is valid.
+func (p PasswordPolicy) Validate() error {
+	if p.MinLength < 0 {
+		return errors.New("minimum password length cannot be negative")
+	}
+	if p.HistorySize < 0 {
+		return errors.New("password history size cannot be negative")
+	}
+	if p.MaxAgeDays < 0 {
+		return errors.New("maximum password age cannot be negative")
+	}
+	return nil
+}
+
+// Check returns an error describing all the requirements of the policy the password does not satisfy.
+func (p PasswordPolicy) Check(password string) error {
+	var upper, lower, digit, special bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsDigit(r):
+			digit = true
+		default:
+			special = true
+		}
+	}
+
+	var violations []string
+	if n := len([]rune(password)); n < p.MinLength {
+		violations = append(violations, fmt.Sprintf("must be at least %d characters long", p.MinLength))
+	}
+	if p.RequireUppercase && !upper {
+		violations = append(violations, "must contain an uppercase letter")
+	}
+	if p.RequireLowercase && !lower {
+		violations = append(violations, "must contain a lowercase letter")
+	}
+	if p.RequireDigit && !digit {
+		violations = append(violations, "must contain a digit")
+	}
+	if p.RequireSpecial && !special {
+		violations = append(violations, "must contain a special character")
+	}
+	if p.RejectBreached && IsBreachedPassword(password) {
+		violations = append(violations, "must not be a commonly used password")
+	}
+	if len(violations) > 0 {
+		return fmt.Errorf("%w: password %s", ErrPasswordPolicy, strings.Join(violations, ", "))
+	}
+	return nil
+}
+
+// IsPasswordExpired returns true if the password of the account is older than allowed by the policy at the given time.
+// Accounts without a known password modification time never expire.
+func (p PasswordPolicy) IsPasswordExpired(a Account, now time.Time) bool {
+	if p.MaxAgeDays == 0 || a.PasswordMtime == "" {
+		return false
+	}
+	mtime, err := time.Parse(time.RFC3339, a.PasswordMtime)
+	if err != nil {
+		return false
+	}
+	return now.Sub(mtime) > time.Duration(p.MaxAgeDays)*24*time.Hour
+}
+
+// IsBreachedPassword returns true if the password is in the local list of passwords known from public breaches.
+func IsBreachedPassword(password string) bool {
+	_, found := breachedPasswords()[strings.ToLower(password)]
+	return found
+}
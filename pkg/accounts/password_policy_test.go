@@ -0,0 +1,110 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package accounts
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPasswordPolicyCheck(t *testing.T) {
+	t.Parallel()
+
+	strict := PasswordPolicy{
+		MinLength:        10,
+		RequireUppercase: true,
+		RequireLowercase: true,
+		RequireDigit:     true,
+		RequireSpecial:   true,
+		RejectBreached:   true,
+	}
+	testCases := []struct {
+		name       string
+		policy     PasswordPolicy
+		password   string
+		violations []string
+	}{
+		{
+			name:     "empty policy",
+			password: "a",
+		},
+		{
+			name:     "strict policy satisfied",
+			policy:   strict,
+			password: "Corr3ct-Horse",
+		},
+		{
+			name:       "too short",
+			policy:     PasswordPolicy{MinLength: 10},
+			password:   "Sh0rt!",
+			violations: []string{"at least 10 characters"},
+		},
+		{
+			name:       "missing character classes",
+			policy:     strict,
+			password:   "alllowercaseletters",
+			violations: []string{"uppercase letter", "digit", "special character"},
+		},
+		{
+			name:       "breached",
+			policy:     PasswordPolicy{RejectBreached: true},
+			password:   "Password123",
+			violations: []string{"commonly used password"},
+		},
+		{
+			name:     "breached check disabled",
+			password: "password123",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := tc.policy.Check(tc.password)
+			if len(tc.violations) == 0 {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, ErrPasswordPolicy)
+			for _, v := range tc.violations {
+				assert.Contains(t, err.Error(), v)
+			}
+		})
+	}
+}
+
+func TestPasswordPolicyIsPasswordExpired(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	account := Account{PasswordMtime: now.Add(-31 * 24 * time.Hour).Format(time.RFC3339)}
+
+	assert.False(t, PasswordPolicy{}.IsPasswordExpired(account, now))
+	assert.True(t, PasswordPolicy{MaxAgeDays: 30}.IsPasswordExpired(account, now))
+	assert.False(t, PasswordPolicy{MaxAgeDays: 60}.IsPasswordExpired(account, now))
+	assert.False(t, PasswordPolicy{MaxAgeDays: 30}.IsPasswordExpired(Account{}, now))
+}
+
+func TestPasswordPolicyValidate(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, PasswordPolicy{MinLength: 8, HistorySize: 3, MaxAgeDays: 90}.Validate())
+	require.Error(t, PasswordPolicy{MinLength: -1}.Validate())
+	require.Error(t, PasswordPolicy{HistorySize: -1}.Validate())
+	require.Error(t, PasswordPolicy{MaxAgeDays: -1}.Validate())
+}
@@ -18,6 +18,7 @@ package accounts
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -57,6 +58,140 @@ func Tests(t *testing.T, p Interface) {
 	err = p.Verify(ctx, "user1", "updated-password1")
 	require.NoError(t, err)
 
+	// The password policy applies to the new passwords.
+	policy, err := p.GetPasswordPolicy(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, &PasswordPolicy{}, policy)
+	err = p.SetPasswordPolicy(ctx, PasswordPolicy{MinLength: 8, RejectBreached: true, HistorySize: 2})
+	require.NoError(t, err)
+	err = p.SetPassword(ctx, "user1", "short", true)
+	require.ErrorIs(t, err, ErrPasswordPolicy)
+	err = p.SetPassword(ctx, "user1", "password123", true)
+	require.ErrorIs(t, err, ErrPasswordPolicy)
+	err = p.Create(ctx, "user2", "qwerty")
+	require.ErrorIs(t, err, ErrPasswordPolicy)
+	// The recent passwords cannot be reused.
+	err = p.SetPassword(ctx, "user1", "updated-password1", true)
+	require.ErrorIs(t, err, ErrPasswordReused)
+	err = p.SetPassword(ctx, "user1", "updated-password2", true)
+	require.NoError(t, err)
+	err = p.SetPassword(ctx, "user1", "updated-password3", true)
+	require.NoError(t, err)
+	err = p.SetPassword(ctx, "user1", "updated-password1", true)
+	require.ErrorIs(t, err, ErrPasswordReused)
+	err = p.SetPassword(ctx, "user1", "updated-password4", true)
+	require.NoError(t, err)
+	// Only the last 2 previous passwords are remembered.
+	err = p.SetPassword(ctx, "user1", "updated-password1", true)
+	require.NoError(t, err)
+	err = p.SetPasswordPolicy(ctx, PasswordPolicy{})
+	require.NoError(t, err)
+
+	// API keys require no password, so they are kept when the password changes.
+	err = p.SetCapabilities(ctx, "user1", []AccountCapability{AccountCapabilityLogin, AccountCapabilityAPIKey})
+	require.NoError(t, err)
+	key := APIKey{Name: "ci", ID: "key-1", CreatedAt: time.Now().UTC().Truncate(time.Second)}
+	err = p.CreateAPIKey(ctx, "user1", key)
+	require.NoError(t, err)
+	err = p.CreateAPIKey(ctx, "user1", APIKey{Name: "ci", ID: "key-2"})
+	require.ErrorIs(t, err, ErrAPIKeyAlreadyExists)
+	lastUsed := time.Now().UTC().Truncate(time.Second)
+	err = p.SetAPIKeyLastUsed(ctx, "user1", "key-1", lastUsed)
+	require.NoError(t, err)
+	user1, err = p.Get(ctx, "user1")
+	require.NoError(t, err)
+	assert.True(t, user1.HasCapability(AccountCapabilityAPIKey))
+	require.NotNil(t, user1.APIKey("key-1"))
+	assert.True(t, lastUsed.Equal(*user1.APIKey("key-1").LastUsedAt))
+	// Updating API keys keeps the password secure.
+	secure, err := p.IsSecure(ctx, "user1")
+	require.NoError(t, err)
+	assert.True(t, secure)
+	deleted, err := p.DeleteAPIKey(ctx, "user1", "ci")
+	require.NoError(t, err)
+	assert.Equal(t, "key-1", deleted.ID)
+	_, err = p.DeleteAPIKey(ctx, "user1", "ci")
+	require.ErrorIs(t, err, ErrAPIKeyNotFound)
+
+	// user1 is locked after the threshold of failed logins is reached.
+	lockoutPolicy := LockoutPolicy{Threshold: 2, Window: time.Minute, Duration: time.Hour}
+	locked, err := p.RecordFailedLogin(ctx, "user1", lockoutPolicy)
+	require.NoError(t, err)
+	assert.False(t, locked)
+	locked, err = p.RecordFailedLogin(ctx, "user1", lockoutPolicy)
+	require.NoError(t, err)
+	assert.True(t, locked)
+	user1, err = p.Get(ctx, "user1")
+	require.NoError(t, err)
+	assert.True(t, user1.IsLocked(time.Now()))
+	assert.False(t, user1.IsLocked(time.Now().Add(2*time.Hour)))
+	err = p.Unlock(ctx, "user1")
+	require.NoError(t, err)
+	user1, err = p.Get(ctx, "user1")
+	require.NoError(t, err)
+	assert.False(t, user1.IsLocked(time.Now()))
+	assert.Nil(t, user1.Lockout)
+
+	// Without MFA any code is accepted.
+	require.NoError(t, p.VerifyMFA(ctx, "user1", ""))
+	enrollment, err := p.EnrollMFA(ctx, "user1")
+	require.NoError(t, err)
+	assert.NotEmpty(t, enrollment.Secret)
+	_, err = p.EnrollMFA(ctx, "user1")
+	require.ErrorIs(t, err, ErrMFAAlreadyEnrolled)
+	require.ErrorIs(t, p.VerifyMFA(ctx, "user1", ""), ErrMFARequired)
+	// A recovery code can be used only once.
+	require.NoError(t, p.VerifyMFA(ctx, "user1", enrollment.RecoveryCodes[0]))
+	require.ErrorIs(t, p.VerifyMFA(ctx, "user1", enrollment.RecoveryCodes[0]), ErrInvalidMFACode)
+	err = p.ResetMFA(ctx, "user1")
+	require.NoError(t, err)
+	user1, err = p.Get(ctx, "user1")
+	require.NoError(t, err)
+	assert.False(t, user1.MFAEnabled())
+
+	// Disable and enable user1.
+	require.NoError(t, p.SetEnabled(ctx, "user1", false))
+	user1, err = p.Get(ctx, "user1")
+	require.NoError(t, err)
+	assert.False(t, user1.Enabled)
+	require.NoError(t, p.SetEnabled(ctx, "user1", true))
+	user1, err = p.Get(ctx, "user1")
+	require.NoError(t, err)
+	assert.True(t, user1.Enabled)
+	require.ErrorIs(t, p.SetEnabled(ctx, "unknown", true), ErrAccountNotFound)
+
+	// Sessions are registered, replaced on refresh and removed on revocation.
+	now := time.Now().UTC().Truncate(time.Second)
+	expired := Session{ID: "s-0", LoginAt: now.Add(-2 * time.Hour), IssuedAt: now.Add(-2 * time.Hour), ExpiresAt: now.Add(-time.Hour)}
+	require.NoError(t, p.AddSession(ctx, "user1", expired))
+	s1 := Session{ID: "s-1", IP: "10.0.0.1", LoginAt: now, IssuedAt: now, ExpiresAt: now.Add(time.Hour)}
+	require.NoError(t, p.AddSession(ctx, "user1", s1))
+	require.NoError(t, p.AddSession(ctx, "user1", Session{ID: "s-2", LoginAt: now, IssuedAt: now, ExpiresAt: now.Add(time.Hour)}))
+	user1, err = p.Get(ctx, "user1")
+	require.NoError(t, err)
+	// Expired sessions are removed when a new session is added.
+	assert.Len(t, user1.Sessions, 2)
+	assert.Nil(t, user1.Session("s-0"))
+	refreshed := s1
+	refreshed.ID = "s-3"
+	require.NoError(t, p.ReplaceSession(ctx, "user1", "s-1", refreshed))
+	require.ErrorIs(t, p.ReplaceSession(ctx, "user1", "s-1", refreshed), ErrSessionNotFound)
+	deletedSessions, err := p.DeleteSessions(ctx, "user1", "s-3")
+	require.NoError(t, err)
+	require.Len(t, deletedSessions, 1)
+	assert.Equal(t, "10.0.0.1", deletedSessions[0].IP)
+	_, err = p.DeleteSessions(ctx, "user1", "s-3")
+	require.ErrorIs(t, err, ErrSessionNotFound)
+	// The sessions that no longer exist are skipped.
+	deletedSessions, err = p.DeleteSessions(ctx, "user1", "s-3", "s-2")
+	require.NoError(t, err)
+	require.Len(t, deletedSessions, 1)
+	assert.Equal(t, "s-2", deletedSessions[0].ID)
+	require.NoError(t, p.AddSession(ctx, "user1", s1))
+	deletedSessions, err = p.DeleteSessions(ctx, "user1")
+	require.NoError(t, err)
+	assert.Len(t, deletedSessions, 1)
+
 	// Delete user1.
 	err = p.Delete(ctx, "user1")
 	require.NoError(t, err)
@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"slices"
+	"time"
 )
 
 // AccountCapability represents a capability of an account.
@@ -36,6 +37,14 @@ var (
 	ErrAccountDisabled = errors.New("account disabled")
 	// ErrUserAlreadyExists is returned when we try to create a user that already exists.
 	ErrUserAlreadyExists = errors.New("user already exists")
+	// ErrAccountLocked is returned when the account is locked after too many failed logins.
+	ErrAccountLocked = errors.New("account locked")
+	// ErrAPIKeyNotFound is returned when an API key is not found.
+	ErrAPIKeyNotFound = errors.New("API key not found")
+	// ErrAPIKeyAlreadyExists is returned when we try to create an API key with a name that is already used by the account.
+	ErrAPIKeyAlreadyExists = errors.New("API key already exists")
+	// ErrSessionNotFound is returned when a session is not found.
+	ErrSessionNotFound = errors.New("session not found")
 )
 
 const (
@@ -45,12 +54,145 @@ const (
 	AccountCapabilityAPIKey AccountCapability = "apiKey"
 )
 
+// Capabilities is the list of all the capabilities an account can have.
+//
+//nolint:gochecknoglobals
+var Capabilities = []AccountCapability{AccountCapabilityLogin, AccountCapabilityAPIKey}
+
 // Account is an internal representation of an Everest user account.
 type Account struct {
-	Enabled       bool                `yaml:"enabled"`
-	Capabilities  []AccountCapability `yaml:"capabilities"`
-	PasswordMtime string              `yaml:"passwordMtime"`
-	PasswordHash  string              `yaml:"passwordHash"`
+	Enabled         bool                `yaml:"enabled"`
+	Capabilities    []AccountCapability `yaml:"capabilities"`
+	PasswordMtime   string              `yaml:"passwordMtime"`
+	PasswordHash    string              `yaml:"passwordHash"`
+	PasswordHistory []string            `yaml:"passwordHistory,omitempty"`
+	APIKeys         []APIKey            `yaml:"apiKeys,omitempty"`
+	Sessions        []Session           `yaml:"sessions,omitempty"`
+	Lockout         *Lockout            `yaml:"lockout,omitempty"`
+	TOTPSecret      string              `yaml:"totpSecret,omitempty"`
+	TOTPLastCounter int64               `yaml:"totpLastCounter,omitempty"`
+	RecoveryCodes   []string            `yaml:"recoveryCodes,omitempty"`
+}
+
+// Lockout tracks the failed logins of an account.
+type Lockout struct {
+	// FailedLogins holds the times of the recent failed logins.
+	FailedLogins []time.Time `yaml:"failedLogins,omitempty"`
+	// LockedUntil is the time the account is unlocked at. Nil if the account is not locked.
+	LockedUntil *time.Time `yaml:"lockedUntil,omitempty"`
+}
+
+// LockoutPolicy defines when accounts are locked after failed logins.
+type LockoutPolicy struct {
+	// Threshold is the number of failed logins within Window that locks the account.
+	// Accounts are never locked if it is 0.
+	Threshold int
+	// Window is the period of time in which the failed logins are counted.
+	Window time.Duration
+	// Duration is the period of time the account stays locked for.
+	Duration time.Duration
+}
+
+// IsLocked returns true if the account is locked at the given time.
+func (a Account) IsLocked(now time.Time) bool {
+	return a.Lockout != nil && a.Lockout.LockedUntil != nil && now.Before(*a.Lockout.LockedUntil)
+}
+
+// RecordFailedLogin records a failed login at the given time and locks the account
+// if the number of failed logins within the window of the policy reaches the threshold.
+// It returns true if the account has been locked.
+func (a *Account) RecordFailedLogin(now time.Time, policy LockoutPolicy) bool {
+	if policy.Threshold <= 0 {
+		return false
+	}
+	if a.Lockout == nil {
+		a.Lockout = &Lockout{}
+	}
+	// Only keep the failures that are still within the window.
+	failures := make([]time.Time, 0, len(a.Lockout.FailedLogins)+1)
+	for _, t := range a.Lockout.FailedLogins {
+		if now.Sub(t) < policy.Window {
+			failures = append(failures, t)
+		}
+	}
+	a.Lockout.FailedLogins = append(failures, now)
+	if len(a.Lockout.FailedLogins) < policy.Threshold {
+		return false
+	}
+	lockedUntil := now.Add(policy.Duration)
+	a.Lockout.LockedUntil = &lockedUntil
+	a.Lockout.FailedLogins = nil
+	return true
+}
+
+// APIKey describes a long-lived token issued to an account for programmatic access.
+// The token itself is never stored, only the metadata needed to track and revoke it.
+type APIKey struct {
+	// Name is the unique name of the key within the account.
+	Name string `yaml:"name"`
+	// ID is the unique identifier of the token, stored in its "jti" claim.
+	ID string `yaml:"id"`
+	// SigningKeyID is the ID of the key the token is signed with, stored in its "kid" header.
+	// The signing key is kept as long as the API key is valid. Empty for the keys issued before it was recorded.
+	SigningKeyID string `yaml:"signingKeyId,omitempty"`
+	// CreatedAt is the time the key was issued.
+	CreatedAt time.Time `yaml:"createdAt"`
+	// ExpiresAt is the time the key expires. Nil if the key never expires.
+	ExpiresAt *time.Time `yaml:"expiresAt,omitempty"`
+	// LastUsedAt is the approximate time the key was last used. Nil if the key was never used.
+	LastUsedAt *time.Time `yaml:"lastUsedAt,omitempty"`
+}
+
+// IsExpired returns true if the API key has expired at the given time.
+func (k APIKey) IsExpired(now time.Time) bool {
+	return k.ExpiresAt != nil && !now.Before(*k.ExpiresAt)
+}
+
+// APIKey returns the API key of the account with the given ID, or nil if there is no such key.
+func (a Account) APIKey(id string) *APIKey {
+	for i := range a.APIKeys {
+		if a.APIKeys[i].ID == id {
+			return &a.APIKeys[i]
+		}
+	}
+	return nil
+}
+
+// Session describes a login session of an account, i.e. a session token issued on login or on refresh.
+type Session struct {
+	// ID is the unique identifier of the current token of the session, stored in its "jti" claim.
+	ID string `yaml:"id"`
+	// IP is the IP address the session was started from.
+	IP string `yaml:"ip,omitempty"`
+	// UserAgent is the user agent the session was started with.
+	UserAgent string `yaml:"userAgent,omitempty"`
+	// LoginAt is the time the user logged in. It is kept when the session is refreshed.
+	LoginAt time.Time `yaml:"loginAt"`
+	// IssuedAt is the time the current token of the session was issued.
+	IssuedAt time.Time `yaml:"issuedAt"`
+	// ExpiresAt is the time the current token of the session expires.
+	ExpiresAt time.Time `yaml:"expiresAt"`
+}
+
+// Session returns the session of the account with the given ID, or nil if there is no such session.
+func (a Account) Session(id string) *Session {
+	for i := range a.Sessions {
+		if a.Sessions[i].ID == id {
+			return &a.Sessions[i]
+		}
+	}
+	return nil
+}
+
+// ActiveSessions returns the sessions of the account that have not expired at the given time.
+func (a Account) ActiveSessions(now time.Time) []Session {
+	active := make([]Session, 0, len(a.Sessions))
+	for _, s := range a.Sessions {
+		if now.Before(s.ExpiresAt) {
+			active = append(active, s)
+		}
+	}
+	return active
 }
 
 // HasCapability returns true if the given account has the specified capability.
@@ -67,4 +209,19 @@ type Interface interface {
 	SetPassword(ctx context.Context, username, newPassword string, secure bool) error
 	Verify(ctx context.Context, username, password string) error
 	IsSecure(ctx context.Context, username string) (bool, error)
+	SetCapabilities(ctx context.Context, username string, capabilities []AccountCapability) error
+	SetEnabled(ctx context.Context, username string, enabled bool) error
+	RecordFailedLogin(ctx context.Context, username string, policy LockoutPolicy) (bool, error)
+	Unlock(ctx context.Context, username string) error
+	GetPasswordPolicy(ctx context.Context) (*PasswordPolicy, error)
+	SetPasswordPolicy(ctx context.Context, policy PasswordPolicy) error
+	EnrollMFA(ctx context.Context, username string) (*MFAEnrollment, error)
+	ResetMFA(ctx context.Context, username string) error
+	VerifyMFA(ctx context.Context, username, code string) error
+	CreateAPIKey(ctx context.Context, username string, key APIKey) error
+	DeleteAPIKey(ctx context.Context, username, name string) (*APIKey, error)
+	SetAPIKeyLastUsed(ctx context.Context, username, id string, lastUsed time.Time) error
+	AddSession(ctx context.Context, username string, session Session) error
+	ReplaceSession(ctx context.Context, username, id string, session Session) error
+	DeleteSessions(ctx context.Context, username string, ids ...string) ([]Session, error)
 }
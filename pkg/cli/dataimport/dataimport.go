@@ -0,0 +1,199 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package dataimport provides the functionality to manage data import jobs.
+package dataimport
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/percona/everest/internal/server/handlers"
+	k8shandler "github.com/percona/everest/internal/server/handlers/k8s"
+	valhandler "github.com/percona/everest/internal/server/handlers/validation"
+	cliutils "github.com/percona/everest/pkg/cli/utils"
+	"github.com/percona/everest/pkg/output"
+	versionservice "github.com/percona/everest/pkg/version_service"
+)
+
+type (
+	// Config holds the configuration for the import-jobs subcommands.
+	Config struct {
+		// KubeconfigPath is a path to a kubeconfig
+		KubeconfigPath string
+		// If set, we will print the pretty output.
+		Pretty bool
+	}
+
+	// DataImport provides functionality for managing data import jobs.
+	DataImport struct {
+		handler handlers.Handler
+		l       *zap.SugaredLogger
+		config  Config
+	}
+
+	// CreateOptions holds options for creating a new data import job.
+	CreateOptions struct {
+		// Namespace is the namespace of the target database cluster.
+		Namespace string
+		// Name is the name of the data import job.
+		Name string
+		// TargetClusterName is the name of the database cluster to import data into.
+		TargetClusterName string
+		// DataImporterName is the name of the data importer to use.
+		DataImporterName string
+		// Path is the path to the data in the S3 bucket.
+		Path string
+		// Bucket is the name of the S3 bucket.
+		Bucket string
+		// Region is the region of the S3 bucket.
+		Region string
+		// EndpointURL is the endpoint URL of the S3 storage.
+		EndpointURL string
+		// VerifyTLS is set to ensure TLS/SSL verification.
+		VerifyTLS bool
+		// ForcePathStyle is set to use path-style URLs.
+		ForcePathStyle bool
+		// CredentialsSecretName is the name of the secret containing the S3 credentials.
+		CredentialsSecretName string
+		// AccessKeyID is the S3 access key ID.
+		// If set together with SecretAccessKey, the credentials secret is created by the operator.
+		AccessKeyID string
+		// SecretAccessKey is the S3 secret access key.
+		SecretAccessKey string
+		// Config is the data importer specific configuration in JSON format.
+		Config string
+	}
+)
+
+// NewDataImport creates a new DataImport for running import-jobs commands.
+// The requests go through the same validation as the Everest API, RBAC is not applied.
+func NewDataImport(c Config, l *zap.SugaredLogger) (*DataImport, error) {
+	cli := &DataImport{
+		l:      l.With("component", "data-import"),
+		config: c,
+	}
+	if c.Pretty {
+		cli.l = zap.NewNop().Sugar()
+	}
+
+	k, err := cliutils.NewKubeConnector(cli.l, c.KubeconfigPath)
+	if err != nil {
+		return nil, err
+	}
+
+	valh := valhandler.New(cli.l, k)
+	valh.SetNext(k8shandler.New(cli.l, k, versionservice.Config{}, nil))
+	cli.handler = valh
+	return cli, nil
+}
+
+// WithHandler sets the handler used for managing data import jobs.
+func (c *DataImport) WithHandler(h handlers.Handler) {
+	c.handler = h
+}
+
+// Create a new data import job.
+func (c *DataImport) Create(ctx context.Context, opts CreateOptions) (*everestv1alpha1.DataImportJob, error) {
+	job := &everestv1alpha1.DataImportJob{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      opts.Name,
+			Namespace: opts.Namespace,
+		},
+		Spec: everestv1alpha1.DataImportJobSpec{
+			TargetClusterName: opts.TargetClusterName,
+			DataImportJobTemplate: &everestv1alpha1.DataImportJobTemplate{
+				DataImporterName: opts.DataImporterName,
+				Source: &everestv1alpha1.DataImportJobSource{
+					Path: opts.Path,
+					S3: &everestv1alpha1.DataImportJobS3Source{
+						Bucket:                opts.Bucket,
+						Region:                opts.Region,
+						EndpointURL:           opts.EndpointURL,
+						VerifyTLS:             &opts.VerifyTLS,
+						ForcePathStyle:        &opts.ForcePathStyle,
+						CredentialsSecretName: opts.CredentialsSecretName,
+						AccessKeyID:           opts.AccessKeyID,
+						SecretAccessKey:       opts.SecretAccessKey,
+					},
+				},
+			},
+		},
+	}
+	if opts.Config != "" {
+		if !json.Valid([]byte(opts.Config)) {
+			return nil, errors.New("config is not a valid JSON object")
+		}
+		job.Spec.Config = &runtime.RawExtension{Raw: []byte(opts.Config)}
+	}
+
+	c.l.Infof("Creating data import job '%s' in namespace '%s'", opts.Name, opts.Namespace)
+	result, err := c.handler.CreateDataImportJob(ctx, job)
+	if err != nil {
+		return nil, err
+	}
+
+	c.l.Infof("Data import job '%s' has been created successfully", opts.Name)
+	if c.config.Pretty {
+		_, _ = fmt.Fprintln(os.Stdout, output.Success("Data import job '%s' has been created successfully", opts.Name))
+	}
+	return result, nil
+}
+
+// Get returns an existing data import job.
+func (c *DataImport) Get(ctx context.Context, namespace, name string) (*everestv1alpha1.DataImportJob, error) {
+	return c.handler.GetDataImportJob(ctx, namespace, name)
+}
+
+// List returns the data import jobs of the database cluster.
+func (c *DataImport) List(ctx context.Context, namespace, dbName string) (*everestv1alpha1.DataImportJobList, error) {
+	return c.handler.ListDataImportJobs(ctx, namespace, dbName)
+}
+
+// Cancel stops an in-progress data import job.
+func (c *DataImport) Cancel(ctx context.Context, namespace, name string) error {
+	c.l.Infof("Cancelling data import job '%s' in namespace '%s'", name, namespace)
+	if err := c.handler.CancelDataImportJob(ctx, namespace, name); err != nil {
+		return err
+	}
+
+	c.l.Infof("Data import job '%s' has been cancelled successfully", name)
+	if c.config.Pretty {
+		_, _ = fmt.Fprintln(os.Stdout, output.Success("Data import job '%s' has been cancelled successfully", name))
+	}
+	return nil
+}
+
+// Delete removes a finished data import job.
+func (c *DataImport) Delete(ctx context.Context, namespace, name string) error {
+	c.l.Infof("Deleting data import job '%s' in namespace '%s'", name, namespace)
+	if err := c.handler.DeleteDataImportJob(ctx, namespace, name); err != nil {
+		return err
+	}
+
+	c.l.Infof("Data import job '%s' has been deleted successfully", name)
+	if c.config.Pretty {
+		_, _ = fmt.Fprintln(os.Stdout, output.Success("Data import job '%s' has been deleted successfully", name))
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dataimport
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/percona/everest/internal/server/handlers"
+)
+
+func TestDataImport_Create(t *testing.T) {
+	t.Parallel()
+
+	opts := CreateOptions{
+		Namespace:             "ns-1",
+		Name:                  "import-1",
+		TargetClusterName:     "mysql-1",
+		DataImporterName:      "importer",
+		Path:                  "/dump",
+		Bucket:                "bucket",
+		Region:                "us-east-1",
+		EndpointURL:           "https://s3.amazonaws.com",
+		VerifyTLS:             true,
+		CredentialsSecretName: "creds",
+	}
+
+	t.Run("builds the data import job", func(t *testing.T) {
+		t.Parallel()
+
+		h := &handlers.MockHandler{}
+		h.On("CreateDataImportJob", mock.Anything, mock.Anything).
+			Return(func(_ context.Context, job *everestv1alpha1.DataImportJob) (*everestv1alpha1.DataImportJob, error) {
+				return job, nil
+			})
+		c := &DataImport{l: zap.NewNop().Sugar()}
+		c.WithHandler(h)
+
+		o := opts
+		o.Config = `{"mode":"full"}`
+		job, err := c.Create(context.Background(), o)
+		require.NoError(t, err)
+		assert.Equal(t, "ns-1", job.GetNamespace())
+		assert.Equal(t, "import-1", job.GetName())
+		assert.Equal(t, "mysql-1", job.Spec.TargetClusterName)
+		assert.Equal(t, "importer", job.Spec.DataImporterName)
+		assert.Equal(t, "/dump", job.Spec.Source.Path)
+		assert.Equal(t, "creds", job.Spec.Source.S3.CredentialsSecretName)
+		assert.True(t, *job.Spec.Source.S3.VerifyTLS)
+		assert.False(t, *job.Spec.Source.S3.ForcePathStyle)
+		assert.JSONEq(t, `{"mode":"full"}`, string(job.Spec.Config.Raw))
+	})
+
+	t.Run("invalid config", func(t *testing.T) {
+		t.Parallel()
+
+		h := &handlers.MockHandler{}
+		c := &DataImport{l: zap.NewNop().Sugar()}
+		c.WithHandler(h)
+
+		o := opts
+		o.Config = "{mode"
+		_, err := c.Create(context.Background(), o)
+		require.Error(t, err)
+		h.AssertNotCalled(t, "CreateDataImportJob", mock.Anything, mock.Anything)
+	})
+}
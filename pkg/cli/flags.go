@@ -40,6 +40,10 @@ const (
 	FlagNamespaces = "namespaces"
 	// FlagVersionMetadataURL represents the version service url flag.
 	FlagVersionMetadataURL = "version-metadata-url"
+	// FlagVersionMetadataBundle represents the offline version metadata bundle flag.
+	FlagVersionMetadataBundle = "version-metadata-bundle"
+	// FlagVersionMetadataPublicKey represents the version metadata bundle public key flag.
+	FlagVersionMetadataPublicKey = "version-metadata-public-key"
 	// FlagVersion represents the version flag.
 	FlagVersion = "version"
 	// FlagSkipWizard represents the flag to skip the installation wizard.
@@ -61,6 +65,12 @@ const (
 	FlagNamespaceForce = "force"
 	// FlagNamespaceAll is the name of the all flag.
 	FlagNamespaceAll = "all"
+	// FlagRecommendedVersionsOnly is the name of the recommended-versions-only flag.
+	FlagRecommendedVersionsOnly = "recommended-versions-only"
+	// FlagAllowedVersions is the name of the allowed-versions flag.
+	FlagAllowedVersions = "allowed-versions"
+	// FlagDeniedVersions is the name of the denied-versions flag.
+	FlagDeniedVersions = "denied-versions"
 
 	// `upgrade` flags
 
@@ -77,6 +87,63 @@ const (
 	FlagAccountsCreatePassword = "password"
 	// FlagAccountsNewPassword is the name of the new-password flag.
 	FlagAccountsNewPassword = "new-password"
+	// FlagAccountsCapabilities is the name of the capabilities flag.
+	FlagAccountsCapabilities = "capabilities"
+	// FlagAccountsAPIKeyName is the name of the API key name flag.
+	FlagAccountsAPIKeyName = "name"
+	// FlagAccountsAPIKeyExpiresIn is the name of the expires-in flag.
+	FlagAccountsAPIKeyExpiresIn = "expires-in"
+	// FlagAccountsSessionID is the name of the session ID flag.
+	FlagAccountsSessionID = "id"
+	// FlagAccountsAllSessions is the name of the all flag.
+	FlagAccountsAllSessions = "all"
+	// FlagAccountsGracePeriod is the name of the grace-period flag.
+	FlagAccountsGracePeriod = "grace-period"
+	// FlagPasswordPolicyMinLength is the name of the min-length flag.
+	FlagPasswordPolicyMinLength = "min-length"
+	// FlagPasswordPolicyRequireUppercase is the name of the require-uppercase flag.
+	FlagPasswordPolicyRequireUppercase = "require-uppercase"
+	// FlagPasswordPolicyRequireLowercase is the name of the require-lowercase flag.
+	FlagPasswordPolicyRequireLowercase = "require-lowercase"
+	// FlagPasswordPolicyRequireDigit is the name of the require-digit flag.
+	FlagPasswordPolicyRequireDigit = "require-digit"
+	// FlagPasswordPolicyRequireSpecial is the name of the require-special flag.
+	FlagPasswordPolicyRequireSpecial = "require-special"
+	// FlagPasswordPolicyRejectBreached is the name of the reject-breached flag.
+	FlagPasswordPolicyRejectBreached = "reject-breached"
+	// FlagPasswordPolicyHistorySize is the name of the history-size flag.
+	FlagPasswordPolicyHistorySize = "history-size"
+	// FlagPasswordPolicyMaxAgeDays is the name of the max-age-days flag.
+	FlagPasswordPolicyMaxAgeDays = "max-age-days"
+
+	// `import-jobs` flags
+
+	// FlagImportJobNamespace is the name of the namespace flag.
+	FlagImportJobNamespace = "namespace"
+	// FlagImportJobDBName is the name of the db-name flag.
+	FlagImportJobDBName = "db-name"
+	// FlagImportJobImporter is the name of the importer flag.
+	FlagImportJobImporter = "importer"
+	// FlagImportJobPath is the name of the path flag.
+	FlagImportJobPath = "path"
+	// FlagImportJobBucket is the name of the bucket flag.
+	FlagImportJobBucket = "bucket"
+	// FlagImportJobRegion is the name of the region flag.
+	FlagImportJobRegion = "region"
+	// FlagImportJobEndpointURL is the name of the endpoint-url flag.
+	FlagImportJobEndpointURL = "endpoint-url"
+	// FlagImportJobVerifyTLS is the name of the verify-tls flag.
+	FlagImportJobVerifyTLS = "verify-tls"
+	// FlagImportJobForcePathStyle is the name of the force-path-style flag.
+	FlagImportJobForcePathStyle = "force-path-style"
+	// FlagImportJobCredentialsSecret is the name of the credentials-secret flag.
+	FlagImportJobCredentialsSecret = "credentials-secret"
+	// FlagImportJobAccessKeyID is the name of the access-key-id flag.
+	FlagImportJobAccessKeyID = "access-key-id"
+	// FlagImportJobSecretAccessKey is the name of the secret-access-key flag.
+	FlagImportJobSecretAccessKey = "secret-access-key"
+	// FlagImportJobConfig is the name of the config flag.
+	FlagImportJobConfig = "config"
 
 	// settings flags
 
@@ -86,6 +153,40 @@ const (
 	FlagOIDCClientID = "client-id"
 	// FlagOIDCScopes is the name of the scope flag.
 	FlagOIDCScopes = "scopes"
+	// FlagOIDCUsernameClaim is the name of the username-claim flag.
+	FlagOIDCUsernameClaim = "username-claim"
+	// FlagOIDCUsernamePrefix is the name of the username-prefix flag.
+	FlagOIDCUsernamePrefix = "username-prefix"
+	// FlagOIDCGroupsClaims is the name of the groups-claims flag.
+	FlagOIDCGroupsClaims = "groups-claims"
+	// FlagOIDCGroupsPrefix is the name of the groups-prefix flag.
+	FlagOIDCGroupsPrefix = "groups-prefix"
+	// FlagOIDCProviderName is the name of the provider-name flag.
+	FlagOIDCProviderName = "provider-name"
+	// FlagOIDCAudiences is the name of the audiences flag.
+	FlagOIDCAudiences = "audiences"
 	// FlagRBACPolicyFile is the name of the policy-file flag.
 	FlagRBACPolicyFile = "policy-file"
+	// FlagRBACLint is the name of the lint flag.
+	FlagRBACLint = "lint"
+	// FlagRBACExplain is the name of the explain flag.
+	FlagRBACExplain = "explain"
+	// FlagRBACAttributes is the name of the attributes flag.
+	FlagRBACAttributes = "attributes"
+	// FlagRBACPermission is the name of the permission flag.
+	FlagRBACPermission = "permission"
+	// FlagRBACAssign is the name of the assign flag.
+	FlagRBACAssign = "assign"
+	// FlagRBACFile is the name of the file flag.
+	FlagRBACFile = "file"
+	// FlagRBACDryRun is the name of the dry-run flag.
+	FlagRBACDryRun = "dry-run"
+	// FlagRBACUntil is the name of the until flag.
+	FlagRBACUntil = "until"
+	// FlagRBACFor is the name of the for flag.
+	FlagRBACFor = "for"
+	// FlagRBACReason is the name of the reason flag.
+	FlagRBACReason = "reason"
+	// FlagRBACHistory is the name of the history flag.
+	FlagRBACHistory = "history"
 )
@@ -58,6 +58,11 @@ type (
 		KubeconfigPath string
 		// VersionMetadataURL Version service URL to retrieve version metadata information from.
 		VersionMetadataURL string
+		// VersionMetadataBundle is the path to an offline version metadata bundle, or configmap://<namespace>/<name>.
+		// If it is set, it is used instead of VersionMetadataURL.
+		VersionMetadataBundle string
+		// VersionMetadataPublicKey is the path to the public key that verifies the signature of VersionMetadataBundle.
+		VersionMetadataPublicKey string
 		// Version defines Everest version to be installed. If empty, the latest version is installed.
 		Version string
 		// DisableTelemetry disables telemetry.
@@ -151,7 +156,14 @@ func NewInstall(c InstallConfig, l *zap.SugaredLogger) (*Installer, error) {
 		return nil, err
 	}
 
-	cli.versionService = versionservice.New(c.VersionMetadataURL)
+	cli.versionService, err = cliutils.NewVersionService(cliutils.VersionServiceOptions{
+		URL:           c.VersionMetadataURL,
+		Bundle:        c.VersionMetadataBundle,
+		PublicKeyPath: c.VersionMetadataPublicKey,
+	}, cli.kubeClient)
+	if err != nil {
+		return nil, err
+	}
 	return cli, nil
 }
 
@@ -78,6 +78,13 @@ type (
 		// This flag is set internally only, so that the add functionality may
 		// be re-used for updating the namespace as well.
 		Update bool
+		// SkipOperators is set if only the engine version policy of the existing namespaces
+		// needs to be updated, so the DB namespace chart is not upgraded.
+		// This flag is set internally only.
+		SkipOperators bool
+		// EngineVersionPolicy holds the changes to the engine version policy of the namespaces.
+		// It is applied only when updating namespaces.
+		EngineVersionPolicy EngineVersionPolicyConfig
 		// Helm related options
 		HelmConfig helm.CLIOptions
 	}
@@ -267,7 +274,7 @@ func NewNamespaceAdd(c NamespaceAddConfig, l *zap.SugaredLogger) (*NamespaceAdde
 			return nil, ErrNamespaceListEmpty
 		}
 
-		if !(c.Operators.PXC || c.Operators.PG || c.Operators.PSMDB) {
+		if !c.SkipOperators && !(c.Operators.PXC || c.Operators.PG || c.Operators.PSMDB) {
 			// need to select at least one operator to install
 			return nil, ErrOperatorsNotSelected
 		}
@@ -297,17 +304,19 @@ func (n *NamespaceAdder) Run(ctx context.Context) error {
 		return err
 	}
 
-	if version.IsDev(dbNSChartVersion) && n.cfg.HelmConfig.ChartDir == "" {
-		// Note: new value will be set to n.cfg.ChartDir inside SetupEverestDevChart
-		cleanup, err := helmutils.SetupEverestDevChart(n.l, &n.cfg.HelmConfig.ChartDir)
-		if err != nil {
-			return err
+	if !n.cfg.SkipOperators {
+		if version.IsDev(dbNSChartVersion) && n.cfg.HelmConfig.ChartDir == "" {
+			// Note: new value will be set to n.cfg.ChartDir inside SetupEverestDevChart
+			cleanup, err := helmutils.SetupEverestDevChart(n.l, &n.cfg.HelmConfig.ChartDir)
+			if err != nil {
+				return err
+			}
+			defer cleanup()
 		}
-		defer cleanup()
-	}
 
-	if err := n.cfg.detectKubernetesEnv(ctx, n.l); err != nil {
-		return fmt.Errorf("failed to detect Kubernetes environment: %w", err)
+		if err := n.cfg.detectKubernetesEnv(ctx, n.l); err != nil {
+			return fmt.Errorf("failed to detect Kubernetes environment: %w", err)
+		}
 	}
 
 	installSteps, err := n.GetNamespaceInstallSteps(ctx, dbNSChartVersion)
@@ -323,7 +332,7 @@ func (n *NamespaceAdder) Run(ctx context.Context) error {
 
 // GetNamespaceInstallSteps returns the steps to install namespaces.
 func (n *NamespaceAdder) GetNamespaceInstallSteps(ctx context.Context, dbNSChartVersion string) ([]steps.Step, error) {
-	if n.cfg.Update {
+	if n.cfg.Update && !n.cfg.SkipOperators {
 		// validate operators updated list for each namespace.
 		for _, namespace := range n.cfg.NamespaceList {
 			err := n.validateNamespaceUpdate(ctx, namespace)
@@ -340,9 +349,16 @@ func (n *NamespaceAdder) GetNamespaceInstallSteps(ctx context.Context, dbNSChart
 
 	var installSteps []steps.Step
 	for _, namespace := range n.cfg.NamespaceList {
-		installSteps = append(installSteps,
-			n.newStepInstallNamespace(dbNSChartVersion, namespace),
-		)
+		if !n.cfg.SkipOperators {
+			installSteps = append(installSteps,
+				n.newStepInstallNamespace(dbNSChartVersion, namespace),
+			)
+		}
+		if n.cfg.Update && n.cfg.EngineVersionPolicy.IsSet() {
+			installSteps = append(installSteps,
+				n.newStepUpdateEngineVersionPolicy(namespace),
+			)
+		}
 	}
 
 	return installSteps, nil
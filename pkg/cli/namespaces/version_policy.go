@@ -0,0 +1,91 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package namespaces
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/percona/everest/pkg/cli/steps"
+	"github.com/percona/everest/pkg/versionpolicy"
+)
+
+// EngineVersionPolicyConfig describes the changes to the engine version policy of namespaces.
+type EngineVersionPolicyConfig struct {
+	// RecommendedOnly, if not nil, replaces the recommended only setting of the policy.
+	RecommendedOnly *bool
+	// Allowed, if not nil, replaces the allowed versions of the policy.
+	// The versions are in the form accepted by versionpolicy.ParsePattern.
+	Allowed []string
+	// Denied, if not nil, replaces the denied versions of the policy.
+	// The versions are in the form accepted by versionpolicy.ParsePattern.
+	Denied []string
+}
+
+// IsSet returns true if there are any changes to the policy.
+func (c EngineVersionPolicyConfig) IsSet() bool {
+	return c.RecommendedOnly != nil || c.Allowed != nil || c.Denied != nil
+}
+
+// Validate checks that the provided version patterns are valid.
+func (c EngineVersionPolicyConfig) Validate() error {
+	if _, err := versionpolicy.ParsePatterns(c.Allowed); err != nil {
+		return fmt.Errorf("invalid allowed versions: %w", err)
+	}
+	if _, err := versionpolicy.ParsePatterns(c.Denied); err != nil {
+		return fmt.Errorf("invalid denied versions: %w", err)
+	}
+	return nil
+}
+
+// apply applies the changes to the given policy.
+func (c EngineVersionPolicyConfig) apply(policy *versionpolicy.Policy) error {
+	if c.RecommendedOnly != nil {
+		policy.RecommendedOnly = *c.RecommendedOnly
+	}
+	if c.Allowed != nil {
+		allowed, err := versionpolicy.ParsePatterns(c.Allowed)
+		if err != nil {
+			return err
+		}
+		policy.Allowed = allowed
+	}
+	if c.Denied != nil {
+		denied, err := versionpolicy.ParsePatterns(c.Denied)
+		if err != nil {
+			return err
+		}
+		policy.Denied = denied
+	}
+	return nil
+}
+
+func (n *NamespaceAdder) newStepUpdateEngineVersionPolicy(namespace string) steps.Step {
+	return steps.Step{
+		Desc: fmt.Sprintf("Updating engine version policy of database namespace '%s'", namespace),
+		F: func(ctx context.Context) error {
+			policy, err := n.kubeClient.GetEngineVersionPolicy(ctx, namespace)
+			if err != nil {
+				return fmt.Errorf("cannot get engine version policy: %w", err)
+			}
+			if err := n.cfg.EngineVersionPolicy.apply(policy); err != nil {
+				return err
+			}
+			n.l.Infof("Updating engine version policy in namespace %s", namespace)
+			return n.kubeClient.UpdateEngineVersionPolicy(ctx, namespace, policy)
+		},
+	}
+}
@@ -0,0 +1,87 @@
+package namespaces
+
+import (
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/percona/everest/pkg/versionpolicy"
+)
+
+func TestEngineVersionPolicyConfigApply(t *testing.T) {
+	t.Parallel()
+
+	current := func() *versionpolicy.Policy {
+		return &versionpolicy.Policy{
+			RecommendedOnly: true,
+			Allowed:         []versionpolicy.Pattern{{Version: "8.0.*"}},
+			Denied:          []versionpolicy.Pattern{{Version: "8.0.35"}},
+		}
+	}
+
+	testCases := []struct {
+		name    string
+		cfg     EngineVersionPolicyConfig
+		wantSet bool
+		want    *versionpolicy.Policy
+	}{
+		{
+			name: "no changes",
+			want: current(),
+		},
+		{
+			name:    "disable recommended only",
+			wantSet: true,
+			cfg:     EngineVersionPolicyConfig{RecommendedOnly: pointer.ToBool(false)},
+			want: &versionpolicy.Policy{
+				Allowed: []versionpolicy.Pattern{{Version: "8.0.*"}},
+				Denied:  []versionpolicy.Pattern{{Version: "8.0.35"}},
+			},
+		},
+		{
+			name:    "replace denied versions",
+			wantSet: true,
+			cfg:     EngineVersionPolicyConfig{Denied: []string{"pxc:8.0.36", "psmdb:7.0.1*"}},
+			want: &versionpolicy.Policy{
+				RecommendedOnly: true,
+				Allowed:         []versionpolicy.Pattern{{Version: "8.0.*"}},
+				Denied: []versionpolicy.Pattern{
+					{EngineType: everestv1alpha1.DatabaseEnginePXC, Version: "8.0.36"},
+					{EngineType: everestv1alpha1.DatabaseEnginePSMDB, Version: "7.0.1*"},
+				},
+			},
+		},
+		{
+			name:    "clear allowed versions",
+			wantSet: true,
+			cfg:     EngineVersionPolicyConfig{Allowed: []string{}},
+			want: &versionpolicy.Policy{
+				RecommendedOnly: true,
+				Allowed:         []versionpolicy.Pattern{},
+				Denied:          []versionpolicy.Pattern{{Version: "8.0.35"}},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.wantSet, tc.cfg.IsSet())
+
+			policy := current()
+			require.NoError(t, tc.cfg.apply(policy))
+			assert.Equal(t, tc.want, policy)
+		})
+	}
+}
+
+func TestEngineVersionPolicyConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, EngineVersionPolicyConfig{Allowed: []string{"8.0.*"}, Denied: []string{"pxc:8.0.35"}}.Validate())
+	require.Error(t, EngineVersionPolicyConfig{Allowed: []string{"mysql:8.0.*"}}.Validate())
+	require.Error(t, EngineVersionPolicyConfig{Denied: []string{""}}.Validate())
+}
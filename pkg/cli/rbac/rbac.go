@@ -0,0 +1,281 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package rbac holds the logic for editing the RBAC settings.
+package rbac
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	cliutils "github.com/percona/everest/pkg/cli/utils"
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/kubernetes"
+	"github.com/percona/everest/pkg/output"
+	"github.com/percona/everest/pkg/rbac"
+)
+
+const (
+	policyKey  = "policy.csv"
+	enabledKey = "enabled"
+
+	// cliAuthor is recorded as the author of the changes made with everestctl.
+	cliAuthor = "everestctl"
+)
+
+type (
+	// Config holds the configuration for the RBAC editing subcommands.
+	Config struct {
+		// KubeconfigPath is a path to a kubeconfig
+		KubeconfigPath string
+		// If set, we will print the pretty output.
+		Pretty bool
+	}
+
+	// RBAC provides functionality for editing the RBAC ConfigMap.
+	// Every change is validated before it is written to the cluster.
+	RBAC struct {
+		kubeConnector kubernetes.KubernetesConnector
+		l             *zap.SugaredLogger
+		config        Config
+	}
+)
+
+// NewRBAC creates a new RBAC for running RBAC editing commands.
+func NewRBAC(c Config, l *zap.SugaredLogger) (*RBAC, error) {
+	cli := &RBAC{
+		l:      l.With("component", "rbac"),
+		config: c,
+	}
+	if c.Pretty {
+		cli.l = zap.NewNop().Sugar()
+	}
+
+	k, err := cliutils.NewKubeConnector(cli.l, c.KubeconfigPath)
+	if err != nil {
+		return nil, err
+	}
+	cli.kubeConnector = k
+	return cli, nil
+}
+
+// WithKubeConnector sets the Kubernetes connector for the RBAC.
+func (r *RBAC) WithKubeConnector(k kubernetes.KubernetesConnector) *RBAC {
+	r.kubeConnector = k
+	return r
+}
+
+// Edit applies the given change to the RBAC policy, validates the result and saves it.
+func (r *RBAC) Edit(ctx context.Context, change func(p *rbac.Policy) error) error {
+	cm, err := r.getConfigMap(ctx)
+	if err != nil {
+		return err
+	}
+	policy := rbac.ParsePolicy(cm.Data[policyKey])
+	if err := change(policy); err != nil {
+		return err
+	}
+	if err := policy.Validate(); err != nil {
+		return errors.Join(errors.New("the policy was not updated"), err)
+	}
+	cm.Data[policyKey] = policy.String()
+	rbac.SetPolicyAuthor(cm, cliAuthor)
+	if _, err := r.kubeConnector.UpdateConfigMap(ctx, cm); err != nil {
+		return err
+	}
+
+	r.l.Info("RBAC policy has been updated successfully")
+	if r.config.Pretty {
+		_, _ = fmt.Fprintln(os.Stdout, output.Success("RBAC policy has been updated successfully"))
+	}
+	return nil
+}
+
+// SetEnabled enables or disables RBAC. The policy is validated before RBAC is enabled.
+func (r *RBAC) SetEnabled(ctx context.Context, enabled bool) error {
+	cm, err := r.getConfigMap(ctx)
+	if err != nil {
+		return err
+	}
+	if enabled {
+		if err := rbac.ParsePolicy(cm.Data[policyKey]).Validate(); err != nil {
+			return errors.Join(errors.New("RBAC was not enabled"), err)
+		}
+	}
+	cm.Data[enabledKey] = fmt.Sprintf("%t", enabled)
+	rbac.SetPolicyAuthor(cm, cliAuthor)
+	if _, err := r.kubeConnector.UpdateConfigMap(ctx, cm); err != nil {
+		return err
+	}
+
+	state := "disabled"
+	if enabled {
+		state = "enabled"
+	}
+	r.l.Infof("RBAC has been %s", state)
+	if r.config.Pretty {
+		_, _ = fmt.Fprintln(os.Stdout, output.Success("RBAC has been %s", state))
+	}
+	return nil
+}
+
+// ApplyOptions holds options for applying an RBAC policy.
+type ApplyOptions struct {
+	// Policy is the content of the policy to apply.
+	Policy string
+	// DryRun is set if the difference should only be shown and the policy not written.
+	DryRun bool
+}
+
+// Apply writes the difference between the current and the given policy to out,
+// validates the given policy and replaces the current policy with it.
+func (r *RBAC) Apply(ctx context.Context, opts ApplyOptions, out io.Writer) error {
+	cm, err := r.getConfigMap(ctx)
+	if err != nil {
+		return err
+	}
+	policy := rbac.ParsePolicy(opts.Policy)
+	current := cm.Data[policyKey]
+	if rbac.ParsePolicy(current).String() == policy.String() {
+		_, _ = fmt.Fprintln(out, "No changes to the RBAC policy")
+		return nil
+	}
+	for _, line := range rbac.DiffPolicy(current, policy.String()) {
+		_, _ = fmt.Fprintln(out, line)
+	}
+
+	if err := policy.Validate(); err != nil {
+		return errors.Join(errors.New("the policy was not applied"), err)
+	}
+	if opts.DryRun {
+		return nil
+	}
+	cm.Data[policyKey] = policy.String()
+	rbac.SetPolicyAuthor(cm, cliAuthor)
+	if _, err := r.kubeConnector.UpdateConfigMap(ctx, cm); err != nil {
+		return err
+	}
+
+	r.l.Info("RBAC policy has been applied successfully")
+	if r.config.Pretty {
+		_, _ = fmt.Fprintln(out, output.Success("RBAC policy has been applied successfully"))
+	}
+	return nil
+}
+
+// TemporaryGrantOptions holds options for creating a temporary grant.
+type TemporaryGrantOptions struct {
+	// Subject is the user or group the role is assigned to.
+	Subject string
+	// Role is the assigned role.
+	Role string
+	// ExpiresAt is the time the assignment is removed at.
+	ExpiresAt time.Time
+	// Reason is an optional justification of the grant.
+	Reason string
+}
+
+// CreateTemporaryGrant assigns the role to the subject until the expiry time.
+func (r *RBAC) CreateTemporaryGrant(ctx context.Context, opts TemporaryGrantOptions) error {
+	now := time.Now().UTC()
+	grant := rbac.TemporaryGrant{
+		Subject:   opts.Subject,
+		Role:      opts.Role,
+		ExpiresAt: opts.ExpiresAt.UTC(),
+		GrantedBy: cliAuthor,
+		GrantedAt: now,
+		Reason:    opts.Reason,
+	}
+	if err := rbac.ValidateGrant(ctx, r.kubeConnector, grant, now); err != nil {
+		return errors.Join(errors.New("the grant was not created"), err)
+	}
+	if err := rbac.AddGrant(ctx, r.kubeConnector, grant, now); err != nil {
+		return err
+	}
+
+	r.l.Infof("Role '%s' has been granted to '%s' until %s", grant.Role, grant.Subject, grant.ExpiresAt.Format(time.RFC3339))
+	if r.config.Pretty {
+		_, _ = fmt.Fprintln(os.Stdout, output.Success("Role '%s' has been granted to '%s' until %s",
+			grant.Role, grant.Subject, grant.ExpiresAt.Format(time.RFC3339)))
+	}
+	return nil
+}
+
+// ListTemporaryGrants returns the temporary grants and the history of their changes.
+// Only the grants that have not expired yet are returned.
+func (r *RBAC) ListTemporaryGrants(ctx context.Context) (*rbac.Grants, error) {
+	grants, err := rbac.GetGrants(ctx, r.kubeConnector)
+	if err != nil {
+		return nil, err
+	}
+	grants.Grants = grants.Active(time.Now())
+	return grants, nil
+}
+
+// ListPolicyVersions returns the most recent versions of the RBAC policy.
+func (r *RBAC) ListPolicyVersions(ctx context.Context) (*rbac.PolicyHistory, error) {
+	return rbac.GetPolicyHistory(ctx, r.kubeConnector)
+}
+
+// DiffPolicyVersions writes the difference between two versions of the RBAC policy to out.
+func (r *RBAC) DiffPolicyVersions(ctx context.Context, from, to int, out io.Writer) error {
+	history, err := rbac.GetPolicyHistory(ctx, r.kubeConnector)
+	if err != nil {
+		return err
+	}
+	lines, err := history.Diff(from, to)
+	if err != nil {
+		return err
+	}
+	for _, line := range lines {
+		_, _ = fmt.Fprintln(out, line)
+	}
+	return nil
+}
+
+// Rollback replaces the RBAC policy with the given version of the policy.
+func (r *RBAC) Rollback(ctx context.Context, version int) error {
+	if _, err := rbac.RollbackPolicy(ctx, r.kubeConnector, version, cliAuthor); err != nil {
+		return errors.Join(errors.New("the policy was not rolled back"), err)
+	}
+
+	r.l.Infof("RBAC policy has been rolled back to version %d", version)
+	if r.config.Pretty {
+		_, _ = fmt.Fprintln(os.Stdout, output.Success("RBAC policy has been rolled back to version %d", version))
+	}
+	return nil
+}
+
+func (r *RBAC) getConfigMap(ctx context.Context) (*corev1.ConfigMap, error) {
+	cm, err := r.kubeConnector.GetConfigMap(ctx, types.NamespacedName{
+		Namespace: common.SystemNamespace,
+		Name:      common.EverestRBACConfigMapName,
+	})
+	if err != nil {
+		return nil, errors.Join(err, errors.New("failed to get RBAC ConfigMap"))
+	}
+	if cm.Data == nil {
+		cm.Data = make(map[string]string)
+	}
+	return cm, nil
+}
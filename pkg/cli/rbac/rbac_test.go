@@ -0,0 +1,184 @@
+package rbac
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/kubernetes"
+	"github.com/percona/everest/pkg/rbac"
+)
+
+const testPolicy = `p, role:dev, namespaces, read, ns-dev
+g, admin, role:admin
+g, alice, role:dev
+`
+
+func newTestRBAC(t *testing.T, policy string, enabled bool) (*RBAC, kubernetes.KubernetesConnector) {
+	t.Helper()
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      common.EverestRBACConfigMapName,
+			Namespace: common.SystemNamespace,
+		},
+		Data: map[string]string{
+			policyKey:  policy,
+			enabledKey: "false",
+		},
+	}
+	if enabled {
+		cm.Data[enabledKey] = "true"
+	}
+	c := fakeclient.NewClientBuilder().WithScheme(kubernetes.CreateScheme()).WithObjects(cm).Build()
+	k := kubernetes.NewEmpty(zap.NewNop().Sugar()).WithKubernetesClient(c)
+	r := &RBAC{l: zap.NewNop().Sugar()}
+	return r.WithKubeConnector(k), k
+}
+
+func getConfigMap(t *testing.T, k kubernetes.KubernetesConnector) *corev1.ConfigMap {
+	t.Helper()
+	cm, err := k.GetConfigMap(context.Background(), types.NamespacedName{
+		Namespace: common.SystemNamespace,
+		Name:      common.EverestRBACConfigMapName,
+	})
+	require.NoError(t, err)
+	return cm
+}
+
+func TestEdit(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	r, k := newTestRBAC(t, testPolicy, true)
+	err := r.Edit(ctx, func(p *rbac.Policy) error {
+		return p.Grant("role:dev", "database-clusters", "read", "ns-dev/*")
+	})
+	require.NoError(t, err)
+	assert.Equal(t, testPolicy+"p, role:dev, database-clusters, read, ns-dev/*\n", getConfigMap(t, k).Data[policyKey])
+
+	// invalid changes are not written.
+	err = r.Edit(ctx, func(p *rbac.Policy) error {
+		return p.Grant("role:dev", "unknown", "read", "*")
+	})
+	require.Error(t, err)
+	err = r.Edit(ctx, func(p *rbac.Policy) error {
+		return p.Revoke("admin", "role:admin", "", "")
+	})
+	require.ErrorIs(t, err, rbac.ErrRuleNotFound)
+	assert.Equal(t, testPolicy+"p, role:dev, database-clusters, read, ns-dev/*\n", getConfigMap(t, k).Data[policyKey])
+}
+
+func TestSetEnabled(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	r, k := newTestRBAC(t, "g, alice, role:dev\n", false)
+	require.Error(t, r.SetEnabled(ctx, true))
+	assert.Equal(t, "false", getConfigMap(t, k).Data[enabledKey])
+
+	r, k = newTestRBAC(t, testPolicy, false)
+	require.NoError(t, r.SetEnabled(ctx, true))
+	assert.Equal(t, "true", getConfigMap(t, k).Data[enabledKey])
+	require.NoError(t, r.SetEnabled(ctx, false))
+	assert.Equal(t, "false", getConfigMap(t, k).Data[enabledKey])
+}
+
+func TestApply(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	newPolicy := "p, role:dev, namespaces, read, *\ng, admin, role:admin\ng, alice, role:dev\n"
+
+	r, k := newTestRBAC(t, testPolicy, true)
+	out := &bytes.Buffer{}
+	require.NoError(t, r.Apply(ctx, ApplyOptions{Policy: newPolicy, DryRun: true}, out))
+	assert.Equal(t, "- p, role:dev, namespaces, read, ns-dev\n+ p, role:dev, namespaces, read, *\n  g, admin, role:admin\n  g, alice, role:dev\n", out.String())
+	assert.Equal(t, testPolicy, getConfigMap(t, k).Data[policyKey])
+
+	out.Reset()
+	require.NoError(t, r.Apply(ctx, ApplyOptions{Policy: newPolicy}, out))
+	assert.Equal(t, newPolicy, getConfigMap(t, k).Data[policyKey])
+
+	// a policy that locks out the admins is not applied.
+	out.Reset()
+	require.ErrorIs(t, r.Apply(ctx, ApplyOptions{Policy: "p, role:dev, namespaces, read, *\ng, alice, role:dev\n"}, out), rbac.ErrAdminLockout)
+	assert.Contains(t, out.String(), "- g, admin, role:admin")
+	assert.Equal(t, newPolicy, getConfigMap(t, k).Data[policyKey])
+}
+
+func TestCreateTemporaryGrant(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	r, _ := newTestRBAC(t, testPolicy, true)
+
+	err := r.CreateTemporaryGrant(ctx, TemporaryGrantOptions{
+		Subject:   "bob",
+		Role:      "role:missing",
+		ExpiresAt: time.Now().Add(time.Hour),
+	})
+	require.ErrorIs(t, err, rbac.ErrRoleNotFound)
+
+	err = r.CreateTemporaryGrant(ctx, TemporaryGrantOptions{
+		Subject:   "bob",
+		Role:      "role:dev",
+		ExpiresAt: time.Now().Add(-time.Hour),
+	})
+	require.ErrorIs(t, err, rbac.ErrGrantExpired)
+
+	expiry := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	require.NoError(t, r.CreateTemporaryGrant(ctx, TemporaryGrantOptions{
+		Subject:   "bob",
+		Role:      "role:dev",
+		ExpiresAt: expiry,
+		Reason:    "incident",
+	}))
+
+	grants, err := r.ListTemporaryGrants(ctx)
+	require.NoError(t, err)
+	require.Len(t, grants.Grants, 1)
+	assert.Equal(t, "bob", grants.Grants[0].Subject)
+	assert.Equal(t, "role:dev", grants.Grants[0].Role)
+	assert.True(t, expiry.Equal(grants.Grants[0].ExpiresAt))
+	assert.Equal(t, cliAuthor, grants.Grants[0].GrantedBy)
+	require.Len(t, grants.History, 1)
+	assert.Equal(t, rbac.GrantActionGranted, grants.History[0].Action)
+}
+
+func TestRollback(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	r, k := newTestRBAC(t, testPolicy, true)
+
+	previous := "p, role:dev, namespaces, read, ns-dev\ng, admin, role:admin\ng, bob, role:dev\n"
+	_, err := k.CreateConfigMap(ctx, &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      common.EverestRBACHistoryConfigMapName,
+			Namespace: common.SystemNamespace,
+		},
+		Data: map[string]string{
+			"versions": "- version: 1\n  author: admin\n  valid: true\n  policy: |\n" +
+				"    p, role:dev, namespaces, read, ns-dev\n    g, admin, role:admin\n    g, bob, role:dev\n",
+		},
+	})
+	require.NoError(t, err)
+
+	var out bytes.Buffer
+	require.ErrorIs(t, r.DiffPolicyVersions(ctx, 1, 2, &out), rbac.ErrPolicyVersionNotFound)
+	require.ErrorIs(t, r.Rollback(ctx, 2), rbac.ErrPolicyVersionNotFound)
+
+	require.NoError(t, r.Rollback(ctx, 1))
+	cm := getConfigMap(t, k)
+	assert.Equal(t, previous, cm.Data[policyKey])
+	assert.Equal(t, cliAuthor, cm.Annotations[rbac.PolicyAuthorAnnotation])
+	assert.Equal(t, "true", cm.Data[enabledKey])
+}
@@ -0,0 +1,87 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+// Package telemetry provides the functionality to inspect the Everest telemetry.
+package telemetry
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"go.uber.org/zap"
+
+	cliutils "github.com/percona/everest/pkg/cli/utils"
+	"github.com/percona/everest/pkg/kubernetes"
+	"github.com/percona/everest/pkg/telemetry"
+	"github.com/percona/everest/pkg/version"
+)
+
+type (
+	// Config holds the configuration for the telemetry subcommands.
+	Config struct {
+		// KubeconfigPath is a path to a kubeconfig
+		KubeconfigPath string
+		// If set, we will print the pretty output.
+		Pretty bool
+	}
+
+	// Telemetry provides functionality for inspecting the telemetry reports.
+	Telemetry struct {
+		kubeConnector kubernetes.KubernetesConnector
+		l             *zap.SugaredLogger
+		config        Config
+	}
+)
+
+// NewTelemetry creates a new Telemetry for running telemetry commands.
+func NewTelemetry(c Config, l *zap.SugaredLogger) (*Telemetry, error) {
+	cli := &Telemetry{
+		l:      l.With("component", "telemetry"),
+		config: c,
+	}
+	if c.Pretty {
+		cli.l = zap.NewNop().Sugar()
+	}
+
+	k, err := cliutils.NewKubeConnector(cli.l, c.KubeconfigPath)
+	if err != nil {
+		return nil, err
+	}
+	cli.kubeConnector = k
+	return cli, nil
+}
+
+// Show writes the telemetry report that the Everest server would send, as indented JSON, to out.
+// The version of the installed Everest server is reported, not the version of everestctl.
+func (t *Telemetry) Show(ctx context.Context, out io.Writer) error {
+	everestVersion, err := version.EverestVersionFromDeployment(ctx, t.kubeConnector)
+	if err != nil {
+		return errors.Join(err, errors.New("could not get the installed Everest version"))
+	}
+
+	report, err := telemetry.Collect(ctx, t.kubeConnector, everestVersion.String())
+	if err != nil {
+		return err
+	}
+
+	b, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(out, string(b))
+	return err
+}
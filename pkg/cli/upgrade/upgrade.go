@@ -55,6 +55,11 @@ type (
 		InCluster bool
 		// VersionMetadataURL stores hostname to retrieve version metadata information from.
 		VersionMetadataURL string
+		// VersionMetadataBundle is the path to an offline version metadata bundle, or configmap://<namespace>/<name>.
+		// If it is set, it is used instead of VersionMetadataURL.
+		VersionMetadataBundle string
+		// VersionMetadataPublicKey is the path to the public key that verifies the signature of VersionMetadataBundle.
+		VersionMetadataPublicKey string
 		// DryRun is set if the upgrade process should only perform pre-upgrade checks and not perform the actual upgrade.
 		DryRun bool
 		// If set, we will print the pretty output.
@@ -119,7 +124,15 @@ func NewUpgrade(cfg *Config, l *zap.SugaredLogger) (*Upgrade, error) {
 	}
 
 	cli.kubeConnector = kubeClient
-	cli.versionService = versionservice.New(cfg.VersionMetadataURL)
+	vs, err := cliutils.NewVersionService(cliutils.VersionServiceOptions{
+		URL:           cfg.VersionMetadataURL,
+		Bundle:        cfg.VersionMetadataBundle,
+		PublicKeyPath: cfg.VersionMetadataPublicKey,
+	}, kubeClient)
+	if err != nil {
+		return nil, err
+	}
+	cli.versionService = vs
 	return cli, nil
 }
 
@@ -15,6 +15,7 @@ import (
 	"github.com/percona/everest/pkg/common"
 	"github.com/percona/everest/pkg/kubernetes"
 	"github.com/percona/everest/pkg/version"
+	versionservice "github.com/percona/everest/pkg/version_service"
 )
 
 const (
@@ -74,6 +75,27 @@ func NewKubeConnector(l *zap.SugaredLogger, kubeconfigPath string) (kubernetes.K
 	return k, nil
 }
 
+// VersionServiceOptions holds the options to select the version service client.
+type VersionServiceOptions struct {
+	// URL is the URL of the version service.
+	URL string
+	// Bundle is the path to an offline metadata bundle, or configmap://<namespace>/<name>.
+	// If it is set, it is used instead of URL.
+	Bundle string
+	// PublicKeyPath is the path to the PEM encoded ed25519 public key that verifies the bundle.
+	PublicKeyPath string
+}
+
+// NewVersionService creates a new version service client.
+// kubeConnector is used to read the bundle if it is stored in a ConfigMap.
+func NewVersionService(o VersionServiceOptions, kubeConnector kubernetes.KubernetesConnector) (versionservice.Interface, error) { //nolint:ireturn
+	cfg, err := versionservice.NewConfig(o.URL, o.Bundle, o.PublicKeyPath)
+	if err != nil {
+		return nil, err
+	}
+	return versionservice.NewFromConfig(cfg, kubeConnector)
+}
+
 // VerifyCLIVersion checks if the CLI version satisfies the constraints.
 func VerifyCLIVersion(supVer *common.SupportedVersion) error {
 	if version.Version == "" {
@@ -2,6 +2,13 @@ package utils
 
 import (
 	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -14,6 +21,7 @@ import (
 
 	"github.com/percona/everest/pkg/common"
 	"github.com/percona/everest/pkg/kubernetes"
+	versionservice "github.com/percona/everest/pkg/version_service"
 )
 
 func TestCheckHelmInstallation(t *testing.T) {
@@ -94,3 +102,40 @@ func getDeployment(v, depName string) *appsv1.Deployment {
 		},
 	}
 }
+
+func TestNewVersionService(t *testing.T) {
+	t.Parallel()
+
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(pub)
+	require.NoError(t, err)
+	signed, err := versionservice.SignBundle(&versionservice.Bundle{
+		Metadata: json.RawMessage(`{"versions":[{"version":"1.4.0"}]}`),
+	}, priv)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	keyPath := filepath.Join(dir, "key.pem")
+	require.NoError(t, os.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0o600))
+	bundlePath := filepath.Join(dir, "bundle.json")
+	require.NoError(t, os.WriteFile(bundlePath, signed, 0o600))
+
+	k := kubernetes.NewEmpty(zap.NewNop().Sugar()).WithKubernetesClient(
+		fakeclient.NewClientBuilder().WithScheme(kubernetes.CreateScheme()).WithObjects(&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "bundle", Namespace: common.SystemNamespace},
+			Data:       map[string]string{versionservice.BundleConfigMapKey: string(signed)},
+		}).Build(),
+	)
+
+	for _, bundle := range []string{bundlePath, "configmap://" + common.SystemNamespace + "/bundle"} {
+		vs, err := NewVersionService(VersionServiceOptions{Bundle: bundle, PublicKeyPath: keyPath}, k)
+		require.NoError(t, err)
+		meta, err := vs.GetEverestMetadata(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, "1.4.0", meta.GetVersions()[0].GetVersion())
+	}
+
+	_, err = NewVersionService(VersionServiceOptions{Bundle: bundlePath, PublicKeyPath: filepath.Join(dir, "missing.pem")}, k)
+	require.Error(t, err)
+}
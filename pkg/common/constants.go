@@ -84,11 +84,17 @@ const (
 	EverestAdminRole = EverestRBACRolePrefix + "admin"
 	// EverestAccountsFileName is the key used in everest-accounts secret
 	EverestAccountsFileName = "users.yaml"
+	// EverestPasswordPolicyFileName is the key of the password policy in the everest-accounts secret.
+	EverestPasswordPolicyFileName = "password-policy.yaml"
 
 	// EverestSettingsConfigMapName is the name of the Everest settings ConfigMap.
 	EverestSettingsConfigMapName = "everest-settings"
 	// EverestRBACConfigMapName is the name of the Everest RBAC ConfigMap.
 	EverestRBACConfigMapName = "everest-rbac"
+	// EverestRBACGrantsConfigMapName is the name of the ConfigMap that holds the temporary RBAC grants.
+	EverestRBACGrantsConfigMapName = "everest-rbac-grants"
+	// EverestRBACHistoryConfigMapName is the name of the ConfigMap that holds the versions of the RBAC policy.
+	EverestRBACHistoryConfigMapName = "everest-rbac-history"
 	// KubernetesManagedByLabel is the label used to identify resources managed by Everest.
 	KubernetesManagedByLabel = "app.kubernetes.io/managed-by"
 	// DatabaseClusterNameLabel is the label used to identify resources by DB cluster name.
@@ -1,6 +1,11 @@
 package common
 
 import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
 	"github.com/mitchellh/mapstructure"
 	"gopkg.in/yaml.v3"
 )
@@ -8,16 +13,42 @@ import (
 // DefaultOIDCScopes is the default scopes for OIDC.
 var DefaultOIDCScopes = []string{"openid", "profile", "email"}
 
+// DefaultOIDCUsernameClaim is the default claim of the OIDC tokens that holds the username.
+const DefaultOIDCUsernameClaim = "sub"
+
+// DefaultOIDCGroupsClaims is the default list of claims of the OIDC tokens that hold the groups.
+var DefaultOIDCGroupsClaims = []string{"groups"}
+
+// DefaultOIDCProviderName is the name of the OIDC provider configured in the "oidc.config" setting.
+const DefaultOIDCProviderName = "default"
+
 // EverestSettings represents the everest settings.
 type EverestSettings struct {
 	OIDCConfigRaw string `mapstructure:"oidc.config"`
+	// OIDCProvidersRaw is the YAML list of the OIDC providers configured in addition to "oidc.config".
+	OIDCProvidersRaw string `mapstructure:"oidc.providers,omitempty"`
 }
 
 // OIDCConfig represents the OIDC provider configuration.
 type OIDCConfig struct {
+	// Name identifies the provider, DefaultOIDCProviderName if empty.
+	Name      string   `yaml:"name,omitempty"`
 	IssuerURL string   `yaml:"issuerUrl"`
 	ClientID  string   `yaml:"clientId"`
 	Scopes    []string `yaml:"scopes"`
+	// UsernameClaim is the claim that holds the username, DefaultOIDCUsernameClaim if empty.
+	UsernameClaim string `yaml:"usernameClaim,omitempty"`
+	// UsernamePrefix is prepended to the usernames, so that the users of different providers
+	// may be told apart. It is required if more than one provider is configured.
+	UsernamePrefix string `yaml:"usernamePrefix,omitempty"`
+	// GroupsClaims are the claims that hold the groups, DefaultOIDCGroupsClaims if empty.
+	GroupsClaims []string `yaml:"groupsClaims,omitempty"`
+	// GroupsPrefix is prepended to the names of the groups, so that they may be told apart
+	// from the other subjects in the RBAC policy.
+	GroupsPrefix string `yaml:"groupsPrefix,omitempty"`
+	// Audiences are the accepted values of the "aud" claim of the tokens.
+	// If empty, the tokens must be issued for the client ID, as their audience or their authorized party.
+	Audiences []string `yaml:"audiences,omitempty"`
 }
 
 // Raw converts the OIDCConfig struct to a raw YAML string.
@@ -44,6 +75,106 @@ func (e *EverestSettings) OIDCConfig() (OIDCConfig, error) {
 	return oidc, nil
 }
 
+// OIDCProviders returns the configured OIDC providers.
+// The provider of the "oidc.config" setting, if any, comes first and is named DefaultOIDCProviderName.
+func (e *EverestSettings) OIDCProviders() ([]OIDCConfig, error) {
+	var providers []OIDCConfig
+	if e.OIDCConfigRaw != "" {
+		oidc, err := e.OIDCConfig()
+		if err != nil {
+			return nil, err
+		}
+		// The setting may hold an empty configuration once OIDC is turned off.
+		if oidc.IssuerURL != "" {
+			oidc.Name = DefaultOIDCProviderName
+			providers = append(providers, oidc)
+		}
+	}
+	if e.OIDCProvidersRaw != "" {
+		var additional []OIDCConfig
+		if err := yaml.Unmarshal([]byte(e.OIDCProvidersRaw), &additional); err != nil {
+			return nil, err
+		}
+		for _, oidc := range additional {
+			if len(oidc.Scopes) == 0 {
+				oidc.Scopes = DefaultOIDCScopes
+			}
+			providers = append(providers, oidc)
+		}
+	}
+	if err := validateOIDCProviders(providers); err != nil {
+		return nil, err
+	}
+	return providers, nil
+}
+
+// SetOIDCProvider adds the OIDC provider to the settings, or replaces the provider with the same name.
+// The provider named DefaultOIDCProviderName, or without a name, is stored in the "oidc.config" setting.
+func (e *EverestSettings) SetOIDCProvider(oidc OIDCConfig) error {
+	if oidc.Name == "" || oidc.Name == DefaultOIDCProviderName {
+		oidc.Name = ""
+		raw, err := oidc.Raw()
+		if err != nil {
+			return err
+		}
+		e.OIDCConfigRaw = raw
+		return nil
+	}
+
+	var additional []OIDCConfig
+	if err := yaml.Unmarshal([]byte(e.OIDCProvidersRaw), &additional); err != nil {
+		return err
+	}
+	i := slices.IndexFunc(additional, func(c OIDCConfig) bool { return c.Name == oidc.Name })
+	if i < 0 {
+		additional = append(additional, oidc)
+	} else {
+		additional[i] = oidc
+	}
+	raw, err := yaml.Marshal(additional)
+	if err != nil {
+		return err
+	}
+	e.OIDCProvidersRaw = string(raw)
+	return nil
+}
+
+// validateOIDCProviders checks that the providers can be told apart by their names and issuers,
+// and that the users of different providers can be told apart by their username prefixes.
+func validateOIDCProviders(providers []OIDCConfig) error {
+	names := make(map[string]struct{}, len(providers))
+	issuers := make(map[string]struct{}, len(providers))
+	for i, oidc := range providers {
+		if oidc.Name == "" {
+			return errors.New("OIDC provider name is required")
+		}
+		if _, ok := names[oidc.Name]; ok {
+			return fmt.Errorf("duplicate OIDC provider name '%s'", oidc.Name)
+		}
+		names[oidc.Name] = struct{}{}
+		if oidc.IssuerURL == "" {
+			return fmt.Errorf("OIDC provider '%s' has no issuer URL", oidc.Name)
+		}
+		if _, ok := issuers[oidc.IssuerURL]; ok {
+			return fmt.Errorf("OIDC providers share the issuer URL '%s'", oidc.IssuerURL)
+		}
+		issuers[oidc.IssuerURL] = struct{}{}
+		if len(providers) == 1 {
+			continue
+		}
+		if oidc.UsernamePrefix == "" {
+			return fmt.Errorf("OIDC provider '%s' has no username prefix, which is required with multiple providers", oidc.Name)
+		}
+		for _, other := range providers[:i] {
+			if strings.HasPrefix(oidc.UsernamePrefix, other.UsernamePrefix) ||
+				strings.HasPrefix(other.UsernamePrefix, oidc.UsernamePrefix) {
+				return fmt.Errorf("OIDC providers '%s' and '%s' have overlapping username prefixes", other.Name, oidc.Name)
+			}
+		}
+	}
+	return nil
+}
+
 // ToMap converts the EverestSettings struct to a map struct.
 func (e *EverestSettings) ToMap() (map[string]string, error) {
 	result := make(map[string]string)
@@ -30,6 +30,17 @@ func TestToMap(t *testing.T) {
 			},
 			expected: map[string]string{"oidc.config": "issuerUrl: \"\"\nclientId: \"\"\nscopes: []\n"},
 		},
+		{
+			name: "oidc providers",
+			input: EverestSettings{
+				OIDCConfigRaw:    "issuerUrl: url\n",
+				OIDCProvidersRaw: "- name: contractors\n  issuerUrl: url2\n",
+			},
+			expected: map[string]string{
+				"oidc.config":    "issuerUrl: url\n",
+				"oidc.providers": "- name: contractors\n  issuerUrl: url2\n",
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -133,3 +144,73 @@ func TestOIDCConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestOIDCProviders(t *testing.T) {
+	t.Parallel()
+
+	settings := EverestSettings{
+		OIDCConfigRaw: "issuerUrl: https://okta.example.com\nclientId: employees\nusernamePrefix: 'okta:'\n",
+		OIDCProvidersRaw: "- name: contractors\n  issuerUrl: https://login.microsoftonline.com/tenant/v2.0\n" +
+			"  clientId: contractors\n  usernamePrefix: 'entra:'\n  audiences:\n  - api://everest\n",
+	}
+	providers, err := settings.OIDCProviders()
+	require.NoError(t, err)
+	assert.Equal(t, []OIDCConfig{
+		{
+			Name:           DefaultOIDCProviderName,
+			IssuerURL:      "https://okta.example.com",
+			ClientID:       "employees",
+			Scopes:         DefaultOIDCScopes,
+			UsernamePrefix: "okta:",
+		},
+		{
+			Name:           "contractors",
+			IssuerURL:      "https://login.microsoftonline.com/tenant/v2.0",
+			ClientID:       "contractors",
+			Scopes:         DefaultOIDCScopes,
+			UsernamePrefix: "entra:",
+			Audiences:      []string{"api://everest"},
+		},
+	}, providers)
+
+	// The provider with the same name is replaced.
+	require.NoError(t, settings.SetOIDCProvider(OIDCConfig{Name: "contractors", IssuerURL: "https://entra.example.com", ClientID: "id", UsernamePrefix: "entra:"}))
+	require.NoError(t, settings.SetOIDCProvider(OIDCConfig{Name: "partners", IssuerURL: "https://partners.example.com", ClientID: "id", UsernamePrefix: "partners:"}))
+	require.NoError(t, settings.SetOIDCProvider(OIDCConfig{Name: DefaultOIDCProviderName, IssuerURL: "https://okta.example.com", ClientID: "id", UsernamePrefix: "okta:"}))
+	providers, err = settings.OIDCProviders()
+	require.NoError(t, err)
+	require.Len(t, providers, 3)
+	assert.Equal(t, "id", providers[0].ClientID)
+	assert.Equal(t, "https://entra.example.com", providers[1].IssuerURL)
+	assert.Equal(t, "partners", providers[2].Name)
+
+	// An empty configuration of the default provider is ignored.
+	settings = EverestSettings{OIDCConfigRaw: "issuerUrl: \"\"\nclientId: \"\"\nscopes: []\n"}
+	providers, err = settings.OIDCProviders()
+	require.NoError(t, err)
+	assert.Empty(t, providers)
+
+	// A single provider does not need a username prefix.
+	settings = EverestSettings{OIDCConfigRaw: "issuerUrl: https://okta.example.com\n"}
+	providers, err = settings.OIDCProviders()
+	require.NoError(t, err)
+	assert.Len(t, providers, 1)
+
+	for _, raw := range []string{
+		"- issuerUrl: https://entra.example.com\n  usernamePrefix: 'entra:'\n",
+		"- name: default\n  issuerUrl: https://entra.example.com\n  usernamePrefix: 'entra:'\n",
+		"- name: contractors\n  issuerUrl: https://okta.example.com\n  usernamePrefix: 'entra:'\n",
+		"- name: contractors\n  usernamePrefix: 'entra:'\n",
+		// The users of multiple providers must be told apart.
+		"- name: contractors\n  issuerUrl: https://entra.example.com\n",
+		"- name: contractors\n  issuerUrl: https://entra.example.com\n  usernamePrefix: 'okta:'\n",
+		"- name: contractors\n  issuerUrl: https://entra.example.com\n  usernamePrefix: 'okta:entra:'\n",
+	} {
+		settings := EverestSettings{
+			OIDCConfigRaw:    "issuerUrl: https://okta.example.com\nusernamePrefix: 'okta:'\n",
+			OIDCProvidersRaw: raw,
+		}
+		_, err := settings.OIDCProviders()
+		require.Error(t, err, raw)
+	}
+}
@@ -0,0 +1,143 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package jwks provides the set of keys used for signing and verifying the JWT tokens issued by Everest.
+package jwks
+
+import (
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"slices"
+	"time"
+)
+
+// Key is a key for signing the JWT tokens.
+type Key struct {
+	// ID identifies the key in the "kid" header of the tokens.
+	ID string `yaml:"id"`
+	// PrivateKey is the PEM encoded RSA private key.
+	PrivateKey string `yaml:"privateKey"`
+	// CreatedAt is the time the key was created at.
+	CreatedAt time.Time `yaml:"createdAt"`
+	// RetireAt is the time after which the tokens signed with the key are no longer accepted.
+	// The key is not used for signing once a newer key exists.
+	RetireAt *time.Time `yaml:"retireAt,omitempty"`
+}
+
+// IsRetired returns true if the tokens signed with the key are no longer accepted at the given time.
+func (k Key) IsRetired(now time.Time) bool {
+	return k.RetireAt != nil && !now.Before(*k.RetireAt)
+}
+
+// KeySet is the set of the keys for signing and verifying the JWT tokens.
+// The keys are ordered by creation time, the last key is used for signing.
+type KeySet struct {
+	Keys []Key `yaml:"keys"`
+}
+
+// Newest returns the key used for signing the tokens, or nil if the set is empty.
+func (s KeySet) Newest() *Key {
+	if len(s.Keys) == 0 {
+		return nil
+	}
+	return &s.Keys[len(s.Keys)-1]
+}
+
+// Active returns the keys that have not been retired at the given time.
+func (s KeySet) Active(now time.Time) []Key {
+	return slices.DeleteFunc(slices.Clone(s.Keys), func(k Key) bool {
+		return k.IsRetired(now)
+	})
+}
+
+// Rotate makes key the signing key. The previous keys are retired after gracePeriod,
+// so that the tokens signed with them remain valid meanwhile. The retired keys are removed,
+// except for the ones keep returns true for, e.g. because they still verify long-lived tokens.
+// keep may be nil.
+func (s *KeySet) Rotate(key Key, gracePeriod time.Duration, now time.Time, keep func(Key) bool) {
+	retireAt := now.Add(gracePeriod)
+	keys := slices.DeleteFunc(slices.Clone(s.Keys), func(k Key) bool {
+		return k.IsRetired(now) && (keep == nil || !keep(k))
+	})
+	for i := range keys {
+		// A key already scheduled for retirement keeps its earlier retirement time.
+		if keys[i].RetireAt == nil || keys[i].RetireAt.After(retireAt) {
+			keys[i].RetireAt = &retireAt
+		}
+	}
+	s.Keys = append(keys, key)
+}
+
+// KeyID returns the RFC 7638 thumbprint of the public key, which is used as the key ID.
+func KeyID(key *rsa.PublicKey) string {
+	// The members are in lexicographic order and without whitespace, as required by the RFC.
+	thumbprint, _ := json.Marshal(struct { //nolint:errchkjson
+		E   string `json:"e"`
+		Kty string `json:"kty"`
+		N   string `json:"n"`
+	}{
+		E:   encodeInt(big.NewInt(int64(key.E))),
+		Kty: "RSA",
+		N:   encodeInt(key.N),
+	})
+	sum := sha256.Sum256(thumbprint)
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// ParsePrivateKey parses the PEM encoded RSA private key used for signing the JWT tokens.
+func ParsePrivateKey(pemBytes []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(pemBytes)
+	if block == nil {
+		return nil, errors.New("failed to decode JWT private key")
+	}
+	return x509.ParsePKCS1PrivateKey(block.Bytes)
+}
+
+// JSONWebKey is the RFC 7517 representation of an RSA public key used for verifying the JWT tokens.
+type JSONWebKey struct {
+	KeyType   string `json:"kty"`
+	Use       string `json:"use"`
+	Algorithm string `json:"alg"`
+	KeyID     string `json:"kid"`
+	N         string `json:"n"`
+	E         string `json:"e"`
+}
+
+// JSONWebKeySet is the RFC 7517 set of the public keys used for verifying the JWT tokens.
+type JSONWebKeySet struct {
+	Keys []JSONWebKey `json:"keys"`
+}
+
+// NewJSONWebKey returns the JSON Web Key of the RSA public key used for verifying RS256 signatures.
+func NewJSONWebKey(id string, key *rsa.PublicKey) JSONWebKey {
+	return JSONWebKey{
+		KeyType:   "RSA",
+		Use:       "sig",
+		Algorithm: "RS256",
+		KeyID:     id,
+		N:         encodeInt(key.N),
+		E:         encodeInt(big.NewInt(int64(key.E))),
+	}
+}
+
+func encodeInt(i *big.Int) string {
+	return base64.RawURLEncoding.EncodeToString(i.Bytes())
+}
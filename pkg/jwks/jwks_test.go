@@ -0,0 +1,87 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jwks
+
+import (
+	"crypto/rsa"
+	"encoding/base64"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeySetRotate(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	s := KeySet{}
+	assert.Nil(t, s.Newest())
+
+	s.Rotate(Key{ID: "k1", CreatedAt: now}, time.Hour, now, nil)
+	require.Len(t, s.Keys, 1)
+	assert.Equal(t, "k1", s.Newest().ID)
+	assert.Nil(t, s.Newest().RetireAt)
+
+	// The previous key stays active for the grace period.
+	now = now.Add(time.Minute)
+	s.Rotate(Key{ID: "k2", CreatedAt: now}, time.Hour, now, nil)
+	require.Len(t, s.Keys, 2)
+	assert.Equal(t, "k2", s.Newest().ID)
+	assert.Len(t, s.Active(now), 2)
+	assert.Len(t, s.Active(now.Add(time.Hour)), 1)
+
+	// A shorter grace period brings the retirement forward, a longer one does not postpone it.
+	s.Rotate(Key{ID: "k3", CreatedAt: now}, 10*time.Minute, now, nil)
+	assert.Equal(t, now.Add(10*time.Minute), *s.Keys[0].RetireAt)
+	s.Rotate(Key{ID: "k4", CreatedAt: now}, 2*time.Hour, now, nil)
+	assert.Equal(t, now.Add(10*time.Minute), *s.Keys[0].RetireAt)
+	assert.Equal(t, now.Add(2*time.Hour), *s.Keys[2].RetireAt)
+
+	// The retired keys are removed on rotation.
+	now = now.Add(3 * time.Hour)
+	s.Rotate(Key{ID: "k5", CreatedAt: now}, time.Hour, now, nil)
+	require.Len(t, s.Keys, 2)
+	assert.Equal(t, "k4", s.Keys[0].ID)
+	assert.Equal(t, "k5", s.Keys[1].ID)
+
+	// The retired keys are kept as long as they are needed.
+	now = now.Add(2 * time.Hour)
+	keep := func(k Key) bool { return k.ID == "k4" }
+	s.Rotate(Key{ID: "k6", CreatedAt: now}, time.Hour, now, keep)
+	require.Len(t, s.Keys, 3)
+	assert.True(t, s.Keys[0].IsRetired(now))
+	s.Rotate(Key{ID: "k7", CreatedAt: now}, time.Hour, now, nil)
+	require.Len(t, s.Keys, 3)
+	assert.Equal(t, "k5", s.Keys[0].ID)
+}
+
+func TestKeyID(t *testing.T) {
+	t.Parallel()
+
+	// The example key of RFC 7638, section 3.1.
+	modulus, err := base64.RawURLEncoding.DecodeString("0vx7agoebGcQSuuPiLJXZptN9nndrQmbXEps2aiAFbWhM78LhWx4cbbfAAtVT86zwu1RK7aPFFxuhDR1L6tSoc_BJECPebWKRXjBZCiFV4n3oknjhMstn64tZ_2W-5JsGY4Hc5n9yBXArwl93lqt7_RN5w6Cf0h4QyQ5v-65YGjQR0_FDW2QvzqY368QQMicAtaSqzs8KJZgnYb9c7d0zgdAZHzu6qMQvRL5hajrn1n91CbOpbISD08qNLyrdkt-bFTWhAI4vMQFh6WeZu0fM4lFd2NcRwr3XPksINHaQ-G_xBniIqbw0Ls1jF44-csFCur-kEgU8awapJzKnqDKgw")
+	require.NoError(t, err)
+	n := new(big.Int).SetBytes(modulus)
+	key := &rsa.PublicKey{N: n, E: 65537}
+	assert.Equal(t, "NzbLsXh8uDCcd-6MNwXF4W_7noWXFZAfHkxZsRGC9Xs", KeyID(key))
+
+	jwk := NewJSONWebKey(KeyID(key), key)
+	assert.Equal(t, "AQAB", jwk.E)
+	assert.Equal(t, "RS256", jwk.Algorithm)
+}
@@ -22,11 +22,15 @@ import (
 	"crypto/subtle"
 	"errors"
 	"fmt"
+	"slices"
 	"time"
 
+	"go.uber.org/zap"
 	"golang.org/x/crypto/pbkdf2"
 	"gopkg.in/yaml.v2"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
 
 	"github.com/percona/everest/pkg/accounts"
 	"github.com/percona/everest/pkg/common"
@@ -37,18 +41,20 @@ const (
 	insecurePasswordAnnotation = "insecure-password/%s"
 	insecurePasswordValueTrue  = "true"
 
+	// Parameters of the legacy PBKDF2-SHA256 password hashes.
 	keyLength = 32
 	iter      = 4096
 )
 
 type configMapsClient struct {
 	k KubernetesConnector
+	l *zap.SugaredLogger
 }
 
 // Accounts returns an implementation of the accounts interface that
 // manages everest accounts directly via ConfigMaps.
 func (k *Kubernetes) Accounts() accounts.Interface {
-	return &configMapsClient{k: k}
+	return &configMapsClient{k: k, l: k.l}
 }
 
 // Get returns an account by username.
@@ -83,78 +89,117 @@ func (a *configMapsClient) listAllAccounts(ctx context.Context) (map[string]*acc
 
 // Create a new user account.
 func (a *configMapsClient) Create(ctx context.Context, username, password string) error {
-	// Ensure that the user does not already exist.
-	_, err := a.Get(ctx, username)
-	if err != nil && !errors.Is(err, accounts.ErrAccountNotFound) {
-		return errors.Join(err, errors.New("failed to check if account already exists"))
-	} else if err == nil {
-		return accounts.ErrUserAlreadyExists
-	}
-
 	if password == "" {
 		return errors.New("password cannot be empty")
 	}
 
+	policy, err := a.GetPasswordPolicy(ctx)
+	if err != nil {
+		return errors.Join(err, errors.New("failed to get password policy"))
+	}
+	if err := policy.Check(password); err != nil {
+		return err
+	}
+
 	// Compute a hash for the password.
-	hash, err := a.computePasswordHash(ctx, password)
+	hash, err := accounts.HashPassword(password)
 	if err != nil {
 		return errors.Join(err, errors.New("failed to compute hash"))
 	}
 
-	account := &accounts.Account{
-		Enabled:       true,
-		Capabilities:  []accounts.AccountCapability{accounts.AccountCapabilityLogin},
-		PasswordMtime: time.Now().Format(time.RFC3339),
-		PasswordHash:  hash,
-	}
-	return a.insertOrUpdateAccount(ctx, username, account, true)
+	return a.updateAccounts(ctx, func(secret *corev1.Secret, users map[string]*accounts.Account) error {
+		// Ensure that the user does not already exist.
+		if _, found := users[username]; found {
+			return accounts.ErrUserAlreadyExists
+		}
+		users[username] = &accounts.Account{
+			Enabled:       true,
+			Capabilities:  []accounts.AccountCapability{accounts.AccountCapabilityLogin},
+			PasswordMtime: time.Now().Format(time.RFC3339),
+			PasswordHash:  hash,
+		}
+		setPasswordAnnotation(secret, username, true)
+		return nil
+	})
 }
 
 // SetPassword sets a new password for an existing user account.
 func (a *configMapsClient) SetPassword(ctx context.Context, username, newPassword string, secure bool) error {
-	user, err := a.Get(ctx, username)
-	if err != nil {
-		return err
-	}
-	user.PasswordHash = newPassword
+	var policy *accounts.PasswordPolicy
+	pwHash := newPassword
 	if secure {
-		pwHash, err := a.computePasswordHash(ctx, newPassword)
-		if err != nil {
+		var err error
+		if policy, err = a.GetPasswordPolicy(ctx); err != nil {
+			return errors.Join(err, errors.New("failed to get password policy"))
+		}
+		if err := policy.Check(newPassword); err != nil {
+			return err
+		}
+		if pwHash, err = accounts.HashPassword(newPassword); err != nil {
 			return err
 		}
-		user.PasswordHash = pwHash
 	}
-	user.PasswordMtime = time.Now().Format(time.RFC3339)
-	return a.insertOrUpdateAccount(ctx, username, user, secure)
+
+	return a.updateAccounts(ctx, func(secret *corev1.Secret, users map[string]*accounts.Account) error {
+		user, found := users[username]
+		if !found {
+			return accounts.ErrAccountNotFound
+		}
+		if secure {
+			// Only hashed passwords are kept in the history.
+			wasSecure := isSecurePassword(secret, username)
+			if err := a.updatePasswordHistory(ctx, user, newPassword, wasSecure, policy.HistorySize); err != nil {
+				return err
+			}
+		}
+		user.PasswordHash = pwHash
+		user.PasswordMtime = time.Now().Format(time.RFC3339)
+		setPasswordAnnotation(secret, username, secure)
+		return nil
+	})
 }
 
-func (a *configMapsClient) insertOrUpdateAccount(
+// updatePasswordHistory rejects newPassword if it matches the current or one of the previous passwords of the user,
+// and records the current password in the history which is limited to historySize entries.
+func (a *configMapsClient) updatePasswordHistory(
 	ctx context.Context,
-	username string,
-	account *accounts.Account,
-	secure bool,
+	user *accounts.Account,
+	newPassword string,
+	currentIsHash bool,
+	historySize int,
 ) error {
-	secret, err := a.k.GetSecret(ctx, types.NamespacedName{Namespace: common.SystemNamespace, Name: common.EverestAccountsSecretName})
-	if err != nil {
-		return err
+	if historySize <= 0 {
+		user.PasswordHistory = nil
+		return nil
 	}
-
-	accounts := make(map[string]*accounts.Account)
-	if err := yaml.Unmarshal(secret.Data[common.EverestAccountsFileName], &accounts); err != nil {
-		return err
+	// The hashes are salted per user, so the new password is verified against each of them.
+	hashes := user.PasswordHistory
+	if currentIsHash {
+		hashes = append([]string{user.PasswordHash}, hashes...)
 	}
-
-	accounts[username] = account
-	data, err := yaml.Marshal(accounts)
-	if err != nil {
-		return err
+	for _, h := range hashes {
+		matches, err := a.matchesPasswordHash(ctx, h, newPassword)
+		if err != nil {
+			return err
+		}
+		if matches {
+			return accounts.ErrPasswordReused
+		}
 	}
-
-	if secret.Data == nil {
-		secret.Data = make(map[string][]byte)
+	history := user.PasswordHistory
+	if currentIsHash && user.PasswordHash != "" {
+		history = append([]string{user.PasswordHash}, history...)
 	}
-	secret.Data[common.EverestAccountsFileName] = data
+	if len(history) > historySize {
+		history = history[:historySize]
+	}
+	user.PasswordHistory = history
+	return nil
+}
 
+// setPasswordAnnotation records in the annotations of the accounts Secret
+// whether the password of the user is stored as a hash.
+func setPasswordAnnotation(secret *corev1.Secret, username string, secure bool) {
 	annotations := secret.GetAnnotations()
 	if annotations == nil {
 		annotations = make(map[string]string)
@@ -164,11 +209,13 @@ func (a *configMapsClient) insertOrUpdateAccount(
 		annotations[fmt.Sprintf(insecurePasswordAnnotation, username)] = insecurePasswordValueTrue
 	}
 	secret.SetAnnotations(annotations)
+}
 
-	if _, err := a.k.UpdateSecret(ctx, secret); err != nil {
-		return err
-	}
-	return nil
+// isSecurePassword returns true if the annotations of the accounts Secret
+// do not mark the password of the user as stored in plain text.
+func isSecurePassword(secret *corev1.Secret, username string) bool {
+	isSecure, found := secret.GetAnnotations()[fmt.Sprintf(insecurePasswordAnnotation, username)]
+	return !found || isSecure != insecurePasswordValueTrue
 }
 
 func (a *configMapsClient) salt(ctx context.Context) ([]byte, error) {
@@ -228,35 +275,70 @@ func (a *configMapsClient) Verify(ctx context.Context, username, password string
 		return !found
 	}
 
-	actual := user.PasswordHash
-	provided := password
-
-	if shouldCompareAsHash() {
-		computedHash, err := a.computePasswordHash(ctx, password)
-		if err != nil {
-			return err
+	if !shouldCompareAsHash() {
+		if subtle.ConstantTimeCompare([]byte(user.PasswordHash), []byte(password)) == 0 {
+			return accounts.ErrIncorrectPassword
 		}
-		provided = computedHash
+		return nil
 	}
 
-	if subtle.ConstantTimeCompare([]byte(actual), []byte(provided)) == 0 {
+	matches, err := a.matchesPasswordHash(ctx, user.PasswordHash, password)
+	if err != nil {
+		return err
+	}
+	if !matches {
 		return accounts.ErrIncorrectPassword
 	}
+	if accounts.PasswordHashNeedsRehash(user.PasswordHash) {
+		a.rehashPassword(ctx, username, user.PasswordHash, password)
+	}
 	return nil
 }
 
+// rehashPassword replaces the outdated hash of the verified password with a hash in the current format.
+// The password is not changed, so its modification time and the issued tokens are kept.
+// Failing to rehash must not fail the login, since the outdated hash is still valid, so errors are only logged.
+func (a *configMapsClient) rehashPassword(ctx context.Context, username, oldHash, password string) {
+	newHash, err := accounts.HashPassword(password)
+	if err == nil {
+		err = a.updateAccount(ctx, username, func(user *accounts.Account) error {
+			// Skip the update if the password has been changed since it was verified.
+			if user.PasswordHash == oldHash {
+				user.PasswordHash = newHash
+			}
+			return nil
+		})
+	}
+	if err != nil {
+		a.l.Warnf("failed to rehash the password of user '%s': %v", username, err)
+	}
+}
+
+// matchesPasswordHash returns true if the password matches the hash which is either
+// in the current format or a legacy PBKDF2 hash.
+func (a *configMapsClient) matchesPasswordHash(ctx context.Context, hash, password string) (bool, error) {
+	if !accounts.IsLegacyPasswordHash(hash) {
+		return accounts.VerifyPasswordHash(hash, password)
+	}
+	legacyHash, err := a.computeLegacyPasswordHash(ctx, password)
+	if err != nil {
+		return false, err
+	}
+	return subtle.ConstantTimeCompare([]byte(hash), []byte(legacyHash)) == 1, nil
+}
+
 // IsSecure returns true if the password for the given user is stored as a hash.
 func (a *configMapsClient) IsSecure(ctx context.Context, username string) (bool, error) {
 	secret, err := a.k.GetSecret(ctx, types.NamespacedName{Namespace: common.SystemNamespace, Name: common.EverestAccountsSecretName})
 	if err != nil {
 		return false, err
 	}
-	annotations := secret.GetAnnotations()
-	isSecure, found := annotations[fmt.Sprintf(insecurePasswordAnnotation, username)]
-	return !found || isSecure != insecurePasswordValueTrue, nil
+	return isSecurePassword(secret, username), nil
 }
 
-func (a *configMapsClient) computePasswordHash(ctx context.Context, password string) (string, error) {
+// computeLegacyPasswordHash returns the PBKDF2-SHA256 hash of the password salted with the UID of the system namespace.
+// It is used only to verify the passwords set before argon2id hashes were introduced.
+func (a *configMapsClient) computeLegacyPasswordHash(ctx context.Context, password string) (string, error) {
 	salt, err := a.salt(ctx)
 	if err != nil {
 		return "", errors.Join(err, errors.New("failed to get salt"))
@@ -264,3 +346,252 @@ func (a *configMapsClient) computePasswordHash(ctx context.Context, password str
 	hash := pbkdf2.Key([]byte(password), salt, iter, keyLength, sha256.New)
 	return string(hash), nil
 }
+
+// SetCapabilities replaces the capabilities of an existing user account.
+func (a *configMapsClient) SetCapabilities(ctx context.Context, username string, capabilities []accounts.AccountCapability) error {
+	return a.updateAccount(ctx, username, func(user *accounts.Account) error {
+		user.Capabilities = capabilities
+		return nil
+	})
+}
+
+// SetEnabled enables or disables an existing user account.
+func (a *configMapsClient) SetEnabled(ctx context.Context, username string, enabled bool) error {
+	return a.updateAccount(ctx, username, func(user *accounts.Account) error {
+		user.Enabled = enabled
+		return nil
+	})
+}
+
+// RecordFailedLogin records a failed login of an existing user account
+// and returns true if the account has been locked according to the policy.
+func (a *configMapsClient) RecordFailedLogin(ctx context.Context, username string, policy accounts.LockoutPolicy) (bool, error) {
+	locked := false
+	err := a.updateAccount(ctx, username, func(user *accounts.Account) error {
+		locked = user.RecordFailedLogin(time.Now().UTC().Truncate(time.Second), policy)
+		return nil
+	})
+	return locked, err
+}
+
+// Unlock unlocks an existing user account and resets its failed logins.
+func (a *configMapsClient) Unlock(ctx context.Context, username string) error {
+	return a.updateAccount(ctx, username, func(user *accounts.Account) error {
+		user.Lockout = nil
+		return nil
+	})
+}
+
+// EnrollMFA enrolls an existing user account in TOTP multi-factor authentication.
+func (a *configMapsClient) EnrollMFA(ctx context.Context, username string) (*accounts.MFAEnrollment, error) {
+	var enrollment *accounts.MFAEnrollment
+	err := a.updateAccount(ctx, username, func(user *accounts.Account) error {
+		var err error
+		enrollment, err = user.EnrollMFA(username)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return enrollment, nil
+}
+
+// ResetMFA removes the multi-factor authentication of an existing user account.
+func (a *configMapsClient) ResetMFA(ctx context.Context, username string) error {
+	return a.updateAccount(ctx, username, func(user *accounts.Account) error {
+		user.ResetMFA()
+		return nil
+	})
+}
+
+// VerifyMFA verifies a TOTP code or a recovery code of an existing user account.
+// Accounts that are not enrolled in MFA accept any code.
+func (a *configMapsClient) VerifyMFA(ctx context.Context, username, code string) error {
+	user, err := a.Get(ctx, username)
+	if err != nil {
+		return err
+	}
+	if !user.MFAEnabled() {
+		return nil
+	}
+	// The used codes are recorded, so that they cannot be used again.
+	return a.updateAccount(ctx, username, func(user *accounts.Account) error {
+		return user.VerifyMFACode(code, time.Now())
+	})
+}
+
+// CreateAPIKey adds an API key to an existing user account.
+func (a *configMapsClient) CreateAPIKey(ctx context.Context, username string, key accounts.APIKey) error {
+	return a.updateAccount(ctx, username, func(user *accounts.Account) error {
+		if slices.ContainsFunc(user.APIKeys, func(k accounts.APIKey) bool { return k.Name == key.Name }) {
+			return accounts.ErrAPIKeyAlreadyExists
+		}
+		user.APIKeys = append(user.APIKeys, key)
+		return nil
+	})
+}
+
+// DeleteAPIKey removes the API key with the given name from a user account and returns it.
+func (a *configMapsClient) DeleteAPIKey(ctx context.Context, username, name string) (*accounts.APIKey, error) {
+	var deleted *accounts.APIKey
+	err := a.updateAccount(ctx, username, func(user *accounts.Account) error {
+		idx := slices.IndexFunc(user.APIKeys, func(k accounts.APIKey) bool { return k.Name == name })
+		if idx < 0 {
+			return accounts.ErrAPIKeyNotFound
+		}
+		deleted = &user.APIKeys[idx]
+		user.APIKeys = slices.Delete(slices.Clone(user.APIKeys), idx, idx+1)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return deleted, nil
+}
+
+// SetAPIKeyLastUsed records the time the API key with the given ID was last used.
+func (a *configMapsClient) SetAPIKeyLastUsed(ctx context.Context, username, id string, lastUsed time.Time) error {
+	return a.updateAccount(ctx, username, func(user *accounts.Account) error {
+		key := user.APIKey(id)
+		if key == nil {
+			return accounts.ErrAPIKeyNotFound
+		}
+		key.LastUsedAt = &lastUsed
+		return nil
+	})
+}
+
+// AddSession registers a new session of a user account.
+// The expired sessions of the account are removed meanwhile, so that they do not pile up.
+func (a *configMapsClient) AddSession(ctx context.Context, username string, session accounts.Session) error {
+	return a.updateAccount(ctx, username, func(user *accounts.Account) error {
+		user.Sessions = append(user.ActiveSessions(time.Now()), session)
+		return nil
+	})
+}
+
+// ReplaceSession replaces the session with the given ID of a user account, e.g. when the session is refreshed.
+func (a *configMapsClient) ReplaceSession(ctx context.Context, username, id string, session accounts.Session) error {
+	return a.updateAccount(ctx, username, func(user *accounts.Account) error {
+		current := user.Session(id)
+		if current == nil {
+			return accounts.ErrSessionNotFound
+		}
+		*current = session
+		return nil
+	})
+}
+
+// DeleteSessions removes the sessions with the given IDs from a user account and returns them.
+// The sessions that no longer exist are skipped, ErrSessionNotFound is returned only if none of them exists.
+// All the sessions of the account are removed if no ID is given.
+func (a *configMapsClient) DeleteSessions(ctx context.Context, username string, ids ...string) ([]accounts.Session, error) {
+	var deleted []accounts.Session
+	err := a.updateAccount(ctx, username, func(user *accounts.Account) error {
+		deleted = nil
+		if len(ids) == 0 {
+			deleted = user.Sessions
+			user.Sessions = nil
+			return nil
+		}
+		kept := make([]accounts.Session, 0, len(user.Sessions))
+		for _, s := range user.Sessions {
+			if slices.Contains(ids, s.ID) {
+				deleted = append(deleted, s)
+				continue
+			}
+			kept = append(kept, s)
+		}
+		if len(deleted) == 0 {
+			return accounts.ErrSessionNotFound
+		}
+		user.Sessions = kept
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return deleted, nil
+}
+
+// updateAccount applies mutate to an existing user account and stores the result.
+// It keeps the password annotations of the account as they are.
+func (a *configMapsClient) updateAccount(ctx context.Context, username string, mutate func(*accounts.Account) error) error {
+	return a.updateAccounts(ctx, func(_ *corev1.Secret, users map[string]*accounts.Account) error {
+		user, found := users[username]
+		if !found {
+			return accounts.ErrAccountNotFound
+		}
+		return mutate(user)
+	})
+}
+
+// updateAccounts applies mutate to the accounts and to the Secret that holds them, and stores the result.
+// The accounts are read again and mutate is called again if the Secret was modified meanwhile,
+// so that the concurrent updates of the accounts are not lost.
+func (a *configMapsClient) updateAccounts(
+	ctx context.Context,
+	mutate func(*corev1.Secret, map[string]*accounts.Account) error,
+) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		secret, err := a.k.GetSecret(ctx, types.NamespacedName{Namespace: common.SystemNamespace, Name: common.EverestAccountsSecretName})
+		if err != nil {
+			return err
+		}
+
+		users := make(map[string]*accounts.Account)
+		if err := yaml.Unmarshal(secret.Data[common.EverestAccountsFileName], users); err != nil {
+			return err
+		}
+		if err := mutate(secret, users); err != nil {
+			return err
+		}
+
+		data, err := yaml.Marshal(users)
+		if err != nil {
+			return err
+		}
+		if secret.Data == nil {
+			secret.Data = make(map[string][]byte)
+		}
+		secret.Data[common.EverestAccountsFileName] = data
+		_, err = a.k.UpdateSecret(ctx, secret)
+		return err
+	})
+}
+
+// GetPasswordPolicy returns the password policy of the accounts.
+// An empty policy is returned if no policy has been set.
+func (a *configMapsClient) GetPasswordPolicy(ctx context.Context) (*accounts.PasswordPolicy, error) {
+	secret, err := a.k.GetSecret(ctx, types.NamespacedName{Namespace: common.SystemNamespace, Name: common.EverestAccountsSecretName})
+	if err != nil {
+		return nil, err
+	}
+	policy := &accounts.PasswordPolicy{}
+	if err := yaml.Unmarshal(secret.Data[common.EverestPasswordPolicyFileName], policy); err != nil {
+		return nil, errors.Join(err, errors.New("failed to parse password policy"))
+	}
+	return policy, nil
+}
+
+// SetPasswordPolicy sets the password policy of the accounts.
+// The policy applies to the passwords set afterwards, except for the maximum age which applies at login.
+func (a *configMapsClient) SetPasswordPolicy(ctx context.Context, policy accounts.PasswordPolicy) error {
+	if err := policy.Validate(); err != nil {
+		return err
+	}
+	secret, err := a.k.GetSecret(ctx, types.NamespacedName{Namespace: common.SystemNamespace, Name: common.EverestAccountsSecretName})
+	if err != nil {
+		return err
+	}
+	data, err := yaml.Marshal(policy)
+	if err != nil {
+		return err
+	}
+	if secret.Data == nil {
+		secret.Data = make(map[string][]byte)
+	}
+	secret.Data[common.EverestPasswordPolicyFileName] = data
+	_, err = a.k.UpdateSecret(ctx, secret)
+	return err
+}
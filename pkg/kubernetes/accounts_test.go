@@ -16,13 +16,25 @@
 package kubernetes
 
 import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
+	"golang.org/x/crypto/pbkdf2"
+	"gopkg.in/yaml.v2"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 
 	"github.com/percona/everest/pkg/accounts"
 	"github.com/percona/everest/pkg/common"
@@ -48,3 +60,225 @@ func TestAccounts(t *testing.T) {
 	k := NewEmpty(zap.NewNop().Sugar()).WithKubernetesClient(mockClient.Build())
 	accounts.Tests(t, k.Accounts())
 }
+
+func TestAccountsMixedPasswordHashes(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	salt := "namespace-uid"
+	legacyHash := func(password string) string {
+		return string(pbkdf2.Key([]byte(password), []byte(salt), iter, keyLength, sha256.New))
+	}
+	currentHash, err := accounts.HashPassword("current-password")
+	require.NoError(t, err)
+	passwordMtime := "2025-01-01T00:00:00Z"
+	users := map[string]*accounts.Account{
+		"legacy": {
+			Enabled:         true,
+			PasswordHash:    legacyHash("legacy-password"),
+			PasswordMtime:   passwordMtime,
+			PasswordHistory: []string{legacyHash("old-password")},
+		},
+		"current": {
+			Enabled:       true,
+			PasswordHash:  currentHash,
+			PasswordMtime: passwordMtime,
+		},
+		"plain": {
+			Enabled:      true,
+			PasswordHash: "plain-password",
+		},
+	}
+	data, err := yaml.Marshal(users)
+	require.NoError(t, err)
+
+	objs := []ctrlclient.Object{
+		&corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{Name: common.SystemNamespace, UID: types.UID(salt)},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        common.EverestAccountsSecretName,
+				Namespace:   common.SystemNamespace,
+				Annotations: map[string]string{"insecure-password/plain": "true"},
+			},
+			Data: map[string][]byte{
+				common.EverestAccountsFileName:       data,
+				common.EverestPasswordPolicyFileName: []byte("historySize: 2"),
+			},
+		},
+	}
+	mockClient := fakeclient.NewClientBuilder().WithScheme(CreateScheme()).WithObjects(objs...)
+	a := NewEmpty(zap.NewNop().Sugar()).WithKubernetesClient(mockClient.Build()).Accounts()
+
+	// A failed verification keeps the legacy hash.
+	require.ErrorIs(t, a.Verify(ctx, "legacy", "wrong"), accounts.ErrIncorrectPassword)
+	legacy, err := a.Get(ctx, "legacy")
+	require.NoError(t, err)
+	assert.True(t, accounts.IsLegacyPasswordHash(legacy.PasswordHash))
+
+	// A successful verification replaces the legacy hash without changing the password.
+	require.NoError(t, a.Verify(ctx, "legacy", "legacy-password"))
+	legacy, err = a.Get(ctx, "legacy")
+	require.NoError(t, err)
+	assert.False(t, accounts.IsLegacyPasswordHash(legacy.PasswordHash))
+	assert.Equal(t, passwordMtime, legacy.PasswordMtime)
+	require.NoError(t, a.Verify(ctx, "legacy", "legacy-password"))
+	require.ErrorIs(t, a.Verify(ctx, "legacy", "wrong"), accounts.ErrIncorrectPassword)
+
+	require.NoError(t, a.Verify(ctx, "current", "current-password"))
+	current, err := a.Get(ctx, "current")
+	require.NoError(t, err)
+	assert.Equal(t, currentHash, current.PasswordHash)
+	require.NoError(t, a.Verify(ctx, "plain", "plain-password"))
+
+	// The legacy hashes in the history are still checked for reuse.
+	require.ErrorIs(t, a.SetPassword(ctx, "legacy", "old-password", true), accounts.ErrPasswordReused)
+	require.ErrorIs(t, a.SetPassword(ctx, "legacy", "legacy-password", true), accounts.ErrPasswordReused)
+	require.NoError(t, a.SetPassword(ctx, "legacy", "new-password", true))
+	require.NoError(t, a.Verify(ctx, "legacy", "new-password"))
+}
+
+// newAccountsWithConcurrentReads returns the accounts client of a Secret holding an enabled account "alice".
+// The first n reads of the Secret wait for each other, so that n concurrent updates are made
+// on the same version of the Secret and all but one of them conflict.
+func newAccountsWithConcurrentReads(t *testing.T, n int32) accounts.Interface {
+	t.Helper()
+
+	data, err := yaml.Marshal(map[string]*accounts.Account{"alice": {Enabled: true}})
+	require.NoError(t, err)
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      common.EverestAccountsSecretName,
+			Namespace: common.SystemNamespace,
+		},
+		Data: map[string][]byte{common.EverestAccountsFileName: data},
+	}
+
+	var reads atomic.Int32
+	var firstReads sync.WaitGroup
+	firstReads.Add(int(n))
+	mockClient := fakeclient.NewClientBuilder().WithScheme(CreateScheme()).WithObjects(secret).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Get: func(ctx context.Context, c ctrlclient.WithWatch, key ctrlclient.ObjectKey, obj ctrlclient.Object, opts ...ctrlclient.GetOption) error {
+				if err := c.Get(ctx, key, obj, opts...); err != nil {
+					return err
+				}
+				if reads.Add(1) <= n {
+					firstReads.Done()
+					firstReads.Wait()
+				}
+				return nil
+			},
+		})
+	return NewEmpty(zap.NewNop().Sugar()).WithKubernetesClient(mockClient.Build()).Accounts()
+}
+
+func TestAccountsConcurrentFailedLogins(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	const failures = 5
+	a := newAccountsWithConcurrentReads(t, failures)
+
+	policy := accounts.LockoutPolicy{Threshold: failures + 1, Window: time.Hour, Duration: time.Hour}
+	var wg sync.WaitGroup
+	for range failures {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			locked, err := a.RecordFailedLogin(ctx, "alice", policy)
+			assert.NoError(t, err)
+			assert.False(t, locked)
+		}()
+	}
+	wg.Wait()
+
+	alice, err := a.Get(ctx, "alice")
+	require.NoError(t, err)
+	require.NotNil(t, alice.Lockout)
+	assert.Len(t, alice.Lockout.FailedLogins, failures)
+
+	// The next failure reaches the threshold.
+	locked, err := a.RecordFailedLogin(ctx, "alice", policy)
+	require.NoError(t, err)
+	assert.True(t, locked)
+}
+
+func TestAccountsConcurrentSessions(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	const sessions = 5
+	a := newAccountsWithConcurrentReads(t, sessions)
+
+	now := time.Now().UTC().Truncate(time.Second)
+	var wg sync.WaitGroup
+	for i := range sessions {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, a.AddSession(ctx, "alice", accounts.Session{
+				ID:        fmt.Sprintf("session-%d", i),
+				LoginAt:   now,
+				IssuedAt:  now,
+				ExpiresAt: now.Add(time.Hour),
+			}))
+		}()
+	}
+	wg.Wait()
+
+	alice, err := a.Get(ctx, "alice")
+	require.NoError(t, err)
+	assert.Len(t, alice.Sessions, sessions)
+
+	deleted, err := a.DeleteSessions(ctx, "alice", "session-0", "session-1")
+	require.NoError(t, err)
+	assert.Len(t, deleted, 2)
+	alice, err = a.Get(ctx, "alice")
+	require.NoError(t, err)
+	assert.Len(t, alice.Sessions, sessions-2)
+}
+
+func TestAccountsConcurrentPasswordChange(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	data, err := yaml.Marshal(map[string]*accounts.Account{"alice": {Enabled: true}})
+	require.NoError(t, err)
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      common.EverestAccountsSecretName,
+			Namespace: common.SystemNamespace,
+		},
+		Data: map[string][]byte{common.EverestAccountsFileName: data},
+	}
+	// Another writer disables the account right after the Secret is first read.
+	var reads atomic.Int32
+	mockClient := fakeclient.NewClientBuilder().WithScheme(CreateScheme()).WithObjects(secret).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Get: func(ctx context.Context, c ctrlclient.WithWatch, key ctrlclient.ObjectKey, obj ctrlclient.Object, opts ...ctrlclient.GetOption) error {
+				if err := c.Get(ctx, key, obj, opts...); err != nil {
+					return err
+				}
+				if reads.Add(1) > 1 {
+					return nil
+				}
+				disabled, err := yaml.Marshal(map[string]*accounts.Account{"alice": {Enabled: false}})
+				if err != nil {
+					return err
+				}
+				modified := obj.DeepCopyObject().(*corev1.Secret) //nolint:forcetypeassert
+				modified.Data[common.EverestAccountsFileName] = disabled
+				return c.Update(ctx, modified)
+			},
+		})
+	a := NewEmpty(zap.NewNop().Sugar()).WithKubernetesClient(mockClient.Build()).Accounts()
+
+	require.NoError(t, a.SetPassword(ctx, "alice", "new-password", false))
+
+	alice, err := a.Get(ctx, "alice")
+	require.NoError(t, err)
+	assert.False(t, alice.Enabled)
+	require.NoError(t, a.Verify(ctx, "alice", "new-password"))
+}
@@ -33,8 +33,39 @@ func (k *Kubernetes) ListDataImportJobs(ctx context.Context, namespace, dbName s
 			common.DatabaseClusterNameLabel: dbName,
 		},
 	}
-	if err := k.k8sClient.List(ctx, result, listOpts...); err != nil {
+	if err := k.k8sClient.List(ctx, result, append(listOpts, opts...)...); err != nil {
 		return nil, err
 	}
 	return result, nil
 }
+
+// ListAllDataImportJobs lists DataImportJobs across all namespaces that match the criteria.
+func (k *Kubernetes) ListAllDataImportJobs(ctx context.Context, opts ...ctrlclient.ListOption) (*everestv1alpha1.DataImportJobList, error) {
+	result := &everestv1alpha1.DataImportJobList{}
+	if err := k.k8sClient.List(ctx, result, opts...); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// GetDataImportJob returns DataImportJob that matches the criteria.
+func (k *Kubernetes) GetDataImportJob(ctx context.Context, key ctrlclient.ObjectKey) (*everestv1alpha1.DataImportJob, error) {
+	result := &everestv1alpha1.DataImportJob{}
+	if err := k.k8sClient.Get(ctx, key, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// CreateDataImportJob creates a DataImportJob.
+func (k *Kubernetes) CreateDataImportJob(ctx context.Context, job *everestv1alpha1.DataImportJob) (*everestv1alpha1.DataImportJob, error) {
+	if err := k.k8sClient.Create(ctx, job); err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+// DeleteDataImportJob deletes a DataImportJob.
+func (k *Kubernetes) DeleteDataImportJob(ctx context.Context, obj *everestv1alpha1.DataImportJob, opts ...ctrlclient.DeleteOption) error {
+	return k.k8sClient.Delete(ctx, obj, opts...)
+}
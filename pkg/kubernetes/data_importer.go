@@ -31,3 +31,33 @@ func (k *Kubernetes) ListDataImporters(ctx context.Context, opts ...ctrlclient.L
 	}
 	return result, nil
 }
+
+// GetDataImporter returns DataImporter that matches the criteria.
+func (k *Kubernetes) GetDataImporter(ctx context.Context, key ctrlclient.ObjectKey) (*everestv1alpha1.DataImporter, error) {
+	result := &everestv1alpha1.DataImporter{}
+	if err := k.k8sClient.Get(ctx, key, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// CreateDataImporter creates a DataImporter.
+func (k *Kubernetes) CreateDataImporter(ctx context.Context, di *everestv1alpha1.DataImporter) (*everestv1alpha1.DataImporter, error) {
+	if err := k.k8sClient.Create(ctx, di); err != nil {
+		return nil, err
+	}
+	return di, nil
+}
+
+// UpdateDataImporter updates a DataImporter.
+func (k *Kubernetes) UpdateDataImporter(ctx context.Context, di *everestv1alpha1.DataImporter) (*everestv1alpha1.DataImporter, error) {
+	if err := k.k8sClient.Update(ctx, di); err != nil {
+		return nil, err
+	}
+	return di, nil
+}
+
+// DeleteDataImporter deletes a DataImporter.
+func (k *Kubernetes) DeleteDataImporter(ctx context.Context, obj *everestv1alpha1.DataImporter) error {
+	return k.k8sClient.Delete(ctx, obj)
+}
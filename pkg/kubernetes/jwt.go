@@ -7,18 +7,24 @@ import (
 	"crypto/x509"
 	"encoding/pem"
 	"errors"
+	"fmt"
+	"time"
 
+	"gopkg.in/yaml.v2"
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 
 	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/jwks"
 )
 
 const (
 	privateKeyFile = "id_rsa"
 	publicKeyFile  = "id_rsa.pub"
+	// keySetFile holds all the keys for verifying the tokens. The newest one is also stored in privateKeyFile.
+	keySetFile = "keys.yaml"
 
 	keySize = 1024
 )
@@ -54,21 +60,30 @@ func generatePrivateKey() (*rsa.PrivateKey, error) {
 	return key, nil
 }
 
-// newRSAKeyPair generates a new RSA key pair.
-// Returns: [publicKey, privateKey, error].
-func newRSAKeyPair() ([]byte, []byte, error) {
+// newJWTKey generates a new key for signing the JWT tokens.
+func newJWTKey(now time.Time) (jwks.Key, []byte, error) {
 	privateKey, err := generatePrivateKey()
 	if err != nil {
-		return nil, nil, err
+		return jwks.Key{}, nil, err
 	}
 	publicKey := privateKey.Public().(*rsa.PublicKey) //nolint:forcetypeassert
-	return encodeRSAPublicKey(publicKey), encodeRSAPrivateKey(privateKey), nil
+	key := jwks.Key{
+		ID:         jwks.KeyID(publicKey),
+		PrivateKey: string(encodeRSAPrivateKey(privateKey)),
+		CreatedAt:  now.UTC().Truncate(time.Second),
+	}
+	return key, encodeRSAPublicKey(publicKey), nil
 }
 
 // CreateRSAKeyPair creates a new RSA key pair and stores it in a secret.
+// It replaces all the existing keys, so the tokens signed with them are no longer accepted.
 func (k *Kubernetes) CreateRSAKeyPair(ctx context.Context) error {
 	// Create a new key pair.
-	publicKey, privateKey, err := newRSAKeyPair()
+	key, publicKey, err := newJWTKey(time.Now())
+	if err != nil {
+		return err
+	}
+	keySet, err := yaml.Marshal(jwks.KeySet{Keys: []jwks.Key{key}})
 	if err != nil {
 		return err
 	}
@@ -91,7 +106,8 @@ func (k *Kubernetes) CreateRSAKeyPair(ctx context.Context) error {
 			},
 			Data: map[string][]byte{
 				publicKeyFile:  publicKey,
-				privateKeyFile: privateKey,
+				privateKeyFile: []byte(key.PrivateKey),
+				keySetFile:     keySet,
 			},
 		}
 		if _, err := k.CreateSecret(ctx, secret); err != nil {
@@ -102,11 +118,121 @@ func (k *Kubernetes) CreateRSAKeyPair(ctx context.Context) error {
 	}
 
 	// Otherwise, update the secret.
+	if secret.Data == nil {
+		secret.Data = make(map[string][]byte)
+	}
 	secret.Data[publicKeyFile] = publicKey
-	secret.Data[privateKeyFile] = privateKey
+	secret.Data[privateKeyFile] = []byte(key.PrivateKey)
+	secret.Data[keySetFile] = keySet
 	if _, err := k.UpdateSecret(ctx, secret); err != nil {
 		return err
 	}
 	// Restart the deployment to pick up the new secret.
 	return k.RestartDeployment(ctx, types.NamespacedName{Namespace: common.SystemNamespace, Name: common.PerconaEverestDeploymentName})
 }
+
+// RotateJWTKeys adds a new key for signing the JWT tokens.
+// The tokens signed with the previous keys are accepted for gracePeriod, after which the keys are retired.
+// The retired keys that signed unexpired API keys are kept for verifying the API keys only.
+// The Everest server picks up the new key without a restart.
+func (k *Kubernetes) RotateJWTKeys(ctx context.Context, gracePeriod time.Duration) (*jwks.Key, error) {
+	secret, err := k.GetSecret(ctx, types.NamespacedName{Namespace: common.SystemNamespace, Name: common.EverestJWTSecretName})
+	if err != nil {
+		return nil, err
+	}
+	keySet, err := jwtKeySetFromSecret(secret)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	keep, err := k.apiKeySigningKeys(ctx, now)
+	if err != nil {
+		return nil, err
+	}
+	key, publicKey, err := newJWTKey(now)
+	if err != nil {
+		return nil, err
+	}
+	keySet.Rotate(key, gracePeriod, now, keep)
+	data, err := yaml.Marshal(keySet)
+	if err != nil {
+		return nil, err
+	}
+
+	// The newest key is kept in the legacy files as well, for the consumers that read only a single key.
+	secret.Data[publicKeyFile] = publicKey
+	secret.Data[privateKeyFile] = []byte(key.PrivateKey)
+	secret.Data[keySetFile] = data
+	if _, err := k.UpdateSecret(ctx, secret); err != nil {
+		return nil, err
+	}
+	return &key, nil
+}
+
+// apiKeySigningKeys returns a function that reports whether the key signed any of the API keys
+// that have not expired at the given time, so that the key is kept after it is retired.
+// The API keys issued before their signing key was recorded may be signed with any key older than them.
+func (k *Kubernetes) apiKeySigningKeys(ctx context.Context, now time.Time) (func(jwks.Key) bool, error) {
+	users, err := k.Accounts().List(ctx)
+	if k8serrors.IsNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, errors.Join(err, errors.New("failed to list API keys"))
+	}
+	signingKeyIDs := make(map[string]struct{})
+	var newestUnrecorded time.Time
+	for _, user := range users {
+		for _, apiKey := range user.APIKeys {
+			if apiKey.IsExpired(now) {
+				continue
+			}
+			if apiKey.SigningKeyID != "" {
+				signingKeyIDs[apiKey.SigningKeyID] = struct{}{}
+			} else if apiKey.CreatedAt.After(newestUnrecorded) {
+				newestUnrecorded = apiKey.CreatedAt
+			}
+		}
+	}
+	return func(key jwks.Key) bool {
+		if _, ok := signingKeyIDs[key.ID]; ok {
+			return true
+		}
+		return !newestUnrecorded.IsZero() && !key.CreatedAt.After(newestUnrecorded)
+	}, nil
+}
+
+// GetJWTKeySet returns the keys used for signing and verifying the JWT tokens.
+func (k *Kubernetes) GetJWTKeySet(ctx context.Context) (*jwks.KeySet, error) {
+	secret, err := k.GetSecret(ctx, types.NamespacedName{Namespace: common.SystemNamespace, Name: common.EverestJWTSecretName})
+	if err != nil {
+		return nil, err
+	}
+	return jwtKeySetFromSecret(secret)
+}
+
+// jwtKeySetFromSecret returns the key set stored in the secret.
+// The secrets created before the key rotation was supported hold only a single key.
+func jwtKeySetFromSecret(secret *corev1.Secret) (*jwks.KeySet, error) {
+	if data, ok := secret.Data[keySetFile]; ok {
+		keySet := &jwks.KeySet{}
+		if err := yaml.Unmarshal(data, keySet); err != nil {
+			return nil, errors.Join(err, errors.New("failed to parse JWT key set"))
+		}
+		return keySet, nil
+	}
+
+	pemKey, ok := secret.Data[privateKeyFile]
+	if !ok {
+		return nil, fmt.Errorf("secret %s does not contain the JWT private key", common.EverestJWTSecretName)
+	}
+	privateKey, err := jwks.ParsePrivateKey(pemKey)
+	if err != nil {
+		return nil, err
+	}
+	return &jwks.KeySet{Keys: []jwks.Key{{
+		ID:         jwks.KeyID(&privateKey.PublicKey),
+		PrivateKey: string(pemKey),
+		CreatedAt:  secret.GetCreationTimestamp().UTC(),
+	}}}, nil
+}
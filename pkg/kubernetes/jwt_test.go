@@ -0,0 +1,136 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"gopkg.in/yaml.v2"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/percona/everest/pkg/accounts"
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/jwks"
+)
+
+func TestRotateJWTKeys(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// The secrets created before the key rotation was supported hold a single key.
+	legacyKey, _, err := newJWTKey(time.Now())
+	require.NoError(t, err)
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      common.EverestJWTSecretName,
+			Namespace: common.SystemNamespace,
+		},
+		Data: map[string][]byte{
+			privateKeyFile: []byte(legacyKey.PrivateKey),
+		},
+	}
+	mockClient := fakeclient.NewClientBuilder().WithScheme(CreateScheme()).WithObjects(secret)
+	k := NewEmpty(zap.NewNop().Sugar()).WithKubernetesClient(mockClient.Build())
+
+	keySet, err := k.GetJWTKeySet(ctx)
+	require.NoError(t, err)
+	require.Len(t, keySet.Keys, 1)
+	assert.Equal(t, legacyKey.ID, keySet.Keys[0].ID)
+
+	key, err := k.RotateJWTKeys(ctx, time.Hour)
+	require.NoError(t, err)
+	keySet, err = k.GetJWTKeySet(ctx)
+	require.NoError(t, err)
+	require.Len(t, keySet.Keys, 2)
+	assert.Equal(t, legacyKey.ID, keySet.Keys[0].ID)
+	require.NotNil(t, keySet.Keys[0].RetireAt)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), *keySet.Keys[0].RetireAt, time.Minute)
+	assert.Equal(t, key.ID, keySet.Newest().ID)
+	assert.Nil(t, keySet.Newest().RetireAt)
+
+	// The newest key is kept in the single key files as well.
+	secret, err = k.GetSecret(ctx, types.NamespacedName{Namespace: common.SystemNamespace, Name: common.EverestJWTSecretName})
+	require.NoError(t, err)
+	assert.Equal(t, key.PrivateKey, string(secret.Data[privateKeyFile]))
+	privateKey, err := jwks.ParsePrivateKey(secret.Data[privateKeyFile])
+	require.NoError(t, err)
+	assert.Equal(t, encodeRSAPublicKey(&privateKey.PublicKey), secret.Data[publicKeyFile])
+}
+
+func TestRotateJWTKeysKeepsAPIKeySigningKeys(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	now := time.Now()
+	unrecordedKey, _, err := newJWTKey(now.Add(-3 * time.Hour))
+	require.NoError(t, err)
+	pinnedKey, _, err := newJWTKey(now.Add(-2 * time.Hour))
+	require.NoError(t, err)
+	expiredKey, _, err := newJWTKey(now.Add(-time.Hour))
+	require.NoError(t, err)
+	keySet, err := yaml.Marshal(jwks.KeySet{Keys: []jwks.Key{unrecordedKey, pinnedKey, expiredKey}})
+	require.NoError(t, err)
+
+	expired := now.Add(-time.Minute)
+	users, err := yaml.Marshal(map[string]*accounts.Account{
+		"ci": {
+			Enabled: true,
+			APIKeys: []accounts.APIKey{
+				// A key without expiry.
+				{Name: "pinned", ID: "1", SigningKeyID: pinnedKey.ID, CreatedAt: pinnedKey.CreatedAt},
+				{Name: "expired", ID: "2", SigningKeyID: expiredKey.ID, CreatedAt: expiredKey.CreatedAt, ExpiresAt: &expired},
+				// A key issued before the signing keys were recorded, which may be signed with any older key.
+				{Name: "unrecorded", ID: "3", CreatedAt: unrecordedKey.CreatedAt.Add(time.Minute)},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	mockClient := fakeclient.NewClientBuilder().WithScheme(CreateScheme()).WithObjects(
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: common.EverestJWTSecretName, Namespace: common.SystemNamespace},
+			Data:       map[string][]byte{keySetFile: keySet},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: common.EverestAccountsSecretName, Namespace: common.SystemNamespace},
+			Data:       map[string][]byte{common.EverestAccountsFileName: users},
+		},
+	)
+	k := NewEmpty(zap.NewNop().Sugar()).WithKubernetesClient(mockClient.Build())
+
+	// The keys are retired immediately, and removed on the next rotation unless an API key needs them.
+	previous, err := k.RotateJWTKeys(ctx, 0)
+	require.NoError(t, err)
+	newest, err := k.RotateJWTKeys(ctx, 0)
+	require.NoError(t, err)
+
+	got, err := k.GetJWTKeySet(ctx)
+	require.NoError(t, err)
+	ids := make([]string, 0, len(got.Keys))
+	for _, key := range got.Keys {
+		ids = append(ids, key.ID)
+	}
+	assert.Equal(t, []string{unrecordedKey.ID, pinnedKey.ID, previous.ID, newest.ID}, ids)
+	assert.True(t, got.Keys[1].IsRetired(time.Now()))
+}
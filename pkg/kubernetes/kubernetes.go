@@ -34,6 +34,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/client-go/discovery"
+	clientset "k8s.io/client-go/kubernetes"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
@@ -85,7 +86,9 @@ var once sync.Once
 
 // Kubernetes is a client for Kubernetes.
 type Kubernetes struct {
-	k8sClient  ctrlclient.Client
+	k8sClient ctrlclient.Client
+	// clientset serves the subresources that are not supported by k8sClient, e.g. the pod logs.
+	clientset  clientset.Interface
 	l          *zap.SugaredLogger
 	restConfig *rest.Config
 	kubeconfig string
@@ -122,9 +125,14 @@ func New(kubeconfigPath string, l *zap.SugaredLogger) (KubernetesConnector, erro
 	if err != nil {
 		return nil, err
 	}
+	cs, err := clientset.NewForConfig(restConfig)
+	if err != nil {
+		return nil, err
+	}
 
 	return &Kubernetes{
 		k8sClient:  k8client,
+		clientset:  cs,
 		l:          l.With("component", "kubernetes"),
 		restConfig: restConfig,
 		kubeconfig: path,
@@ -157,9 +165,14 @@ func NewInCluster(l *zap.SugaredLogger, ctx context.Context, cacheOptions *cache
 	if err != nil {
 		return nil, err
 	}
+	cs, err := clientset.NewForConfig(restConfig)
+	if err != nil {
+		return nil, err
+	}
 
 	return &Kubernetes{
 		k8sClient:  k8sclient,
+		clientset:  cs,
 		l:          l.With("component", "kubernetes"),
 		restConfig: restConfig,
 	}, nil
@@ -228,6 +241,12 @@ func (k *Kubernetes) WithKubernetesClient(c ctrlclient.Client) *Kubernetes {
 	return k
 }
 
+// WithClientset sets the clientset used for the subresources not supported by the k8s client.
+func (k *Kubernetes) WithClientset(cs clientset.Interface) *Kubernetes {
+	k.clientset = cs
+	return k
+}
+
 // Namespace returns the Everest system namespace.
 func (k *Kubernetes) Namespace() string {
 	return common.SystemNamespace
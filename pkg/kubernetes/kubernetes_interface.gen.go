@@ -4,6 +4,8 @@ package kubernetes
 
 import (
 	"context"
+	"io"
+	"time"
 
 	goversion "github.com/hashicorp/go-version"
 	olmv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
@@ -19,6 +21,9 @@ import (
 	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
 	"github.com/percona/everest/pkg/accounts"
 	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/jwks"
+	"github.com/percona/everest/pkg/maintenance"
+	"github.com/percona/everest/pkg/versionpolicy"
 )
 
 // KubernetesConnector ...
@@ -117,8 +122,24 @@ type KubernetesConnector interface {
 	SetDatabaseEngineLock(ctx context.Context, key ctrlclient.ObjectKey, locked bool) error
 	// ListDataImporters lists all DataImporters in the cluster.
 	ListDataImporters(ctx context.Context, opts ...ctrlclient.ListOption) (*everestv1alpha1.DataImporterList, error)
+	// GetDataImporter returns DataImporter that matches the criteria.
+	GetDataImporter(ctx context.Context, key ctrlclient.ObjectKey) (*everestv1alpha1.DataImporter, error)
+	// CreateDataImporter creates a DataImporter.
+	CreateDataImporter(ctx context.Context, di *everestv1alpha1.DataImporter) (*everestv1alpha1.DataImporter, error)
+	// UpdateDataImporter updates a DataImporter.
+	UpdateDataImporter(ctx context.Context, di *everestv1alpha1.DataImporter) (*everestv1alpha1.DataImporter, error)
+	// DeleteDataImporter deletes a DataImporter.
+	DeleteDataImporter(ctx context.Context, obj *everestv1alpha1.DataImporter) error
 	// ListDataImportJobs lists all DataImportJobs for the specified database cluster.
 	ListDataImportJobs(ctx context.Context, namespace, dbName string, opts ...ctrlclient.ListOption) (*everestv1alpha1.DataImportJobList, error)
+	// ListAllDataImportJobs lists DataImportJobs across all namespaces that match the criteria.
+	ListAllDataImportJobs(ctx context.Context, opts ...ctrlclient.ListOption) (*everestv1alpha1.DataImportJobList, error)
+	// GetDataImportJob returns DataImportJob that matches the criteria.
+	GetDataImportJob(ctx context.Context, key ctrlclient.ObjectKey) (*everestv1alpha1.DataImportJob, error)
+	// CreateDataImportJob creates a DataImportJob.
+	CreateDataImportJob(ctx context.Context, job *everestv1alpha1.DataImportJob) (*everestv1alpha1.DataImportJob, error)
+	// DeleteDataImportJob deletes a DataImportJob.
+	DeleteDataImportJob(ctx context.Context, obj *everestv1alpha1.DataImportJob, opts ...ctrlclient.DeleteOption) error
 	// GetDeployment returns k8s deployment that matches the criteria.
 	GetDeployment(ctx context.Context, key ctrlclient.ObjectKey) (*appsv1.Deployment, error)
 	// UpdateDeployment updates a deployment and returns the updated object.
@@ -190,7 +211,25 @@ type KubernetesConnector interface {
 	// ListInstalledOperators returns the list of installed operators that match the criteria.
 	ListInstalledOperators(ctx context.Context, opts ...ctrlclient.ListOption) (*olmv1alpha1.SubscriptionList, error)
 	// CreateRSAKeyPair creates a new RSA key pair and stores it in a secret.
+	// It replaces all the existing keys, so the tokens signed with them are no longer accepted.
 	CreateRSAKeyPair(ctx context.Context) error
+	// RotateJWTKeys adds a new key for signing the JWT tokens.
+	// The tokens signed with the previous keys are accepted for gracePeriod, after which the keys are retired.
+	// The retired keys that signed unexpired API keys are kept for verifying the API keys only.
+	// The Everest server picks up the new key without a restart.
+	RotateJWTKeys(ctx context.Context, gracePeriod time.Duration) (*jwks.Key, error)
+	// GetJWTKeySet returns the keys used for signing and verifying the JWT tokens.
+	GetJWTKeySet(ctx context.Context) (*jwks.KeySet, error)
+	// GetMaintenanceConfig returns the maintenance windows config of the given namespace.
+	// An empty config is returned if the namespace has no maintenance windows.
+	GetMaintenanceConfig(ctx context.Context, namespace string) (*maintenance.Config, error)
+	// UpdateMaintenanceConfig creates or updates the maintenance windows config of the given namespace.
+	UpdateMaintenanceConfig(ctx context.Context, namespace string, config *maintenance.Config) error
+	// ClaimQueuedUpgrade removes the queued upgrade from the maintenance windows config of the given namespace
+	// and returns it, or nil if there is none or it was claimed concurrently.
+	// The removal is conditional on the version of the config the upgrade was read from,
+	// so that only one of the Everest replicas starts the upgrade.
+	ClaimQueuedUpgrade(ctx context.Context, namespace string) (*maintenance.QueuedUpgrade, error)
 	// UpdateEverestSettings accepts the full list of Everest settings and updates the settings.
 	UpdateEverestSettings(ctx context.Context, settings common.EverestSettings) error
 	// GetEverestSettings returns Everest settings.
@@ -244,4 +283,12 @@ type KubernetesConnector interface {
 	// ListPods returns list of pods that match the criteria.
 	// This method returns a list of full objects (meta and spec).
 	ListPods(ctx context.Context, opts ...ctrlclient.ListOption) (*corev1.PodList, error)
+	// GetPodLogs returns a stream with the logs of the pod.
+	// The caller is responsible for closing the stream.
+	GetPodLogs(ctx context.Context, namespace, name string, opts *corev1.PodLogOptions) (io.ReadCloser, error)
+	// GetEngineVersionPolicy returns the engine version policy of the given namespace.
+	// An empty policy that allows all versions is returned if the namespace has no policy.
+	GetEngineVersionPolicy(ctx context.Context, namespace string) (*versionpolicy.Policy, error)
+	// UpdateEngineVersionPolicy creates or updates the engine version policy of the given namespace.
+	UpdateEngineVersionPolicy(ctx context.Context, namespace string, policy *versionpolicy.Policy) error
 }
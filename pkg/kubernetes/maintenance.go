@@ -0,0 +1,101 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/maintenance"
+)
+
+// GetMaintenanceConfig returns the maintenance windows config of the given namespace.
+// An empty config is returned if the namespace has no maintenance windows.
+func (k *Kubernetes) GetMaintenanceConfig(ctx context.Context, namespace string) (*maintenance.Config, error) {
+	cm, err := k.GetConfigMap(ctx, types.NamespacedName{Namespace: namespace, Name: maintenance.ConfigMapName})
+	if k8serrors.IsNotFound(err) {
+		return &maintenance.Config{}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return maintenance.FromConfigMapData(cm.Data)
+}
+
+// UpdateMaintenanceConfig creates or updates the maintenance windows config of the given namespace.
+func (k *Kubernetes) UpdateMaintenanceConfig(ctx context.Context, namespace string, config *maintenance.Config) error {
+	data, err := config.ToConfigMapData()
+	if err != nil {
+		return err
+	}
+
+	cm, err := k.GetConfigMap(ctx, types.NamespacedName{Namespace: namespace, Name: maintenance.ConfigMapName})
+	if k8serrors.IsNotFound(err) {
+		_, err = k.CreateConfigMap(ctx, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      maintenance.ConfigMapName,
+				Labels: map[string]string{
+					common.KubernetesManagedByLabel: common.Everest,
+				},
+			},
+			Data: data,
+		})
+		return err
+	} else if err != nil {
+		return err
+	}
+
+	cm.Data = data
+	_, err = k.UpdateConfigMap(ctx, cm)
+	return err
+}
+
+// ClaimQueuedUpgrade removes the queued upgrade from the maintenance windows config of the given namespace
+// and returns it, or nil if there is none or it was claimed concurrently.
+// The removal is conditional on the version of the config the upgrade was read from,
+// so that only one of the Everest replicas starts the upgrade.
+func (k *Kubernetes) ClaimQueuedUpgrade(ctx context.Context, namespace string) (*maintenance.QueuedUpgrade, error) {
+	cm, err := k.GetConfigMap(ctx, types.NamespacedName{Namespace: namespace, Name: maintenance.ConfigMapName})
+	if k8serrors.IsNotFound(err) {
+		return nil, nil //nolint:nilnil
+	} else if err != nil {
+		return nil, err
+	}
+	config, err := maintenance.FromConfigMapData(cm.Data)
+	if err != nil {
+		return nil, err
+	}
+	queued := config.QueuedUpgrade
+	if queued == nil {
+		return nil, nil //nolint:nilnil
+	}
+
+	config.QueuedUpgrade = nil
+	if cm.Data, err = config.ToConfigMapData(); err != nil {
+		return nil, err
+	}
+	if _, err := k.UpdateConfigMap(ctx, cm); k8serrors.IsConflict(err) {
+		return nil, nil //nolint:nilnil
+	} else if err != nil {
+		return nil, err
+	}
+	return queued, nil
+}
@@ -0,0 +1,88 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	"github.com/percona/everest/pkg/maintenance"
+)
+
+func TestClaimQueuedUpgrade(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	config := &maintenance.Config{
+		QueuedUpgrade: &maintenance.QueuedUpgrade{RequestedBy: "admin", RequestedAt: time.Now().UTC().Truncate(time.Second)},
+	}
+	data, err := config.ToConfigMapData()
+	require.NoError(t, err)
+	newConnector := func(funcs interceptor.Funcs) *Kubernetes {
+		cm := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: maintenance.ConfigMapName, Namespace: "ns"},
+			Data:       data,
+		}
+		c := fakeclient.NewClientBuilder().WithScheme(CreateScheme()).WithObjects(cm).WithInterceptorFuncs(funcs).Build()
+		return NewEmpty(zap.NewNop().Sugar()).WithKubernetesClient(c)
+	}
+
+	k := newConnector(interceptor.Funcs{})
+	queued, err := k.ClaimQueuedUpgrade(ctx, "ns")
+	require.NoError(t, err)
+	require.NotNil(t, queued)
+	assert.Equal(t, "admin", queued.RequestedBy)
+	// The upgrade is claimed only once.
+	queued, err = k.ClaimQueuedUpgrade(ctx, "ns")
+	require.NoError(t, err)
+	assert.Nil(t, queued)
+	result, err := k.GetMaintenanceConfig(ctx, "ns")
+	require.NoError(t, err)
+	assert.Nil(t, result.QueuedUpgrade)
+
+	// Another replica claims the upgrade between reading and updating the config.
+	concurrent := true
+	k = newConnector(interceptor.Funcs{
+		Update: func(ctx context.Context, c ctrlclient.WithWatch, obj ctrlclient.Object, opts ...ctrlclient.UpdateOption) error {
+			if concurrent {
+				concurrent = false
+				other := &corev1.ConfigMap{}
+				require.NoError(t, c.Get(ctx, ctrlclient.ObjectKeyFromObject(obj), other))
+				other.Data = map[string]string{}
+				require.NoError(t, c.Update(ctx, other))
+			}
+			return c.Update(ctx, obj, opts...)
+		},
+	})
+	queued, err = k.ClaimQueuedUpgrade(ctx, "ns")
+	require.NoError(t, err)
+	assert.Nil(t, queued)
+
+	// There is nothing to claim in the namespaces without maintenance windows.
+	queued, err = k.ClaimQueuedUpgrade(ctx, "other")
+	require.NoError(t, err)
+	assert.Nil(t, queued)
+}
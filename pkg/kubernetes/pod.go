@@ -2,6 +2,8 @@ package kubernetes
 
 import (
 	"context"
+	"errors"
+	"io"
 
 	corev1 "k8s.io/api/core/v1"
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -16,3 +18,13 @@ func (k *Kubernetes) ListPods(ctx context.Context, opts ...ctrlclient.ListOption
 	}
 	return result, nil
 }
+
+// GetPodLogs returns a stream with the logs of the pod.
+// The caller is responsible for closing the stream.
+func (k *Kubernetes) GetPodLogs(ctx context.Context, namespace, name string, opts *corev1.PodLogOptions) (io.ReadCloser, error) {
+	// Logs are served by a subresource that is not supported by the controller-runtime client.
+	if k.clientset == nil {
+		return nil, errors.New("cannot get pod logs without a clientset")
+	}
+	return k.clientset.CoreV1().Pods(namespace).GetLogs(name, opts).Stream(ctx)
+}
@@ -0,0 +1,49 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	fakeclientset "k8s.io/client-go/kubernetes/fake"
+)
+
+func TestGetPodLogs(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewEmpty(zap.NewNop().Sugar()).GetPodLogs(context.Background(), "ns", "pod", &corev1.PodLogOptions{})
+	require.Error(t, err)
+
+	k := NewEmpty(zap.NewNop().Sugar()).WithClientset(fakeclientset.NewClientset(&corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "pod", Namespace: "ns"},
+	}))
+	// The same clientset serves every request.
+	for range 2 {
+		stream, err := k.GetPodLogs(context.Background(), "ns", "pod", &corev1.PodLogOptions{})
+		require.NoError(t, err)
+		logs, err := io.ReadAll(stream)
+		require.NoError(t, err)
+		require.NoError(t, stream.Close())
+		assert.Equal(t, "fake logs", string(logs))
+	}
+}
@@ -0,0 +1,69 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/versionpolicy"
+)
+
+// GetEngineVersionPolicy returns the engine version policy of the given namespace.
+// An empty policy that allows all versions is returned if the namespace has no policy.
+func (k *Kubernetes) GetEngineVersionPolicy(ctx context.Context, namespace string) (*versionpolicy.Policy, error) {
+	cm, err := k.GetConfigMap(ctx, types.NamespacedName{Namespace: namespace, Name: versionpolicy.ConfigMapName})
+	if k8serrors.IsNotFound(err) {
+		return &versionpolicy.Policy{}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return versionpolicy.FromConfigMapData(cm.Data)
+}
+
+// UpdateEngineVersionPolicy creates or updates the engine version policy of the given namespace.
+func (k *Kubernetes) UpdateEngineVersionPolicy(ctx context.Context, namespace string, policy *versionpolicy.Policy) error {
+	data, err := policy.ToConfigMapData()
+	if err != nil {
+		return err
+	}
+
+	cm, err := k.GetConfigMap(ctx, types.NamespacedName{Namespace: namespace, Name: versionpolicy.ConfigMapName})
+	if k8serrors.IsNotFound(err) {
+		_, err = k.CreateConfigMap(ctx, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      versionpolicy.ConfigMapName,
+				Labels: map[string]string{
+					common.KubernetesManagedByLabel: common.Everest,
+				},
+			},
+			Data: data,
+		})
+		return err
+	} else if err != nil {
+		return err
+	}
+
+	cm.Data = data
+	_, err = k.UpdateConfigMap(ctx, cm)
+	return err
+}
@@ -0,0 +1,194 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package maintenance provides the maintenance windows of DB namespaces.
+package maintenance
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// ConfigMapName is the name of the ConfigMap that holds the maintenance windows of a DB namespace.
+	ConfigMapName = "everest-maintenance-windows"
+
+	windowsKey       = "windows"
+	queuedUpgradeKey = "queuedUpgrade"
+
+	// MaxWindowDuration is the maximum duration of a single maintenance window.
+	MaxWindowDuration = 7 * 24 * time.Hour
+)
+
+var (
+	errNoWindowName    = errors.New("maintenance window name cannot be empty")
+	errDuplicateWindow = func(name string) error { return fmt.Errorf("duplicate maintenance window '%s'", name) }
+	errInvalidSchedule = func(name string, err error) error {
+		return fmt.Errorf("invalid schedule for maintenance window '%s': %w", name, err)
+	}
+	errInvalidDuration = func(name string, err error) error {
+		return fmt.Errorf("invalid duration for maintenance window '%s': %w", name, err)
+	}
+	errDurationOutOfBand = func(name string) error {
+		return fmt.Errorf("duration of maintenance window '%s' must be greater than 0 and at most %s", name, MaxWindowDuration)
+	}
+)
+
+// Window is a recurring period of time during which disruptive operations are allowed.
+type Window struct {
+	// Name is the unique name of the window.
+	Name string `yaml:"name"`
+	// Schedule is a standard cron expression that defines when the window opens.
+	// The schedule is evaluated in UTC unless a CRON_TZ= prefix is specified.
+	Schedule string `yaml:"schedule"`
+	// Duration defines for how long the window stays open, e.g. "4h".
+	Duration string `yaml:"duration"`
+}
+
+// QueuedUpgrade is an approved operator upgrade that waits for the next maintenance window.
+type QueuedUpgrade struct {
+	// RequestedBy is the user who approved the upgrade.
+	RequestedBy string `yaml:"requestedBy"`
+	// RequestedByGroups are the groups of the user who approved the upgrade.
+	RequestedByGroups []string `yaml:"requestedByGroups,omitempty"`
+	// RequestedAt is the time the upgrade was approved.
+	RequestedAt time.Time `yaml:"requestedAt"`
+	// TargetVersions are the operator versions that were approved, keyed by the name of the engine.
+	TargetVersions map[string]string `yaml:"targetVersions,omitempty"`
+}
+
+// Config holds the maintenance windows of a DB namespace.
+type Config struct {
+	Windows       []Window
+	QueuedUpgrade *QueuedUpgrade
+}
+
+// Validate checks that all the windows in the config are valid.
+func (c *Config) Validate() error {
+	seen := make(map[string]struct{}, len(c.Windows))
+	for _, w := range c.Windows {
+		if w.Name == "" {
+			return errNoWindowName
+		}
+		if _, ok := seen[w.Name]; ok {
+			return errDuplicateWindow(w.Name)
+		}
+		seen[w.Name] = struct{}{}
+		if _, err := cron.ParseStandard(w.Schedule); err != nil {
+			return errInvalidSchedule(w.Name, err)
+		}
+		d, err := time.ParseDuration(w.Duration)
+		if err != nil {
+			return errInvalidDuration(w.Name, err)
+		}
+		if d <= 0 || d > MaxWindowDuration {
+			return errDurationOutOfBand(w.Name)
+		}
+	}
+	return nil
+}
+
+// IsOpen returns true if disruptive operations are allowed at the given time.
+// A namespace without maintenance windows is always open.
+func (c *Config) IsOpen(now time.Time) (bool, error) {
+	if len(c.Windows) == 0 {
+		return true, nil
+	}
+	start, _, err := c.Next(now)
+	if err != nil {
+		return false, err
+	}
+	return !start.IsZero() && !start.After(now), nil
+}
+
+// Next returns the start and end of the window that is currently open, or, if none is open,
+// of the window that opens next. If there are no windows, zero times are returned.
+// The returned times are in UTC, regardless of the location of now.
+func (c *Config) Next(now time.Time) (time.Time, time.Time, error) {
+	// The schedules without a CRON_TZ= prefix are evaluated in the location of the given time.
+	now = now.UTC()
+	var start, end time.Time
+	var open bool
+	for _, w := range c.Windows {
+		sched, err := cron.ParseStandard(w.Schedule)
+		if err != nil {
+			return time.Time{}, time.Time{}, errInvalidSchedule(w.Name, err)
+		}
+		d, err := time.ParseDuration(w.Duration)
+		if err != nil {
+			return time.Time{}, time.Time{}, errInvalidDuration(w.Name, err)
+		}
+		// The window is open if it was activated within the last d.
+		wStart := sched.Next(now.Add(-d))
+		if wStart.After(now) {
+			wStart = sched.Next(now)
+		}
+		if wStart.IsZero() {
+			// The schedule never fires.
+			continue
+		}
+		wEnd := wStart.Add(d)
+		wOpen := !wStart.After(now)
+		switch {
+		case wOpen && (!open || wEnd.After(end)):
+			// Among the open windows, prefer the one that closes last.
+			start, end, open = wStart, wEnd, true
+		case !wOpen && !open && (start.IsZero() || wStart.Before(start)):
+			// Otherwise, prefer the window that opens first.
+			start, end = wStart, wEnd
+		}
+	}
+	return start, end, nil
+}
+
+// FromConfigMapData parses the maintenance windows config from the data of a ConfigMap.
+func FromConfigMapData(data map[string]string) (*Config, error) {
+	c := &Config{}
+	if raw, ok := data[windowsKey]; ok {
+		if err := yaml.Unmarshal([]byte(raw), &c.Windows); err != nil {
+			return nil, errors.Join(err, errors.New("failed to parse maintenance windows"))
+		}
+	}
+	if raw, ok := data[queuedUpgradeKey]; ok && raw != "" {
+		c.QueuedUpgrade = &QueuedUpgrade{}
+		if err := yaml.Unmarshal([]byte(raw), c.QueuedUpgrade); err != nil {
+			return nil, errors.Join(err, errors.New("failed to parse queued upgrade"))
+		}
+	}
+	return c, nil
+}
+
+// ToConfigMapData converts the maintenance windows config to the data of a ConfigMap.
+func (c *Config) ToConfigMapData() (map[string]string, error) {
+	windows, err := yaml.Marshal(c.Windows)
+	if err != nil {
+		return nil, err
+	}
+	data := map[string]string{
+		windowsKey: string(windows),
+	}
+	if c.QueuedUpgrade != nil {
+		queued, err := yaml.Marshal(c.QueuedUpgrade)
+		if err != nil {
+			return nil, err
+		}
+		data[queuedUpgradeKey] = string(queued)
+	}
+	return data, nil
+}
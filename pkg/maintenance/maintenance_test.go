@@ -0,0 +1,232 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maintenance
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name    string
+		windows []Window
+		wantErr bool
+	}{
+		{
+			name: "no windows",
+		},
+		{
+			name: "valid windows",
+			windows: []Window{
+				{Name: "sunday", Schedule: "0 2 * * 0", Duration: "4h"},
+				{Name: "nightly", Schedule: "CRON_TZ=Europe/Berlin 30 1 * * *", Duration: "30m"},
+			},
+		},
+		{
+			name:    "empty name",
+			windows: []Window{{Schedule: "0 2 * * 0", Duration: "4h"}},
+			wantErr: true,
+		},
+		{
+			name: "duplicate name",
+			windows: []Window{
+				{Name: "sunday", Schedule: "0 2 * * 0", Duration: "4h"},
+				{Name: "sunday", Schedule: "0 3 * * 0", Duration: "4h"},
+			},
+			wantErr: true,
+		},
+		{
+			name:    "invalid schedule",
+			windows: []Window{{Name: "sunday", Schedule: "every sunday", Duration: "4h"}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid duration",
+			windows: []Window{{Name: "sunday", Schedule: "0 2 * * 0", Duration: "four hours"}},
+			wantErr: true,
+		},
+		{
+			name:    "zero duration",
+			windows: []Window{{Name: "sunday", Schedule: "0 2 * * 0", Duration: "0s"}},
+			wantErr: true,
+		},
+		{
+			name:    "too long duration",
+			windows: []Window{{Name: "sunday", Schedule: "0 2 * * 0", Duration: "169h"}},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := (&Config{Windows: tc.windows}).Validate()
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestNext(t *testing.T) {
+	t.Parallel()
+
+	sunday := Window{Name: "sunday", Schedule: "0 2 * * 0", Duration: "4h"}
+	nightly := Window{Name: "nightly", Schedule: "0 1 * * *", Duration: "2h"}
+	date := func(day, hour, minute int) time.Time {
+		// 2025-06-01 is a Sunday.
+		return time.Date(2025, time.June, day, hour, minute, 0, 0, time.UTC)
+	}
+
+	testCases := []struct {
+		name      string
+		windows   []Window
+		now       time.Time
+		wantOpen  bool
+		wantStart time.Time
+		wantEnd   time.Time
+	}{
+		{
+			name:     "no windows",
+			now:      date(1, 12, 0),
+			wantOpen: true,
+		},
+		{
+			name:      "before the window",
+			windows:   []Window{sunday},
+			now:       date(1, 1, 0),
+			wantStart: date(1, 2, 0),
+			wantEnd:   date(1, 6, 0),
+		},
+		{
+			name:      "window opens",
+			windows:   []Window{sunday},
+			now:       date(1, 2, 0),
+			wantOpen:  true,
+			wantStart: date(1, 2, 0),
+			wantEnd:   date(1, 6, 0),
+		},
+		{
+			name:      "inside the window",
+			windows:   []Window{sunday},
+			now:       date(1, 5, 59),
+			wantOpen:  true,
+			wantStart: date(1, 2, 0),
+			wantEnd:   date(1, 6, 0),
+		},
+		{
+			name:      "after the window",
+			windows:   []Window{sunday},
+			now:       date(1, 6, 0),
+			wantStart: date(8, 2, 0),
+			wantEnd:   date(8, 6, 0),
+		},
+		{
+			name:      "earliest window is preferred",
+			windows:   []Window{sunday, nightly},
+			now:       date(3, 12, 0),
+			wantStart: date(4, 1, 0),
+			wantEnd:   date(4, 3, 0),
+		},
+		{
+			name:      "open window that closes last is preferred",
+			windows:   []Window{nightly, sunday},
+			now:       date(1, 2, 30),
+			wantOpen:  true,
+			wantStart: date(1, 2, 0),
+			wantEnd:   date(1, 6, 0),
+		},
+		{
+			name:      "open window is preferred over the next one",
+			windows:   []Window{sunday, nightly},
+			now:       date(2, 1, 30),
+			wantOpen:  true,
+			wantStart: date(2, 1, 0),
+			wantEnd:   date(2, 3, 0),
+		},
+		{
+			// 2:30 at UTC+5 is 21:30 UTC of the day before.
+			name:      "non-UTC time outside the window",
+			windows:   []Window{sunday},
+			now:       time.Date(2025, time.June, 1, 2, 30, 0, 0, time.FixedZone("UTC+5", 5*60*60)),
+			wantStart: date(1, 2, 0),
+			wantEnd:   date(1, 6, 0),
+		},
+		{
+			name:      "non-UTC time inside the window",
+			windows:   []Window{sunday},
+			now:       time.Date(2025, time.June, 1, 7, 30, 0, 0, time.FixedZone("UTC+5", 5*60*60)),
+			wantOpen:  true,
+			wantStart: date(1, 2, 0),
+			wantEnd:   date(1, 6, 0),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			config := &Config{Windows: tc.windows}
+			open, err := config.IsOpen(tc.now)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantOpen, open)
+
+			start, end, err := config.Next(tc.now)
+			require.NoError(t, err)
+			assert.True(t, tc.wantStart.Equal(start), "expected start %s, got %s", tc.wantStart, start)
+			assert.True(t, tc.wantEnd.Equal(end), "expected end %s, got %s", tc.wantEnd, end)
+		})
+	}
+}
+
+func TestConfigMapData(t *testing.T) {
+	t.Parallel()
+
+	config := &Config{
+		Windows: []Window{
+			{Name: "sunday", Schedule: "0 2 * * 0", Duration: "4h"},
+		},
+		QueuedUpgrade: &QueuedUpgrade{
+			RequestedBy:       "admin",
+			RequestedByGroups: []string{"dba"},
+			RequestedAt:       time.Date(2025, time.June, 1, 0, 0, 0, 0, time.UTC),
+			TargetVersions:    map[string]string{"percona-xtradb-cluster-operator": "1.16.0"},
+		},
+	}
+
+	data, err := config.ToConfigMapData()
+	require.NoError(t, err)
+	parsed, err := FromConfigMapData(data)
+	require.NoError(t, err)
+	assert.Equal(t, config, parsed)
+
+	config.QueuedUpgrade = nil
+	data, err = config.ToConfigMapData()
+	require.NoError(t, err)
+	assert.NotContains(t, data, queuedUpgradeKey)
+
+	empty, err := FromConfigMapData(nil)
+	require.NoError(t, err)
+	assert.Equal(t, &Config{}, empty)
+}
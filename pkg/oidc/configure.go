@@ -25,12 +25,14 @@ import (
 
 	"go.uber.org/zap"
 	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/percona/everest/pkg/cli/steps"
 	"github.com/percona/everest/pkg/cli/tui"
 	cliutils "github.com/percona/everest/pkg/cli/utils"
 	"github.com/percona/everest/pkg/common"
 	"github.com/percona/everest/pkg/kubernetes"
+	"github.com/percona/everest/pkg/rbac"
 )
 
 // OIDC describes the command to configure OIDC settings.
@@ -54,6 +56,18 @@ type Config struct {
 	ClientID string
 	// Scopes requested scopes.
 	Scopes []string
+	// UsernameClaim claim of the tokens that holds the username.
+	UsernameClaim string
+	// UsernamePrefix prefix prepended to the usernames.
+	UsernamePrefix string
+	// GroupsClaims claims of the tokens that hold the groups.
+	GroupsClaims []string
+	// GroupsPrefix prefix prepended to the names of the groups.
+	GroupsPrefix string
+	// Name of the provider, the providers with other names are kept.
+	Name string
+	// Audiences accepted audiences of the tokens.
+	Audiences []string
 }
 
 // PopulateIssuerURL function to fill the configuration with the required IssuerURL.
@@ -122,6 +136,10 @@ func (u *OIDC) Run(ctx context.Context) error {
 		return err
 	}
 
+	if err := rbac.ValidateClaimMapping(u.oidcConfig()); err != nil {
+		return err
+	}
+
 	if err := steps.RunStepsWithSpinner(ctx, u.l, u.getOIDCProviderConfigureSteps(), u.config.Pretty); err != nil {
 		return err
 	}
@@ -149,19 +167,18 @@ func (u *OIDC) getOIDCProviderConfigureSteps() []steps.Step {
 	stepList = append(stepList, steps.Step{
 		Desc: "Updating Everest settings",
 		F: func(ctx context.Context) error {
-			oidcCfg := common.OIDCConfig{
-				IssuerURL: u.config.IssuerURL,
-				ClientID:  u.config.ClientID,
-				Scopes:    u.config.Scopes,
+			settings, err := u.kubeClient.GetEverestSettings(ctx)
+			if client.IgnoreNotFound(err) != nil {
+				return err
 			}
-
-			oidcRaw, err := oidcCfg.Raw()
-			if err != nil {
+			if err := settings.SetOIDCProvider(u.oidcConfig()); err != nil {
 				return err
 			}
-			return u.kubeClient.UpdateEverestSettings(ctx, common.EverestSettings{
-				OIDCConfigRaw: oidcRaw,
-			})
+			// Check that the provider can be told apart from the other ones.
+			if _, err := settings.OIDCProviders(); err != nil {
+				return err
+			}
+			return u.kubeClient.UpdateEverestSettings(ctx, settings)
 		},
 	},
 	)
@@ -181,6 +198,21 @@ func (u *OIDC) getOIDCProviderConfigureSteps() []steps.Step {
 	return stepList
 }
 
+// oidcConfig returns the OIDC settings to be stored in the Everest settings.
+func (u *OIDC) oidcConfig() common.OIDCConfig {
+	return common.OIDCConfig{
+		IssuerURL:      u.config.IssuerURL,
+		ClientID:       u.config.ClientID,
+		Scopes:         u.config.Scopes,
+		UsernameClaim:  u.config.UsernameClaim,
+		UsernamePrefix: u.config.UsernamePrefix,
+		GroupsClaims:   u.config.GroupsClaims,
+		GroupsPrefix:   u.config.GroupsPrefix,
+		Name:           u.config.Name,
+		Audiences:      u.config.Audiences,
+	}
+}
+
 // ValidateURL checks if the provided URL is valid.
 func ValidateURL(u string) error {
 	if u == "" {
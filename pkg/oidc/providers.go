@@ -0,0 +1,139 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oidc
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/golang-jwt/jwt/v5"
+	"go.uber.org/zap"
+
+	"github.com/percona/everest/pkg/common"
+)
+
+// ErrInvalidAudience is returned when the token is not issued for any of the audiences accepted by the provider.
+var ErrInvalidAudience = errors.New("token audience is not accepted by the OIDC provider")
+
+// Provider is an OIDC provider that issues the tokens accepted by Everest.
+type Provider struct {
+	// Config is the configuration of the provider in the Everest settings.
+	Config common.OIDCConfig
+	// ProviderConfig is the configuration discovered from the provider.
+	ProviderConfig ProviderConfig
+
+	keyFunc jwt.Keyfunc
+}
+
+// NewProvider returns the provider with the configuration discovered from its issuer URL.
+func NewProvider(ctx context.Context, config common.OIDCConfig) (*Provider, error) {
+	providerConfig, err := NewProviderConfig(ctx, config.IssuerURL)
+	if err != nil {
+		return nil, err
+	}
+	keyFunc, err := providerConfig.NewKeyFunc(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &Provider{
+		Config:         config,
+		ProviderConfig: providerConfig,
+		keyFunc:        keyFunc,
+	}, nil
+}
+
+// Issues returns true if the tokens with the issuer are issued by the provider.
+func (p *Provider) Issues(issuer string) bool {
+	return issuer == p.ProviderConfig.Issuer || issuer == p.ProviderConfig.OriginalIssuer
+}
+
+// KeyFunc returns a function for getting the public keys of the provider,
+// which also checks that the token is issued for one of the accepted audiences.
+// If no audiences are configured, the token must be issued for the client ID,
+// either as its audience or as its authorized party.
+func (p *Provider) KeyFunc() jwt.Keyfunc {
+	return func(token *jwt.Token) (interface{}, error) {
+		if err := p.checkAudience(token); err != nil {
+			return nil, err
+		}
+		return p.keyFunc(token)
+	}
+}
+
+func (p *Provider) checkAudience(token *jwt.Token) error {
+	accepted := p.Config.Audiences
+	if len(accepted) == 0 {
+		if p.Config.ClientID == "" {
+			return nil
+		}
+		// The access tokens of some providers, e.g. Keycloak, are issued for other audiences,
+		// but hold the client they were issued to in the "azp" claim.
+		if claims, ok := token.Claims.(jwt.MapClaims); ok && claims["azp"] == p.Config.ClientID {
+			return nil
+		}
+		accepted = []string{p.Config.ClientID}
+	}
+	audience, err := token.Claims.GetAudience()
+	if err != nil {
+		return errors.Join(err, errors.New("failed to get audience from claims"))
+	}
+	for _, aud := range audience {
+		if slices.Contains(accepted, aud) {
+			return nil
+		}
+	}
+	return ErrInvalidAudience
+}
+
+// Providers routes the tokens to the OIDC provider that issued them.
+type Providers struct {
+	providers []*Provider
+}
+
+// NewProviders returns the providers with the given configurations.
+// A provider whose configuration cannot be discovered is skipped, so that
+// an unavailable provider does not prevent signing in with the other ones.
+func NewProviders(ctx context.Context, l *zap.SugaredLogger, configs []common.OIDCConfig) *Providers {
+	providers := make([]*Provider, 0, len(configs))
+	for _, config := range configs {
+		provider, err := NewProvider(ctx, config)
+		if err != nil {
+			l.Errorf("skipping OIDC provider '%s': %v", config.Name, err)
+			continue
+		}
+		providers = append(providers, provider)
+	}
+	return &Providers{providers: providers}
+}
+
+// List returns all the providers.
+func (p *Providers) List() []*Provider {
+	if p == nil {
+		return nil
+	}
+	return p.providers
+}
+
+// ForIssuer returns the provider that issues the tokens with the issuer, or nil if there is none.
+func (p *Providers) ForIssuer(issuer string) *Provider {
+	for _, provider := range p.List() {
+		if provider.Issues(issuer) {
+			return provider
+		}
+	}
+	return nil
+}
@@ -0,0 +1,128 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/jwks"
+)
+
+type testIDP struct {
+	server *httptest.Server
+	key    *rsa.PrivateKey
+	// issuer is the issuer of the tokens, which may differ from the URL of the server.
+	issuer string
+}
+
+func newTestIDP(t *testing.T, issuer string) *testIDP {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	idp := &testIDP{key: key, issuer: issuer}
+	mux := http.NewServeMux()
+	mux.HandleFunc(WellKnownPath, func(w http.ResponseWriter, _ *http.Request) {
+		iss := idp.issuer
+		if iss == "" {
+			iss = idp.server.URL
+		}
+		_ = json.NewEncoder(w).Encode(map[string]string{ //nolint:errchkjson
+			"issuer":   iss,
+			"jwks_uri": idp.server.URL + "/keys",
+		})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(jwks.JSONWebKeySet{ //nolint:errchkjson
+			Keys: []jwks.JSONWebKey{jwks.NewJSONWebKey("k1", &key.PublicKey)},
+		})
+	})
+	idp.server = httptest.NewServer(mux)
+	t.Cleanup(idp.server.Close)
+	return idp
+}
+
+func (idp *testIDP) token(t *testing.T, issuer, audience string) *jwt.Token {
+	t.Helper()
+	return idp.tokenWithClaims(t, jwt.MapClaims{"iss": issuer, "aud": audience, "sub": "user"})
+}
+
+func (idp *testIDP) tokenWithClaims(t *testing.T, claims jwt.MapClaims) *jwt.Token {
+	t.Helper()
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = "k1"
+	signed, err := token.SignedString(idp.key)
+	require.NoError(t, err)
+	parsed, _, err := jwt.NewParser().ParseUnverified(signed, jwt.MapClaims{})
+	require.NoError(t, err)
+	return parsed
+}
+
+func TestProviders(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	okta := newTestIDP(t, "")
+	// The issuer of the tokens differs from the configured URL, as it happens with Microsoft Entra ID.
+	entra := newTestIDP(t, "https://login.microsoftonline.com/tenant/v2.0")
+	unavailable := httptest.NewServer(http.NotFoundHandler())
+	t.Cleanup(unavailable.Close)
+	providers := NewProviders(ctx, zap.NewNop().Sugar(), []common.OIDCConfig{
+		{Name: "employees", IssuerURL: okta.server.URL, ClientID: "everest"},
+		// The providers that cannot be discovered are skipped.
+		{Name: "partners", IssuerURL: unavailable.URL, ClientID: "everest"},
+		{Name: "contractors", IssuerURL: entra.server.URL, ClientID: "everest", Audiences: []string{"api://everest"}},
+	})
+	require.Len(t, providers.List(), 2)
+	assert.Nil(t, providers.ForIssuer(unavailable.URL))
+
+	// The client ID is the accepted audience if none are configured.
+	p := providers.ForIssuer(okta.server.URL)
+	require.NotNil(t, p)
+	assert.Equal(t, "employees", p.Config.Name)
+	_, err := p.KeyFunc()(okta.token(t, okta.server.URL, "everest"))
+	require.NoError(t, err)
+	_, err = p.KeyFunc()(okta.token(t, okta.server.URL, "any"))
+	require.ErrorIs(t, err, ErrInvalidAudience)
+	_, err = p.KeyFunc()(okta.tokenWithClaims(t, jwt.MapClaims{"iss": okta.server.URL, "aud": "account", "azp": "everest"}))
+	require.NoError(t, err)
+
+	p = providers.ForIssuer(entra.issuer)
+	require.NotNil(t, p)
+	assert.Equal(t, "contractors", p.Config.Name)
+	key, err := p.KeyFunc()(entra.token(t, entra.issuer, "api://everest"))
+	require.NoError(t, err)
+	assert.True(t, entra.key.PublicKey.Equal(key))
+	_, err = p.KeyFunc()(entra.token(t, entra.issuer, "api://other"))
+	require.ErrorIs(t, err, ErrInvalidAudience)
+	_, err = p.KeyFunc()(entra.token(t, entra.issuer, "everest"))
+	require.ErrorIs(t, err, ErrInvalidAudience)
+
+	assert.Nil(t, providers.ForIssuer("https://unknown.example.com"))
+	assert.Nil(t, (*Providers)(nil).ForIssuer(okta.server.URL))
+}
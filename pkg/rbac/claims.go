@@ -0,0 +1,117 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rbac
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/percona/everest/pkg/common"
+)
+
+// ClaimMapping describes how the user is extracted from the claims of OIDC tokens.
+type ClaimMapping struct {
+	// UsernameClaim is the claim that holds the username.
+	UsernameClaim string
+	// UsernamePrefix is prepended to the username.
+	UsernamePrefix string
+	// GroupsClaims are the claims that hold the groups.
+	GroupsClaims []string
+	// GroupsPrefix is prepended to the names of the groups.
+	GroupsPrefix string
+}
+
+type claimMappingCtxKey struct{}
+
+var claimNameRegex = regexp.MustCompile(`^\S+$`)
+
+// NewClaimMapping returns the claim mapping of the OIDC configuration.
+// The default claims are used for the claims that are not configured.
+func NewClaimMapping(c common.OIDCConfig) ClaimMapping {
+	m := ClaimMapping{
+		UsernameClaim:  c.UsernameClaim,
+		UsernamePrefix: c.UsernamePrefix,
+		GroupsClaims:   c.GroupsClaims,
+		GroupsPrefix:   c.GroupsPrefix,
+	}
+	if m.UsernameClaim == "" {
+		m.UsernameClaim = common.DefaultOIDCUsernameClaim
+	}
+	if len(m.GroupsClaims) == 0 {
+		m.GroupsClaims = slices.Clone(common.DefaultOIDCGroupsClaims)
+	}
+	return m
+}
+
+// ValidateClaimMapping checks that the claims of the OIDC configuration and the prefixes are well-formed.
+// Empty claims are valid and stand for the default claims.
+func ValidateClaimMapping(c common.OIDCConfig) error {
+	if c.UsernameClaim != "" && !claimNameRegex.MatchString(c.UsernameClaim) {
+		return fmt.Errorf("invalid username claim '%s'", c.UsernameClaim)
+	}
+	for _, claim := range c.GroupsClaims {
+		if !claimNameRegex.MatchString(claim) {
+			return fmt.Errorf("invalid groups claim '%s'", claim)
+		}
+	}
+	if c.UsernamePrefix != "" {
+		if err := validateTerms([]string{c.UsernamePrefix}); err != nil {
+			return fmt.Errorf("invalid username prefix '%s'", c.UsernamePrefix)
+		}
+	}
+	if c.GroupsPrefix != "" {
+		if err := validateTerms([]string{c.GroupsPrefix}); err != nil {
+			return fmt.Errorf("invalid groups prefix '%s'", c.GroupsPrefix)
+		}
+	}
+	return nil
+}
+
+// ContextWithClaimMapping returns a copy of ctx that carries the claim mapping used by GetUser.
+func ContextWithClaimMapping(ctx context.Context, m ClaimMapping) context.Context {
+	return context.WithValue(ctx, claimMappingCtxKey{}, m)
+}
+
+func claimMappingFromContext(ctx context.Context) ClaimMapping {
+	if m, ok := ctx.Value(claimMappingCtxKey{}).(ClaimMapping); ok {
+		return m
+	}
+	return NewClaimMapping(common.OIDCConfig{})
+}
+
+// userFromClaims returns the user described by the claims of an OIDC token.
+// The username of the built-in admin account is rejected, so that the OIDC users cannot get its permissions.
+func userFromClaims(claims jwt.MapClaims, m ClaimMapping) (User, error) {
+	username, ok := claims[m.UsernameClaim].(string)
+	if !ok || username == "" {
+		return User{}, fmt.Errorf("failed to get username from claim '%s'", m.UsernameClaim)
+	}
+	username = m.UsernamePrefix + username
+	if username == common.EverestAdminUser {
+		return User{}, fmt.Errorf("username '%s' of OIDC tokens is reserved for the built-in account", username)
+	}
+	groups := getScopeValues(claims, m.GroupsClaims)
+	if m.GroupsPrefix != "" {
+		for i := range groups {
+			groups[i] = m.GroupsPrefix + groups[i]
+		}
+	}
+	return User{Subject: username, Groups: groups}, nil
+}
@@ -0,0 +1,123 @@
+package rbac
+
+import (
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/percona/everest/pkg/common"
+)
+
+func TestUserFromClaims(t *testing.T) {
+	t.Parallel()
+	testcases := []struct {
+		desc    string
+		claims  jwt.MapClaims
+		config  common.OIDCConfig
+		out     User
+		wantErr bool
+	}{
+		{
+			desc:   "default claims",
+			claims: jwt.MapClaims{"sub": "1234", "email": "alice@example.com", "groups": []string{"dev"}},
+			config: common.OIDCConfig{},
+			out:    User{Subject: "1234", Groups: []string{"dev"}},
+		},
+		{
+			desc:   "email as username",
+			claims: jwt.MapClaims{"sub": "1234", "email": "alice@example.com", "groups": []string{"dev"}},
+			config: common.OIDCConfig{UsernameClaim: "email"},
+			out:    User{Subject: "alice@example.com", Groups: []string{"dev"}},
+		},
+		{
+			desc:   "multiple groups claims with prefix",
+			claims: jwt.MapClaims{"sub": "1234", "cognito:groups": []string{"dev"}, "roles": []string{"admin"}},
+			config: common.OIDCConfig{GroupsClaims: []string{"cognito:groups", "roles"}, GroupsPrefix: "oidc:"},
+			out:    User{Subject: "1234", Groups: []string{"oidc:dev", "oidc:admin"}},
+		},
+		{
+			desc:   "username prefix",
+			claims: jwt.MapClaims{"sub": "1234", "email": "alice@example.com"},
+			config: common.OIDCConfig{UsernameClaim: "email", UsernamePrefix: "entra:"},
+			out:    User{Subject: "entra:alice@example.com", Groups: []string{}},
+		},
+		{
+			desc:    "username of the built-in admin",
+			claims:  jwt.MapClaims{"sub": "admin"},
+			config:  common.OIDCConfig{},
+			wantErr: true,
+		},
+		{
+			desc:   "username of the built-in admin with prefix",
+			claims: jwt.MapClaims{"sub": "admin"},
+			config: common.OIDCConfig{UsernamePrefix: "okta:"},
+			out:    User{Subject: "okta:admin", Groups: []string{}},
+		},
+		{
+			desc:    "missing username claim",
+			claims:  jwt.MapClaims{"sub": "1234"},
+			config:  common.OIDCConfig{UsernameClaim: "email"},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			user, err := userFromClaims(tc.claims, NewClaimMapping(tc.config))
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.out, user)
+		})
+	}
+}
+
+func TestValidateClaimMapping(t *testing.T) {
+	t.Parallel()
+	testcases := []struct {
+		desc    string
+		config  common.OIDCConfig
+		wantErr bool
+	}{
+		{
+			desc:   "defaults",
+			config: common.OIDCConfig{},
+		},
+		{
+			desc:   "custom claims",
+			config: common.OIDCConfig{UsernameClaim: "email", UsernamePrefix: "okta:", GroupsClaims: []string{"https://example.com/groups"}, GroupsPrefix: "oidc:"},
+		},
+		{
+			desc:    "username claim with spaces",
+			config:  common.OIDCConfig{UsernameClaim: "user name"},
+			wantErr: true,
+		},
+		{
+			desc:    "username prefix with spaces",
+			config:  common.OIDCConfig{UsernamePrefix: "okta "},
+			wantErr: true,
+		},
+		{
+			desc:    "empty groups claim",
+			config:  common.OIDCConfig{GroupsClaims: []string{""}},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			err := ValidateClaimMapping(tc.config)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,219 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rbac
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/casbin/casbin/v2"
+	"github.com/casbin/casbin/v2/util"
+)
+
+// Object attributes that may be used in the conditions of the policy rules.
+const (
+	// AttributeEngineType is the engine type of a database cluster, e.g. postgresql.
+	AttributeEngineType = "engineType"
+	// AttributeStorageType is the type of a backup storage, e.g. s3.
+	AttributeStorageType = "storageType"
+	// AttributeLabelPrefix is the prefix of the attributes that hold the labels of a database cluster,
+	// e.g. labels.team.
+	AttributeLabelPrefix = "labels."
+
+	// conditionSeparator separates the object pattern and the conditions of a policy rule,
+	// e.g. ns-dev/*;engineType=postgresql;labels.team=dev.
+	conditionSeparator = ";"
+	// valueSeparator separates the alternative values of a condition, e.g. engineType=pxc|postgresql.
+	valueSeparator = "|"
+)
+
+var (
+	errInvalidCondition = func(cond string) error {
+		return fmt.Errorf("invalid condition '%s', expected <attribute>=<value>[|<value>...]", cond)
+	}
+	errUnsupportedAttribute = func(attr, resource string) error {
+		return fmt.Errorf("condition on '%s' is not supported for resource '%s'", attr, resource)
+	}
+
+	// conditionTermRegex matches the attribute names and values of the conditions.
+	conditionTermRegex = regexp.MustCompile(`^[*?.\-_/a-zA-Z0-9]+$`)
+)
+
+// resourceAttributes lists the attributes provided for the objects of the resources.
+var resourceAttributes = map[string][]string{
+	ResourceDatabaseClusters:           {AttributeEngineType, AttributeLabelPrefix},
+	ResourceDatabaseClusterCredentials: {AttributeEngineType, AttributeLabelPrefix},
+	ResourceBackupStorages:             {AttributeStorageType},
+}
+
+// Attributes are the attributes of an object matched against the conditions of the policy rules.
+type Attributes map[string]string
+
+type condition struct {
+	attribute string
+	values    []string
+}
+
+// parseObjectPattern splits the object of a policy rule into the object pattern and the conditions.
+func parseObjectPattern(object string) (string, []condition, error) {
+	parts := strings.Split(object, conditionSeparator)
+	conds := make([]condition, 0, len(parts)-1)
+	for _, part := range parts[1:] {
+		attr, values, ok := strings.Cut(part, "=")
+		if !ok || !conditionTermRegex.MatchString(attr) {
+			return "", nil, errInvalidCondition(part)
+		}
+		cond := condition{attribute: attr}
+		for _, v := range strings.Split(values, valueSeparator) {
+			if !conditionTermRegex.MatchString(v) {
+				return "", nil, errInvalidCondition(part)
+			}
+			if _, err := util.GlobMatch("", v); err != nil {
+				return "", nil, errors.Join(errInvalidCondition(part), err)
+			}
+			cond.values = append(cond.values, v)
+		}
+		conds = append(conds, cond)
+	}
+	return parts[0], conds, nil
+}
+
+// validateObjectConditions checks that the conditions of the object of a policy rule
+// are well-formed and use the attributes provided for the resource.
+func validateObjectConditions(resource, object string) error {
+	_, conds, err := parseObjectPattern(object)
+	if err != nil {
+		return err
+	}
+	for _, cond := range conds {
+		if resource == "*" {
+			if !isKnownAttribute(cond.attribute) {
+				return errUnsupportedAttribute(cond.attribute, resource)
+			}
+			continue
+		}
+		if !isResourceAttribute(resource, cond.attribute) {
+			return errUnsupportedAttribute(cond.attribute, resource)
+		}
+	}
+	return nil
+}
+
+func isKnownAttribute(attr string) bool {
+	for resource := range resourceAttributes {
+		if isResourceAttribute(resource, attr) {
+			return true
+		}
+	}
+	return false
+}
+
+func isResourceAttribute(resource, attr string) bool {
+	for _, a := range resourceAttributes[resource] {
+		// The attribute prefixes, e.g. labels., match any attribute with a non-empty suffix.
+		if strings.HasSuffix(a, ".") {
+			if strings.HasPrefix(attr, a) && len(attr) > len(a) {
+				return true
+			}
+			continue
+		}
+		if a == attr {
+			return true
+		}
+	}
+	return false
+}
+
+// objectMatch returns true if the object matches the object pattern of a policy rule,
+// and its attributes satisfy all the conditions of the rule.
+// A condition on an attribute that is not provided is not satisfied.
+func objectMatch(object string, attrs Attributes, pattern string) (bool, error) {
+	namePattern, conds, err := parseObjectPattern(pattern)
+	if err != nil {
+		return false, err
+	}
+	ok, err := util.GlobMatch(object, namePattern)
+	if err != nil || !ok {
+		return false, err
+	}
+	for _, cond := range conds {
+		value, found := attrs[cond.attribute]
+		if !found {
+			return false, nil
+		}
+		matched := false
+		for _, v := range cond.values {
+			if ok, err := util.GlobMatch(value, v); err != nil {
+				return false, err
+			} else if ok {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// objectMatchFunc is the objectMatch function of the casbin model.
+func objectMatchFunc(args ...interface{}) (interface{}, error) {
+	if len(args) != 3 { //nolint:mnd
+		return false, fmt.Errorf("objectMatch: expected 3 arguments, got %d", len(args))
+	}
+	object, ok := args[0].(string)
+	if !ok {
+		return false, errors.New("objectMatch: the object must be a string")
+	}
+	attrs, _ := args[1].(Attributes)
+	pattern, ok := args[2].(string)
+	if !ok {
+		return false, errors.New("objectMatch: the object pattern must be a string")
+	}
+	return objectMatch(object, attrs, pattern)
+}
+
+// HasConditions returns true if any rule of the policy has conditions on the object attributes.
+func HasConditions(enforcer casbin.IEnforcer) bool {
+	policies, err := enforcer.GetPolicy()
+	if err != nil {
+		return false
+	}
+	for _, p := range policies {
+		if len(p) > 3 && strings.Contains(p[3], conditionSeparator) {
+			return true
+		}
+	}
+	return false
+}
+
+// DatabaseClusterAttributes returns the attributes of a database cluster
+// with the given engine type and labels.
+func DatabaseClusterAttributes(engineType string, labels map[string]string) Attributes {
+	attrs := Attributes{AttributeEngineType: engineType}
+	for k, v := range labels {
+		attrs[AttributeLabelPrefix+k] = v
+	}
+	return attrs
+}
+
+// BackupStorageAttributes returns the attributes of a backup storage of the given type.
+func BackupStorageAttributes(storageType string) Attributes {
+	return Attributes{AttributeStorageType: storageType}
+}
@@ -0,0 +1,93 @@
+package rbac
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestObjectMatch(t *testing.T) {
+	t.Parallel()
+
+	postgres := DatabaseClusterAttributes("postgresql", map[string]string{"team": "dev"})
+	testCases := []struct {
+		desc    string
+		object  string
+		attrs   Attributes
+		pattern string
+		want    bool
+	}{
+		{desc: "no conditions", object: "ns-dev/db", pattern: "ns-dev/*", want: true},
+		{desc: "other namespace", object: "ns-prod/db", attrs: postgres, pattern: "ns-dev/*;engineType=postgresql"},
+		{desc: "engine type", object: "ns-dev/db", attrs: postgres, pattern: "ns-dev/*;engineType=postgresql", want: true},
+		{desc: "other engine type", object: "ns-dev/db", attrs: DatabaseClusterAttributes("psmdb", nil), pattern: "ns-dev/*;engineType=postgresql"},
+		{desc: "alternatives", object: "ns-dev/db", attrs: postgres, pattern: "ns-dev/*;engineType=pxc|postgresql", want: true},
+		{desc: "glob value", object: "ns-dev/db", attrs: postgres, pattern: "ns-dev/*;engineType=post*", want: true},
+		{desc: "all conditions", object: "ns-dev/db", attrs: postgres, pattern: "ns-dev/*;engineType=postgresql;labels.team=dev", want: true},
+		{desc: "one condition fails", object: "ns-dev/db", attrs: postgres, pattern: "ns-dev/*;engineType=postgresql;labels.team=ops"},
+		{desc: "missing attribute", object: "ns-dev/db", pattern: "ns-dev/*;engineType=postgresql"},
+		{desc: "storage type", object: "ns-dev/s3", attrs: BackupStorageAttributes("s3"), pattern: "*/*;storageType=s3", want: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			got, err := objectMatch(tc.object, tc.attrs, tc.pattern)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestValidateObjectConditions(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		resource string
+		object   string
+		wantErr  bool
+	}{
+		{resource: ResourceDatabaseClusters, object: "*/*"},
+		{resource: ResourceDatabaseClusters, object: "ns/*;engineType=postgresql;labels.team=dev"},
+		{resource: ResourceBackupStorages, object: "ns/*;storageType=s3|azure"},
+		{resource: "*", object: "ns/*;labels.team=dev"},
+		{resource: ResourceBackupStorages, object: "ns/*;engineType=pxc", wantErr: true},
+		{resource: ResourceDatabaseEngines, object: "ns/*;engineType=pxc", wantErr: true},
+		{resource: ResourceDatabaseClusters, object: "ns/*;labels.=dev", wantErr: true},
+		{resource: ResourceDatabaseClusters, object: "ns/*;engineType", wantErr: true},
+		{resource: ResourceDatabaseClusters, object: "ns/*;engineType=", wantErr: true},
+		{resource: ResourceDatabaseClusters, object: "ns/*;engineType=pxc||psmdb", wantErr: true},
+		{resource: "*", object: "ns/*;unknown=value", wantErr: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.resource+" "+tc.object, func(t *testing.T) {
+			t.Parallel()
+			err := validateObjectConditions(tc.resource, tc.object)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestCanWithAttributes(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	request := []string{"bob", "create", "database-clusters", "ns-dev/db"}
+
+	can, err := CanWithAttributes(ctx, "./testdata/policy-8-good.csv", nil, DatabaseClusterAttributes("postgresql", nil), request...)
+	require.NoError(t, err)
+	assert.True(t, can)
+
+	can, err = CanWithAttributes(ctx, "./testdata/policy-8-good.csv", nil, DatabaseClusterAttributes("psmdb", nil), request...)
+	require.NoError(t, err)
+	assert.False(t, can)
+
+	can, err = Can(ctx, "./testdata/policy-8-good.csv", nil, request...)
+	require.NoError(t, err)
+	assert.False(t, can)
+}
@@ -0,0 +1,180 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rbac
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/casbin/casbin/v2"
+	"github.com/casbin/casbin/v2/util"
+
+	"github.com/percona/everest/pkg/kubernetes"
+)
+
+const (
+	effectField = "eft"
+	effectDeny  = "deny"
+)
+
+// Explanation describes how the RBAC policy decides on a request.
+type Explanation struct {
+	// Allowed is the decision of the enforcer.
+	Allowed bool
+	// Policies are the policy rules (p) that match the request,
+	// either directly for the subject or for one of the roles it inherits.
+	Policies [][]string
+	// Roles are the grouping rules (g) through which the subject inherits
+	// the roles of the matching policy rules.
+	Roles [][]string
+	// DenyOverride is true if, for the subject or one of the groups, a matching deny rule
+	// takes precedence over the matching allow rules under the policy effect.
+	DenyOverride bool
+}
+
+// Explain explains the decision of the enforcer on the request of the user
+// to perform the action on the object of the resource with the given attributes.
+// Like the RBAC handler, the request is allowed if the subject or any of the groups of the user is allowed,
+// so the explanation covers the rules of all of them.
+func Explain(enforcer casbin.IEnforcer, user User, resource, action, object string, attrs Attributes) (*Explanation, error) {
+	object = requestObject(resource, object)
+	grouping, err := enforcer.GetGroupingPolicy()
+	if err != nil {
+		return nil, err
+	}
+	policies, err := enforcer.GetPolicy()
+	if err != nil {
+		return nil, err
+	}
+	effectIdx, err := enforcer.GetModel().GetFieldIndex("p", effectField)
+	if err != nil {
+		// The model does not define an effect, all the rules allow.
+		effectIdx = -1
+	}
+
+	result := &Explanation{}
+	for _, subject := range append([]string{user.Subject}, user.Groups...) {
+		allowed, err := enforcer.Enforce(subject, resource, action, object, attrs)
+		if err != nil {
+			return nil, err
+		}
+		result.Allowed = result.Allowed || allowed
+		explainSubject(result, subject, grouping, policies, effectIdx, resource, action, object, attrs)
+	}
+	return result, nil
+}
+
+// explainSubject adds the policy rules that match the request of the subject to the explanation,
+// along with the grouping rules through which the subject inherits them.
+func explainSubject(
+	result *Explanation,
+	subject string,
+	grouping, policies [][]string,
+	effectIdx int,
+	resource, action, object string,
+	attrs Attributes,
+) {
+	// parents maps the subject and the roles it inherits to the grouping rule
+	// through which they were first reached.
+	parents := map[string][]string{subject: nil}
+	queue := []string{subject}
+	for len(queue) > 0 {
+		sub := queue[0]
+		queue = queue[1:]
+		for _, g := range grouping {
+			if len(g) < 2 || g[0] != sub { //nolint:mnd
+				continue
+			}
+			if _, ok := parents[g[1]]; ok {
+				continue
+			}
+			parents[g[1]] = g
+			queue = append(queue, g[1])
+		}
+	}
+
+	var allows, denies int
+	for _, p := range policies {
+		if len(p) < 4 { //nolint:mnd
+			continue
+		}
+		if _, ok := parents[p[0]]; !ok {
+			continue
+		}
+		if !globMatch(resource, p[1]) || !globMatch(action, p[2]) {
+			continue
+		}
+		if ok, err := objectMatch(object, attrs, p[3]); err != nil || !ok {
+			continue
+		}
+		result.Policies = appendUnique(result.Policies, p)
+		if effectIdx >= 0 && effectIdx < len(p) && p[effectIdx] == effectDeny {
+			denies++
+		} else {
+			allows++
+		}
+		// Record the inheritance chain that leads from the subject to the rule.
+		for g := parents[p[0]]; g != nil; g = parents[g[0]] {
+			result.Roles = appendUnique(result.Roles, g)
+		}
+	}
+	result.DenyOverride = result.DenyOverride || (allows > 0 && denies > 0)
+}
+
+// appendUnique appends the rule to the rules, unless they already contain it.
+func appendUnique(rules [][]string, rule []string) [][]string {
+	if slices.ContainsFunc(rules, func(r []string) bool { return slices.Equal(r, rule) }) {
+		return rules
+	}
+	return append(rules, rule)
+}
+
+// ExplainRequest explains a request of the form [user action resource object] on an object
+// with the given attributes using the policy either from Kubernetes or from the local file, see Can.
+func ExplainRequest(
+	ctx context.Context,
+	filePath string,
+	k kubernetes.KubernetesConnector,
+	attrs Attributes,
+	req ...string,
+) (*Explanation, error) {
+	if len(req) != 4 { //nolint:mnd
+		return nil, errors.New("expected input of the form [user action resource object]")
+	}
+	enforcer, err := newKubeOrFileEnforcer(ctx, k, filePath)
+	if err != nil {
+		return nil, err
+	}
+	return Explain(enforcer, User{Subject: req[0]}, req[2], req[1], req[3], attrs)
+}
+
+// requestObject converts the object of a request, where "*" and "all" stand for
+// all the objects of the resource, to the form used by the policy.
+func requestObject(resource, object string) string {
+	if object == "*" || object == "all" {
+		object = "/"
+		if IsGlobalResource(resource) {
+			object = ""
+		}
+	}
+	return object
+}
+
+func globMatch(key, pattern string) bool {
+	ok, err := util.GlobMatch(key, pattern)
+	return err == nil && ok
+}
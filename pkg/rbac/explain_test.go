@@ -0,0 +1,151 @@
+package rbac
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/casbin/casbin/v2"
+	"github.com/casbin/casbin/v2/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExplain(t *testing.T) {
+	t.Parallel()
+
+	enforcer, err := NewIOReaderEnforcer(strings.NewReader(`
+p, role:dev, namespaces, read, *
+p, role:dev, database-clusters, *, */*
+p, role:team, database-clusters, read, my-ns/*
+p, carol, database-clusters, read, other-ns/*
+p, ops, database-clusters, delete, other-ns/*
+g, alice, role:dev
+g, role:dev, role:team
+g, bob, role:team
+g, admin, role:admin
+`))
+	require.NoError(t, err)
+
+	testCases := []struct {
+		desc                              string
+		subject, resource, action, object string
+		groups                            []string
+		want                              *Explanation
+	}{
+		{
+			desc:    "inherited roles",
+			subject: "alice", resource: "database-clusters", action: "read", object: "my-ns/cluster-1",
+			want: &Explanation{
+				Allowed: true,
+				Policies: [][]string{
+					{"role:dev", "database-clusters", "*", "*/*"},
+					{"role:team", "database-clusters", "read", "my-ns/*"},
+				},
+				Roles: [][]string{
+					{"alice", "role:dev"},
+					{"role:dev", "role:team"},
+				},
+			},
+		},
+		{
+			desc:    "no matching action",
+			subject: "bob", resource: "database-clusters", action: "update", object: "my-ns/cluster-1",
+			want: &Explanation{},
+		},
+		{
+			desc:    "direct policy",
+			subject: "carol", resource: "database-clusters", action: "read", object: "other-ns/cluster-1",
+			want: &Explanation{
+				Allowed:  true,
+				Policies: [][]string{{"carol", "database-clusters", "read", "other-ns/*"}},
+			},
+		},
+		{
+			desc:    "permission of a group",
+			subject: "dave", resource: "database-clusters", action: "delete", object: "other-ns/cluster-1",
+			groups: []string{"ops"},
+			want: &Explanation{
+				Allowed:  true,
+				Policies: [][]string{{"ops", "database-clusters", "delete", "other-ns/*"}},
+			},
+		},
+		{
+			desc:    "roles of a group",
+			subject: "dave", resource: "database-clusters", action: "read", object: "my-ns/cluster-1",
+			groups: []string{"role:team"},
+			want: &Explanation{
+				Allowed:  true,
+				Policies: [][]string{{"role:team", "database-clusters", "read", "my-ns/*"}},
+			},
+		},
+		{
+			desc:    "all objects of a global resource",
+			subject: "alice", resource: "namespaces", action: "read", object: "*",
+			want: &Explanation{
+				Allowed:  true,
+				Policies: [][]string{{"role:dev", "namespaces", "read", "*"}},
+				Roles:    [][]string{{"alice", "role:dev"}},
+			},
+		},
+		{
+			desc:    "admin",
+			subject: "admin", resource: "namespaces", action: "delete", object: "my-ns",
+			want: &Explanation{
+				Allowed:  true,
+				Policies: [][]string{{"role:admin", "namespaces", "*", "*"}},
+				Roles:    [][]string{{"admin", "role:admin"}},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			got, err := Explain(enforcer, User{Subject: tc.subject, Groups: tc.groups}, tc.resource, tc.action, tc.object, nil)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestExplainDenyOverride(t *testing.T) {
+	t.Parallel()
+
+	m, err := model.NewModelFromString(`
+[request_definition]
+r = sub, res, act, obj, attrs
+
+[policy_definition]
+p = sub, res, act, obj, eft
+
+[role_definition]
+g = _, _
+
+[policy_effect]
+e = some(where (p.eft == allow)) && !some(where (p.eft == deny))
+
+[matchers]
+m = g(r.sub, p.sub) && globMatch(r.res, p.res) && globMatch(r.act, p.act) && objectMatch(r.obj, r.attrs, p.obj)
+`)
+	require.NoError(t, err)
+	enforcer, err := casbin.NewEnforcer(m)
+	require.NoError(t, err)
+	enforcer.AddFunction("objectMatch", objectMatchFunc)
+	_, err = enforcer.AddPolicies([][]string{
+		{"role:dev", "database-clusters", "*", "*/*", "allow"},
+		{"alice", "database-clusters", "delete", "prod/*", "deny"},
+	})
+	require.NoError(t, err)
+	_, err = enforcer.AddGroupingPolicy("alice", "role:dev")
+	require.NoError(t, err)
+
+	got, err := Explain(enforcer, User{Subject: "alice"}, "database-clusters", "delete", "prod/cluster-1", nil)
+	require.NoError(t, err)
+	assert.False(t, got.Allowed)
+	assert.True(t, got.DenyOverride)
+	assert.Len(t, got.Policies, 2)
+
+	got, err = Explain(enforcer, User{Subject: "alice"}, "database-clusters", "delete", "dev/cluster-1", nil)
+	require.NoError(t, err)
+	assert.True(t, got.Allowed)
+	assert.False(t, got.DenyOverride)
+}
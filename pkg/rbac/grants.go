@@ -0,0 +1,285 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rbac
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/casbin/casbin/v2"
+	"gopkg.in/yaml.v3"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/kubernetes"
+)
+
+const (
+	grantsKey        = "grants"
+	grantsHistoryKey = "history"
+
+	// maxGrantsHistory is the number of the most recent changes of the temporary grants that are kept.
+	maxGrantsHistory = 200
+	// grantsExpiryInterval is how often the expired temporary grants are removed.
+	grantsExpiryInterval = 30 * time.Second
+)
+
+// Actions recorded in the history of the temporary grants.
+const (
+	GrantActionGranted = "granted"
+	GrantActionExpired = "expired"
+)
+
+// ErrGrantExpired is returned when creating a temporary grant that has already expired.
+var ErrGrantExpired = errors.New("the expiry time of the grant must be in the future")
+
+// TemporaryGrant assigns a role to a user or a group until the expiry time.
+type TemporaryGrant struct {
+	// Subject is the user or group the role is assigned to.
+	Subject string `yaml:"subject"`
+	// Role is the assigned role.
+	Role string `yaml:"role"`
+	// ExpiresAt is the time the assignment is removed at.
+	ExpiresAt time.Time `yaml:"expiresAt"`
+	// GrantedBy is the user who created the grant.
+	GrantedBy string `yaml:"grantedBy"`
+	// GrantedAt is the time the grant was created at.
+	GrantedAt time.Time `yaml:"grantedAt"`
+	// Reason is an optional justification of the grant.
+	Reason string `yaml:"reason,omitempty"`
+}
+
+// GrantEvent is a recorded change of the temporary grants.
+type GrantEvent struct {
+	// Time is the time of the change.
+	Time time.Time `yaml:"time"`
+	// Action is either GrantActionGranted or GrantActionExpired.
+	Action string `yaml:"action"`
+	// Actor is the user who made the change, empty for automatic changes.
+	Actor string `yaml:"actor,omitempty"`
+	// Grant is the grant that was changed.
+	Grant TemporaryGrant `yaml:"grant"`
+}
+
+// Grants holds the temporary grants and the history of their changes.
+type Grants struct {
+	Grants  []TemporaryGrant
+	History []GrantEvent
+}
+
+// Add adds the grant, replacing the grant of the same role to the same subject if there is one.
+func (g *Grants) Add(grant TemporaryGrant, now time.Time) error {
+	if !grant.ExpiresAt.After(now) {
+		return ErrGrantExpired
+	}
+	g.Grants = slices.DeleteFunc(g.Grants, func(other TemporaryGrant) bool {
+		return other.Subject == grant.Subject && other.Role == grant.Role
+	})
+	g.Grants = append(g.Grants, grant)
+	g.record(GrantEvent{Time: now, Action: GrantActionGranted, Actor: grant.GrantedBy, Grant: grant})
+	return nil
+}
+
+// RemoveExpired removes the grants that expired at the given time and returns them.
+func (g *Grants) RemoveExpired(now time.Time) []TemporaryGrant {
+	var expired []TemporaryGrant
+	g.Grants = slices.DeleteFunc(g.Grants, func(grant TemporaryGrant) bool {
+		if grant.ExpiresAt.After(now) {
+			return false
+		}
+		expired = append(expired, grant)
+		return true
+	})
+	for _, grant := range expired {
+		g.record(GrantEvent{Time: now, Action: GrantActionExpired, Grant: grant})
+	}
+	return expired
+}
+
+// Active returns the grants that have not expired at the given time.
+func (g *Grants) Active(now time.Time) []TemporaryGrant {
+	active := make([]TemporaryGrant, 0, len(g.Grants))
+	for _, grant := range g.Grants {
+		if grant.ExpiresAt.After(now) {
+			active = append(active, grant)
+		}
+	}
+	return active
+}
+
+func (g *Grants) record(e GrantEvent) {
+	g.History = append(g.History, e)
+	if len(g.History) > maxGrantsHistory {
+		g.History = g.History[len(g.History)-maxGrantsHistory:]
+	}
+}
+
+// grantsFromConfigMapData parses the temporary grants from the data of a ConfigMap.
+func grantsFromConfigMapData(data map[string]string) (*Grants, error) {
+	g := &Grants{}
+	if raw, ok := data[grantsKey]; ok {
+		if err := yaml.Unmarshal([]byte(raw), &g.Grants); err != nil {
+			return nil, errors.Join(err, errors.New("failed to parse temporary grants"))
+		}
+	}
+	if raw, ok := data[grantsHistoryKey]; ok {
+		if err := yaml.Unmarshal([]byte(raw), &g.History); err != nil {
+			return nil, errors.Join(err, errors.New("failed to parse temporary grants history"))
+		}
+	}
+	return g, nil
+}
+
+// toConfigMapData converts the temporary grants to the data of a ConfigMap.
+func (g *Grants) toConfigMapData() (map[string]string, error) {
+	grants, err := yaml.Marshal(g.Grants)
+	if err != nil {
+		return nil, err
+	}
+	history, err := yaml.Marshal(g.History)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]string{
+		grantsKey:        string(grants),
+		grantsHistoryKey: string(history),
+	}, nil
+}
+
+// GetGrants returns the temporary grants stored in Kubernetes.
+// Empty grants are returned if none were ever created.
+func GetGrants(ctx context.Context, k kubernetes.KubernetesConnector) (*Grants, error) {
+	cm, err := k.GetConfigMap(ctx, grantsConfigMapKey())
+	if k8serrors.IsNotFound(err) {
+		return &Grants{}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return grantsFromConfigMapData(cm.Data)
+}
+
+// ValidateGrant checks that the grant assigns an existing role to a valid subject
+// and has not expired at the given time.
+// It does not check whether the granting user may assign the role, which is up to the caller.
+func ValidateGrant(ctx context.Context, k kubernetes.KubernetesConnector, grant TemporaryGrant, now time.Time) error {
+	if err := validateTerms([]string{grant.Subject, grant.Role}); err != nil {
+		return err
+	}
+	if !grant.ExpiresAt.After(now) {
+		return ErrGrantExpired
+	}
+	if !strings.HasPrefix(grant.Role, common.EverestRBACRolePrefix) {
+		return fmt.Errorf("invalid role '%s', roles must start with '%s'", grant.Role, common.EverestRBACRolePrefix)
+	}
+	if grant.Role == common.EverestAdminRole {
+		return nil
+	}
+	cm, err := k.GetConfigMap(ctx, types.NamespacedName{
+		Namespace: common.SystemNamespace,
+		Name:      common.EverestRBACConfigMapName,
+	})
+	if err != nil {
+		return errors.Join(err, errors.New("failed to get RBAC ConfigMap"))
+	}
+	if !ParsePolicy(cm.Data["policy.csv"]).hasRole(grant.Role) {
+		return fmt.Errorf("%w: '%s'", ErrRoleNotFound, grant.Role)
+	}
+	return nil
+}
+
+// AddGrant stores the temporary grant in Kubernetes and records the change.
+func AddGrant(ctx context.Context, k kubernetes.KubernetesConnector, grant TemporaryGrant, now time.Time) error {
+	return updateGrants(ctx, k, func(g *Grants) (bool, error) {
+		return true, g.Add(grant, now)
+	})
+}
+
+// removeExpiredGrants removes the temporary grants that expired at the given time from Kubernetes
+// and records the changes. The removed grants are returned.
+func removeExpiredGrants(ctx context.Context, k kubernetes.KubernetesConnector, now time.Time) ([]TemporaryGrant, error) {
+	var expired []TemporaryGrant
+	err := updateGrants(ctx, k, func(g *Grants) (bool, error) {
+		expired = g.RemoveExpired(now)
+		return len(expired) > 0, nil
+	})
+	return expired, err
+}
+
+// updateGrants applies the change to the temporary grants stored in Kubernetes.
+// The grants are written only if the change reports that they were modified.
+func updateGrants(ctx context.Context, k kubernetes.KubernetesConnector, change func(g *Grants) (bool, error)) error {
+	cm, err := k.GetConfigMap(ctx, grantsConfigMapKey())
+	notFound := k8serrors.IsNotFound(err)
+	if err != nil && !notFound {
+		return err
+	}
+
+	g := &Grants{}
+	if !notFound {
+		if g, err = grantsFromConfigMapData(cm.Data); err != nil {
+			return err
+		}
+	}
+	modified, err := change(g)
+	if err != nil || !modified {
+		return err
+	}
+	data, err := g.toConfigMapData()
+	if err != nil {
+		return err
+	}
+
+	if notFound {
+		_, err = k.CreateConfigMap(ctx, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: common.SystemNamespace,
+				Name:      common.EverestRBACGrantsConfigMapName,
+				Labels: map[string]string{
+					common.KubernetesManagedByLabel: common.Everest,
+				},
+			},
+			Data: data,
+		})
+		return err
+	}
+	cm.Data = data
+	_, err = k.UpdateConfigMap(ctx, cm)
+	return err
+}
+
+// loadGrants adds the role assignments of the grants that are active at the given time to the enforcer.
+func loadGrants(enforcer casbin.IEnforcer, g *Grants, now time.Time) error {
+	for _, grant := range g.Active(now) {
+		if _, err := enforcer.AddGroupingPolicy(grant.Subject, grant.Role); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func grantsConfigMapKey() types.NamespacedName {
+	return types.NamespacedName{
+		Namespace: common.SystemNamespace,
+		Name:      common.EverestRBACGrantsConfigMapName,
+	}
+}
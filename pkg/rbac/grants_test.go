@@ -0,0 +1,66 @@
+package rbac
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGrants(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2026, 10, 20, 12, 0, 0, 0, time.UTC)
+	bob := TemporaryGrant{Subject: "bob", Role: "role:prod-dba", ExpiresAt: now.Add(time.Hour), GrantedBy: "admin", GrantedAt: now}
+	alice := TemporaryGrant{Subject: "alice", Role: "role:prod-dba", ExpiresAt: now.Add(2 * time.Hour), GrantedBy: "admin", GrantedAt: now}
+
+	g := &Grants{}
+	require.ErrorIs(t, g.Add(TemporaryGrant{Subject: "bob", Role: "role:prod-dba", ExpiresAt: now}, now), ErrGrantExpired)
+	require.NoError(t, g.Add(bob, now))
+	require.NoError(t, g.Add(alice, now))
+
+	// A grant of the same role to the same subject replaces the existing one.
+	extended := bob
+	extended.ExpiresAt = now.Add(3 * time.Hour)
+	require.NoError(t, g.Add(extended, now))
+	assert.Equal(t, []TemporaryGrant{alice, extended}, g.Grants)
+
+	later := now.Add(150 * time.Minute)
+	assert.Equal(t, []TemporaryGrant{extended}, g.Active(later))
+	assert.Equal(t, []TemporaryGrant{alice}, g.RemoveExpired(later))
+	assert.Equal(t, []TemporaryGrant{extended}, g.Grants)
+
+	actions := make([]string, 0, len(g.History))
+	for _, e := range g.History {
+		actions = append(actions, e.Action+" "+e.Grant.Subject)
+	}
+	assert.Equal(t, []string{"granted bob", "granted alice", "granted bob", "expired alice"}, actions)
+
+	// The grants survive a round trip through the ConfigMap data.
+	data, err := g.toConfigMapData()
+	require.NoError(t, err)
+	parsed, err := grantsFromConfigMapData(data)
+	require.NoError(t, err)
+	assert.Equal(t, g, parsed)
+}
+
+func TestLoadGrants(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	enf, err := NewIOReaderEnforcer(strings.NewReader("p, role:prod-dba, database-clusters, *, prod/*\ng, carol, role:prod-dba\n"))
+	require.NoError(t, err)
+
+	g := &Grants{Grants: []TemporaryGrant{
+		{Subject: "bob", Role: "role:prod-dba", ExpiresAt: now.Add(time.Hour)},
+		{Subject: "alice", Role: "role:prod-dba", ExpiresAt: now.Add(-time.Hour)},
+	}}
+	require.NoError(t, loadGrants(enf, g, now))
+
+	ok, err := enf.Enforce("bob", ResourceDatabaseClusters, ActionRead, "prod/db1", Attributes{})
+	require.NoError(t, err)
+	assert.True(t, ok)
+	ok, err = enf.Enforce("alice", ResourceDatabaseClusters, ActionRead, "prod/db1", Attributes{})
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
@@ -0,0 +1,289 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rbac
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"gopkg.in/yaml.v3"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/kubernetes"
+)
+
+const (
+	policyVersionsKey = "versions"
+
+	// maxPolicyVersions is the number of the most recent versions of the policy that are kept.
+	maxPolicyVersions = 50
+
+	// PolicyAuthorAnnotation is set on the RBAC ConfigMap by Everest to the user who changed the policy.
+	PolicyAuthorAnnotation = "everest.percona.com/rbac-policy-author"
+	// policyHashAnnotation holds the hash of the policy the author annotation refers to,
+	// so that changes made outside of Everest are not attributed to the previous author.
+	policyHashAnnotation = "everest.percona.com/rbac-policy-hash"
+
+	unknownPolicyAuthor = "unknown"
+)
+
+// ErrPolicyVersionNotFound is returned when a version of the policy is not in the history.
+var ErrPolicyVersionNotFound = errors.New("policy version not found")
+
+// PolicyVersion is a snapshot of the RBAC ConfigMap.
+type PolicyVersion struct {
+	// Version is the number of the version, starting from 1.
+	Version int `yaml:"version"`
+	// Author is the user who made the change.
+	Author string `yaml:"author"`
+	// Timestamp is the time the change was recorded at.
+	Timestamp time.Time `yaml:"timestamp"`
+	// Enabled is set if RBAC was enabled.
+	Enabled bool `yaml:"enabled"`
+	// Valid is set if the policy passed the validation.
+	Valid bool `yaml:"valid"`
+	// Policy is the content of the policy.
+	Policy string `yaml:"policy"`
+}
+
+// PolicyHistory holds the most recent versions of the RBAC policy, from the oldest to the newest.
+type PolicyHistory struct {
+	Versions []PolicyVersion
+}
+
+// Get returns the given version of the policy.
+func (h *PolicyHistory) Get(version int) (PolicyVersion, error) {
+	idx := slices.IndexFunc(h.Versions, func(v PolicyVersion) bool { return v.Version == version })
+	if idx < 0 {
+		return PolicyVersion{}, fmt.Errorf("%w: %d", ErrPolicyVersionNotFound, version)
+	}
+	return h.Versions[idx], nil
+}
+
+// LastValid returns the newest version of the policy that passed the validation.
+func (h *PolicyHistory) LastValid() (PolicyVersion, bool) {
+	for i := len(h.Versions) - 1; i >= 0; i-- {
+		if h.Versions[i].Valid {
+			return h.Versions[i], true
+		}
+	}
+	return PolicyVersion{}, false
+}
+
+// Diff returns the line-based difference between two versions of the policy, see DiffPolicy.
+func (h *PolicyHistory) Diff(from, to int) ([]string, error) {
+	a, err := h.Get(from)
+	if err != nil {
+		return nil, err
+	}
+	b, err := h.Get(to)
+	if err != nil {
+		return nil, err
+	}
+	return DiffPolicy(a.Policy, b.Policy), nil
+}
+
+// Add records a new version unless it does not differ from the newest one.
+// It returns true if the version was recorded.
+func (h *PolicyHistory) Add(v PolicyVersion) bool {
+	if n := len(h.Versions); n > 0 {
+		last := h.Versions[n-1]
+		if last.Policy == v.Policy && last.Enabled == v.Enabled && last.Valid == v.Valid {
+			return false
+		}
+		v.Version = last.Version + 1
+	} else {
+		v.Version = 1
+	}
+	h.Versions = append(h.Versions, v)
+	if len(h.Versions) > maxPolicyVersions {
+		h.Versions = h.Versions[len(h.Versions)-maxPolicyVersions:]
+	}
+	return true
+}
+
+// SetPolicyAuthor records the user who changes the policy in the RBAC ConfigMap.
+// It shall be called by Everest before the ConfigMap is updated.
+func SetPolicyAuthor(cm *corev1.ConfigMap, author string) {
+	if cm.Annotations == nil {
+		cm.Annotations = make(map[string]string)
+	}
+	cm.Annotations[PolicyAuthorAnnotation] = author
+	cm.Annotations[policyHashAnnotation] = policyHash(cm.Data["policy.csv"])
+}
+
+// policyAuthor returns the user who made the last change of the policy in the RBAC ConfigMap.
+// For changes made outside of Everest, the name of the manager of the most recent change is returned.
+func policyAuthor(cm *corev1.ConfigMap) string {
+	if author := cm.Annotations[PolicyAuthorAnnotation]; author != "" &&
+		cm.Annotations[policyHashAnnotation] == policyHash(cm.Data["policy.csv"]) {
+		return author
+	}
+	var latest *metav1.ManagedFieldsEntry
+	for i, entry := range cm.GetManagedFields() {
+		if entry.Time == nil {
+			continue
+		}
+		if latest == nil || !entry.Time.Before(latest.Time) {
+			latest = &cm.GetManagedFields()[i]
+		}
+	}
+	if latest == nil || latest.Manager == "" {
+		return unknownPolicyAuthor
+	}
+	return latest.Manager
+}
+
+func policyHash(policy string) string {
+	sum := sha256.Sum256([]byte(policy))
+	return hex.EncodeToString(sum[:])
+}
+
+// policyHistoryFromConfigMapData parses the versions of the policy from the data of a ConfigMap.
+func policyHistoryFromConfigMapData(data map[string]string) (*PolicyHistory, error) {
+	h := &PolicyHistory{}
+	if raw, ok := data[policyVersionsKey]; ok {
+		if err := yaml.Unmarshal([]byte(raw), &h.Versions); err != nil {
+			return nil, errors.Join(err, errors.New("failed to parse RBAC policy history"))
+		}
+	}
+	return h, nil
+}
+
+// toConfigMapData converts the versions of the policy to the data of a ConfigMap.
+func (h *PolicyHistory) toConfigMapData() (map[string]string, error) {
+	versions, err := yaml.Marshal(h.Versions)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]string{
+		policyVersionsKey: string(versions),
+	}, nil
+}
+
+// GetPolicyHistory returns the versions of the RBAC policy stored in Kubernetes.
+// An empty history is returned if no version was ever recorded.
+func GetPolicyHistory(ctx context.Context, k kubernetes.KubernetesConnector) (*PolicyHistory, error) {
+	cm, err := k.GetConfigMap(ctx, policyHistoryConfigMapKey())
+	if k8serrors.IsNotFound(err) {
+		return &PolicyHistory{}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return policyHistoryFromConfigMapData(cm.Data)
+}
+
+// recordPolicyVersion stores a snapshot of the RBAC ConfigMap in the history
+// unless it does not differ from the newest version.
+func recordPolicyVersion(
+	ctx context.Context,
+	k kubernetes.KubernetesConnector,
+	rbacCM *corev1.ConfigMap,
+	valid bool,
+	now time.Time,
+) error {
+	cm, err := k.GetConfigMap(ctx, policyHistoryConfigMapKey())
+	notFound := k8serrors.IsNotFound(err)
+	if err != nil && !notFound {
+		return err
+	}
+
+	h := &PolicyHistory{}
+	if !notFound {
+		if h, err = policyHistoryFromConfigMapData(cm.Data); err != nil {
+			return err
+		}
+	}
+	if !h.Add(PolicyVersion{
+		Author:    policyAuthor(rbacCM),
+		Timestamp: now.UTC(),
+		Enabled:   IsEnabled(rbacCM),
+		Valid:     valid,
+		Policy:    rbacCM.Data["policy.csv"],
+	}) {
+		return nil
+	}
+	data, err := h.toConfigMapData()
+	if err != nil {
+		return err
+	}
+
+	if notFound {
+		_, err = k.CreateConfigMap(ctx, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: common.SystemNamespace,
+				Name:      common.EverestRBACHistoryConfigMapName,
+				Labels: map[string]string{
+					common.KubernetesManagedByLabel: common.Everest,
+				},
+			},
+			Data: data,
+		})
+		return err
+	}
+	cm.Data = data
+	_, err = k.UpdateConfigMap(ctx, cm)
+	return err
+}
+
+// RollbackPolicy replaces the policy in the RBAC ConfigMap with the given version of the policy.
+// The version is validated before it is restored. Whether RBAC is enabled is not changed.
+func RollbackPolicy(ctx context.Context, k kubernetes.KubernetesConnector, version int, author string) (PolicyVersion, error) {
+	h, err := GetPolicyHistory(ctx, k)
+	if err != nil {
+		return PolicyVersion{}, err
+	}
+	v, err := h.Get(version)
+	if err != nil {
+		return PolicyVersion{}, err
+	}
+	if err := ParsePolicy(v.Policy).Validate(); err != nil {
+		return PolicyVersion{}, errors.Join(fmt.Errorf("policy version %d cannot be restored", version), err)
+	}
+
+	cm, err := k.GetConfigMap(ctx, types.NamespacedName{
+		Namespace: common.SystemNamespace,
+		Name:      common.EverestRBACConfigMapName,
+	})
+	if err != nil {
+		return PolicyVersion{}, errors.Join(err, errors.New("failed to get RBAC ConfigMap"))
+	}
+	if cm.Data == nil {
+		cm.Data = make(map[string]string)
+	}
+	cm.Data["policy.csv"] = v.Policy
+	SetPolicyAuthor(cm, author)
+	if _, err := k.UpdateConfigMap(ctx, cm); err != nil {
+		return PolicyVersion{}, err
+	}
+	return v, nil
+}
+
+func policyHistoryConfigMapKey() types.NamespacedName {
+	return types.NamespacedName{
+		Namespace: common.SystemNamespace,
+		Name:      common.EverestRBACHistoryConfigMapName,
+	}
+}
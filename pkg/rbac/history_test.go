@@ -0,0 +1,108 @@
+package rbac
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/kubernetes"
+)
+
+const (
+	historyTestPolicyV1 = "p, role:dev, namespaces, read, *\ng, alice, role:dev\n"
+	historyTestPolicyV2 = "p, role:dev, namespaces, read, *\ng, bob, role:dev\n"
+)
+
+func TestPolicyHistory(t *testing.T) {
+	t.Parallel()
+	h := &PolicyHistory{}
+	assert.True(t, h.Add(PolicyVersion{Author: "admin", Valid: true, Policy: historyTestPolicyV1}))
+	// Versions that do not differ from the newest one are not recorded.
+	assert.False(t, h.Add(PolicyVersion{Author: "admin", Valid: true, Policy: historyTestPolicyV1}))
+	assert.True(t, h.Add(PolicyVersion{Author: "admin", Valid: true, Policy: historyTestPolicyV2}))
+	assert.True(t, h.Add(PolicyVersion{Author: "kubectl-edit", Valid: false, Policy: "invalid"}))
+
+	last, ok := h.LastValid()
+	require.True(t, ok)
+	assert.Equal(t, 2, last.Version)
+
+	diff, err := h.Diff(1, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"  p, role:dev, namespaces, read, *", "- g, alice, role:dev", "+ g, bob, role:dev"}, diff)
+
+	_, err = h.Get(5)
+	require.ErrorIs(t, err, ErrPolicyVersionNotFound)
+
+	for range maxPolicyVersions {
+		h.Add(PolicyVersion{Valid: true, Policy: historyTestPolicyV1})
+		h.Add(PolicyVersion{Valid: true, Policy: historyTestPolicyV2})
+	}
+	assert.Len(t, h.Versions, maxPolicyVersions)
+	assert.Equal(t, 3+2*maxPolicyVersions, h.Versions[len(h.Versions)-1].Version)
+}
+
+func TestPolicyAuthor(t *testing.T) {
+	t.Parallel()
+	cm := &corev1.ConfigMap{Data: map[string]string{"policy.csv": historyTestPolicyV1}}
+	assert.Equal(t, unknownPolicyAuthor, policyAuthor(cm))
+
+	SetPolicyAuthor(cm, "alice")
+	assert.Equal(t, "alice", policyAuthor(cm))
+
+	// The policy was changed outside of Everest.
+	cm.Data["policy.csv"] = historyTestPolicyV2
+	cm.ManagedFields = []metav1.ManagedFieldsEntry{
+		{Manager: "everest", Time: &metav1.Time{Time: time.Now().Add(-time.Hour)}},
+		{Manager: "kubectl-edit", Time: &metav1.Time{Time: time.Now()}},
+	}
+	assert.Equal(t, "kubectl-edit", policyAuthor(cm))
+}
+
+func TestReloadPolicyFallback(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: common.SystemNamespace,
+			Name:      common.EverestRBACConfigMapName,
+		},
+		Data: map[string]string{"enabled": "true", "policy.csv": historyTestPolicyV1},
+	}
+	c := fakeclient.NewClientBuilder().WithScheme(kubernetes.CreateScheme()).WithObjects(cm).Build()
+	k := kubernetes.NewEmpty(zap.NewNop().Sugar()).WithKubernetesClient(c)
+	l := zap.NewNop().Sugar()
+
+	enforcer, err := NewEnforcer(ctx, k, l)
+	require.NoError(t, err)
+	require.NoError(t, recordPolicyVersion(ctx, k, cm, true, time.Now()))
+
+	// A policy that uses an unknown resource fails the validation.
+	cm.Data["policy.csv"] = "p, role:dev, unknown-resource, read, *\ng, bob, role:dev\n"
+	_, err = k.UpdateConfigMap(ctx, cm)
+	require.NoError(t, err)
+
+	policyErr := reloadPolicy(ctx, k, enforcer, cm, l)
+	require.ErrorIs(t, policyErr, ErrPolicySyntax)
+
+	// The last valid version is enforced instead.
+	ok, err := enforcer.Enforce("alice", ResourceNamespaces, ActionRead, "ns-1", Attributes{})
+	require.NoError(t, err)
+	assert.True(t, ok)
+	ok, err = enforcer.Enforce("bob", ResourceNamespaces, ActionRead, "ns-1", Attributes{})
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	require.NoError(t, recordPolicyVersion(ctx, k, cm, policyErr == nil, time.Now()))
+	history, err := GetPolicyHistory(ctx, k)
+	require.NoError(t, err)
+	require.Len(t, history.Versions, 2)
+	assert.False(t, history.Versions[1].Valid)
+}
@@ -49,6 +49,11 @@ func New(in io.Reader) (*Adapter, error) {
 	}, nil
 }
 
+// Content returns the policy content read by the adapter.
+func (a *Adapter) Content() string {
+	return a.content
+}
+
 // SetContent sets the content of the adapter.
 func (a *Adapter) SetContent(content string) {
 	a.content = content
@@ -0,0 +1,233 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rbac
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/casbin/casbin/v2"
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/kubernetes"
+	configmapadapter "github.com/percona/everest/pkg/rbac/configmap-adapter"
+	readeradapter "github.com/percona/everest/pkg/rbac/io-reader-adapter"
+)
+
+// LintIssue is a likely mistake in a policy that is not a syntax error.
+type LintIssue struct {
+	// Rule is the policy line the issue refers to.
+	Rule []string
+	// Message describes the issue.
+	Message string
+}
+
+// String returns the policy line followed by the description of the issue.
+func (i LintIssue) String() string {
+	return fmt.Sprintf("'%s': %s", formatPolicyLine(i.Rule), i.Message)
+}
+
+// LintError is returned by ValidatePolicy in lint mode if the linter finds issues in the policy.
+type LintError struct {
+	Issues []LintIssue
+}
+
+func (e *LintError) Error() string {
+	msgs := make([]string, 0, len(e.Issues))
+	for _, issue := range e.Issues {
+		msgs = append(msgs, issue.String())
+	}
+	return strings.Join(msgs, "\n")
+}
+
+// Lint checks the policy of the enforcer for likely mistakes that are not syntax errors:
+// roles that are never assigned, subjects assigned to non-existent roles,
+// rules that are shadowed by broader rules or overridden by deny rules,
+// rules that grant all actions on all resources to a role other than the admin role,
+// and resources that are not known.
+func Lint(enforcer casbin.IEnforcer) ([]LintIssue, error) {
+	policies, err := enforcer.GetPolicy()
+	if err != nil {
+		return nil, err
+	}
+	grouping, err := enforcer.GetGroupingPolicy()
+	if err != nil {
+		return nil, err
+	}
+	effectIdx, err := enforcer.GetModel().GetFieldIndex("p", effectField)
+	if err != nil {
+		// The model does not define an effect, all the rules allow.
+		effectIdx = -1
+	}
+	isDeny := func(p []string) bool {
+		return effectIdx >= 0 && effectIdx < len(p) && p[effectIdx] == effectDeny
+	}
+
+	var issues []LintIssue
+	roles := make(map[string]struct{})
+	for _, p := range policies {
+		if len(p) < 4 { //nolint:mnd
+			continue
+		}
+		roles[p[0]] = struct{}{}
+		if !slices.Contains(Resources, p[1]) && p[1] != "*" {
+			issues = append(issues, LintIssue{Rule: policyLine(policyTypeRule, p),
+				Message: fmt.Sprintf("resource '%s' is not a known Everest resource", p[1])})
+		}
+		if !isDeny(p) && p[0] != common.EverestAdminRole && grantsAll(p) {
+			issues = append(issues, LintIssue{Rule: policyLine(policyTypeRule, p),
+				Message: fmt.Sprintf("rule grants all actions on all resources, assign the role '%s' instead", common.EverestAdminRole)})
+		}
+	}
+	for _, g := range grouping {
+		if len(g) >= 2 && strings.HasPrefix(g[0], common.EverestRBACRolePrefix) { //nolint:mnd
+			// A role that inherits another role exists too.
+			roles[g[0]] = struct{}{}
+		}
+	}
+
+	assigned := make(map[string]struct{})
+	for _, g := range grouping {
+		if len(g) < 2 { //nolint:mnd
+			continue
+		}
+		assigned[g[1]] = struct{}{}
+		if _, ok := roles[g[1]]; !ok && g[1] != common.EverestAdminRole {
+			issues = append(issues, LintIssue{Rule: policyLine(policyTypeBinding, g),
+				Message: fmt.Sprintf("'%s' is assigned the role '%s' that does not exist", g[0], g[1])})
+		}
+	}
+	for _, p := range policies {
+		if len(p) < 4 || !strings.HasPrefix(p[0], common.EverestRBACRolePrefix) || p[0] == common.EverestAdminRole { //nolint:mnd
+			continue
+		}
+		if _, ok := assigned[p[0]]; !ok {
+			issues = append(issues, LintIssue{Rule: policyLine(policyTypeRule, p),
+				Message: fmt.Sprintf("role '%s' is never assigned to a user or group", p[0])})
+		}
+	}
+
+	for i, p := range policies {
+		if len(p) < 4 || isDeny(p) { //nolint:mnd
+			continue
+		}
+		inherited, err := enforcer.GetImplicitRolesForUser(p[0])
+		if err != nil {
+			return nil, err
+		}
+		for j, other := range policies {
+			if i == j || len(other) < 4 || !covers(other, p) { //nolint:mnd
+				continue
+			}
+			if other[0] != p[0] && !slices.Contains(inherited, other[0]) {
+				continue
+			}
+			if isDeny(other) {
+				issues = append(issues, LintIssue{Rule: policyLine(policyTypeRule, p),
+					Message: fmt.Sprintf("rule is overridden by the deny rule '%s'", formatPolicyLine(policyLine(policyTypeRule, other)))})
+				break
+			}
+			if covers(p, other) && other[0] == p[0] {
+				// Of two equivalent rules, only the latter one is reported.
+				if j < i {
+					issues = append(issues, LintIssue{Rule: policyLine(policyTypeRule, p),
+						Message: fmt.Sprintf("rule duplicates the rule '%s'", formatPolicyLine(policyLine(policyTypeRule, other)))})
+					break
+				}
+				continue
+			}
+			issues = append(issues, LintIssue{Rule: policyLine(policyTypeRule, p),
+				Message: fmt.Sprintf("rule is shadowed by the broader rule '%s'", formatPolicyLine(policyLine(policyTypeRule, other)))})
+			break
+		}
+	}
+	return issues, nil
+}
+
+// covers returns true if the broad rule applies to every request that the narrow rule applies to,
+// regardless of their subjects.
+func covers(broad, narrow []string) bool {
+	if !globMatch(narrow[1], broad[1]) || !globMatch(narrow[2], broad[2]) {
+		return false
+	}
+	broadObject, broadConds := splitObjectConditions(broad[3])
+	narrowObject, narrowConds := splitObjectConditions(narrow[3])
+	if !globMatch(narrowObject, broadObject) {
+		return false
+	}
+	// The conditions of the broad rule must all be conditions of the narrow rule.
+	for _, cond := range broadConds {
+		if !slices.Contains(narrowConds, cond) {
+			return false
+		}
+	}
+	return true
+}
+
+func grantsAll(p []string) bool {
+	object, conds := splitObjectConditions(p[3])
+	return p[1] == "*" && p[2] == ActionAll && (object == "*" || object == "*/*") && len(conds) == 0
+}
+
+func splitObjectConditions(object string) (string, []string) {
+	parts := strings.Split(object, conditionSeparator)
+	return parts[0], parts[1:]
+}
+
+func policyLine(ptype string, rule []string) []string {
+	return append([]string{ptype}, rule...)
+}
+
+// lintPolicy lints the policy from either Kubernetes or local file as it is,
+// that is, without the admin policy.
+//
+//nolint:nonamedreturns
+func lintPolicy(k kubernetes.KubernetesConnector, filePath string) (issues []LintIssue, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("cannot create enforcer: %v", r)
+			issues = nil
+		}
+	}()
+	var enforcer *casbin.Enforcer
+	if filePath != "" {
+		f, err := os.Open(filePath) //nolint:gosec
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close() //nolint:errcheck
+		adapter, err := readeradapter.New(f)
+		if err != nil {
+			return nil, err
+		}
+		if enforcer, err = newUnvalidatedEnforcer(adapter, false); err != nil {
+			return nil, err
+		}
+	} else {
+		adapter := configmapadapter.New(zap.NewNop().Sugar(), k, types.NamespacedName{
+			Namespace: common.SystemNamespace,
+			Name:      common.EverestRBACConfigMapName,
+		})
+		if enforcer, err = newUnvalidatedEnforcer(adapter, false); err != nil {
+			return nil, err
+		}
+	}
+	return Lint(enforcer)
+}
@@ -0,0 +1,114 @@
+package rbac
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	readeradapter "github.com/percona/everest/pkg/rbac/io-reader-adapter"
+)
+
+func TestLint(t *testing.T) {
+	t.Parallel()
+
+	lint := func(t *testing.T, policy string) []string {
+		t.Helper()
+		adapter, err := readeradapter.New(strings.NewReader(policy))
+		require.NoError(t, err)
+		enforcer, err := newUnvalidatedEnforcer(adapter, false)
+		require.NoError(t, err)
+		issues, err := Lint(enforcer)
+		require.NoError(t, err)
+		result := make([]string, 0, len(issues))
+		for _, issue := range issues {
+			result = append(result, issue.String())
+		}
+		return result
+	}
+
+	t.Run("no issues", func(t *testing.T) {
+		t.Parallel()
+		assert.Empty(t, lint(t, `
+p, role:dev, database-clusters, read, ns-dev/*
+p, role:dev, database-clusters, *, ns-dev/cluster-1;engineType=postgresql
+p, role:admin, *, *, *
+g, alice, role:dev
+`))
+	})
+
+	t.Run("unassigned and non-existent roles", func(t *testing.T) {
+		t.Parallel()
+		assert.ElementsMatch(t, []string{
+			"'p, role:ops, namespaces, read, *': role 'role:ops' is never assigned to a user or group",
+			"'g, alice, role:missing': 'alice' is assigned the role 'role:missing' that does not exist",
+		}, lint(t, `
+p, role:dev, namespaces, read, *
+p, role:ops, namespaces, read, *
+g, alice, role:dev
+g, alice, role:missing
+g, bob, role:admin
+`))
+	})
+
+	t.Run("shadowed rules", func(t *testing.T) {
+		t.Parallel()
+		assert.ElementsMatch(t, []string{
+			"'p, role:dev, database-clusters, read, ns-dev/*': rule is shadowed by the broader rule 'p, role:dev, database-clusters, *, ns-dev/*'",
+			"'p, role:dev, database-clusters, read, ns-dev/*;engineType=postgresql': rule is shadowed by the broader rule 'p, role:dev, database-clusters, *, ns-dev/*'",
+			"'p, alice, database-clusters, update, ns-dev/cluster-1': rule is shadowed by the broader rule 'p, role:dev, database-clusters, *, ns-dev/*'",
+			"'p, role:dev, database-clusters, *, ns-qa/*;labels.team=qa;engineType=pxc': rule duplicates the rule 'p, role:dev, database-clusters, *, ns-qa/*;engineType=pxc;labels.team=qa'",
+		}, lint(t, `
+p, role:dev, database-clusters, *, ns-dev/*
+p, role:dev, database-clusters, read, ns-dev/*
+p, role:dev, database-clusters, read, ns-dev/*;engineType=postgresql
+p, role:dev, namespaces, read, ns-dev
+p, role:dev, database-clusters, *, ns-qa/*;engineType=pxc;labels.team=qa
+p, role:dev, database-clusters, *, ns-qa/*;labels.team=qa;engineType=pxc
+p, role:dev, database-clusters, *, ns-prod/*;engineType=postgresql
+p, role:dev, database-clusters, *, ns-prod/cluster-1
+p, alice, database-clusters, update, ns-dev/cluster-1
+p, bob, database-clusters, update, ns-dev/cluster-1
+g, alice, role:dev
+`))
+	})
+
+	t.Run("wildcards and unknown resources", func(t *testing.T) {
+		t.Parallel()
+		assert.ElementsMatch(t, []string{
+			"'p, role:ops, *, *, */*': rule grants all actions on all resources, assign the role 'role:admin' instead",
+			"'p, alice, *, *, *': rule grants all actions on all resources, assign the role 'role:admin' instead",
+			"'p, role:ops, database-clusterz, read, */*': resource 'database-clusterz' is not a known Everest resource",
+			"'p, role:ops, database-clusterz, read, */*': rule is shadowed by the broader rule 'p, role:ops, *, *, */*'",
+			"'p, role:ops, *, *, */*;engineType=postgresql': rule is shadowed by the broader rule 'p, role:ops, *, *, */*'",
+		}, lint(t, `
+p, role:ops, *, *, */*
+p, role:ops, database-clusterz, read, */*
+p, role:ops, *, *, */*;engineType=postgresql
+p, alice, *, *, *
+p, role:admin, *, *, */*
+g, bob, role:ops
+`))
+	})
+}
+
+func TestValidatePolicyLint(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	require.NoError(t, ValidatePolicy(ctx, nil, "./testdata/policy-1-good.csv", WithLint()))
+
+	err := ValidatePolicy(ctx, nil, "./testdata/policy-11-lint.csv", WithLint())
+	var lintErr *LintError
+	require.ErrorAs(t, err, &lintErr)
+	assert.False(t, errors.Is(err, ErrPolicySyntax))
+	assert.Len(t, lintErr.Issues, 5)
+
+	// syntax errors are reported together with the lint issues.
+	err = ValidatePolicy(ctx, nil, "./testdata/policy-2-bad.csv", WithLint())
+	require.ErrorIs(t, err, ErrPolicySyntax)
+	require.NoError(t, ValidatePolicy(ctx, nil, "./testdata/policy-11-lint.csv"))
+}
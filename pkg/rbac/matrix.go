@@ -0,0 +1,83 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rbac
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/casbin/casbin/v2"
+)
+
+// PermissionMatrix maps the resources to the object patterns of the policy
+// and the actions allowed on the objects that match them.
+type PermissionMatrix map[string]map[string][]string
+
+// NewPermissionMatrix computes the permission matrix of the given subjects, e.g. a user and its groups,
+// by enumerating the policy rules assigned to them directly or through their roles.
+// Wildcards in the resources and actions of the rules are expanded to the known resources
+// and to the individual actions.
+func NewPermissionMatrix(enforcer casbin.IEnforcer, subjects ...string) (PermissionMatrix, error) {
+	resourcePathMap, _, err := buildPathResourceMap("")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get resource path map: %w", err)
+	}
+	resources := make([]string, 0, len(resourcePathMap))
+	for _, resource := range resourcePathMap {
+		if !slices.Contains(resources, resource) {
+			resources = append(resources, resource)
+		}
+	}
+	// The actions are expanded in the order in which they are listed.
+	actions := []string{ActionCreate, ActionRead, ActionUpdate, ActionDelete}
+
+	matrix := make(PermissionMatrix)
+	for _, sub := range subjects {
+		perms, err := enforcer.GetImplicitPermissionsForUser(sub)
+		if err != nil {
+			return nil, fmt.Errorf("failed to GetImplicitPermissionsForUser: %w", err)
+		}
+		for _, perm := range perms {
+			if len(perm) < 4 { //nolint:mnd
+				return nil, errors.New("invalid permission")
+			}
+			for _, resource := range resources {
+				if !globMatch(resource, perm[1]) {
+					continue
+				}
+				if matrix[resource] == nil {
+					matrix[resource] = make(map[string][]string)
+				}
+				object := perm[3]
+				for _, action := range actions {
+					if globMatch(action, perm[2]) && !slices.Contains(matrix[resource][object], action) {
+						matrix[resource][object] = append(matrix[resource][object], action)
+					}
+				}
+			}
+		}
+	}
+	for _, objects := range matrix {
+		for object, allowed := range objects {
+			slices.SortFunc(allowed, func(a, b string) int {
+				return slices.Index(actions, a) - slices.Index(actions, b)
+			})
+			objects[object] = allowed
+		}
+	}
+	return matrix, nil
+}
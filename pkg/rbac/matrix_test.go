@@ -0,0 +1,73 @@
+package rbac
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPermissionMatrix(t *testing.T) {
+	t.Parallel()
+
+	enforcer, err := NewIOReaderEnforcer(strings.NewReader(`
+p, role:dev, namespaces, read, *
+p, role:dev, database-clusters, *, dev/*
+p, role:dev, database-clusters, read, prod/*
+p, devs, database-engines, update, dev/*
+p, devs, database-engines, read, dev/*
+g, alice, role:dev
+`))
+	require.NoError(t, err)
+
+	testCases := []struct {
+		desc     string
+		subjects []string
+		want     PermissionMatrix
+	}{
+		{
+			desc:     "roles and groups",
+			subjects: []string{"alice", "devs"},
+			want: PermissionMatrix{
+				"namespaces": {"*": {"read"}},
+				"database-clusters": {
+					"dev/*":  {"create", "read", "update", "delete"},
+					"prod/*": {"read"},
+				},
+				"database-engines": {"dev/*": {"read", "update"}},
+			},
+		},
+		{
+			desc:     "no permissions",
+			subjects: []string{"carol"},
+			want:     PermissionMatrix{},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			got, err := NewPermissionMatrix(enforcer, tc.subjects...)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestNewPermissionMatrixWildcardResource(t *testing.T) {
+	t.Parallel()
+
+	enforcer, err := NewIOReaderEnforcer(strings.NewReader(`
+p, role:ops, *, read, prod/*
+g, bob, role:ops
+`))
+	require.NoError(t, err)
+	resourcePathMap, _, err := buildPathResourceMap("")
+	require.NoError(t, err)
+
+	got, err := NewPermissionMatrix(enforcer, "bob")
+	require.NoError(t, err)
+	for _, resource := range resourcePathMap {
+		assert.Equal(t, map[string][]string{"prod/*": {"read"}}, got[resource], resource)
+	}
+}
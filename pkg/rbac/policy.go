@@ -0,0 +1,282 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rbac
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/percona/everest/pkg/common"
+)
+
+const (
+	policyTypeRule    = "p"
+	policyTypeBinding = "g"
+)
+
+var (
+	// ErrRoleExists is returned when adding a role that already has policy rules.
+	ErrRoleExists = errors.New("role already exists")
+	// ErrRoleNotFound is returned when a role has no policy rules.
+	ErrRoleNotFound = errors.New("role not found")
+	// ErrRuleExists is returned when adding a policy line that is already present.
+	ErrRuleExists = errors.New("policy rule already exists")
+	// ErrRuleNotFound is returned when removing a policy line that is not present.
+	ErrRuleNotFound = errors.New("policy rule not found")
+	// ErrBuiltinRole is returned when modifying the built-in admin role.
+	ErrBuiltinRole = fmt.Errorf("role '%s' is built-in and cannot be modified", common.EverestAdminRole)
+	// ErrAdminLockout is returned when a policy does not assign the admin role to any user.
+	ErrAdminLockout = fmt.Errorf("no user or group is assigned the role '%s'", common.EverestAdminRole)
+)
+
+// Policy is an editable RBAC policy in the CSV format used by the RBAC ConfigMap.
+// Comments, empty lines and the order of the lines are preserved on edits.
+type Policy struct {
+	lines []string
+}
+
+// ParsePolicy returns a new Policy for the given CSV content.
+func ParsePolicy(content string) *Policy {
+	content = strings.TrimRight(content, "\n")
+	if content == "" {
+		return &Policy{}
+	}
+	return &Policy{lines: strings.Split(content, "\n")}
+}
+
+// String returns the CSV content of the policy.
+func (p *Policy) String() string {
+	if len(p.lines) == 0 {
+		return ""
+	}
+	return strings.Join(p.lines, "\n") + "\n"
+}
+
+// Validate checks the syntax of the policy and ensures that the admin role
+// remains assigned to at least one user or group.
+//
+//nolint:nonamedreturns
+func (p *Policy) Validate() (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = errors.Join(ErrPolicySyntax, fmt.Errorf("cannot create enforcer: %v", r))
+		}
+	}()
+	enforcer, err := NewIOReaderEnforcer(strings.NewReader(p.String()))
+	if err != nil {
+		return err
+	}
+	users, err := enforcer.GetImplicitUsersForRole(common.EverestAdminRole)
+	if err != nil {
+		return err
+	}
+	for _, user := range users {
+		if !strings.HasPrefix(user, common.EverestRBACRolePrefix) {
+			return nil
+		}
+	}
+	return ErrAdminLockout
+}
+
+// AddRole adds a new role with the given permissions and assigns it to the given subjects.
+// Each permission is a list of [resource action object].
+func (p *Policy) AddRole(role string, permissions [][]string, subjects []string) error {
+	if err := checkEditableRole(role); err != nil {
+		return err
+	}
+	if p.hasRole(role) {
+		return fmt.Errorf("%w: '%s'", ErrRoleExists, role)
+	}
+	if len(permissions) == 0 {
+		return fmt.Errorf("role '%s' must have at least one permission", role)
+	}
+	if len(subjects) == 0 {
+		return fmt.Errorf("role '%s' must be assigned to at least one user or group", role)
+	}
+	for _, perm := range permissions {
+		if len(perm) != 3 { //nolint:mnd
+			return fmt.Errorf("invalid permission '%s', expected <resource>,<action>,<object>", strings.Join(perm, ","))
+		}
+		if err := p.Grant(role, perm[0], perm[1], perm[2]); err != nil {
+			return err
+		}
+	}
+	for _, subject := range subjects {
+		if err := p.Assign(subject, role); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RemoveRole removes all the policy rules of the role and all its assignments.
+func (p *Policy) RemoveRole(role string) error {
+	if err := checkEditableRole(role); err != nil {
+		return err
+	}
+	if !p.hasRole(role) {
+		return fmt.Errorf("%w: '%s'", ErrRoleNotFound, role)
+	}
+	p.lines = slices.DeleteFunc(p.lines, func(line string) bool {
+		tokens := parsePolicyLine(line)
+		switch {
+		case len(tokens) < 3: //nolint:mnd
+			return false
+		case tokens[0] == policyTypeRule:
+			return tokens[1] == role
+		case tokens[0] == policyTypeBinding:
+			return tokens[1] == role || tokens[2] == role
+		}
+		return false
+	})
+	return nil
+}
+
+// Grant adds a policy rule that allows the subject to perform the action on the resource object.
+func (p *Policy) Grant(subject, resource, action, object string) error {
+	return p.add(policyTypeRule, subject, resource, action, object)
+}
+
+// Revoke removes the policy rule that allows the subject to perform the action on the resource object.
+func (p *Policy) Revoke(subject, resource, action, object string) error {
+	if subject == common.EverestAdminRole {
+		return ErrBuiltinRole
+	}
+	return p.remove(policyTypeRule, subject, resource, action, object)
+}
+
+// Assign assigns the role to the subject, which is either a user or a group.
+func (p *Policy) Assign(subject, role string) error {
+	if !strings.HasPrefix(role, common.EverestRBACRolePrefix) {
+		return fmt.Errorf("invalid role '%s', roles must start with '%s'", role, common.EverestRBACRolePrefix)
+	}
+	if role != common.EverestAdminRole && !p.hasRole(role) {
+		return fmt.Errorf("%w: '%s'", ErrRoleNotFound, role)
+	}
+	return p.add(policyTypeBinding, subject, role)
+}
+
+func (p *Policy) add(tokens ...string) error {
+	if p.indexOf(tokens) >= 0 {
+		return fmt.Errorf("%w: '%s'", ErrRuleExists, formatPolicyLine(tokens))
+	}
+	p.lines = append(p.lines, formatPolicyLine(tokens))
+	return nil
+}
+
+func (p *Policy) remove(tokens ...string) error {
+	idx := p.indexOf(tokens)
+	if idx < 0 {
+		return fmt.Errorf("%w: '%s'", ErrRuleNotFound, formatPolicyLine(tokens))
+	}
+	p.lines = slices.Delete(p.lines, idx, idx+1)
+	return nil
+}
+
+func (p *Policy) indexOf(tokens []string) int {
+	return slices.IndexFunc(p.lines, func(line string) bool {
+		return slices.Equal(parsePolicyLine(line), tokens)
+	})
+}
+
+func (p *Policy) hasRole(role string) bool {
+	return slices.ContainsFunc(p.lines, func(line string) bool {
+		tokens := parsePolicyLine(line)
+		return len(tokens) > 1 && tokens[0] == policyTypeRule && tokens[1] == role
+	})
+}
+
+func checkEditableRole(role string) error {
+	if !strings.HasPrefix(role, common.EverestRBACRolePrefix) {
+		return fmt.Errorf("invalid role '%s', roles must start with '%s'", role, common.EverestRBACRolePrefix)
+	}
+	if role == common.EverestAdminRole {
+		return ErrBuiltinRole
+	}
+	return nil
+}
+
+// parsePolicyLine returns the tokens of the policy line,
+// or nil if the line is empty, a comment or cannot be parsed.
+func parsePolicyLine(line string) []string {
+	line = strings.TrimSpace(line)
+	if line == "" || strings.HasPrefix(line, "#") {
+		return nil
+	}
+	r := csv.NewReader(strings.NewReader(line))
+	r.TrimLeadingSpace = true
+	tokens, err := r.Read()
+	if err != nil {
+		return nil
+	}
+	for i := range tokens {
+		tokens[i] = strings.TrimSpace(tokens[i])
+	}
+	return tokens
+}
+
+func formatPolicyLine(tokens []string) string {
+	return strings.Join(tokens, ", ")
+}
+
+// DiffPolicy returns the line-based difference between two policies.
+// Removed lines are prefixed with '-', added lines with '+' and unchanged lines with a space.
+func DiffPolicy(from, to string) []string {
+	a := ParsePolicy(from).lines
+	b := ParsePolicy(to).lines
+
+	// lcs[i][j] is the length of the longest common subsequence of a[i:] and b[j:].
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	diff := make([]string, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			diff = append(diff, "  "+a[i])
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			diff = append(diff, "- "+a[i])
+			i++
+		default:
+			diff = append(diff, "+ "+b[j])
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		diff = append(diff, "- "+a[i])
+	}
+	for ; j < len(b); j++ {
+		diff = append(diff, "+ "+b[j])
+	}
+	return diff
+}
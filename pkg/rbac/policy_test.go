@@ -0,0 +1,90 @@
+package rbac
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testPolicy = `# Everest policy
+p, role:dev, database-clusters, read, ns-dev/*
+p, role:dev, namespaces, read, ns-dev
+
+g, admin, role:admin
+g, alice, role:dev
+`
+
+func TestPolicy(t *testing.T) {
+	t.Parallel()
+
+	t.Run("add and remove role", func(t *testing.T) {
+		t.Parallel()
+		p := ParsePolicy(testPolicy)
+		require.NoError(t, p.AddRole("role:ops", [][]string{{"database-clusters", "*", "*/*"}}, []string{"bob"}))
+		require.NoError(t, p.Validate())
+		assert.Contains(t, p.String(), "p, role:ops, database-clusters, *, */*\ng, bob, role:ops\n")
+
+		perms := [][]string{{"namespaces", "read", "*"}}
+		require.ErrorIs(t, p.AddRole("role:ops", perms, []string{"bob"}), ErrRoleExists)
+		require.ErrorIs(t, p.AddRole("role:admin", perms, []string{"bob"}), ErrBuiltinRole)
+		require.Error(t, p.AddRole("role:empty", nil, []string{"bob"}))
+		require.Error(t, p.AddRole("role:unassigned", perms, nil))
+
+		require.NoError(t, p.RemoveRole("role:dev"))
+		assert.NotContains(t, p.String(), "role:dev")
+		assert.Contains(t, p.String(), "# Everest policy\n")
+		require.ErrorIs(t, p.RemoveRole("role:dev"), ErrRoleNotFound)
+		require.ErrorIs(t, p.RemoveRole("role:admin"), ErrBuiltinRole)
+		require.NoError(t, p.Validate())
+	})
+
+	t.Run("grant and revoke", func(t *testing.T) {
+		t.Parallel()
+		p := ParsePolicy(testPolicy)
+		require.NoError(t, p.Grant("alice", "backup-storages", "read", "ns-dev/*"))
+		require.ErrorIs(t, p.Grant("alice", "backup-storages", "read", "ns-dev/*"), ErrRuleExists)
+		require.NoError(t, p.Validate())
+
+		require.NoError(t, p.Revoke("role:dev", "database-clusters", "read", "ns-dev/*"))
+		require.ErrorIs(t, p.Revoke("role:dev", "database-clusters", "read", "ns-dev/*"), ErrRuleNotFound)
+		assert.NotContains(t, p.String(), "database-clusters")
+	})
+
+	t.Run("invalid changes are rejected", func(t *testing.T) {
+		t.Parallel()
+		p := ParsePolicy(testPolicy)
+		require.NoError(t, p.Grant("alice", "unknown-resource", "read", "*"))
+		require.Error(t, p.Validate())
+
+		p = ParsePolicy(testPolicy)
+		require.NoError(t, p.Grant("role:unassigned", "namespaces", "read", "*"))
+		require.Error(t, p.Validate())
+
+		require.ErrorIs(t, ParsePolicy(testPolicy).Assign("bob", "role:missing"), ErrRoleNotFound)
+		require.Error(t, ParsePolicy(testPolicy).Assign("bob", "dev"))
+	})
+
+	t.Run("admin lockout", func(t *testing.T) {
+		t.Parallel()
+		p := ParsePolicy(testPolicy)
+		require.NoError(t, p.remove("g", "admin", "role:admin"))
+		require.ErrorIs(t, p.Validate(), ErrAdminLockout)
+
+		// the admin role may be inherited through another role.
+		require.NoError(t, p.Assign("role:dev", "role:admin"))
+		require.NoError(t, p.Validate())
+	})
+}
+
+func TestDiffPolicy(t *testing.T) {
+	t.Parallel()
+	from := "p, role:dev, namespaces, read, *\ng, admin, role:admin\ng, alice, role:dev\n"
+	to := "p, role:dev, namespaces, read, *\np, role:dev, database-clusters, read, */*\ng, admin, role:admin\n"
+	assert.Equal(t, []string{
+		"  p, role:dev, namespaces, read, *",
+		"+ p, role:dev, database-clusters, read, */*",
+		"  g, admin, role:admin",
+		"- g, alice, role:dev",
+	}, DiffPolicy(from, to))
+}
@@ -19,11 +19,15 @@ package rbac
 import (
 	"context"
 	"errors"
+	"fmt"
 	"io"
 	"io/fs"
 	"os"
 	"slices"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/casbin/casbin/v2"
 	"github.com/casbin/casbin/v2/model"
@@ -57,6 +61,9 @@ const (
 	ResourcePodSchedulingPolicies      = "pod-scheduling-policies"
 	ResourceDataImporters              = "data-importers"
 	ResourceDataImportJobs             = "data-import-jobs"
+	ResourceTelemetry                  = "telemetry"
+	ResourceSettings                   = "settings"
+	ResourceAccounts                   = "accounts"
 )
 
 // GlobalResources is a list of all Everest API resources that are considered global.
@@ -64,6 +71,27 @@ var GlobalResources = []string{
 	ResourceNamespaces,
 	ResourcePodSchedulingPolicies,
 	ResourceDataImporters,
+	ResourceTelemetry,
+	ResourceSettings,
+	ResourceAccounts,
+}
+
+// Resources is a list of all Everest API resources.
+var Resources = []string{
+	ResourceBackupStorages,
+	ResourceDatabaseClusters,
+	ResourceDatabaseClusterBackups,
+	ResourceDatabaseClusterCredentials,
+	ResourceDatabaseClusterRestores,
+	ResourceDatabaseEngines,
+	ResourceMonitoringInstances,
+	ResourceNamespaces,
+	ResourcePodSchedulingPolicies,
+	ResourceDataImporters,
+	ResourceDataImportJobs,
+	ResourceTelemetry,
+	ResourceSettings,
+	ResourceAccounts,
 }
 
 func IsGlobalResource(resource string) bool {
@@ -95,42 +123,157 @@ type User struct {
 	Groups  []string
 }
 
-// Setup a new informer that watches our RBAC ConfigMap.
-// This informer reloads the policy whenever the ConfigMap is updated.
+// Setup a new informer that watches our RBAC ConfigMaps.
+// This informer reloads the policy whenever the RBAC ConfigMap or the temporary grants are updated.
+// The expired temporary grants are removed periodically.
 func refreshEnforcerInBackground(
 	ctx context.Context,
 	kubeConnector kubernetes.KubernetesConnector,
 	enforcer *casbin.Enforcer,
 	l *zap.SugaredLogger,
 ) error {
+	var mu sync.Mutex
+	reload := func(cm *corev1.ConfigMap) error {
+		mu.Lock()
+		defer mu.Unlock()
+		return reloadPolicy(ctx, kubeConnector, enforcer, cm, l)
+	}
+	reloadFromCluster := func() {
+		cm, err := kubeConnector.GetConfigMap(ctx, types.NamespacedName{
+			Namespace: common.SystemNamespace,
+			Name:      common.EverestRBACConfigMapName,
+		})
+		if err != nil {
+			l.Errorf("failed to get RBAC ConfigMap: %v", err)
+			return
+		}
+		_ = reload(cm)
+	}
+
 	inf, err := informer.New(
 		informer.WithConfig(kubeConnector.Config()),
 		informer.WithLogger(l),
 		informer.Watches(&corev1.ConfigMap{}, common.SystemNamespace),
 	)
+	inf.OnAdd(func(obj interface{}) {
+		cm, ok := obj.(*corev1.ConfigMap)
+		if !ok || cm.GetName() != common.EverestRBACGrantsConfigMapName {
+			return
+		}
+		reloadFromCluster()
+	})
 	inf.OnUpdate(func(_, newObj interface{}) {
 		cm, ok := newObj.(*corev1.ConfigMap)
-		if !ok || cm.GetName() != common.EverestRBACConfigMapName {
+		if !ok {
 			return
 		}
-		if err := enforcer.LoadPolicy(); err != nil {
-			panic("invalid policy detected - " + err.Error())
-		}
-		if err := validatePolicy(enforcer); err != nil {
-			panic("invalid policy detected - " + err.Error())
-		}
-		// Calling LoadPolicy() re-writes the entire model, so we need to add back the admin role.
-		if err := loadAdminPolicy(enforcer); err != nil {
-			panic("failed to load admin policy - " + err.Error())
+		switch cm.GetName() {
+		case common.EverestRBACConfigMapName:
+			policyErr := reload(cm)
+			if err := recordPolicyVersion(ctx, kubeConnector, cm, policyErr == nil, time.Now()); err != nil {
+				l.Errorf("failed to record RBAC policy version: %v", err)
+			}
+		case common.EverestRBACGrantsConfigMapName:
+			reloadFromCluster()
 		}
-		enforcer.EnableEnforce(IsEnabled(cm))
 	})
 	if inf.Start(ctx, &corev1.ConfigMap{}) != nil {
 		return errors.Join(err, errors.New("failed to watch RBAC ConfigMap"))
 	}
+
+	go func() {
+		ticker := time.NewTicker(grantsExpiryInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+			expired, err := removeExpiredGrants(ctx, kubeConnector, time.Now())
+			if err != nil {
+				// The expired grants are not loaded anyway, make sure they are not enforced.
+				l.Errorf("failed to remove expired temporary grants: %v", err)
+				reloadFromCluster()
+				continue
+			}
+			for _, grant := range expired {
+				l.Infof("temporary grant of role '%s' to '%s' has expired", grant.Role, grant.Subject)
+			}
+		}
+	}()
 	return nil
 }
 
+// reloadPolicy reloads the policy of the enforcer and adds back the admin policy and the active temporary grants.
+// If the policy in the ConfigMap is invalid, the last valid version of the policy is loaded instead
+// and the validation error is returned.
+func reloadPolicy(
+	ctx context.Context,
+	kubeConnector kubernetes.KubernetesConnector,
+	enforcer *casbin.Enforcer,
+	cm *corev1.ConfigMap,
+	l *zap.SugaredLogger,
+) error {
+	enabled := IsEnabled(cm)
+	policyErr := loadPolicy(enforcer)
+	if policyErr != nil {
+		history, err := GetPolicyHistory(ctx, kubeConnector)
+		if err != nil {
+			panic("invalid policy detected - " + errors.Join(policyErr, err).Error())
+		}
+		v, ok := history.LastValid()
+		if !ok {
+			panic("invalid policy detected - " + policyErr.Error())
+		}
+		l.Errorf("invalid policy detected, falling back to version %d: %v", v.Version, policyErr)
+		if err := loadPolicyContent(enforcer, v.Policy); err != nil {
+			panic("failed to load policy version " + strconv.Itoa(v.Version) + " - " + err.Error())
+		}
+		enabled = v.Enabled
+	}
+	// Calling LoadPolicy() re-writes the entire model, so we need to add back the admin role.
+	if err := loadAdminPolicy(enforcer); err != nil {
+		panic("failed to load admin policy - " + err.Error())
+	}
+	grants, err := GetGrants(ctx, kubeConnector)
+	if err != nil {
+		l.Errorf("failed to get temporary grants: %v", err)
+	} else if err := loadGrants(enforcer, grants, time.Now()); err != nil {
+		l.Errorf("failed to load temporary grants: %v", err)
+	}
+	enforcer.EnableEnforce(enabled)
+	return policyErr
+}
+
+// loadPolicy loads the policy of the enforcer from its adapter and validates it.
+//
+//nolint:nonamedreturns
+func loadPolicy(enforcer *casbin.Enforcer) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("cannot load policy: %v", r)
+		}
+	}()
+	if err := enforcer.LoadPolicy(); err != nil {
+		return err
+	}
+	return validatePolicy(enforcer)
+}
+
+// loadPolicyContent loads the given policy into the enforcer and validates it.
+// The adapter of the enforcer is left unchanged.
+func loadPolicyContent(enforcer *casbin.Enforcer, policy string) error {
+	adapter, err := readeradapter.New(strings.NewReader(policy))
+	if err != nil {
+		return err
+	}
+	current := enforcer.GetAdapter()
+	defer enforcer.SetAdapter(current)
+	enforcer.SetAdapter(adapter)
+	return loadPolicy(enforcer)
+}
+
 func getModel() (model.Model, error) {
 	modelData, err := fs.ReadFile(data.RBAC, "rbac/model.conf")
 	if err != nil {
@@ -139,7 +282,9 @@ func getModel() (model.Model, error) {
 	return model.NewModelFromString(string(modelData))
 }
 
-func newEnforcer(adapter persist.Adapter, enableLogs bool) (*casbin.Enforcer, error) {
+// newUnvalidatedEnforcer creates a new enforcer with the policy from the adapter as it is,
+// without the admin policy and without validating the policy.
+func newUnvalidatedEnforcer(adapter persist.Adapter, enableLogs bool) (*casbin.Enforcer, error) {
 	model, err := getModel()
 	if err != nil {
 		return nil, err
@@ -148,6 +293,15 @@ func newEnforcer(adapter persist.Adapter, enableLogs bool) (*casbin.Enforcer, er
 	if err != nil {
 		return nil, err
 	}
+	enf.AddFunction("objectMatch", objectMatchFunc)
+	return enf, nil
+}
+
+func newEnforcer(adapter persist.Adapter, enableLogs bool) (*casbin.Enforcer, error) {
+	enf, err := newUnvalidatedEnforcer(adapter, enableLogs)
+	if err != nil {
+		return nil, err
+	}
 	if err := loadAdminPolicy(enf); err != nil {
 		return nil, errors.Join(err, errors.New("failed to load admin policy"))
 	}
@@ -177,11 +331,23 @@ func NewIOReaderEnforcer(r io.Reader) (*casbin.Enforcer, error) {
 }
 
 // NewEnforcerWithRefresh creates a new enforcer that refreshes the policy whenever the ConfigMap is updated.
+// Every change of the ConfigMap is recorded in the policy history.
 func NewEnforcerWithRefresh(ctx context.Context, kubeConnector kubernetes.KubernetesConnector, l *zap.SugaredLogger) (*casbin.Enforcer, error) {
 	enf, err := NewEnforcer(ctx, kubeConnector, l)
 	if err != nil {
 		return nil, err
 	}
+	cm, err := kubeConnector.GetConfigMap(ctx, types.NamespacedName{
+		Namespace: common.SystemNamespace,
+		Name:      common.EverestRBACConfigMapName,
+	})
+	if err != nil {
+		return nil, errors.Join(err, errors.New("failed to get RBAC ConfigMap"))
+	}
+	// The policy has just been validated by NewEnforcer.
+	if err := recordPolicyVersion(ctx, kubeConnector, cm, true, time.Now()); err != nil {
+		l.Errorf("failed to record RBAC policy version: %v", err)
+	}
 	return enf, refreshEnforcerInBackground(ctx, kubeConnector, enf, l)
 }
 
@@ -200,12 +366,32 @@ func NewEnforcer(ctx context.Context, kubeConnector kubernetes.KubernetesConnect
 	if err != nil {
 		return nil, errors.Join(err, errors.New("failed to get RBAC ConfigMap"))
 	}
+	grants, err := GetGrants(ctx, kubeConnector)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("failed to get temporary grants"))
+	}
+	if err := loadGrants(enforcer, grants, time.Now()); err != nil {
+		return nil, errors.Join(err, errors.New("failed to load temporary grants"))
+	}
 	enforcer.EnableEnforce(IsEnabled(cm))
 	return enforcer, nil
 }
 
-// GetUser extracts the user from the JWT token in the context.
+type userCtxKey struct{}
+
+// ContextWithUser returns a copy of ctx that carries the user returned by GetUser.
+// It is used for the operations that run on behalf of a user outside of a request.
+func ContextWithUser(ctx context.Context, user User) context.Context {
+	return context.WithValue(ctx, userCtxKey{}, user)
+}
+
+// GetUser extracts the user from the JWT token in the context, unless the context carries a user, see ContextWithUser.
+// The user of OIDC tokens is extracted with the claim mapping of the context, see ContextWithClaimMapping.
 func GetUser(ctx context.Context) (User, error) {
+	if user, ok := ctx.Value(userCtxKey{}).(User); ok {
+		return user, nil
+	}
+
 	token, ok := ctx.Value(common.UserCtxKey).(*jwt.Token)
 	if !ok {
 		return User{}, errors.New("failed to get token from context")
@@ -216,20 +402,21 @@ func GetUser(ctx context.Context) (User, error) {
 		return User{}, errors.New("failed to get claims from token")
 	}
 
-	subject, err := claims.GetSubject()
-	if err != nil {
-		return User{}, errors.Join(err, errors.New("failed to get subject from claims"))
-	}
-
 	issuer, err := claims.GetIssuer()
 	if err != nil {
 		return User{}, errors.Join(err, errors.New("failed to get issuer from claims"))
 	}
 
-	if issuer == session.SessionManagerClaimsIssuer {
-		subject = strings.Split(subject, ":")[0]
+	if issuer != session.SessionManagerClaimsIssuer {
+		// The user of OIDC tokens is described by the configured claims.
+		return userFromClaims(claims, claimMappingFromContext(ctx))
 	}
 
+	subject, err := claims.GetSubject()
+	if err != nil {
+		return User{}, errors.Join(err, errors.New("failed to get subject from claims"))
+	}
+	subject = strings.Split(subject, ":")[0]
 	groups := getScopeValues(claims, []string{"groups"})
 	return User{Subject: subject, Groups: groups}, nil
 }
@@ -330,21 +517,27 @@ func NewSkipper(basePath string) (func(echo.Context) bool, error) {
 // Can checks if a user is allowed to perform an action on a resource.
 // Input request should be of the form [user action resource object].
 func Can(ctx context.Context, filePath string, k kubernetes.KubernetesConnector, req ...string) (bool, error) {
+	return CanWithAttributes(ctx, filePath, k, nil, req...)
+}
+
+// CanWithAttributes is like Can, but also matches the conditions of the policy
+// against the given attributes of the object.
+func CanWithAttributes(
+	ctx context.Context,
+	filePath string,
+	k kubernetes.KubernetesConnector,
+	attrs Attributes,
+	req ...string,
+) (bool, error) {
 	if len(req) != 4 { //nolint:mnd
 		return false, errors.New("expected input of the form [user action resource object]")
 	}
-	user, action, resource, object := req[0], req[1], req[2], req[3]
-	if object == "*" || object == "all" {
-		object = "/"
-		if IsGlobalResource(resource) {
-			object = ""
-		}
-	}
+	user, action, resource, object := req[0], req[1], req[2], requestObject(req[2], req[3])
 	enforcer, err := newKubeOrFileEnforcer(ctx, k, filePath)
 	if err != nil {
 		return false, err
 	}
-	return enforcer.Enforce(user, resource, action, object)
+	return enforcer.Enforce(user, resource, action, object, attrs)
 }
 
 // IsEnabled returns true if enabled == 'true' in the given ConfigMap.
@@ -16,7 +16,7 @@ import (
 )
 
 // ErrPolicySyntax is returned when a policy has a syntax error.
-var errPolicySyntax = errors.New("policy syntax error")
+var ErrPolicySyntax = errors.New("policy syntax error")
 
 func validatePolicy(enforcer *casbin.Enforcer) error {
 	// check basic policy syntax.
@@ -25,8 +25,16 @@ func validatePolicy(enforcer *casbin.Enforcer) error {
 		return err
 	}
 	for _, policy := range policy {
-		if err := validateTerms(policy); err != nil {
-			return errors.Join(errPolicySyntax, err)
+		terms := slices.Clone(policy)
+		if len(terms) > 3 { //nolint:mnd
+			// The conditions of the object are validated separately.
+			terms[3], _, _ = strings.Cut(terms[3], conditionSeparator)
+			if err := validateObjectConditions(policy[1], policy[3]); err != nil {
+				return errors.Join(ErrPolicySyntax, err)
+			}
+		}
+		if err := validateTerms(terms); err != nil {
+			return errors.Join(ErrPolicySyntax, err)
 		}
 	}
 
@@ -36,27 +44,66 @@ func validatePolicy(enforcer *casbin.Enforcer) error {
 		return err
 	}
 	if err := checkRoles(roles, policy); err != nil {
-		return errors.Join(errPolicySyntax, err)
+		return errors.Join(ErrPolicySyntax, err)
 	}
 
 	// ensure that non-existent resources are not used.
 	if err := checkResourceNames(policy); err != nil {
-		return errors.Join(errPolicySyntax, err)
+		return errors.Join(ErrPolicySyntax, err)
 	}
 	return nil
 }
 
+// ValidateOption configures ValidatePolicy.
+type ValidateOption func(*validateOptions)
+
+type validateOptions struct {
+	lint bool
+}
+
+// WithLint enables the lint mode of ValidatePolicy, in which the policy is also checked
+// for likely mistakes that are not syntax errors. The issues are returned as *LintError.
+func WithLint() ValidateOption {
+	return func(o *validateOptions) {
+		o.lint = true
+	}
+}
+
 // ValidatePolicy validates a policy from either Kubernetes or local file.
 func ValidatePolicy(
 	ctx context.Context,
 	k kubernetes.KubernetesConnector,
 	filepath string,
+	opts ...ValidateOption,
 ) error {
-	enforcer, err := newKubeOrFileEnforcer(ctx, k, filepath)
-	if err != nil {
-		return errors.Join(errPolicySyntax, err)
+	options := &validateOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	var err error
+	enforcer, newErr := newKubeOrFileEnforcer(ctx, k, filepath)
+	if newErr != nil {
+		err = errors.Join(ErrPolicySyntax, newErr)
+	} else {
+		err = validatePolicy(enforcer)
+	}
+	if !options.lint {
+		return err
+	}
+
+	issues, lintErr := lintPolicy(k, filepath)
+	if lintErr != nil {
+		if err != nil {
+			// The policy cannot be loaded, which is already reported by the validation.
+			return err
+		}
+		return lintErr
+	}
+	if len(issues) > 0 {
+		return errors.Join(err, &LintError{Issues: issues})
 	}
-	return validatePolicy(enforcer)
+	return err
 }
 
 func checkResourceNames(policies [][]string) error {
@@ -21,27 +21,39 @@ func TestValidatePolicy(t *testing.T) {
 		},
 		{
 			path: "./testdata/policy-2-bad.csv",
-			err:  errPolicySyntax,
+			err:  ErrPolicySyntax,
 		},
 		{
 			path: "./testdata/policy-3-bad.csv",
-			err:  errPolicySyntax,
+			err:  ErrPolicySyntax,
 		},
 		{
 			path: "./testdata/policy-4-bad.csv",
-			err:  errPolicySyntax,
+			err:  ErrPolicySyntax,
 		},
 		{
 			path: "./testdata/policy-5-bad.csv",
-			err:  errPolicySyntax,
+			err:  ErrPolicySyntax,
 		},
 		{
 			path: "./testdata/policy-6-bad.csv",
-			err:  errPolicySyntax,
+			err:  ErrPolicySyntax,
 		},
 		{
 			path: "./testdata/policy-7-bad.csv",
-			err:  errPolicySyntax,
+			err:  ErrPolicySyntax,
+		},
+		{
+			path: "./testdata/policy-8-good.csv",
+			err:  nil,
+		},
+		{
+			path: "./testdata/policy-9-bad.csv",
+			err:  ErrPolicySyntax,
+		},
+		{
+			path: "./testdata/policy-10-bad.csv",
+			err:  ErrPolicySyntax,
 		},
 	}
 
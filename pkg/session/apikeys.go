@@ -0,0 +1,214 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package session
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+
+	"github.com/percona/everest/pkg/accounts"
+)
+
+const (
+	// apiKeyLastUsedResolution is the minimal interval between two updates of the last used time of an API key.
+	// It keeps the accounts secret from being updated on every API request.
+	apiKeyLastUsedResolution = time.Minute
+	apiKeyNameMaxLength      = 63
+)
+
+var (
+	// apiKeyNoExpiry is the expiration time of the tokens of the API keys that never expire.
+	// The blocklist identifies tokens by their "exp" claim, so such tokens still carry one,
+	// far enough in the future and with the 10 digits expected by the token store.
+	apiKeyNoExpiry = time.Unix(9999999999, 0).UTC() //nolint:gochecknoglobals
+
+	apiKeyNameRegex = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9._-]*[a-zA-Z0-9])?$`)
+
+	// ErrInvalidAPIKeyName is returned when the name of an API key is not valid.
+	ErrInvalidAPIKeyName = fmt.Errorf("API key name must consist of at most %d alphanumeric characters, '.', '_' or '-', "+
+		"and must start and end with an alphanumeric character", apiKeyNameMaxLength)
+	// ErrAPIKeyExpiryInPast is returned when the requested expiry of an API key is not in the future.
+	ErrAPIKeyExpiryInPast = errors.New("API key expiry must be in the future")
+)
+
+// APIKeyIssuer issues and revokes the API keys of the built-in accounts.
+type APIKeyIssuer struct {
+	accountManager accounts.Interface
+	keys           *KeyRing
+	blocklist      Blocklist
+}
+
+// NewAPIKeyIssuer returns a new APIKeyIssuer that signs the tokens with the newest key of the key ring
+// and revokes them by adding them to the blocklist.
+func NewAPIKeyIssuer(accountManager accounts.Interface, keys *KeyRing, blocklist Blocklist) *APIKeyIssuer {
+	return &APIKeyIssuer{
+		accountManager: accountManager,
+		keys:           keys,
+		blocklist:      blocklist,
+	}
+}
+
+// ValidateAPIKeyName checks if the provided API key name is valid.
+func ValidateAPIKeyName(name string) error {
+	if len(name) > apiKeyNameMaxLength || !apiKeyNameRegex.MatchString(name) {
+		return ErrInvalidAPIKeyName
+	}
+	return nil
+}
+
+// Issue creates a new named API key for the given user and returns the token and the stored metadata of the key.
+// The user must have the apiKey capability. A nil expiresAt creates a key that never expires.
+func (i *APIKeyIssuer) Issue(ctx context.Context, username, name string, expiresAt *time.Time) (string, *accounts.APIKey, error) {
+	if err := ValidateAPIKeyName(name); err != nil {
+		return "", nil, err
+	}
+	now := time.Now().UTC()
+	if expiresAt != nil && !expiresAt.After(now) {
+		return "", nil, ErrAPIKeyExpiryInPast
+	}
+
+	account, err := i.accountManager.Get(ctx, username)
+	if err != nil {
+		return "", nil, err
+	}
+	if !account.Enabled {
+		return "", nil, accounts.ErrAccountDisabled
+	}
+	if !account.HasCapability(accounts.AccountCapabilityAPIKey) {
+		return "", nil, errors.Join(accounts.ErrInsufficientCapabilities, errors.New("user does not have capability to use API keys"))
+	}
+
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return "", nil, err
+	}
+	key := accounts.APIKey{
+		Name:      name,
+		ID:        id.String(),
+		CreatedAt: now.Truncate(time.Second),
+	}
+	exp := apiKeyNoExpiry
+	if expiresAt != nil {
+		exp = expiresAt.UTC().Truncate(time.Second)
+		key.ExpiresAt = &exp
+	}
+
+	claims := jwt.RegisteredClaims{
+		IssuedAt:  jwt.NewNumericDate(now),
+		Issuer:    SessionManagerClaimsIssuer,
+		NotBefore: jwt.NewNumericDate(now),
+		ExpiresAt: jwt.NewNumericDate(exp),
+		Subject:   apiKeySubject(username),
+		ID:        key.ID,
+	}
+	token, signingKeyID, err := i.keys.sign(claims)
+	if err != nil {
+		return "", nil, err
+	}
+	// The signing key is kept as long as the API key is valid, see Kubernetes.RotateJWTKeys.
+	key.SigningKeyID = signingKeyID
+
+	// The token is stored only once it has been signed successfully.
+	if err := i.accountManager.CreateAPIKey(ctx, username, key); err != nil {
+		return "", nil, err
+	}
+	return token, &key, nil
+}
+
+// List returns the API keys of the given user.
+func (i *APIKeyIssuer) List(ctx context.Context, username string) ([]accounts.APIKey, error) {
+	account, err := i.accountManager.Get(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+	return account.APIKeys, nil
+}
+
+// Revoke invalidates the API key of the given user by adding its token to the blocklist
+// and removes the key from the account.
+func (i *APIKeyIssuer) Revoke(ctx context.Context, username, name string) error {
+	account, err := i.accountManager.Get(ctx, username)
+	if err != nil {
+		return err
+	}
+	var key *accounts.APIKey
+	for idx := range account.APIKeys {
+		if account.APIKeys[idx].Name == name {
+			key = &account.APIKeys[idx]
+			break
+		}
+	}
+	if key == nil {
+		return accounts.ErrAPIKeyNotFound
+	}
+
+	// Block the token first, so that a failure leaves the key listed and the revocation can be retried.
+	exp := apiKeyNoExpiry
+	if key.ExpiresAt != nil {
+		exp = *key.ExpiresAt
+	}
+	if err := i.blocklist.Block(ctx, jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+		"jti": key.ID,
+		"exp": float64(exp.Unix()),
+	})); err != nil {
+		return errors.Join(err, errors.New("failed to block API key"))
+	}
+
+	_, err = i.accountManager.DeleteAPIKey(ctx, username, name)
+	return err
+}
+
+// recordUsage updates the last used time of the API key the token belongs to.
+// The time is updated at most once per apiKeyLastUsedResolution.
+func (i *APIKeyIssuer) recordUsage(ctx context.Context, token *jwt.Token, now time.Time) error {
+	content, err := extractContent(token)
+	if err != nil {
+		return err
+	}
+	username, ok := apiKeyUsername(content.getStringClaim("sub"))
+	if !ok {
+		return nil
+	}
+	account, err := i.accountManager.Get(ctx, username)
+	if err != nil {
+		return err
+	}
+	key := account.APIKey(content.getStringClaim("jti"))
+	if key == nil {
+		return accounts.ErrAPIKeyNotFound
+	}
+	if key.LastUsedAt != nil && now.Sub(*key.LastUsedAt) < apiKeyLastUsedResolution {
+		return nil
+	}
+	return i.accountManager.SetAPIKeyLastUsed(ctx, username, key.ID, now.UTC().Truncate(time.Second))
+}
+
+// apiKeySubject returns the subject of the API key tokens of the given user.
+func apiKeySubject(username string) string {
+	return username + ":" + string(accounts.AccountCapabilityAPIKey)
+}
+
+// apiKeyUsername returns the user of the given token subject, if it is the subject of an API key token.
+func apiKeyUsername(subject string) (string, bool) {
+	return strings.CutSuffix(subject, ":"+string(accounts.AccountCapabilityAPIKey))
+}
@@ -0,0 +1,170 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package session
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/percona/everest/pkg/accounts"
+)
+
+const apiKeyUsersFile = `ci:
+  enabled: true
+  capabilities:
+  - apiKey
+human:
+  enabled: true
+  capabilities:
+  - login`
+
+func TestAPIKeys(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	mgr, err := mockManager(ctx, apiKeyUsersFile, "")
+	require.NoError(t, err)
+	signingKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	require.NoError(t, err)
+	mgr.keys = NewStaticKeyRing(signingKey)
+	mgr.Blocklist = &memoryBlocklist{}
+	mgr.apiKeys = NewAPIKeyIssuer(mgr.accountManager, mgr.keys, mgr.Blocklist)
+
+	parse := func(token string) *jwt.Token {
+		parsed, err := jwt.Parse(token, mgr.KeyFunc())
+		require.NoError(t, err)
+		return parsed
+	}
+
+	// Issuing requires the apiKey capability and a valid name.
+	_, _, err = mgr.APIKeys().Issue(ctx, "human", "pipeline", nil)
+	require.ErrorIs(t, err, accounts.ErrInsufficientCapabilities)
+	_, _, err = mgr.APIKeys().Issue(ctx, "ci", "-pipeline", nil)
+	require.ErrorIs(t, err, ErrInvalidAPIKeyName)
+	past := time.Now().Add(-time.Hour)
+	_, _, err = mgr.APIKeys().Issue(ctx, "ci", "pipeline", &past)
+	require.ErrorIs(t, err, ErrAPIKeyExpiryInPast)
+
+	// A key without expiry.
+	token, key, err := mgr.APIKeys().Issue(ctx, "ci", "pipeline", nil)
+	require.NoError(t, err)
+	assert.Nil(t, key.ExpiresAt)
+	parsed := parse(token)
+	sub, err := parsed.Claims.GetSubject()
+	require.NoError(t, err)
+	assert.Equal(t, "ci:apiKey", sub)
+	exp, err := parsed.Claims.GetExpirationTime()
+	require.NoError(t, err)
+	assert.Equal(t, apiKeyNoExpiry, exp.UTC())
+	assert.True(t, isAPIKey(parsed))
+	// The signing key is recorded, so that it is kept as long as the API key is valid.
+	assert.Equal(t, parsed.Header["kid"], key.SigningKeyID)
+
+	blocked, err := mgr.IsBlocked(ctx, parsed)
+	require.NoError(t, err)
+	assert.False(t, blocked)
+	// The API key is not accepted if it is signed with another key than the one it was issued with.
+	otherKey := *parsed
+	otherKey.Header = map[string]interface{}{"kid": "other"}
+	blocked, err = mgr.IsBlocked(ctx, &otherKey)
+	require.NoError(t, err)
+	assert.True(t, blocked)
+
+	_, _, err = mgr.APIKeys().Issue(ctx, "ci", "pipeline", nil)
+	require.ErrorIs(t, err, accounts.ErrAPIKeyAlreadyExists)
+
+	// A key with expiry.
+	expiresAt := time.Now().Add(24 * time.Hour)
+	token2, key2, err := mgr.APIKeys().Issue(ctx, "ci", "nightly", &expiresAt)
+	require.NoError(t, err)
+	require.NotNil(t, key2.ExpiresAt)
+	exp, err = parse(token2).Claims.GetExpirationTime()
+	require.NoError(t, err)
+	assert.True(t, key2.ExpiresAt.Equal(exp.Time))
+
+	// The usage is recorded at most once per resolution interval.
+	now := time.Now().UTC().Truncate(time.Second)
+	require.NoError(t, mgr.apiKeys.recordUsage(ctx, parsed, now))
+	require.NoError(t, mgr.apiKeys.recordUsage(ctx, parsed, now.Add(apiKeyLastUsedResolution/2)))
+	keys, err := mgr.APIKeys().List(ctx, "ci")
+	require.NoError(t, err)
+	require.Len(t, keys, 2)
+	require.NotNil(t, keys[0].LastUsedAt)
+	assert.True(t, now.Equal(*keys[0].LastUsedAt))
+	assert.Nil(t, keys[1].LastUsedAt)
+
+	// Revoked keys are blocked and removed from the account.
+	require.NoError(t, mgr.APIKeys().Revoke(ctx, "ci", "pipeline"))
+	blocked, err = mgr.Blocklist.IsBlocked(ctx, parsed)
+	require.NoError(t, err)
+	assert.True(t, blocked)
+	blocked, err = mgr.IsBlocked(ctx, parsed)
+	require.NoError(t, err)
+	assert.True(t, blocked)
+	keys, err = mgr.APIKeys().List(ctx, "ci")
+	require.NoError(t, err)
+	require.Len(t, keys, 1)
+	assert.Equal(t, "nightly", keys[0].Name)
+	require.ErrorIs(t, mgr.APIKeys().Revoke(ctx, "ci", "pipeline"), accounts.ErrAPIKeyNotFound)
+
+	// Keys stop working once the account loses the capability.
+	require.NoError(t, mgr.accountManager.SetCapabilities(ctx, "ci", []accounts.AccountCapability{accounts.AccountCapabilityLogin}))
+	blocked, err = mgr.IsBlocked(ctx, parse(token2))
+	require.NoError(t, err)
+	assert.True(t, blocked)
+}
+
+func TestLoginUsername(t *testing.T) {
+	t.Parallel()
+	username, ok := LoginUsername(jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"sub": "admin:login", "iss": SessionManagerClaimsIssuer}))
+	assert.True(t, ok)
+	assert.Equal(t, "admin", username)
+
+	_, ok = LoginUsername(jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"sub": "admin:apiKey", "iss": SessionManagerClaimsIssuer}))
+	assert.False(t, ok)
+	_, ok = LoginUsername(jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"sub": "admin:login", "iss": "external_issuer"}))
+	assert.False(t, ok)
+}
+
+// memoryBlocklist is a Blocklist that keeps the blocked tokens in memory.
+type memoryBlocklist struct {
+	blocked []string
+}
+
+func (b *memoryBlocklist) Block(_ context.Context, token *jwt.Token) error {
+	shortened, err := shortenToken(token)
+	if err != nil {
+		return err
+	}
+	b.blocked = append(b.blocked, shortened)
+	return nil
+}
+
+func (b *memoryBlocklist) IsBlocked(_ context.Context, token *jwt.Token) (bool, error) {
+	shortened, err := shortenToken(token)
+	if err != nil {
+		return false, err
+	}
+	return slices.Contains(b.blocked, shortened), nil
+}
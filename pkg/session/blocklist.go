@@ -87,7 +87,11 @@ func NewBlocklist(ctx context.Context, logger *zap.SugaredLogger) (Blocklist, er
 	if err != nil {
 		return nil, errors.Join(err, errors.New("failed creating Kubernetes client for blockList"))
 	}
+	return NewBlocklistWithClient(ctx, tokenStoreClient, logger)
+}
 
+// NewBlocklistWithClient creates a new block list that stores the tokens using the given client.
+func NewBlocklistWithClient(ctx context.Context, tokenStoreClient TokenStoreClient, logger *zap.SugaredLogger) (Blocklist, error) {
 	store, err := newTokenStore(ctx, tokenStoreClient, logger)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,179 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package session
+
+import (
+	"context"
+	"crypto/rsa"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/percona/everest/pkg/jwks"
+)
+
+var (
+	// ErrUnknownSigningKey is returned when verifying a token signed with a key that is unknown or retired.
+	ErrUnknownSigningKey = errors.New("token is signed with an unknown or retired key")
+
+	errNoSigningKey = errors.New("no key for signing tokens")
+)
+
+// KeyStore stores the keys for signing and verifying the tokens issued by everest.
+type KeyStore interface {
+	// GetJWTKeySet returns the keys used for signing and verifying the JWT tokens.
+	GetJWTKeySet(ctx context.Context) (*jwks.KeySet, error)
+	// RotateJWTKeys adds a new key for signing the JWT tokens.
+	// The tokens signed with the previous keys are accepted for gracePeriod, after which the keys are retired.
+	// The retired keys that signed unexpired API keys are kept for verifying the API keys only.
+	RotateJWTKeys(ctx context.Context, gracePeriod time.Duration) (*jwks.Key, error)
+}
+
+type verificationKey struct {
+	id       string
+	key      *rsa.PublicKey
+	retireAt *time.Time
+}
+
+// KeyRing signs the tokens with the newest key of a key set and verifies them with any of its non-retired keys.
+// The retired keys are used only for verifying the API keys signed with them.
+// It is safe for concurrent use, so that the keys can be updated while tokens are signed and verified.
+type KeyRing struct {
+	mu               sync.RWMutex
+	signingKeyID     string
+	signingKey       *rsa.PrivateKey
+	signingCreatedAt time.Time
+	verificationKeys []verificationKey
+}
+
+// NewKeyRing returns a KeyRing with the keys of the key set.
+func NewKeyRing(keySet jwks.KeySet) (*KeyRing, error) {
+	r := &KeyRing{}
+	if err := r.Update(keySet); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// NewStaticKeyRing returns a KeyRing with a single key that is never retired.
+func NewStaticKeyRing(key *rsa.PrivateKey) *KeyRing {
+	id := jwks.KeyID(&key.PublicKey)
+	return &KeyRing{
+		signingKeyID:     id,
+		signingKey:       key,
+		verificationKeys: []verificationKey{{id: id, key: &key.PublicKey}},
+	}
+}
+
+// Update replaces the keys of the ring with the keys of the key set.
+func (r *KeyRing) Update(keySet jwks.KeySet) error {
+	newest := keySet.Newest()
+	if newest == nil {
+		return errNoSigningKey
+	}
+	verificationKeys := make([]verificationKey, 0, len(keySet.Keys))
+	var signingKey *rsa.PrivateKey
+	for _, k := range keySet.Keys {
+		privateKey, err := jwks.ParsePrivateKey([]byte(k.PrivateKey))
+		if err != nil {
+			return errors.Join(err, fmt.Errorf("failed to parse JWT key '%s'", k.ID))
+		}
+		verificationKeys = append(verificationKeys, verificationKey{id: k.ID, key: &privateKey.PublicKey, retireAt: k.RetireAt})
+		if k.ID == newest.ID {
+			signingKey = privateKey
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.signingKeyID = newest.ID
+	r.signingKey = signingKey
+	r.signingCreatedAt = newest.CreatedAt
+	r.verificationKeys = verificationKeys
+	return nil
+}
+
+// Sign returns the token with the claims signed with the newest key.
+func (r *KeyRing) Sign(claims jwt.Claims) (string, error) {
+	token, _, err := r.sign(claims)
+	return token, err
+}
+
+// sign returns the token with the claims signed with the newest key and the ID of the key.
+func (r *KeyRing) sign(claims jwt.Claims) (string, string, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = r.signingKeyID
+	signed, err := token.SignedString(r.signingKey)
+	return signed, r.signingKeyID, err
+}
+
+// SigningKeyCreatedAt returns the time the key used for signing was created at.
+// It is zero if it is unknown.
+func (r *KeyRing) SigningKeyCreatedAt() time.Time {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.signingCreatedAt
+}
+
+// KeyFunc returns a function for getting the public RSA keys used for verifying the tokens.
+// The tokens signed before the keys had IDs are verified with any of the non-retired keys.
+// The retired keys that are still in the ring verify only the API keys, which are checked
+// against the API keys of the accounts afterwards.
+func (r *KeyRing) KeyFunc() jwt.Keyfunc {
+	return func(token *jwt.Token) (interface{}, error) {
+		r.mu.RLock()
+		defer r.mu.RUnlock()
+		now := time.Now()
+		kid, hasKID := token.Header["kid"].(string)
+		keys := jwt.VerificationKeySet{}
+		for _, k := range r.verificationKeys {
+			if k.retireAt != nil && !now.Before(*k.retireAt) {
+				if hasKID && k.id == kid && isAPIKey(token) {
+					return k.key, nil
+				}
+				continue
+			}
+			if hasKID && k.id == kid {
+				return k.key, nil
+			}
+			keys.Keys = append(keys.Keys, k.key)
+		}
+		if hasKID || len(keys.Keys) == 0 {
+			return nil, ErrUnknownSigningKey
+		}
+		return keys, nil
+	}
+}
+
+// JWKS returns the public keys that are not retired, for verifying the tokens outside of everest.
+func (r *KeyRing) JWKS() jwks.JSONWebKeySet {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	now := time.Now()
+	result := jwks.JSONWebKeySet{Keys: make([]jwks.JSONWebKey, 0, len(r.verificationKeys))}
+	for _, k := range r.verificationKeys {
+		if k.retireAt != nil && !now.Before(*k.retireAt) {
+			continue
+		}
+		result.Keys = append(result.Keys, jwks.NewJSONWebKey(k.id, k.key))
+	}
+	return result
+}
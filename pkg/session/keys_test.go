@@ -0,0 +1,142 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package session
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/percona/everest/pkg/jwks"
+)
+
+// memoryKeyStore is a KeyStore that keeps the keys in memory.
+type memoryKeyStore struct {
+	keySet jwks.KeySet
+}
+
+func (s *memoryKeyStore) GetJWTKeySet(_ context.Context) (*jwks.KeySet, error) {
+	return &jwks.KeySet{Keys: append([]jwks.Key(nil), s.keySet.Keys...)}, nil
+}
+
+func (s *memoryKeyStore) RotateJWTKeys(_ context.Context, gracePeriod time.Duration) (*jwks.Key, error) {
+	now := time.Now()
+	key, err := newTestKey(now)
+	if err != nil {
+		return nil, err
+	}
+	s.keySet.Rotate(key, gracePeriod, now, nil)
+	return &key, nil
+}
+
+func newTestKey(now time.Time) (jwks.Key, error) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		return jwks.Key{}, err
+	}
+	return jwks.Key{
+		ID:         jwks.KeyID(&privateKey.PublicKey),
+		PrivateKey: string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(privateKey)})),
+		CreatedAt:  now,
+	}, nil
+}
+
+func TestKeyRotation(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	first, err := newTestKey(time.Now().Add(-2 * time.Hour))
+	require.NoError(t, err)
+	store := &memoryKeyStore{keySet: jwks.KeySet{Keys: []jwks.Key{first}}}
+	mgr := &Manager{keyStore: store, l: zap.NewNop().Sugar()}
+	mgr.keys, err = mgr.loadKeys(ctx)
+	require.NoError(t, err)
+
+	verify := func(token string) error {
+		_, err := jwt.Parse(token, mgr.KeyFunc())
+		return err
+	}
+	kid := func(token string) string {
+		parsed, _, err := jwt.NewParser().ParseUnverified(token, jwt.MapClaims{})
+		require.NoError(t, err)
+		return parsed.Header["kid"].(string) //nolint:forcetypeassert
+	}
+
+	oldToken, err := mgr.Create("admin:login", 0, "")
+	require.NoError(t, err)
+	assert.Equal(t, first.ID, kid(oldToken))
+
+	// The key is not rotated before the interval passes.
+	rotated, err := mgr.RotateKeysIfDue(ctx, 3*time.Hour, time.Hour)
+	require.NoError(t, err)
+	assert.False(t, rotated)
+
+	rotated, err = mgr.RotateKeysIfDue(ctx, time.Hour, time.Hour)
+	require.NoError(t, err)
+	assert.True(t, rotated)
+	newToken, err := mgr.Create("admin:login", 0, "")
+	require.NoError(t, err)
+	assert.NotEqual(t, first.ID, kid(newToken))
+
+	// Both keys are accepted during the grace period.
+	require.NoError(t, verify(oldToken))
+	require.NoError(t, verify(newToken))
+	assert.Len(t, mgr.JWKS().Keys, 2)
+
+	// The tokens signed before the keys had IDs are verified with any of the active keys.
+	legacyToken, err := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{"sub": "admin:login"}).
+		SignedString(mustParseKey(t, first))
+	require.NoError(t, err)
+	require.NoError(t, verify(legacyToken))
+
+	// An API key signed with the first key.
+	apiKey := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+		"sub": "ci:apiKey",
+		"iss": SessionManagerClaimsIssuer,
+		"jti": "api-key-id",
+	})
+	apiKey.Header["kid"] = first.ID
+	apiKeyToken, err := apiKey.SignedString(mustParseKey(t, first))
+	require.NoError(t, err)
+
+	// The retired key is no longer accepted, except for the API keys signed with it.
+	retired := time.Now().Add(-time.Second)
+	store.keySet.Keys[0].RetireAt = &retired
+	require.NoError(t, mgr.RefreshKeys(ctx))
+	require.ErrorIs(t, verify(oldToken), ErrUnknownSigningKey)
+	require.Error(t, verify(legacyToken))
+	require.NoError(t, verify(newToken))
+	require.NoError(t, verify(apiKeyToken))
+	jwksKeys := mgr.JWKS().Keys
+	require.Len(t, jwksKeys, 1)
+	assert.Equal(t, kid(newToken), jwksKeys[0].KeyID)
+}
+
+func mustParseKey(t *testing.T, key jwks.Key) *rsa.PrivateKey {
+	t.Helper()
+	privateKey, err := jwks.ParsePrivateKey([]byte(key.PrivateKey))
+	require.NoError(t, err)
+	return privateKey
+}
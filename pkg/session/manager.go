@@ -18,9 +18,6 @@ package session
 
 import (
 	"context"
-	"crypto/rsa"
-	"crypto/x509"
-	"encoding/pem"
 	"errors"
 	"fmt"
 	"net/http"
@@ -30,6 +27,7 @@ import (
 
 	"github.com/AlekSi/pointer"
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	echomiddleware "github.com/labstack/echo/v4/middleware"
 	"go.uber.org/zap"
@@ -41,25 +39,48 @@ import (
 	"github.com/percona/everest/api"
 	"github.com/percona/everest/pkg/accounts"
 	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/jwks"
 )
 
 const (
 	// SessionManagerClaimsIssuer fills the "iss" field of the token.
 	SessionManagerClaimsIssuer = "everest"
+
+	// DefaultTokenExpiry is the default validity period of the session tokens.
+	DefaultTokenExpiry = 24 * time.Hour
+	// DefaultRefreshWindow is the default period of time after login in which a session can be refreshed.
+	DefaultRefreshWindow = 24 * time.Hour
 )
 
 var (
 	errExtractSub       = errors.New("could not extract sub")
 	errExtractIss       = errors.New("could not extract iss")
 	errExtractIssueTime = errors.New("could not extract issue time")
+
+	// ErrRefreshNotAllowed is returned when refreshing a token that is not a session token of a built-in user.
+	ErrRefreshNotAllowed = errors.New("only session tokens of built-in users can be refreshed")
+	// ErrRefreshWindowExpired is returned when refreshing a session after its refresh window has passed.
+	ErrRefreshWindowExpired = errors.New("session refresh window has expired, log in again")
 )
 
+// ClientInfo describes the client a session is started from.
+type ClientInfo struct {
+	IP        string
+	UserAgent string
+}
+
 // Manager provides functionality for creating and managing JWT tokens.
 type Manager struct {
 	accountManager accounts.Interface
-	signingKey     *rsa.PrivateKey
+	keyStore       KeyStore
+	keys           *KeyRing
 	Blocklist
-	l *zap.SugaredLogger
+	apiKeys       *APIKeyIssuer
+	sessions      *SessionRegistry
+	lockoutPolicy accounts.LockoutPolicy
+	tokenExpiry   time.Duration
+	refreshWindow time.Duration
+	l             *zap.SugaredLogger
 }
 
 // Option is a function that modifies a SessionManager.
@@ -79,6 +100,28 @@ func (mgr *Manager) IsBlocked(ctx context.Context, token *jwt.Token) (bool, erro
 			}
 			return false, err
 		}
+		// the tokens of the disabled accounts are not accepted until the account is enabled again
+		if !user.Enabled {
+			return true, nil
+		}
+		if isAPIKey(token) {
+			// API keys are independent of the password, but are valid only as long as
+			// they are listed in the account and the account may use them.
+			content, err := extractContent(token)
+			if err != nil {
+				return false, err
+			}
+			if !user.HasCapability(accounts.AccountCapabilityAPIKey) {
+				return true, nil
+			}
+			// The API key must be signed with the key it was issued with, since the retired keys
+			// are still accepted for the API keys.
+			key := user.APIKey(content.getStringClaim("jti"))
+			if kid, _ := token.Header["kid"].(string); key == nil || (key.SigningKeyID != "" && key.SigningKeyID != kid) {
+				return true, nil
+			}
+			return mgr.Blocklist.IsBlocked(ctx, token)
+		}
 		// checking the time when the password was last updated
 		if user.PasswordMtime != "" {
 			passwordCreationTime, err := time.Parse(time.RFC3339, user.PasswordMtime)
@@ -100,16 +143,19 @@ func (mgr *Manager) IsBlocked(ctx context.Context, token *jwt.Token) (bool, erro
 
 // New creates a new session manager with the given options.
 func New(ctx context.Context, l *zap.SugaredLogger, options ...Option) (*Manager, error) {
-	m := &Manager{}
+	m := &Manager{
+		tokenExpiry:   DefaultTokenExpiry,
+		refreshWindow: DefaultRefreshWindow,
+	}
 	for _, opt := range options {
 		opt(m)
 	}
-	privKey, err := getPrivateKey()
+	m.l = l
+	keys, err := m.loadKeys(ctx)
 	if err != nil {
-		return nil, errors.Join(err, errors.New("failed to get private key"))
+		return nil, errors.Join(err, errors.New("failed to get JWT keys"))
 	}
-	m.signingKey = privKey
-	m.l = l
+	m.keys = keys
 
 	blockList, err := NewBlocklist(ctx, l)
 	if err != nil {
@@ -117,6 +163,8 @@ func New(ctx context.Context, l *zap.SugaredLogger, options ...Option) (*Manager
 	}
 
 	m.Blocklist = blockList
+	m.apiKeys = NewAPIKeyIssuer(m.accountManager, keys, blockList)
+	m.sessions = NewSessionRegistry(m.accountManager, blockList)
 	return m, nil
 }
 
@@ -127,6 +175,30 @@ func WithAccountManager(i accounts.Interface) Option {
 	}
 }
 
+// WithKeyStore sets the store of the keys for signing and verifying the tokens.
+// Without it, the tokens are signed with the single key mounted to the Everest server.
+func WithKeyStore(s KeyStore) Option {
+	return func(m *Manager) {
+		m.keyStore = s
+	}
+}
+
+// WithLockoutPolicy sets the policy for locking accounts after failed logins.
+func WithLockoutPolicy(p accounts.LockoutPolicy) Option {
+	return func(m *Manager) {
+		m.lockoutPolicy = p
+	}
+}
+
+// WithSessionExpiry sets the validity period of the session tokens
+// and the period of time after login in which a session can be refreshed.
+func WithSessionExpiry(tokenExpiry, refreshWindow time.Duration) Option {
+	return func(m *Manager) {
+		m.tokenExpiry = tokenExpiry
+		m.refreshWindow = refreshWindow
+	}
+}
+
 // Create creates a new token for a given subject (user) and returns it as a string.
 // Passing a value of `0` for secondsBeforeExpiry creates a token that never expires.
 // The id parameter holds an optional unique JWT token identifier and stored as a standard claim "jti" in the JWT token.
@@ -150,25 +222,46 @@ func (mgr *Manager) Create(subject string, secondsBeforeExpiry int64, id string)
 }
 
 func (mgr *Manager) signClaims(claims jwt.Claims) (string, error) {
-	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
-	return token.SignedString(mgr.signingKey)
+	return mgr.keys.Sign(claims)
 }
 
-// Authenticate verifies the given username and password.
-func (mgr *Manager) Authenticate(ctx context.Context, username string, password string) error {
+// Authenticate verifies the given username, password and, if the account is enrolled in MFA, the MFA code.
+func (mgr *Manager) Authenticate(ctx context.Context, username, password, mfaCode string) error {
 	if password == "" {
 		return fmt.Errorf("blank passwords are not allowed")
 	}
 
+	account, err := mgr.accountManager.Get(ctx, username)
+	if err != nil {
+		return err
+	}
+
+	// Locked accounts are rejected before verifying the password, so that the password cannot be guessed meanwhile.
+	if account.IsLocked(time.Now()) {
+		return accounts.ErrAccountLocked
+	}
+
 	if err := mgr.accountManager.Verify(ctx, username, password); err != nil {
+		if errors.Is(err, accounts.ErrIncorrectPassword) {
+			mgr.recordFailedLogin(ctx, username)
+		}
 		return err
 	}
 
-	account, err := mgr.accountManager.Get(ctx, username)
-	if err != nil {
+	if err := mgr.accountManager.VerifyMFA(ctx, username, mfaCode); err != nil {
+		if errors.Is(err, accounts.ErrInvalidMFACode) {
+			mgr.recordFailedLogin(ctx, username)
+		}
 		return err
 	}
 
+	if account.Lockout != nil {
+		// A successful login resets the failed logins.
+		if err := mgr.accountManager.Unlock(ctx, username); err != nil {
+			mgr.l.Warnf("failed to reset failed logins of user '%s': %v", username, err)
+		}
+	}
+
 	if !account.Enabled {
 		return accounts.ErrAccountDisabled
 	}
@@ -176,24 +269,230 @@ func (mgr *Manager) Authenticate(ctx context.Context, username string, password
 	if !account.HasCapability(accounts.AccountCapabilityLogin) {
 		return errors.Join(accounts.ErrInsufficientCapabilities, errors.New("user does not have capability to login"))
 	}
+
+	policy, err := mgr.accountManager.GetPasswordPolicy(ctx)
+	if err != nil {
+		return err
+	}
+	if policy.IsPasswordExpired(*account, time.Now()) {
+		return accounts.ErrPasswordExpired
+	}
 	return nil
 }
 
-func getPrivateKey() (*rsa.PrivateKey, error) {
+// StartSession issues a session token for an authenticated user and registers the session.
+func (mgr *Manager) StartSession(ctx context.Context, username string, client ClientInfo) (string, error) {
+	now := time.Now().UTC().Truncate(time.Second)
+	return mgr.issueSessionToken(ctx, username, accounts.Session{
+		IP:        client.IP,
+		UserAgent: client.UserAgent,
+		LoginAt:   now,
+	}, now, "")
+}
+
+// RefreshSession replaces the given session token with a new one, as long as the refresh window
+// of the session has not passed. The replaced token is blocked.
+func (mgr *Manager) RefreshSession(ctx context.Context, token *jwt.Token) (string, error) {
+	username, ok := LoginUsername(token)
+	if !ok {
+		return "", ErrRefreshNotAllowed
+	}
+	content, err := extractContent(token)
+	if err != nil {
+		return "", err
+	}
+	account, err := mgr.accountManager.Get(ctx, username)
+	if err != nil {
+		return "", err
+	}
+	if !account.Enabled {
+		return "", accounts.ErrAccountDisabled
+	}
+	if !account.HasCapability(accounts.AccountCapabilityLogin) {
+		return "", errors.Join(accounts.ErrInsufficientCapabilities, errors.New("user does not have capability to login"))
+	}
+	current := account.Session(content.getStringClaim("jti"))
+	if current == nil {
+		return "", accounts.ErrSessionNotFound
+	}
+	now := time.Now().UTC().Truncate(time.Second)
+	if !now.Before(current.LoginAt.Add(mgr.refreshWindow)) {
+		return "", ErrRefreshWindowExpired
+	}
+
+	newToken, err := mgr.issueSessionToken(ctx, username, *current, now, current.ID)
+	if err != nil {
+		return "", err
+	}
+	if err := mgr.Block(ctx, token); err != nil {
+		return "", errors.Join(err, errors.New("failed to block refreshed token"))
+	}
+	return newToken, nil
+}
+
+// issueSessionToken signs a new session token for the session and stores it in the account.
+// The session with the replaceID is replaced, or a new session is added if replaceID is empty.
+// The token never outlives the refresh window of the session.
+func (mgr *Manager) issueSessionToken(
+	ctx context.Context,
+	username string,
+	s accounts.Session,
+	now time.Time,
+	replaceID string,
+) (string, error) {
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return "", err
+	}
+	s.ID = id.String()
+	s.IssuedAt = now
+	s.ExpiresAt = now.Add(mgr.tokenExpiry)
+	if end := s.LoginAt.Add(mgr.refreshWindow); mgr.refreshWindow > 0 && end.Before(s.ExpiresAt) {
+		s.ExpiresAt = end
+	}
+
+	token, err := mgr.signClaims(jwt.RegisteredClaims{
+		IssuedAt:  jwt.NewNumericDate(now),
+		Issuer:    SessionManagerClaimsIssuer,
+		NotBefore: jwt.NewNumericDate(now),
+		ExpiresAt: jwt.NewNumericDate(s.ExpiresAt),
+		Subject:   username + ":" + string(accounts.AccountCapabilityLogin),
+		ID:        s.ID,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if replaceID != "" {
+		err = mgr.accountManager.ReplaceSession(ctx, username, replaceID, s)
+	} else {
+		err = mgr.accountManager.AddSession(ctx, username, s)
+	}
+	if err != nil {
+		return "", errors.Join(err, errors.New("failed to register session"))
+	}
+	return token, nil
+}
+
+// ChangeExpiredPassword sets a new password for a user whose password has expired.
+// The caller must have authenticated the user with the expired password.
+func (mgr *Manager) ChangeExpiredPassword(ctx context.Context, username, newPassword string) error {
+	if newPassword == "" {
+		return fmt.Errorf("blank passwords are not allowed")
+	}
+	return mgr.accountManager.SetPassword(ctx, username, newPassword, true)
+}
+
+// ChangePassword replaces the password of a user after verifying the current one.
+// A wrong current password counts as a failed login, so that the endpoint cannot be used to guess passwords.
+// All the tokens issued before the change, including the current one, become invalid.
+func (mgr *Manager) ChangePassword(ctx context.Context, username, currentPassword, newPassword string) error {
+	if newPassword == "" {
+		return fmt.Errorf("blank passwords are not allowed")
+	}
+	account, err := mgr.accountManager.Get(ctx, username)
+	if err != nil {
+		return err
+	}
+	if account.IsLocked(time.Now()) {
+		return accounts.ErrAccountLocked
+	}
+	if err := mgr.accountManager.Verify(ctx, username, currentPassword); err != nil {
+		if errors.Is(err, accounts.ErrIncorrectPassword) {
+			mgr.recordFailedLogin(ctx, username)
+		}
+		return err
+	}
+	return mgr.accountManager.SetPassword(ctx, username, newPassword, true)
+}
+
+// APIKeys returns the issuer of the API keys of the built-in accounts.
+func (mgr *Manager) APIKeys() *APIKeyIssuer {
+	return mgr.apiKeys
+}
+
+// Sessions returns the registry of the login sessions of the built-in accounts.
+func (mgr *Manager) Sessions() *SessionRegistry {
+	return mgr.sessions
+}
+
+// recordFailedLogin records a failed login of the user and locks the account according to the lockout policy.
+// Failing to record it must not change the result of the login, so errors are only logged.
+func (mgr *Manager) recordFailedLogin(ctx context.Context, username string) {
+	locked, err := mgr.accountManager.RecordFailedLogin(ctx, username, mgr.lockoutPolicy)
+	if err != nil {
+		mgr.l.Warnf("failed to record failed login of user '%s': %v", username, err)
+		return
+	}
+	if locked {
+		mgr.l.Warnf("user '%s' has been locked for %s after %d failed logins",
+			username, mgr.lockoutPolicy.Duration, mgr.lockoutPolicy.Threshold)
+	}
+}
+
+// loadKeys returns the keys from the key store. If they cannot be read, e.g. because the key store is not set,
+// the single key mounted to the Everest server is used, which does not support rotation.
+func (mgr *Manager) loadKeys(ctx context.Context) (*KeyRing, error) {
+	if mgr.keyStore != nil {
+		keySet, err := mgr.keyStore.GetJWTKeySet(ctx)
+		if err == nil {
+			return NewKeyRing(*keySet)
+		}
+		mgr.l.Warnf("failed to get JWT keys, falling back to %s: %v", common.EverestJWTPrivateKeyFile, err)
+	}
 	pemString, err := os.ReadFile(common.EverestJWTPrivateKeyFile)
 	if err != nil {
 		return nil, errors.Join(err, errors.New("failed to read JWT private key"))
 	}
-	block, _ := pem.Decode(pemString)
-	return x509.ParsePKCS1PrivateKey(block.Bytes)
+	privKey, err := jwks.ParsePrivateKey(pemString)
+	if err != nil {
+		return nil, err
+	}
+	return NewStaticKeyRing(privKey), nil
+}
+
+// RefreshKeys reloads the keys from the key store, so that the keys rotated by another
+// Everest instance or by everestctl are used.
+func (mgr *Manager) RefreshKeys(ctx context.Context) error {
+	if mgr.keyStore == nil {
+		return nil
+	}
+	keySet, err := mgr.keyStore.GetJWTKeySet(ctx)
+	if err != nil {
+		return err
+	}
+	return mgr.keys.Update(*keySet)
+}
+
+// RotateKeysIfDue rotates the keys if the signing key is older than interval and returns true if it did.
+// The previous keys remain valid for gracePeriod.
+func (mgr *Manager) RotateKeysIfDue(ctx context.Context, interval, gracePeriod time.Duration) (bool, error) {
+	if mgr.keyStore == nil || interval <= 0 {
+		return false, nil
+	}
+	if err := mgr.RefreshKeys(ctx); err != nil {
+		return false, err
+	}
+	if time.Since(mgr.keys.SigningKeyCreatedAt()) < interval {
+		return false, nil
+	}
+	key, err := mgr.keyStore.RotateJWTKeys(ctx, gracePeriod)
+	if err != nil {
+		return false, errors.Join(err, errors.New("failed to rotate JWT keys"))
+	}
+	mgr.l.Infof("rotated JWT keys, new tokens are signed with key '%s'", key.ID)
+	return true, mgr.RefreshKeys(ctx)
 }
 
 // KeyFunc retruns a function for getting the public RSA keys used
 // for verifying the JWT tokens signed by everest.
 func (mgr *Manager) KeyFunc() jwt.Keyfunc {
-	return func(_ *jwt.Token) (interface{}, error) {
-		return mgr.signingKey.Public(), nil
-	}
+	return mgr.keys.KeyFunc()
+}
+
+// JWKS returns the public keys used for verifying the JWT tokens signed by everest.
+func (mgr *Manager) JWKS() jwks.JSONWebKeySet {
+	return mgr.keys.JWKS()
 }
 
 func (mgr *Manager) BlocklistMiddleWare(skipperFunc func() (echomiddleware.Skipper, error)) (echo.MiddlewareFunc, error) {
@@ -221,6 +520,12 @@ func (mgr *Manager) BlocklistMiddleWare(skipperFunc func() (echomiddleware.Skipp
 					Message: pointer.ToString("Invalid token"),
 				})
 			}
+			if isAPIKey(token) {
+				// Failing to track the usage must not fail the request.
+				if err := mgr.apiKeys.recordUsage(ctx, token, time.Now()); err != nil {
+					mgr.l.Warnf("failed to record API key usage: %v", err)
+				}
+			}
 			return next(c)
 		}
 	}, nil
@@ -244,9 +549,36 @@ func extractUsername(token *jwt.Token) (string, bool, error) {
 		return "", false, errExtractIss
 	}
 	username := strings.TrimSuffix(sub, ":login")
+	if u, ok := apiKeyUsername(sub); ok {
+		username = u
+	}
 	return username, iss == SessionManagerClaimsIssuer, nil
 }
 
+// LoginUsername returns the user of the token if it is a session token issued by everest on login.
+func LoginUsername(token *jwt.Token) (string, bool) {
+	content, err := extractContent(token)
+	if err != nil {
+		return "", false
+	}
+	sub, _ := content.Payload["sub"].(string)
+	iss, _ := content.Payload["iss"].(string)
+	username, ok := strings.CutSuffix(sub, ":"+string(accounts.AccountCapabilityLogin))
+	return username, ok && iss == SessionManagerClaimsIssuer
+}
+
+// isAPIKey returns true if the token is an API key issued by everest.
+func isAPIKey(token *jwt.Token) bool {
+	content, err := extractContent(token)
+	if err != nil {
+		return false
+	}
+	sub, _ := content.Payload["sub"].(string)
+	iss, _ := content.Payload["iss"].(string)
+	_, ok := apiKeyUsername(sub)
+	return ok && iss == SessionManagerClaimsIssuer
+}
+
 func extractCreationTime(token *jwt.Token) (*time.Time, error) {
 	content, err := extractContent(token)
 	if err != nil {
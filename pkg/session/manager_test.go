@@ -9,12 +9,14 @@ import (
 	"github.com/AlekSi/pointer"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	"github.com/percona/everest/pkg/accounts"
 	"github.com/percona/everest/pkg/common"
 	"github.com/percona/everest/pkg/kubernetes"
 )
@@ -42,6 +44,13 @@ func TestExtractUsername(t *testing.T) {
 			username:      "admin",
 			isBuiltInUser: true,
 		},
+		{
+			name:          "built-in user API key",
+			token:         jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"sub": "ci:apiKey", "iss": "everest"}),
+			error:         nil,
+			username:      "ci",
+			isBuiltInUser: true,
+		},
 		{
 			name:          "no sub in token",
 			token:         jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{}),
@@ -176,6 +185,21 @@ func TestIsBlocked(t *testing.T) {
   enabled: true
   capabilities:
   - login
+  passwordMtime: "2025-05-12T18:58:45+05:00"`,
+		},
+		{
+			name: "disabled account - block the request",
+			token: jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+				"iat": float64(1847058325),
+				"sub": "test:login",
+				"iss": SessionManagerClaimsIssuer,
+			}),
+			error:     nil,
+			isBlocked: true,
+			usersFile: `test:
+  enabled: false
+  capabilities:
+  - login
   passwordMtime: "2025-05-12T18:58:45+05:00"`,
 		},
 		{
@@ -228,7 +252,7 @@ func mockManager(ctx context.Context, usersFile, blocklistContent string) (*Mana
 
 	return &Manager{
 		accountManager: k.Accounts(),
-		signingKey:     nil,
+		keys:           nil,
 		Blocklist:      bl,
 		l:              l,
 	}, nil
@@ -249,3 +273,123 @@ func userSecret(file string) *corev1.Secret {
 		},
 	}
 }
+
+func TestAuthenticateLockout(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	l := zap.NewNop().Sugar()
+
+	usersSecret := userSecret(`test:
+  enabled: true
+  capabilities:
+  - login
+  passwordHash: password`)
+	// The password is stored in plain text, so that no salt is needed to verify it.
+	usersSecret.SetAnnotations(map[string]string{"insecure-password/test": "true"})
+	mockClient := fakeclient.NewClientBuilder().WithScheme(kubernetes.CreateScheme()).WithObjects(usersSecret)
+	k := kubernetes.NewEmpty(l).WithKubernetesClient(mockClient.Build())
+	mgr := &Manager{
+		accountManager: k.Accounts(),
+		lockoutPolicy:  accounts.LockoutPolicy{Threshold: 3, Window: time.Minute, Duration: time.Hour},
+		l:              l,
+	}
+
+	// A successful login resets the failed logins.
+	require.ErrorIs(t, mgr.Authenticate(ctx, "test", "wrong", ""), accounts.ErrIncorrectPassword)
+	require.ErrorIs(t, mgr.Authenticate(ctx, "test", "wrong", ""), accounts.ErrIncorrectPassword)
+	require.NoError(t, mgr.Authenticate(ctx, "test", "password", ""))
+	account, err := mgr.accountManager.Get(ctx, "test")
+	require.NoError(t, err)
+	assert.Nil(t, account.Lockout)
+
+	for range 3 {
+		require.ErrorIs(t, mgr.Authenticate(ctx, "test", "wrong", ""), accounts.ErrIncorrectPassword)
+	}
+	// The correct password is rejected while the account is locked.
+	require.ErrorIs(t, mgr.Authenticate(ctx, "test", "password", ""), accounts.ErrAccountLocked)
+
+	require.NoError(t, mgr.accountManager.Unlock(ctx, "test"))
+	require.NoError(t, mgr.Authenticate(ctx, "test", "password", ""))
+}
+
+func TestAuthenticateExpiredPassword(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	l := zap.NewNop().Sugar()
+
+	usersSecret := userSecret(`test:
+  enabled: true
+  capabilities:
+  - login
+  passwordMtime: "2025-01-01T00:00:00Z"
+  passwordHash: password`)
+	usersSecret.SetAnnotations(map[string]string{"insecure-password/test": "true"})
+	usersSecret.Data[common.EverestPasswordPolicyFileName] = []byte("maxAgeDays: 30")
+	mockClient := fakeclient.NewClientBuilder().WithScheme(kubernetes.CreateScheme()).WithObjects(usersSecret)
+	k := kubernetes.NewEmpty(l).WithKubernetesClient(mockClient.Build())
+	mgr := &Manager{accountManager: k.Accounts(), l: l}
+
+	// The expired password is verified before it is reported as expired.
+	require.ErrorIs(t, mgr.Authenticate(ctx, "test", "wrong", ""), accounts.ErrIncorrectPassword)
+	require.ErrorIs(t, mgr.Authenticate(ctx, "test", "password", ""), accounts.ErrPasswordExpired)
+}
+
+func TestAuthenticateMFA(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	l := zap.NewNop().Sugar()
+
+	usersSecret := userSecret(`test:
+  enabled: true
+  capabilities:
+  - login
+  passwordHash: password`)
+	usersSecret.SetAnnotations(map[string]string{"insecure-password/test": "true"})
+	mockClient := fakeclient.NewClientBuilder().WithScheme(kubernetes.CreateScheme()).WithObjects(usersSecret)
+	k := kubernetes.NewEmpty(l).WithKubernetesClient(mockClient.Build())
+	mgr := &Manager{
+		accountManager: k.Accounts(),
+		lockoutPolicy:  accounts.LockoutPolicy{Threshold: 3, Window: time.Minute, Duration: time.Hour},
+		l:              l,
+	}
+
+	enrollment, err := mgr.accountManager.EnrollMFA(ctx, "test")
+	require.NoError(t, err)
+
+	require.ErrorIs(t, mgr.Authenticate(ctx, "test", "password", ""), accounts.ErrMFARequired)
+	require.ErrorIs(t, mgr.Authenticate(ctx, "test", "password", "abcd-efgh"), accounts.ErrInvalidMFACode)
+	// The code is not checked when the password is wrong.
+	require.ErrorIs(t, mgr.Authenticate(ctx, "test", "wrong", enrollment.RecoveryCodes[0]), accounts.ErrIncorrectPassword)
+	require.NoError(t, mgr.Authenticate(ctx, "test", "password", enrollment.RecoveryCodes[0]))
+}
+
+func TestChangePassword(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	l := zap.NewNop().Sugar()
+
+	usersSecret := userSecret(`test:
+  enabled: true
+  capabilities:
+  - login
+  passwordHash: password`)
+	usersSecret.SetAnnotations(map[string]string{"insecure-password/test": "true"})
+	// The namespace provides the salt for hashing the new password.
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: common.SystemNamespace, UID: "salt"}}
+	mockClient := fakeclient.NewClientBuilder().WithScheme(kubernetes.CreateScheme()).WithObjects(usersSecret, ns)
+	k := kubernetes.NewEmpty(l).WithKubernetesClient(mockClient.Build())
+	mgr := &Manager{
+		accountManager: k.Accounts(),
+		lockoutPolicy:  accounts.LockoutPolicy{Threshold: 2, Window: time.Minute, Duration: time.Hour},
+		l:              l,
+	}
+
+	require.Error(t, mgr.ChangePassword(ctx, "test", "password", ""))
+	require.NoError(t, mgr.ChangePassword(ctx, "test", "password", "new-password"))
+	require.NoError(t, mgr.Authenticate(ctx, "test", "new-password", ""))
+
+	// A wrong current password counts as a failed login.
+	require.ErrorIs(t, mgr.ChangePassword(ctx, "test", "password", "another-password"), accounts.ErrIncorrectPassword)
+	require.ErrorIs(t, mgr.ChangePassword(ctx, "test", "password", "another-password"), accounts.ErrIncorrectPassword)
+	require.ErrorIs(t, mgr.ChangePassword(ctx, "test", "new-password", "another-password"), accounts.ErrAccountLocked)
+}
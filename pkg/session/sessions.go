@@ -0,0 +1,106 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package session
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/percona/everest/pkg/accounts"
+)
+
+// SessionRegistry keeps track of the login sessions of the built-in accounts and revokes them.
+type SessionRegistry struct {
+	accountManager accounts.Interface
+	blocklist      Blocklist
+}
+
+// NewSessionRegistry returns a new SessionRegistry that revokes the sessions by adding their tokens to the blocklist.
+func NewSessionRegistry(accountManager accounts.Interface, blocklist Blocklist) *SessionRegistry {
+	return &SessionRegistry{
+		accountManager: accountManager,
+		blocklist:      blocklist,
+	}
+}
+
+// List returns the active sessions of the given user.
+func (r *SessionRegistry) List(ctx context.Context, username string) ([]accounts.Session, error) {
+	account, err := r.accountManager.Get(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+	return account.ActiveSessions(time.Now()), nil
+}
+
+// Revoke invalidates the session of the given user by adding its token to the blocklist
+// and removes the session from the account.
+func (r *SessionRegistry) Revoke(ctx context.Context, username, id string) error {
+	account, err := r.accountManager.Get(ctx, username)
+	if err != nil {
+		return err
+	}
+	s := account.Session(id)
+	if s == nil {
+		return accounts.ErrSessionNotFound
+	}
+	// Block the token first, so that a failure leaves the session listed and the revocation can be retried.
+	if err := r.block(ctx, *s); err != nil {
+		return err
+	}
+	_, err = r.accountManager.DeleteSessions(ctx, username, id)
+	return err
+}
+
+// RevokeAll invalidates all the active sessions of the given user and returns their number.
+func (r *SessionRegistry) RevokeAll(ctx context.Context, username string) (int, error) {
+	account, err := r.accountManager.Get(ctx, username)
+	if err != nil {
+		return 0, err
+	}
+	active := account.ActiveSessions(time.Now())
+	for _, s := range active {
+		if err := r.block(ctx, s); err != nil {
+			return 0, err
+		}
+	}
+	// Only the blocked sessions are removed, so that the sessions added meanwhile stay listed and can be revoked.
+	// The expired sessions are removed as well, since their tokens cannot be used anymore.
+	// The sessions may have been removed meanwhile, e.g. when the expired ones are cleaned up on login.
+	ids := make([]string, 0, len(account.Sessions))
+	for _, s := range account.Sessions {
+		ids = append(ids, s.ID)
+	}
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	if _, err := r.accountManager.DeleteSessions(ctx, username, ids...); err != nil && !errors.Is(err, accounts.ErrSessionNotFound) {
+		return 0, err
+	}
+	return len(active), nil
+}
+
+func (r *SessionRegistry) block(ctx context.Context, s accounts.Session) error {
+	if err := r.blocklist.Block(ctx, jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+		"jti": s.ID,
+		"exp": float64(s.ExpiresAt.Unix()),
+	})); err != nil {
+		return errors.Join(err, errors.New("failed to block session token"))
+	}
+	return nil
+}
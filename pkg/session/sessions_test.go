@@ -0,0 +1,159 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package session
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/percona/everest/pkg/accounts"
+)
+
+func TestSessions(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	mgr, err := mockManager(ctx, apiKeyUsersFile, "")
+	require.NoError(t, err)
+	signingKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	require.NoError(t, err)
+	mgr.keys = NewStaticKeyRing(signingKey)
+	mgr.Blocklist = &memoryBlocklist{}
+	mgr.sessions = NewSessionRegistry(mgr.accountManager, mgr.Blocklist)
+	mgr.tokenExpiry = time.Hour
+	mgr.refreshWindow = 2 * time.Hour
+
+	parse := func(token string) *jwt.Token {
+		parsed, err := jwt.Parse(token, mgr.KeyFunc())
+		require.NoError(t, err)
+		return parsed
+	}
+	must := func(token string, err error) string {
+		require.NoError(t, err)
+		return token
+	}
+	isBlocked := func(token *jwt.Token) bool {
+		blocked, err := mgr.IsBlocked(ctx, token)
+		require.NoError(t, err)
+		return blocked
+	}
+
+	// Sessions are registered on login.
+	client := ClientInfo{IP: "10.0.0.1", UserAgent: "curl/8.0"}
+	first := parse(must(mgr.StartSession(ctx, "human", client)))
+	second := parse(must(mgr.StartSession(ctx, "human", client)))
+	sessions, err := mgr.Sessions().List(ctx, "human")
+	require.NoError(t, err)
+	require.Len(t, sessions, 2)
+	assert.Equal(t, client.IP, sessions[0].IP)
+	assert.Equal(t, client.UserAgent, sessions[0].UserAgent)
+	exp, err := first.Claims.GetExpirationTime()
+	require.NoError(t, err)
+	assert.True(t, sessions[0].ExpiresAt.Equal(exp.Time))
+
+	// Refreshing replaces the token of the session and blocks the old one.
+	refreshed := parse(must(mgr.RefreshSession(ctx, first)))
+	assert.True(t, isBlocked(first))
+	assert.False(t, isBlocked(refreshed))
+	_, err = mgr.RefreshSession(ctx, first)
+	require.ErrorIs(t, err, accounts.ErrSessionNotFound)
+	sessions, err = mgr.Sessions().List(ctx, "human")
+	require.NoError(t, err)
+	require.Len(t, sessions, 2)
+	jti, err := extractContent(refreshed)
+	require.NoError(t, err)
+	assert.Equal(t, jti.getStringClaim("jti"), sessions[0].ID)
+
+	// API keys cannot be refreshed.
+	apiKey, _, err := NewAPIKeyIssuer(mgr.accountManager, mgr.keys, mgr.Blocklist).Issue(ctx, "ci", "pipeline", nil)
+	require.NoError(t, err)
+	_, err = mgr.RefreshSession(ctx, parse(apiKey))
+	require.ErrorIs(t, err, ErrRefreshNotAllowed)
+
+	// The tokens never outlive the refresh window, which cannot be extended.
+	s := sessions[0]
+	s.LoginAt = s.LoginAt.Add(-90 * time.Minute)
+	require.NoError(t, mgr.accountManager.ReplaceSession(ctx, "human", s.ID, s))
+	last := parse(must(mgr.RefreshSession(ctx, refreshed)))
+	exp, err = last.Claims.GetExpirationTime()
+	require.NoError(t, err)
+	assert.True(t, s.LoginAt.Add(mgr.refreshWindow).Equal(exp.Time))
+	sessions, err = mgr.Sessions().List(ctx, "human")
+	require.NoError(t, err)
+	s = sessions[0]
+	s.LoginAt = s.LoginAt.Add(-time.Hour)
+	require.NoError(t, mgr.accountManager.ReplaceSession(ctx, "human", s.ID, s))
+	_, err = mgr.RefreshSession(ctx, last)
+	require.ErrorIs(t, err, ErrRefreshWindowExpired)
+
+	// Revoking a session blocks its token.
+	require.NoError(t, mgr.Sessions().Revoke(ctx, "human", s.ID))
+	assert.True(t, isBlocked(last))
+	require.ErrorIs(t, mgr.Sessions().Revoke(ctx, "human", s.ID), accounts.ErrSessionNotFound)
+
+	revoked, err := mgr.Sessions().RevokeAll(ctx, "human")
+	require.NoError(t, err)
+	assert.Equal(t, 1, revoked)
+	assert.True(t, isBlocked(second))
+	sessions, err = mgr.Sessions().List(ctx, "human")
+	require.NoError(t, err)
+	assert.Empty(t, sessions)
+}
+
+func TestRevokeAllKeepsNewSessions(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	mgr, err := mockManager(ctx, apiKeyUsersFile, "")
+	require.NoError(t, err)
+	now := time.Now().UTC().Truncate(time.Second)
+	active := accounts.Session{ID: "active", LoginAt: now, IssuedAt: now, ExpiresAt: now.Add(time.Hour)}
+	expired := accounts.Session{ID: "expired", LoginAt: now.Add(-2 * time.Hour), IssuedAt: now.Add(-2 * time.Hour), ExpiresAt: now.Add(-time.Hour)}
+	added := accounts.Session{ID: "added", LoginAt: now, IssuedAt: now, ExpiresAt: now.Add(time.Hour)}
+	require.NoError(t, mgr.accountManager.AddSession(ctx, "human", active))
+	require.NoError(t, mgr.accountManager.AddSession(ctx, "human", expired))
+
+	// A session is started while the sessions are being revoked.
+	blocklist := &onBlockBlocklist{onBlock: func() {
+		require.NoError(t, mgr.accountManager.AddSession(ctx, "human", added))
+	}}
+	revoked, err := NewSessionRegistry(mgr.accountManager, blocklist).RevokeAll(ctx, "human")
+	require.NoError(t, err)
+	assert.Equal(t, 1, revoked)
+
+	account, err := mgr.accountManager.Get(ctx, "human")
+	require.NoError(t, err)
+	require.Len(t, account.Sessions, 1)
+	assert.Equal(t, added.ID, account.Sessions[0].ID)
+}
+
+// onBlockBlocklist is a memoryBlocklist that calls onBlock before blocking a token.
+type onBlockBlocklist struct {
+	memoryBlocklist
+	onBlock func()
+}
+
+func (b *onBlockBlocklist) Block(ctx context.Context, token *jwt.Token) error {
+	b.onBlock()
+	return b.memoryBlocklist.Block(ctx, token)
+}
@@ -0,0 +1,168 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package telemetry collects the Everest telemetry reports and delivers them.
+package telemetry
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	corev1 "k8s.io/api/core/v1"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+)
+
+const (
+	// ProductFamily is the product family of the Everest telemetry reports.
+	ProductFamily = "PRODUCT_FAMILY_EVEREST"
+	// VersionKey is the key of the metric that holds the Everest version.
+	VersionKey = "version"
+
+	numEngineTypes = 3
+)
+
+// Telemetry is the struct for telemetry reports.
+type Telemetry struct {
+	Reports []Report `json:"reports"`
+}
+
+// Report is a struct for a single telemetry report.
+type Report struct {
+	ID            string    `json:"id"`
+	CreateTime    time.Time `json:"createTime"`
+	InstanceID    string    `json:"instanceId"`
+	ProductFamily string    `json:"productFamily"`
+	Metrics       []Metric  `json:"metrics"`
+}
+
+// Metric represents key-value metrics.
+type Metric struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// KubeClient is the subset of the Kubernetes connector used to collect the metrics.
+type KubeClient interface {
+	GetEverestID(ctx context.Context) (string, error)
+	GetDBNamespaces(ctx context.Context, opts ...ctrlclient.ListOption) (*corev1.NamespaceList, error)
+	ListDatabaseClusters(ctx context.Context, opts ...ctrlclient.ListOption) (*everestv1alpha1.DatabaseClusterList, error)
+}
+
+// Collect collects the telemetry report of the Everest installation running the given version.
+// The result is the exact payload that is sent to the telemetry service.
+func Collect(ctx context.Context, k KubeClient, everestVersion string) (*Telemetry, error) {
+	everestID, err := k.GetEverestID(ctx)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("failed to get Everest settings"))
+	}
+
+	namespaces, err := k.GetDBNamespaces(ctx)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("failed to get watched namespaces"))
+	}
+
+	types := make(map[string]int, numEngineTypes)
+	for _, ns := range namespaces.Items {
+		clusters, err := k.ListDatabaseClusters(ctx, ctrlclient.InNamespace(ns.GetName()))
+		if err != nil {
+			return nil, errors.Join(err, errors.New("failed to list database clusters"))
+		}
+
+		for _, cl := range clusters.Items {
+			types[string(cl.Spec.Engine.Type)]++
+		}
+	}
+
+	metrics := make([]Metric, 0, numEngineTypes+1)
+	// Everest version.
+	metrics = append(metrics, Metric{
+		Key:   VersionKey,
+		Value: everestVersion,
+	})
+	keys := make([]string, 0, len(types))
+	for key := range types {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	for _, key := range keys {
+		// Number of DBs per DB engine.
+		metrics = append(metrics, Metric{Key: key, Value: strconv.Itoa(types[key])})
+	}
+
+	return &Telemetry{
+		Reports: []Report{
+			{
+				ID:            uuid.NewString(),
+				CreateTime:    time.Now(),
+				InstanceID:    everestID,
+				ProductFamily: ProductFamily,
+				Metrics:       metrics,
+			},
+		},
+	}, nil
+}
+
+// Send sends the telemetry reports to the telemetry service at baseURL.
+// It returns the HTTP status code of the response.
+func Send(ctx context.Context, baseURL string, data *Telemetry) (int, error) {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return 0, errors.Join(err, errors.New("failed to marshal the telemetry report"))
+	}
+
+	url := fmt.Sprintf("%s/v1/telemetry/GenericReport", baseURL)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(b))
+	if err != nil {
+		return 0, errors.Join(err, errors.New("failed to create http request"))
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return 0, errors.Join(err, errors.New("failed to send telemetry request"))
+	}
+	defer resp.Body.Close() //nolint:errcheck
+	return resp.StatusCode, nil
+}
+
+// WriteFile appends the telemetry reports to the file at path as a single line of JSON.
+// The file is created if it does not exist.
+func WriteFile(path string, data *Telemetry) error {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return errors.Join(err, errors.New("failed to marshal the telemetry report"))
+	}
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600) //nolint:gosec
+	if err != nil {
+		return errors.Join(err, errors.New("failed to open the telemetry file"))
+	}
+	if _, err := f.Write(append(b, '\n')); err != nil {
+		f.Close() //nolint:errcheck,gosec
+		return errors.Join(err, errors.New("failed to write the telemetry file"))
+	}
+	return f.Close()
+}
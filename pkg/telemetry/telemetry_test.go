@@ -0,0 +1,117 @@
+package telemetry
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/percona/everest/pkg/common"
+	"github.com/percona/everest/pkg/kubernetes"
+)
+
+func TestCollect(t *testing.T) {
+	t.Parallel()
+
+	managed := map[string]string{common.KubernetesManagedByLabel: common.Everest}
+	db := func(namespace, name string, engineType everestv1alpha1.EngineType) ctrlclient.Object {
+		return &everestv1alpha1.DatabaseCluster{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+			Spec:       everestv1alpha1.DatabaseClusterSpec{Engine: everestv1alpha1.Engine{Type: engineType}},
+		}
+	}
+	c := fakeclient.NewClientBuilder().
+		WithScheme(kubernetes.CreateScheme()).
+		WithObjects(
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: common.SystemNamespace, UID: "everest-id"}},
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "ns-1", Labels: managed}},
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "ns-2", Labels: managed}},
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "unmanaged"}},
+			db("ns-1", "a", everestv1alpha1.DatabaseEnginePXC),
+			db("ns-1", "b", everestv1alpha1.DatabaseEnginePostgresql),
+			db("ns-2", "c", everestv1alpha1.DatabaseEnginePXC),
+			db("unmanaged", "d", everestv1alpha1.DatabaseEnginePSMDB),
+		).
+		Build()
+	k := kubernetes.NewEmpty(zap.NewNop().Sugar()).WithKubernetesClient(c)
+
+	got, err := Collect(context.Background(), k, "1.5.0")
+	require.NoError(t, err)
+	require.Len(t, got.Reports, 1)
+
+	report := got.Reports[0]
+	assert.NotEmpty(t, report.ID)
+	assert.False(t, report.CreateTime.IsZero())
+	assert.Equal(t, "everest-id", report.InstanceID)
+	assert.Equal(t, ProductFamily, report.ProductFamily)
+	assert.Equal(t, []Metric{
+		{Key: VersionKey, Value: "1.5.0"},
+		{Key: "postgresql", Value: "1"},
+		{Key: "pxc", Value: "2"},
+	}, report.Metrics)
+}
+
+func testReport() *Telemetry {
+	return &Telemetry{
+		Reports: []Report{{
+			ID:            "id",
+			InstanceID:    "everest-id",
+			ProductFamily: ProductFamily,
+			Metrics:       []Metric{{Key: VersionKey, Value: "1.5.0"}},
+		}},
+	}
+}
+
+func TestSend(t *testing.T) {
+	t.Parallel()
+
+	var body []byte
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/telemetry/GenericReport", r.URL.Path)
+		body, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	status, err := Send(context.Background(), srv.URL, testReport())
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, status)
+
+	got := &Telemetry{}
+	require.NoError(t, json.Unmarshal(body, got))
+	assert.Equal(t, testReport(), got)
+}
+
+func TestWriteFile(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "telemetry.jsonl")
+	require.NoError(t, WriteFile(path, testReport()))
+	require.NoError(t, WriteFile(path, testReport()))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSuffix(string(data), "\n"), "\n")
+	require.Len(t, lines, 2)
+	for _, line := range lines {
+		got := &Telemetry{}
+		require.NoError(t, json.Unmarshal([]byte(line), got))
+		assert.Equal(t, testReport(), got)
+	}
+
+	require.Error(t, WriteFile(filepath.Join(t.TempDir(), "missing", "telemetry.jsonl"), testReport()))
+}
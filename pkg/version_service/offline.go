@@ -0,0 +1,253 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package versionservice
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	perconavs "github.com/Percona-Lab/percona-version-service/versionpb"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// BundleConfigMapKey is the key of the ConfigMap data that holds the metadata bundle.
+	BundleConfigMapKey = "bundle.json"
+
+	configMapBundlePrefix = "configmap://"
+)
+
+var (
+	errBundleNotSigned       = errors.New("metadata bundle is not signed")
+	errNoPublicKey           = errors.New("a public key is required to verify the signature of the metadata bundle")
+	errInvalidBundleSig      = errors.New("metadata bundle signature is invalid")
+	errInvalidConfigMapRef   = errors.New("metadata bundle ConfigMap must be specified as configmap://<namespace>/<name>")
+	errInvalidPublicKey      = errors.New("public key must be a PEM encoded ed25519 public key")
+	errBundleConfigMapNoData = fmt.Errorf("metadata bundle ConfigMap does not contain the key '%s'", BundleConfigMapKey)
+)
+
+// Config selects the implementation of the version service client.
+type Config struct {
+	// URL is the URL of the version service. It is used if Bundle is not set.
+	URL string
+	// Bundle is the path to an offline metadata bundle, or a reference to
+	// a ConfigMap that holds it in the form configmap://<namespace>/<name>.
+	Bundle string
+	// PublicKey verifies the signature of the bundle. It is required if Bundle is set.
+	PublicKey ed25519.PublicKey
+}
+
+// NewConfig returns the config of the version service client.
+// publicKeyPath is the path to the PEM encoded ed25519 public key that verifies the signature of the bundle.
+func NewConfig(url, bundle, publicKeyPath string) (Config, error) {
+	cfg := Config{
+		URL:    url,
+		Bundle: bundle,
+	}
+	if publicKeyPath != "" {
+		data, err := os.ReadFile(publicKeyPath)
+		if err != nil {
+			return Config{}, errors.Join(err, errors.New("could not read version metadata public key"))
+		}
+		if cfg.PublicKey, err = ParsePublicKey(data); err != nil {
+			return Config{}, err
+		}
+	}
+	return cfg, nil
+}
+
+// ConfigMapGetter gets ConfigMaps from the cluster.
+type ConfigMapGetter interface {
+	GetConfigMap(ctx context.Context, key ctrlclient.ObjectKey) (*corev1.ConfigMap, error)
+}
+
+// NewFromConfig returns a version service client that reads the offline metadata bundle
+// if it is configured, otherwise a client of the version service at cfg.URL.
+// configMaps is only used if the bundle is stored in a ConfigMap, in which case
+// the ConfigMap is read on every call so that updates to it apply immediately.
+func NewFromConfig(cfg Config, configMaps ConfigMapGetter) (Interface, error) { //nolint:ireturn
+	switch {
+	case cfg.Bundle == "":
+		return New(cfg.URL), nil
+	case cfg.PublicKey == nil:
+		return nil, errNoPublicKey
+	case strings.HasPrefix(cfg.Bundle, configMapBundlePrefix):
+		namespace, name, ok := strings.Cut(strings.TrimPrefix(cfg.Bundle, configMapBundlePrefix), "/")
+		if !ok || namespace == "" || name == "" || strings.Contains(name, "/") {
+			return nil, errInvalidConfigMapRef
+		}
+		if configMaps == nil {
+			return nil, errors.New("cannot read metadata bundle from a ConfigMap without a Kubernetes client")
+		}
+		return &configMapClient{
+			configMaps: configMaps,
+			key:        types.NamespacedName{Namespace: namespace, Name: name},
+			publicKey:  cfg.PublicKey,
+		}, nil
+	default:
+		data, err := os.ReadFile(cfg.Bundle)
+		if err != nil {
+			return nil, errors.Join(err, errors.New("could not read metadata bundle"))
+		}
+		return NewOffline(data, cfg.PublicKey)
+	}
+}
+
+// Bundle is the offline copy of the version service data.
+type Bundle struct {
+	// Metadata is the response of the Everest metadata endpoint.
+	Metadata json.RawMessage `json:"metadata"`
+	// SupportedEngineVersions maps the operator names, e.g. pxc-operator,
+	// and their versions to the engine versions they support.
+	SupportedEngineVersions map[string]map[string][]string `json:"supportedEngineVersions"`
+}
+
+// SignedBundle is the format of the metadata bundle files.
+type SignedBundle struct {
+	// Bundle is the JSON encoded Bundle.
+	Bundle []byte `json:"bundle"`
+	// Signature is the ed25519 signature of Bundle.
+	Signature []byte `json:"signature,omitempty"`
+}
+
+// SignBundle encodes the bundle and signs it with the given key.
+// The result can be read by NewOffline.
+func SignBundle(bundle *Bundle, key ed25519.PrivateKey) ([]byte, error) {
+	raw, err := json.Marshal(bundle)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(SignedBundle{
+		Bundle:    raw,
+		Signature: ed25519.Sign(key, raw),
+	})
+}
+
+// ParsePublicKey parses a PEM encoded ed25519 public key.
+func ParsePublicKey(data []byte) (ed25519.PublicKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errInvalidPublicKey
+	}
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, errors.Join(err, errInvalidPublicKey)
+	}
+	edKey, ok := key.(ed25519.PublicKey)
+	if !ok {
+		return nil, errInvalidPublicKey
+	}
+	return edKey, nil
+}
+
+type offlineClient struct {
+	metadata *perconavs.MetadataResponse
+	versions map[string]map[string][]string
+}
+
+// NewOffline returns a version service client that serves the data of the given signed bundle.
+// The bundle must be signed with the private key corresponding to publicKey.
+func NewOffline(data []byte, publicKey ed25519.PublicKey) (Interface, error) { //nolint:ireturn
+	if publicKey == nil {
+		return nil, errNoPublicKey
+	}
+	signed := &SignedBundle{}
+	if err := json.Unmarshal(data, signed); err != nil {
+		return nil, errors.Join(err, errors.New("could not decode metadata bundle"))
+	}
+	if len(signed.Signature) == 0 {
+		return nil, errBundleNotSigned
+	}
+	if !ed25519.Verify(publicKey, signed.Bundle, signed.Signature) {
+		return nil, errInvalidBundleSig
+	}
+
+	bundle := &Bundle{}
+	if err := json.Unmarshal(signed.Bundle, bundle); err != nil {
+		return nil, errors.Join(err, errors.New("could not decode metadata bundle"))
+	}
+	metadata := &perconavs.MetadataResponse{}
+	if len(bundle.Metadata) > 0 {
+		if err := json.Unmarshal(bundle.Metadata, metadata); err != nil {
+			return nil, errors.Join(err, errors.New("could not decode Everest metadata from metadata bundle"))
+		}
+	}
+	return &offlineClient{
+		metadata: metadata,
+		versions: bundle.SupportedEngineVersions,
+	}, nil
+}
+
+// GetSupportedEngineVersions returns a list of supported versions for a given operator and version.
+func (c *offlineClient) GetSupportedEngineVersions(_ context.Context, operator, version string) ([]string, error) {
+	versions := slices.Clone(c.versions[operator][strings.TrimPrefix(version, "v")])
+	if len(versions) == 0 {
+		return nil, errors.New("no versions found")
+	}
+	slices.Sort(versions)
+	return versions, nil
+}
+
+// GetEverestMetadata returns the Everest metadata from the bundle.
+func (c *offlineClient) GetEverestMetadata(_ context.Context) (*perconavs.MetadataResponse, error) {
+	return c.metadata, nil
+}
+
+type configMapClient struct {
+	configMaps ConfigMapGetter
+	key        types.NamespacedName
+	publicKey  ed25519.PublicKey
+}
+
+func (c *configMapClient) load(ctx context.Context) (Interface, error) { //nolint:ireturn
+	cm, err := c.configMaps.GetConfigMap(ctx, c.key)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("could not get metadata bundle ConfigMap"))
+	}
+	data, ok := cm.Data[BundleConfigMapKey]
+	if !ok {
+		return nil, errBundleConfigMapNoData
+	}
+	return NewOffline([]byte(data), c.publicKey)
+}
+
+// GetSupportedEngineVersions returns a list of supported versions for a given operator and version.
+func (c *configMapClient) GetSupportedEngineVersions(ctx context.Context, operator, version string) ([]string, error) {
+	vs, err := c.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return vs.GetSupportedEngineVersions(ctx, operator, version)
+}
+
+// GetEverestMetadata returns the Everest metadata from the bundle.
+func (c *configMapClient) GetEverestMetadata(ctx context.Context) (*perconavs.MetadataResponse, error) {
+	vs, err := c.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return vs.GetEverestMetadata(ctx)
+}
@@ -0,0 +1,181 @@
+package versionservice
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func testBundle() *Bundle {
+	return &Bundle{
+		Metadata: json.RawMessage(`{"versions":[{"version":"1.4.0","recommended":{"pxc-operator":"1.15.0"}}]}`),
+		SupportedEngineVersions: map[string]map[string][]string{
+			PXCOperatorName: {
+				"1.15.0": {"8.0.36-28.1", "8.0.35-27.1"},
+			},
+		},
+	}
+}
+
+func TestNewOffline(t *testing.T) {
+	t.Parallel()
+
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	otherPub, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	signed, err := SignBundle(testBundle(), priv)
+	require.NoError(t, err)
+	raw, err := json.Marshal(testBundle())
+	require.NoError(t, err)
+	unsigned, err := json.Marshal(SignedBundle{Bundle: raw})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name      string
+		data      []byte
+		publicKey ed25519.PublicKey
+		wantErr   error
+	}{
+		{name: "signed", data: signed, publicKey: pub},
+		{name: "signed without key", data: signed, wantErr: errNoPublicKey},
+		{name: "unsigned without key", data: unsigned, wantErr: errNoPublicKey},
+		{name: "unsigned with key", data: unsigned, publicKey: pub, wantErr: errBundleNotSigned},
+		{name: "signed with another key", data: signed, publicKey: otherPub, wantErr: errInvalidBundleSig},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			vs, err := NewOffline(tc.data, tc.publicKey)
+			if tc.wantErr != nil {
+				require.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			meta, err := vs.GetEverestMetadata(context.Background())
+			require.NoError(t, err)
+			require.Len(t, meta.GetVersions(), 1)
+			assert.Equal(t, "1.4.0", meta.GetVersions()[0].GetVersion())
+
+			versions, err := vs.GetSupportedEngineVersions(context.Background(), PXCOperatorName, "v1.15.0")
+			require.NoError(t, err)
+			assert.Equal(t, []string{"8.0.35-27.1", "8.0.36-28.1"}, versions)
+
+			_, err = vs.GetSupportedEngineVersions(context.Background(), PXCOperatorName, "1.16.0")
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestParsePublicKey(t *testing.T) {
+	t.Parallel()
+
+	pub, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(pub)
+	require.NoError(t, err)
+
+	key, err := ParsePublicKey(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+	require.NoError(t, err)
+	assert.Equal(t, pub, key)
+
+	_, err = ParsePublicKey([]byte("not a key"))
+	require.ErrorIs(t, err, errInvalidPublicKey)
+}
+
+type fakeConfigMapGetter map[ctrlclient.ObjectKey]*corev1.ConfigMap
+
+func (f fakeConfigMapGetter) GetConfigMap(_ context.Context, key ctrlclient.ObjectKey) (*corev1.ConfigMap, error) {
+	cm, ok := f[key]
+	if !ok {
+		return nil, k8serrors.NewNotFound(schema.GroupResource{Resource: "configmaps"}, key.Name)
+	}
+	return cm, nil
+}
+
+func TestNewFromConfig(t *testing.T) {
+	t.Parallel()
+
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	signed, err := SignBundle(testBundle(), priv)
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "bundle.json")
+	require.NoError(t, os.WriteFile(path, signed, 0o600))
+
+	configMaps := fakeConfigMapGetter{
+		{Namespace: "everest-system", Name: "bundle"}: {Data: map[string]string{BundleConfigMapKey: string(signed)}},
+		{Namespace: "everest-system", Name: "empty"}:  {},
+	}
+
+	t.Run("online", func(t *testing.T) {
+		t.Parallel()
+		vs, err := NewFromConfig(Config{URL: "https://check.percona.com"}, nil)
+		require.NoError(t, err)
+		assert.IsType(t, &versionServiceClient{}, vs)
+	})
+
+	t.Run("file", func(t *testing.T) {
+		t.Parallel()
+		vs, err := NewFromConfig(Config{Bundle: path, PublicKey: pub}, nil)
+		require.NoError(t, err)
+		_, err = vs.GetSupportedEngineVersions(context.Background(), PXCOperatorName, "1.15.0")
+		require.NoError(t, err)
+
+		_, err = NewFromConfig(Config{Bundle: filepath.Join(t.TempDir(), "missing.json"), PublicKey: pub}, nil)
+		require.Error(t, err)
+
+		// The bundle is not accepted if its signature cannot be verified.
+		_, err = NewFromConfig(Config{Bundle: path}, nil)
+		require.ErrorIs(t, err, errNoPublicKey)
+	})
+
+	t.Run("configmap", func(t *testing.T) {
+		t.Parallel()
+		vs, err := NewFromConfig(Config{Bundle: "configmap://everest-system/bundle", PublicKey: pub}, configMaps)
+		require.NoError(t, err)
+		meta, err := vs.GetEverestMetadata(context.Background())
+		require.NoError(t, err)
+		assert.Len(t, meta.GetVersions(), 1)
+
+		vs, err = NewFromConfig(Config{Bundle: "configmap://everest-system/empty", PublicKey: pub}, configMaps)
+		require.NoError(t, err)
+		_, err = vs.GetEverestMetadata(context.Background())
+		require.ErrorIs(t, err, errBundleConfigMapNoData)
+
+		vs, err = NewFromConfig(Config{Bundle: "configmap://everest-system/missing", PublicKey: pub}, configMaps)
+		require.NoError(t, err)
+		_, err = vs.GetEverestMetadata(context.Background())
+		require.Error(t, err)
+	})
+
+	t.Run("configmap without key", func(t *testing.T) {
+		t.Parallel()
+		_, err := NewFromConfig(Config{Bundle: "configmap://everest-system/bundle"}, configMaps)
+		require.ErrorIs(t, err, errNoPublicKey)
+	})
+
+	t.Run("invalid configmap reference", func(t *testing.T) {
+		t.Parallel()
+		for _, ref := range []string{"configmap://bundle", "configmap:///bundle", "configmap://ns/a/b"} {
+			_, err := NewFromConfig(Config{Bundle: ref, PublicKey: pub}, configMaps)
+			require.ErrorIs(t, err, errInvalidConfigMapRef, ref)
+		}
+	})
+}
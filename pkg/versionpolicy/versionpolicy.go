@@ -0,0 +1,202 @@
+// everest
+// Copyright (C) 2025 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package versionpolicy provides the policy that restricts the engine versions allowed in DB namespaces.
+package versionpolicy
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+)
+
+const (
+	// ConfigMapName is the name of the ConfigMap that holds the engine version policy of a DB namespace.
+	ConfigMapName = "everest-engine-version-policy"
+
+	policyKey = "policy"
+
+	// patternSeparator separates the engine type from the version in the string form of a Pattern.
+	patternSeparator = ":"
+)
+
+var (
+	errEmptyPattern   = errors.New("version pattern cannot be empty")
+	errInvalidPattern = func(pattern string, err error) error {
+		return fmt.Errorf("invalid version pattern '%s': %w", pattern, err)
+	}
+	errUnknownEngineType = func(engineType everestv1alpha1.EngineType) error {
+		return fmt.Errorf("unknown engine type '%s' in version pattern", engineType)
+	}
+	errNotRecommended = func(engineType everestv1alpha1.EngineType, version string) error {
+		return fmt.Errorf("%s version %s is not allowed by the namespace policy: only recommended versions are allowed", engineType, version)
+	}
+	errNotAllowed = func(engineType everestv1alpha1.EngineType, version string) error {
+		return fmt.Errorf("%s version %s is not allowed by the namespace policy: it does not match any allowed version", engineType, version)
+	}
+	errDenied = func(engineType everestv1alpha1.EngineType, version string, p Pattern) error {
+		return fmt.Errorf("%s version %s is not allowed by the namespace policy: it is denied by '%s'", engineType, version, p)
+	}
+)
+
+// Pattern matches engine versions.
+type Pattern struct {
+	// EngineType is the type of the engine the pattern applies to.
+	// The pattern applies to all engines if it is empty.
+	EngineType everestv1alpha1.EngineType `yaml:"engineType,omitempty"`
+	// Version is a shell pattern, as supported by path.Match, e.g. "8.0.*".
+	Version string `yaml:"version"`
+}
+
+// ParsePattern parses a pattern of the form [<engineType>:]<version>, e.g. "pxc:8.0.3*".
+func ParsePattern(s string) (Pattern, error) {
+	p := Pattern{Version: s}
+	if engineType, version, ok := strings.Cut(s, patternSeparator); ok {
+		p = Pattern{EngineType: everestv1alpha1.EngineType(engineType), Version: version}
+	}
+	if err := p.Validate(); err != nil {
+		return Pattern{}, err
+	}
+	return p, nil
+}
+
+// ParsePatterns parses a list of patterns using ParsePattern.
+func ParsePatterns(list []string) ([]Pattern, error) {
+	result := make([]Pattern, 0, len(list))
+	for _, s := range list {
+		p, err := ParsePattern(s)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, p)
+	}
+	return result, nil
+}
+
+// String returns the pattern in the form accepted by ParsePattern.
+func (p Pattern) String() string {
+	if p.EngineType == "" {
+		return p.Version
+	}
+	return string(p.EngineType) + patternSeparator + p.Version
+}
+
+// Validate checks that the pattern is well-formed.
+func (p Pattern) Validate() error {
+	if p.Version == "" {
+		return errEmptyPattern
+	}
+	switch p.EngineType {
+	case "", everestv1alpha1.DatabaseEnginePXC, everestv1alpha1.DatabaseEnginePSMDB, everestv1alpha1.DatabaseEnginePostgresql:
+	default:
+		return errUnknownEngineType(p.EngineType)
+	}
+	if _, err := path.Match(p.Version, ""); err != nil {
+		return errInvalidPattern(p.String(), err)
+	}
+	return nil
+}
+
+// Matches returns true if the pattern matches the given version of the given engine.
+func (p Pattern) Matches(engineType everestv1alpha1.EngineType, version string) bool {
+	if p.EngineType != "" && p.EngineType != engineType {
+		return false
+	}
+	// The version may be prefixed with "v" in some places, the pattern is matched against the bare version.
+	ok, _ := path.Match(p.Version, strings.TrimPrefix(version, "v"))
+	return ok
+}
+
+// Policy restricts the engine versions that may be used by the database clusters of a DB namespace.
+// The zero value allows all versions.
+type Policy struct {
+	// RecommendedOnly allows only the versions that have the recommended status.
+	RecommendedOnly bool `yaml:"recommendedOnly"`
+	// Allowed lists the allowed versions. If there are no patterns for an engine, all its versions are allowed.
+	Allowed []Pattern `yaml:"allowed"`
+	// Denied lists the denied versions. It takes precedence over Allowed.
+	Denied []Pattern `yaml:"denied"`
+}
+
+// Validate checks that all the patterns of the policy are well-formed.
+func (p *Policy) Validate() error {
+	for _, list := range [][]Pattern{p.Allowed, p.Denied} {
+		for _, pattern := range list {
+			if err := pattern.Validate(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Check returns an error if the given version of the engine is not allowed by the policy.
+// versions are the available versions of the engine used to look up the recommended ones.
+// A nil policy allows all versions.
+func (p *Policy) Check(engineType everestv1alpha1.EngineType, version string, versions everestv1alpha1.ComponentsMap) error {
+	if p == nil || version == "" {
+		return nil
+	}
+	if p.RecommendedOnly {
+		c, ok := versions[version]
+		if !ok || c == nil || c.Status != everestv1alpha1.DBEngineComponentRecommended {
+			return errNotRecommended(engineType, version)
+		}
+	}
+	for _, pattern := range p.Denied {
+		if pattern.Matches(engineType, version) {
+			return errDenied(engineType, version, pattern)
+		}
+	}
+	var restricted bool
+	for _, pattern := range p.Allowed {
+		if pattern.EngineType != "" && pattern.EngineType != engineType {
+			continue
+		}
+		restricted = true
+		if pattern.Matches(engineType, version) {
+			return nil
+		}
+	}
+	if restricted {
+		return errNotAllowed(engineType, version)
+	}
+	return nil
+}
+
+// FromConfigMapData parses the engine version policy from the data of a ConfigMap.
+func FromConfigMapData(data map[string]string) (*Policy, error) {
+	p := &Policy{}
+	if raw, ok := data[policyKey]; ok {
+		if err := yaml.Unmarshal([]byte(raw), p); err != nil {
+			return nil, errors.Join(err, errors.New("failed to parse engine version policy"))
+		}
+	}
+	return p, nil
+}
+
+// ToConfigMapData converts the engine version policy to the data of a ConfigMap.
+func (p *Policy) ToConfigMapData() (map[string]string, error) {
+	raw, err := yaml.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]string{policyKey: string(raw)}, nil
+}
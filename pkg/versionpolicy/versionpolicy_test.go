@@ -0,0 +1,135 @@
+package versionpolicy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+)
+
+func TestParsePattern(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		name    string
+		in      string
+		want    Pattern
+		wantErr bool
+	}{
+		{name: "all engines", in: "8.0.*", want: Pattern{Version: "8.0.*"}},
+		{name: "single engine", in: "pxc:8.0.3*", want: Pattern{EngineType: everestv1alpha1.DatabaseEnginePXC, Version: "8.0.3*"}},
+		{name: "empty", in: "", wantErr: true},
+		{name: "empty version", in: "psmdb:", wantErr: true},
+		{name: "unknown engine", in: "mysql:8.0.*", wantErr: true},
+		{name: "bad pattern", in: "8.0.[", wantErr: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := ParsePattern(tc.in)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+			assert.Equal(t, tc.in, got.String())
+		})
+	}
+}
+
+func TestPolicyCheck(t *testing.T) {
+	t.Parallel()
+	versions := everestv1alpha1.ComponentsMap{
+		"8.0.35": &everestv1alpha1.Component{Status: everestv1alpha1.DBEngineComponentAvailable},
+		"8.0.36": &everestv1alpha1.Component{Status: everestv1alpha1.DBEngineComponentRecommended},
+		"8.4.1":  &everestv1alpha1.Component{Status: everestv1alpha1.DBEngineComponentAvailable},
+	}
+	pxc := everestv1alpha1.DatabaseEnginePXC
+
+	testCases := []struct {
+		name    string
+		policy  *Policy
+		version string
+		wantErr bool
+	}{
+		{name: "nil policy", policy: nil, version: "8.0.35"},
+		{name: "empty policy", policy: &Policy{}, version: "8.0.35"},
+		{name: "recommended only, recommended", policy: &Policy{RecommendedOnly: true}, version: "8.0.36"},
+		{name: "recommended only, available", policy: &Policy{RecommendedOnly: true}, version: "8.0.35", wantErr: true},
+		{name: "recommended only, unknown", policy: &Policy{RecommendedOnly: true}, version: "8.0.99", wantErr: true},
+		{
+			name:    "denied",
+			policy:  &Policy{Denied: []Pattern{{EngineType: pxc, Version: "8.0.35"}}},
+			version: "8.0.35",
+			wantErr: true,
+		},
+		{
+			name:    "denied for another engine",
+			policy:  &Policy{Denied: []Pattern{{EngineType: everestv1alpha1.DatabaseEnginePSMDB, Version: "8.0.*"}}},
+			version: "8.0.35",
+		},
+		{
+			name:    "allowed",
+			policy:  &Policy{Allowed: []Pattern{{Version: "8.0.*"}}},
+			version: "8.0.35",
+		},
+		{
+			name:    "not allowed",
+			policy:  &Policy{Allowed: []Pattern{{Version: "8.0.*"}}},
+			version: "8.4.1",
+			wantErr: true,
+		},
+		{
+			name:    "allowed for another engine only",
+			policy:  &Policy{Allowed: []Pattern{{EngineType: everestv1alpha1.DatabaseEnginePostgresql, Version: "16.*"}}},
+			version: "8.4.1",
+		},
+		{
+			name: "denied takes precedence",
+			policy: &Policy{
+				Allowed: []Pattern{{Version: "8.0.*"}},
+				Denied:  []Pattern{{Version: "8.0.35"}},
+			},
+			version: "8.0.35",
+			wantErr: true,
+		},
+		{
+			name:    "v prefix",
+			policy:  &Policy{Denied: []Pattern{{Version: "8.0.35"}}},
+			version: "v8.0.35",
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := tc.policy.Check(pxc, tc.version, versions)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestConfigMapData(t *testing.T) {
+	t.Parallel()
+	p := &Policy{
+		RecommendedOnly: true,
+		Allowed:         []Pattern{{Version: "8.0.*"}},
+		Denied:          []Pattern{{EngineType: everestv1alpha1.DatabaseEnginePSMDB, Version: "7.0.1"}},
+	}
+	data, err := p.ToConfigMapData()
+	require.NoError(t, err)
+
+	got, err := FromConfigMapData(data)
+	require.NoError(t, err)
+	assert.Equal(t, p, got)
+
+	got, err = FromConfigMapData(nil)
+	require.NoError(t, err)
+	assert.Equal(t, &Policy{}, got)
+}
@@ -14,6 +14,7 @@ import { DrawerContextProvider } from 'contexts/drawer/drawer.context';
 import router from 'router';
 import { useEffect, useState } from 'react';
 import { EverestConfig } from 'shared-types/configs.types';
+import { DEFAULT_SSO_PROVIDER_NAME } from 'consts';
 import { getEverestConfigs } from 'api/everestConfigs';
 import LoadingPageSkeleton from 'components/loading-page-skeleton/LoadingPageSkeleton';
 import UpgradeEverestProvider from 'contexts/upgrade-everest/upgrade-everest.provider';
@@ -34,15 +35,19 @@ const App = () => {
   useEffect(() => {
     const loadConfigs = async () => {
       try {
-        const { oidcConfig = { clientId: '', issuerURL: '', scopes: [] } } =
-          await getEverestConfigs();
+        const { oidcConfig, oidcProviders } = await getEverestConfigs();
+        // Older servers return the single provider only.
+        const providers =
+          oidcProviders ?? (oidcConfig?.issuerURL ? [oidcConfig] : []);
         setConfigs({
-          oidc: {
-            authority: oidcConfig.issuerURL,
-            clientId: oidcConfig.clientId,
-            scope: oidcConfig.scopes.join(' '),
-            redirectUri: `${window.location.protocol}//${window.location.host}/`,
-          },
+          ssoProviders: providers
+            .filter((provider) => provider.issuerURL && provider.clientId)
+            .map((provider) => ({
+              name: provider.name || DEFAULT_SSO_PROVIDER_NAME,
+              authority: provider.issuerURL,
+              clientId: provider.clientId,
+              scope: provider.scopes.join(' '),
+            })),
         });
       } catch (error) {
         setConfigs(null);
@@ -87,8 +92,8 @@ const App = () => {
           >
             <QueryClientProvider client={queryClient}>
               <AuthProvider
+                ssoProviders={configs?.ssoProviders}
                 oidcConfig={{
-                  ...configs?.oidc,
                   redirectUri: `${window.location.protocol}//${window.location.host}/login-callback`,
                   responseType: 'code',
                   autoSignIn: false,
@@ -47,6 +47,8 @@ export const MAX_DB_CLUSTER_NAME_LENGTH = 22;
 // export const MAX_RFC_1123_NAME_LENGTH = 63;
 export const MAX_SCHEDULE_NAME_LENGTH = 57;
 export const EVEREST_JWT_ISSUER = 'everest';
+// Name of the OIDC provider configured in the "oidc.config" Everest setting
+export const DEFAULT_SSO_PROVIDER_NAME = 'default';
 export const PG_SLOTS_LIMIT = 3;
 export const EVEREST_READ_ONLY_FINALIZER =
   'everest.percona.com/readonly-protection';
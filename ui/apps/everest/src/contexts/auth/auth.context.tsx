@@ -8,6 +8,7 @@ const AuthContext = createContext<AuthContextProps>({
   authStatus: 'unknown',
   redirectRoute: null,
   isSsoEnabled: false,
+  ssoProviders: [],
 });
 
 export default AuthContext;
@@ -8,15 +8,23 @@ export type UserAuthStatus =
 export type AuthMode = 'manual' | 'sso';
 export type ManualAuthArgs = { username: string; password: string };
 export interface AuthContextProps {
-  login: (mode: AuthMode, manualAuthArgs?: ManualAuthArgs) => void;
+  login: (
+    mode: AuthMode,
+    manualAuthArgs?: ManualAuthArgs,
+    ssoProvider?: string
+  ) => void;
   logout: () => void;
   setRedirectRoute: (route: string) => void;
   authStatus: UserAuthStatus;
   redirectRoute: string | null;
   isSsoEnabled: boolean;
+  ssoProviders: string[];
 }
 
 export interface AuthProviderProps {
   children: React.ReactNode;
   isSsoEnabled: boolean;
+  ssoProviders: string[];
+  ssoProvider?: string;
+  selectSsoProvider: (name: string) => void;
 }
@@ -23,32 +23,80 @@ import {
   UserAuthStatus,
 } from './auth.context.types';
 import { isAfter } from 'date-fns';
+import { SsoProviderConfig } from 'shared-types/configs.types';
 import {
   initializeAuthorizerFetchLoop,
   stopAuthorizerFetchLoop,
 } from 'utils/rbac';
 
+// The SSO provider chosen by the user,
+// which is also used when the provider redirects back after the login.
+const SSO_PROVIDER_KEY = 'everestSsoProvider';
+// The SSO provider to sign in with once its user manager is created.
+const SSO_SIGN_IN_KEY = 'everestSsoSignIn';
+
 const Provider = ({
   oidcConfig,
+  ssoProviders = [],
   children,
 }: {
   oidcConfig?: OidcAuthProviderProps;
+  ssoProviders?: SsoProviderConfig[];
   children: React.ReactNode;
 }) => {
+  const [ssoProvider, setSsoProvider] = useState<
+    SsoProviderConfig | undefined
+  >(() => {
+    const saved = localStorage.getItem(SSO_PROVIDER_KEY);
+    return (
+      ssoProviders.find((provider) => provider.name === saved) ??
+      ssoProviders[0]
+    );
+  });
+
+  const selectSsoProvider = useCallback(
+    (name: string) => {
+      const provider = ssoProviders.find((p) => p.name === name);
+      if (provider) {
+        localStorage.setItem(SSO_PROVIDER_KEY, name);
+        setSsoProvider(provider);
+      }
+    },
+    [ssoProviders]
+  );
+
   const authProvider = useMemo(
     () => (
       <AuthProvider
-        isSsoEnabled={!!oidcConfig?.authority && !!oidcConfig?.clientId}
+        isSsoEnabled={!!ssoProvider}
+        ssoProviders={ssoProviders.map((provider) => provider.name)}
+        ssoProvider={ssoProvider?.name}
+        selectSsoProvider={selectSsoProvider}
       >
         {children}
       </AuthProvider>
     ),
-    [children, oidcConfig]
+    [children, ssoProvider, ssoProviders, selectSsoProvider]
+  );
+
+  const { name, ...ssoConfig }: Partial<SsoProviderConfig> =
+    ssoProvider ?? {};
+  // The user manager is created once per provider,
+  // so a new one is mounted when another provider is chosen.
+  return (
+    <OidcAuthProvider key={name} {...ssoConfig} {...oidcConfig}>
+      {authProvider}
+    </OidcAuthProvider>
   );
-  return <OidcAuthProvider {...oidcConfig}>{authProvider}</OidcAuthProvider>;
 };
 
-const AuthProvider = ({ children, isSsoEnabled }: AuthProviderProps) => {
+const AuthProvider = ({
+  children,
+  isSsoEnabled,
+  ssoProviders,
+  ssoProvider,
+  selectSsoProvider,
+}: AuthProviderProps) => {
   const [authStatus, setAuthStatus] = useState<UserAuthStatus>('unknown');
   const [redirect, setRedirect] = useState<string | null>(null);
 
@@ -64,9 +112,18 @@ const AuthProvider = ({ children, isSsoEnabled }: AuthProviderProps) => {
     }
   }, []);
 
-  const login = async (mode: AuthMode, manualAuthArgs?: ManualAuthArgs) => {
+  const login = async (
+    mode: AuthMode,
+    manualAuthArgs?: ManualAuthArgs,
+    ssoProviderName?: string
+  ) => {
     setAuthStatus('loggingIn');
     if (mode === 'sso') {
+      if (ssoProviderName && ssoProviderName !== ssoProvider) {
+        sessionStorage.setItem(SSO_SIGN_IN_KEY, ssoProviderName);
+        selectSsoProvider(ssoProviderName);
+        return;
+      }
       await signIn();
     } else {
       const { username, password } = manualAuthArgs!;
@@ -159,6 +216,17 @@ const AuthProvider = ({ children, isSsoEnabled }: AuthProviderProps) => {
     }
   }, [isSsoEnabled, silentlyRenewToken, userManager]);
 
+  useEffect(() => {
+    if (
+      ssoProvider &&
+      sessionStorage.getItem(SSO_SIGN_IN_KEY) === ssoProvider
+    ) {
+      sessionStorage.removeItem(SSO_SIGN_IN_KEY);
+      setAuthStatus('loggingIn');
+      signIn();
+    }
+  }, [ssoProvider, signIn]);
+
   useEffect(() => {
     if (window.location !== window.parent.location) {
       // This is running in the iframe, so we are renewing the token silently
@@ -221,6 +289,7 @@ const AuthProvider = ({ children, isSsoEnabled }: AuthProviderProps) => {
         redirectRoute: redirect,
         setRedirectRoute,
         isSsoEnabled,
+        ssoProviders,
       }}
     >
       {children}
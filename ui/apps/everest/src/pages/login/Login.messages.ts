@@ -8,4 +8,6 @@ export const Messages = {
   ok: 'OK',
   username: 'Username',
   password: 'Password',
+  ssoLogin: 'Log in with SSO',
+  ssoLoginWith: (provider: string) => `Log in with ${provider}`,
 };
@@ -9,6 +9,7 @@ import { Navigate } from 'react-router-dom';
 import { LoginFormType, loginSchema } from './Login.constants';
 import { Messages } from './Login.messages';
 import { HiddenInput } from 'components/hidden-input';
+import { DEFAULT_SSO_PROVIDER_NAME } from 'consts';
 
 const LoginLinkButton = ({
   icon,
@@ -32,7 +33,7 @@ const Login = () => {
     defaultValues: { username: '', password: '' },
     resolver: zodResolver(loginSchema),
   });
-  const { login, authStatus, redirectRoute, isSsoEnabled } =
+  const { login, authStatus, redirectRoute, isSsoEnabled, ssoProviders } =
     useContext(AuthContext);
 
   const handleLogin: SubmitHandler<LoginFormType> = ({
@@ -42,8 +43,8 @@ const Login = () => {
     login('manual', { username, password });
   };
 
-  const handleSsoLogin = () => {
-    login('sso');
+  const handleSsoLogin = (ssoProvider?: string) => {
+    login('sso', undefined, ssoProvider);
   };
 
   if (authStatus === 'unknown') {
@@ -163,15 +164,33 @@ const Login = () => {
                     <Divider flexItem sx={{ mb: 1, fontSize: '12px' }}>
                       OR
                     </Divider>
-                    <Button
-                      variant="outlined"
-                      fullWidth
-                      disabled={authStatus === 'loggingIn'}
-                      sx={{ fontSize: '13px' }}
-                      onClick={handleSsoLogin}
-                    >
-                      Log in with SSO
-                    </Button>
+                    {ssoProviders.length > 1 ? (
+                      ssoProviders.map((ssoProvider) => (
+                        <Button
+                          key={ssoProvider}
+                          variant="outlined"
+                          fullWidth
+                          disabled={authStatus === 'loggingIn'}
+                          sx={{ fontSize: '13px', mb: 1 }}
+                          onClick={() => handleSsoLogin(ssoProvider)}
+                          data-testid={`sso-login-button-${ssoProvider}`}
+                        >
+                          {ssoProvider === DEFAULT_SSO_PROVIDER_NAME
+                            ? Messages.ssoLogin
+                            : Messages.ssoLoginWith(ssoProvider)}
+                        </Button>
+                      ))
+                    ) : (
+                      <Button
+                        variant="outlined"
+                        fullWidth
+                        disabled={authStatus === 'loggingIn'}
+                        sx={{ fontSize: '13px' }}
+                        onClick={() => handleSsoLogin()}
+                      >
+                        {Messages.ssoLogin}
+                      </Button>
+                    )}
                   </>
                 )}
               </FormProvider>
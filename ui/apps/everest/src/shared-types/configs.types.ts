@@ -1,16 +1,22 @@
+export type OidcConfigPayload = {
+  name?: string;
+  issuerURL: string;
+  clientId: string;
+  scopes: string[];
+};
+
 export type EverestConfigPayload = {
-  oidcConfig?: {
-    issuerURL: string;
-    clientId: string;
-    scopes: string[];
-  };
+  oidcConfig?: OidcConfigPayload;
+  oidcProviders?: OidcConfigPayload[];
+};
+
+export type SsoProviderConfig = {
+  name: string;
+  authority: string;
+  clientId: string;
+  scope: string;
 };
 
 export type EverestConfig = {
-  oidc?: {
-    authority: string;
-    clientId: string;
-    scope: string;
-    redirectUri?: string;
-  };
+  ssoProviders: SsoProviderConfig[];
 };